@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// Bot - bot account owned by a user, TokenHash is empty while the bot has no active token
+type Bot struct {
+	Id          UserID
+	OwnerId     UserID
+	Name        string
+	Description string
+	Public      bool
+	TokenHash   string
+	CreatedAt   time.Time
+}
+
+// HasToken - the bot can authenticate with a token
+func (b *Bot) HasToken() bool {
+	return b.TokenHash != ""
+}
+
+// BotTokenResult - bot with a newly issued token, the token is shown only once
+type BotTokenResult struct {
+	Bot   *Bot
+	Token string
+}
@@ -16,6 +16,7 @@ type User struct {
 	Email          string
 	Password       string
 	AvatarPhotoUrl string
+	IsBot          bool
 }
 
 type Login struct {
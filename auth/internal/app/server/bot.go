@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/auth/internal/app/models"
+	"github.com/Nixonxp/discord/auth/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/auth/pkg/api/v1"
+	"github.com/Nixonxp/discord/auth/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/auth/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AuthServer) CreateBot(ctx context.Context, req *pb.CreateBotRequest) (*pb.CreateBotResponse, error) {
+	s.Log.WithContext(ctx).WithField("name", req.GetName()).Info("create bot: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.AuthUsecase.CreateBot(ctx, usecases.CreateBotRequest{
+		OwnerId:     userId,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Public:      req.GetPublic(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateBotResponse{
+		Bot:   botToPb(result.Bot),
+		Token: result.Token,
+	}, nil
+}
+
+func (s *AuthServer) ListBots(ctx context.Context, req *pb.ListBotsRequest) (*pb.ListBotsResponse, error) {
+	s.Log.WithContext(ctx).Info("list bots: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.AuthUsecase.ListBots(ctx, usecases.ListBotsRequest{
+		OwnerId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bots := make([]*pb.Bot, len(result))
+	for i, bot := range result {
+		bots[i] = botToPb(bot)
+	}
+
+	return &pb.ListBotsResponse{
+		Bots: bots,
+	}, nil
+}
+
+func (s *AuthServer) ResetBotToken(ctx context.Context, req *pb.ResetBotTokenRequest) (*pb.ResetBotTokenResponse, error) {
+	s.Log.WithContext(ctx).WithField("botId", req.GetBotId()).Info("reset bot token: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.AuthUsecase.ResetBotToken(ctx, usecases.ResetBotTokenRequest{
+		BotId:         req.GetBotId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetBotTokenResponse{
+		Token: result.Token,
+	}, nil
+}
+
+func (s *AuthServer) RevokeBotToken(ctx context.Context, req *pb.RevokeBotTokenRequest) (*pb.RevokeBotTokenResponse, error) {
+	s.Log.WithContext(ctx).WithField("botId", req.GetBotId()).Info("revoke bot token: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	_, err = s.AuthUsecase.RevokeBotToken(ctx, usecases.RevokeBotTokenRequest{
+		BotId:         req.GetBotId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeBotTokenResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *AuthServer) VerifyBotToken(ctx context.Context, req *pb.VerifyBotTokenRequest) (*pb.VerifyBotTokenResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	bot, err := s.AuthUsecase.VerifyBotToken(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyBotTokenResponse{
+		BotId:   bot.Id.String(),
+		OwnerId: bot.OwnerId.String(),
+	}, nil
+}
+
+func botToPb(bot *models.Bot) *pb.Bot {
+	return &pb.Bot{
+		Id:          bot.Id.String(),
+		OwnerId:     bot.OwnerId.String(),
+		Name:        bot.Name,
+		Description: bot.Description,
+		Public:      bot.Public,
+		HasToken:    bot.HasToken(),
+		CreatedAt:   timestamppb.New(bot.CreatedAt),
+	}
+}
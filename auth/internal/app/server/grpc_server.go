@@ -66,6 +66,11 @@ func NewAuthServer(_ context.Context, s *MainServer) (*AuthServer, error) {
 				&pb.OauthLoginRequest{},
 				&pb.OauthLoginCallbackRequest{},
 				&pb.RefreshRequest{},
+				&pb.CreateBotRequest{},
+				&pb.ListBotsRequest{},
+				&pb.ResetBotTokenRequest{},
+				&pb.RevokeBotTokenRequest{},
+				&pb.VerifyBotTokenRequest{},
 			),
 		)
 		if err != nil {
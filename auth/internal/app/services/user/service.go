@@ -7,6 +7,8 @@ import (
 	"github.com/Nixonxp/discord/auth/pkg/api/user"
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *UserClient) Register(ctx context.Context, registerInfo usecases.RegisterUserInfo) (*models.User, error) {
@@ -46,6 +48,7 @@ func (s *UserClient) GetUserForLogin(ctx context.Context, loginInfo usecases.Log
 		Name:     response.Name,
 		Email:    response.Email,
 		Password: response.Password,
+		IsBot:    response.IsBot,
 	}, nil
 }
 
@@ -72,3 +75,76 @@ func (s *UserClient) CreateOrCreateUser(ctx context.Context, userInfo usecases.G
 		AvatarPhotoUrl: response.AvatarPhotoUrl,
 	}, nil
 }
+
+func (s *UserClient) CreateBot(ctx context.Context, req usecases.CreateBotRequest) (*models.Bot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user_service.CreateBot")
+	defer span.Finish()
+	response, err := s.client.CreateBot(ctx, &user.CreateBotRequest{
+		OwnerId:     req.OwnerId,
+		Name:        req.Name,
+		Description: req.Description,
+		Public:      req.Public,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return botFromPb(response), nil
+}
+
+func (s *UserClient) GetBot(ctx context.Context, botId string) (*models.Bot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user_service.GetBot")
+	defer span.Finish()
+	response, err := s.client.GetBot(ctx, &user.GetBotRequest{
+		BotId: botId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return botFromPb(response), nil
+}
+
+func (s *UserClient) GetBotsByOwner(ctx context.Context, ownerId string) ([]*models.Bot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user_service.GetBotsByOwner")
+	defer span.Finish()
+	response, err := s.client.GetBotsByOwner(ctx, &user.GetBotsByOwnerRequest{
+		OwnerId: ownerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bots := make([]*models.Bot, len(response.GetBots()))
+	for i, bot := range response.GetBots() {
+		bots[i] = botFromPb(bot)
+	}
+
+	return bots, nil
+}
+
+func (s *UserClient) SetBotTokenHash(ctx context.Context, botId string, tokenHash string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user_service.SetBotTokenHash")
+	defer span.Finish()
+	_, err := s.client.SetBotTokenHash(ctx, &user.SetBotTokenHashRequest{
+		BotId:     botId,
+		TokenHash: tokenHash,
+	})
+
+	return err
+}
+
+func botFromPb(bot *user.Bot) *models.Bot {
+	return &models.Bot{
+		Id:          models.UserID(uuid.MustParse(bot.GetId())),
+		OwnerId:     models.UserID(uuid.MustParse(bot.GetOwnerId())),
+		Name:        bot.GetName(),
+		Description: bot.GetDescription(),
+		Public:      bot.GetPublic(),
+		TokenHash:   bot.GetTokenHash(),
+		CreatedAt:   bot.GetCreatedAt().AsTime(),
+	}
+}
@@ -55,6 +55,11 @@ func (u *AuthUsecase) Login(ctx context.Context, loginInfo usecases.LoginUserInf
 		return nil, err
 	}
 
+	// bots authenticate only with bot tokens
+	if user.IsBot {
+		return nil, pkgerrors.Wrap("wrong password or login", models.ErrCredInvalid)
+	}
+
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(loginInfo.Password))
 	if err != nil {
 		return nil, pkgerrors.Wrap("wrong password or login", models.ErrCredInvalid)
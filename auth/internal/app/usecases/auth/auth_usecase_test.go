@@ -263,6 +263,35 @@ func Test_usecase_AuthUsecase_Login(t *testing.T) {
 				f.UsersService.AssertNumberOfCalls(t, "GetUserForLogin", 1)
 			},
 		},
+		{
+			name: "Test 4. Negative. Bot user.",
+			args: args{
+				ctx: ctx, // dumm
+				info: usecases.LoginUserInfo{
+					Login:    "bot-284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Password: "",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "wrong password or login: credentials invalid",
+
+			on: func(f *fields) {
+				f.UsersService.On("GetUserForLogin", ctx, usecases.LoginUserInfo{
+					Login:    "bot-284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Password: "",
+				}).
+					Return(&models.User{
+						UserID: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						Login:  "bot-284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						Name:   "bot",
+						IsBot:  true,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UsersService.AssertNumberOfCalls(t, "GetUserForLogin", 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
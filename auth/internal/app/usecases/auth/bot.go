@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/Nixonxp/discord/auth/internal/app/models"
+	"github.com/Nixonxp/discord/auth/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/auth/pkg/errors"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// botTokenSecretSize - random bytes in the secret part of a bot token
+const botTokenSecretSize = 32
+
+// CreateBot - registers a bot owned by the current user and issues its first token
+func (u *AuthUsecase) CreateBot(ctx context.Context, req usecases.CreateBotRequest) (*models.BotTokenResult, error) {
+	bot, err := u.UserService.CreateBot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := u.issueBotToken(ctx, bot)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create bot", err)
+	}
+
+	return &models.BotTokenResult{
+		Bot:   bot,
+		Token: token,
+	}, nil
+}
+
+func (u *AuthUsecase) ListBots(ctx context.Context, req usecases.ListBotsRequest) ([]*models.Bot, error) {
+	bots, err := u.UserService.GetBotsByOwner(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return bots, nil
+}
+
+// ResetBotToken - issues a new token, the previous token stops working
+func (u *AuthUsecase) ResetBotToken(ctx context.Context, req usecases.ResetBotTokenRequest) (*models.BotTokenResult, error) {
+	bot, err := u.getOwnedBot(ctx, req.BotId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("reset bot token", err)
+	}
+
+	token, err := u.issueBotToken(ctx, bot)
+	if err != nil {
+		return nil, pkgerrors.Wrap("reset bot token", err)
+	}
+
+	return &models.BotTokenResult{
+		Bot:   bot,
+		Token: token,
+	}, nil
+}
+
+// RevokeBotToken - the bot can not authenticate until a new token is issued
+func (u *AuthUsecase) RevokeBotToken(ctx context.Context, req usecases.RevokeBotTokenRequest) (*models.Bot, error) {
+	bot, err := u.getOwnedBot(ctx, req.BotId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("revoke bot token", err)
+	}
+
+	err = u.UserService.SetBotTokenHash(ctx, bot.Id.String(), "")
+	if err != nil {
+		return nil, pkgerrors.Wrap("revoke bot token", err)
+	}
+	bot.TokenHash = ""
+
+	return bot, nil
+}
+
+// VerifyBotToken - resolves a bot token to its bot, any mismatch is unauthenticated
+func (u *AuthUsecase) VerifyBotToken(ctx context.Context, token string) (*models.Bot, error) {
+	botId, secret, ok := strings.Cut(token, ".")
+	if !ok || secret == "" {
+		return nil, pkgerrors.Wrap("invalid bot token", models.Unauthenticated)
+	}
+	if _, err := uuid.Parse(botId); err != nil {
+		return nil, pkgerrors.Wrap("invalid bot token", models.Unauthenticated)
+	}
+
+	bot, err := u.UserService.GetBot(ctx, botId)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, pkgerrors.Wrap("invalid bot token", models.Unauthenticated)
+		}
+		return nil, pkgerrors.Wrap("verify bot token", err)
+	}
+
+	if !bot.HasToken() || subtle.ConstantTimeCompare([]byte(hashBotTokenSecret(secret)), []byte(bot.TokenHash)) != 1 {
+		return nil, pkgerrors.Wrap("invalid bot token", models.Unauthenticated)
+	}
+
+	return bot, nil
+}
+
+func (u *AuthUsecase) getOwnedBot(ctx context.Context, botId string, userId string) (*models.Bot, error) {
+	if _, err := uuid.Parse(botId); err != nil {
+		return nil, models.ErrInvalidArgument
+	}
+
+	bot, err := u.UserService.GetBot(ctx, botId)
+	if err != nil {
+		return nil, err
+	}
+
+	// bots of other users are reported as missing
+	if bot.OwnerId.String() != userId {
+		return nil, models.ErrNotFound
+	}
+
+	return bot, nil
+}
+
+// issueBotToken - generates a token "<bot id>.<secret>", only the hash of the secret is stored
+func (u *AuthUsecase) issueBotToken(ctx context.Context, bot *models.Bot) (string, error) {
+	secretBytes := make([]byte, botTokenSecretSize)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", pkgerrors.Wrap("generate bot token", err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	hash := hashBotTokenSecret(secret)
+
+	err := u.UserService.SetBotTokenHash(ctx, bot.Id.String(), hash)
+	if err != nil {
+		return "", err
+	}
+	bot.TokenHash = hash
+
+	return bot.Id.String() + "." + secret, nil
+}
+
+func hashBotTokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,480 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	config "github.com/Nixonxp/discord/auth/configs"
+	"github.com/Nixonxp/discord/auth/internal/app/models"
+	"github.com/Nixonxp/discord/auth/internal/app/usecases"
+	"github.com/Nixonxp/discord/auth/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/auth/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+)
+
+const (
+	testBotId   = "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
+	testOwnerId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b999"
+)
+
+func testBot() *models.Bot {
+	return &models.Bot{
+		Id:      models.UserID(uuid.MustParse(testBotId)),
+		OwnerId: models.UserID(uuid.MustParse(testOwnerId)),
+		Name:    "helper",
+	}
+}
+
+func Test_usecase_AuthUsecase_CreateBot(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+		req = usecases.CreateBotRequest{
+			OwnerId: testOwnerId,
+			Name:    "helper",
+			Public:  true,
+		}
+	)
+	type fields struct {
+		UsersService *mocks.UserServiceInterface
+		Log          *log.Logger
+		OauthSvc     usecases.OAuthServiceInterface
+		Cfg          *config.Config
+	}
+
+	tests := []struct {
+		name        string
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields, *models.BotTokenResult)
+	}{
+		{
+			name:    "Test 1. Positive.",
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.UsersService.On("CreateBot", ctx, req).
+					Return(testBot(), nil)
+				f.UsersService.On("SetBotTokenHash", ctx, testBotId, mock.AnythingOfType("string")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields, got *models.BotTokenResult) {
+				f.UsersService.AssertNumberOfCalls(t, "SetBotTokenHash", 1)
+
+				botId, secret, ok := strings.Cut(got.Token, ".")
+				assert.True(t, ok)
+				assert.Equal(t, testBotId, botId)
+				assert.Equal(t, hashBotTokenSecret(secret), got.Bot.TokenHash)
+				f.UsersService.AssertCalled(t, "SetBotTokenHash", ctx, testBotId, hashBotTokenSecret(secret))
+			},
+		},
+		{
+			name:        "Test 2. Negative. CreateBot returns error.",
+			wantErr:     true,
+			errorString: "some error",
+
+			on: func(f *fields) {
+				f.UsersService.On("CreateBot", ctx, req).
+					Return(nil, errors.New("some error"))
+			},
+		},
+		{
+			name:        "Test 3. Negative. SetBotTokenHash returns error.",
+			wantErr:     true,
+			errorString: "create bot: some error",
+
+			on: func(f *fields) {
+				f.UsersService.On("CreateBot", ctx, req).
+					Return(testBot(), nil)
+				f.UsersService.On("SetBotTokenHash", ctx, testBotId, mock.AnythingOfType("string")).
+					Return(errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				UsersService: mocks.NewUserServiceInterface(t),
+				Log:          &log.Logger{},
+				OauthSvc:     mocks.NewOAuthServiceInterface(t),
+				Cfg:          config.NewConfig(),
+			}
+			au := NewAuthUsecase(Deps{
+				UserService: f.UsersService,
+				Log:         f.Log,
+				OauthSvc:    f.OauthSvc,
+				Cfg:         f.Cfg,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateBot(ctx, req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateBot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f, got)
+			}
+		})
+	}
+}
+
+func Test_usecase_AuthUsecase_ResetBotToken(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		UsersService *mocks.UserServiceInterface
+		Log          *log.Logger
+		OauthSvc     usecases.OAuthServiceInterface
+		Cfg          *config.Config
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ResetBotTokenRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields, *models.BotTokenResult)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ResetBotTokenRequest{
+					BotId:         testBotId,
+					CurrentUserId: testOwnerId,
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				bot := testBot()
+				bot.TokenHash = hashBotTokenSecret("old")
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(bot, nil)
+				f.UsersService.On("SetBotTokenHash", ctx, testBotId, mock.AnythingOfType("string")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields, got *models.BotTokenResult) {
+				assert.NotEqual(t, hashBotTokenSecret("old"), got.Bot.TokenHash)
+				assert.True(t, strings.HasPrefix(got.Token, testBotId+"."))
+			},
+		},
+		{
+			name: "Test 2. Negative. Bot of another user.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ResetBotTokenRequest{
+					BotId:         testBotId,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				},
+			},
+			wantErr:     true,
+			errorString: "reset bot token: not found",
+
+			on: func(f *fields) {
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(testBot(), nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. Invalid bot id.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ResetBotTokenRequest{
+					BotId:         "invalid",
+					CurrentUserId: testOwnerId,
+				},
+			},
+			wantErr:     true,
+			errorString: "reset bot token: invalid argument",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				UsersService: mocks.NewUserServiceInterface(t),
+				Log:          &log.Logger{},
+				OauthSvc:     mocks.NewOAuthServiceInterface(t),
+				Cfg:          config.NewConfig(),
+			}
+			au := NewAuthUsecase(Deps{
+				UserService: f.UsersService,
+				Log:         f.Log,
+				OauthSvc:    f.OauthSvc,
+				Cfg:         f.Cfg,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ResetBotToken(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ResetBotToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f, got)
+			}
+		})
+	}
+}
+
+func Test_usecase_AuthUsecase_RevokeBotToken(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		UsersService *mocks.UserServiceInterface
+		Log          *log.Logger
+		OauthSvc     usecases.OAuthServiceInterface
+		Cfg          *config.Config
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RevokeBotTokenRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RevokeBotTokenRequest{
+					BotId:         testBotId,
+					CurrentUserId: testOwnerId,
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				bot := testBot()
+				bot.TokenHash = hashBotTokenSecret("secret")
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(bot, nil)
+				f.UsersService.On("SetBotTokenHash", ctx, testBotId, "").
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UsersService.AssertNumberOfCalls(t, "SetBotTokenHash", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Bot not found.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RevokeBotTokenRequest{
+					BotId:         testBotId,
+					CurrentUserId: testOwnerId,
+				},
+			},
+			wantErr:     true,
+			errorString: "revoke bot token: not found",
+
+			on: func(f *fields) {
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				UsersService: mocks.NewUserServiceInterface(t),
+				Log:          &log.Logger{},
+				OauthSvc:     mocks.NewOAuthServiceInterface(t),
+				Cfg:          config.NewConfig(),
+			}
+			au := NewAuthUsecase(Deps{
+				UserService: f.UsersService,
+				Log:         f.Log,
+				OauthSvc:    f.OauthSvc,
+				Cfg:         f.Cfg,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RevokeBotToken(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RevokeBotToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			assert.False(t, got.HasToken())
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_AuthUsecase_VerifyBotToken(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		UsersService *mocks.UserServiceInterface
+		Log          *log.Logger
+		OauthSvc     usecases.OAuthServiceInterface
+		Cfg          *config.Config
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		wantErr     bool
+		errorString string
+
+		on func(*fields)
+	}{
+		{
+			name:    "Test 1. Positive.",
+			token:   testBotId + ".secret",
+			wantErr: false,
+
+			on: func(f *fields) {
+				bot := testBot()
+				bot.TokenHash = hashBotTokenSecret("secret")
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(bot, nil)
+			},
+		},
+		{
+			name:        "Test 2. Negative. Wrong secret.",
+			token:       testBotId + ".other",
+			wantErr:     true,
+			errorString: "invalid bot token: unauthenticated",
+
+			on: func(f *fields) {
+				bot := testBot()
+				bot.TokenHash = hashBotTokenSecret("secret")
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(bot, nil)
+			},
+		},
+		{
+			name:        "Test 3. Negative. Revoked token.",
+			token:       testBotId + ".secret",
+			wantErr:     true,
+			errorString: "invalid bot token: unauthenticated",
+
+			on: func(f *fields) {
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(testBot(), nil)
+			},
+		},
+		{
+			name:        "Test 4. Negative. Unknown bot.",
+			token:       testBotId + ".secret",
+			wantErr:     true,
+			errorString: "invalid bot token: unauthenticated",
+
+			on: func(f *fields) {
+				f.UsersService.On("GetBot", ctx, testBotId).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name:        "Test 5. Negative. Malformed token.",
+			token:       "not-a-token",
+			wantErr:     true,
+			errorString: "invalid bot token: unauthenticated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				UsersService: mocks.NewUserServiceInterface(t),
+				Log:          &log.Logger{},
+				OauthSvc:     mocks.NewOAuthServiceInterface(t),
+				Cfg:          config.NewConfig(),
+			}
+			au := NewAuthUsecase(Deps{
+				UserService: f.UsersService,
+				Log:         f.Log,
+				OauthSvc:    f.OauthSvc,
+				Cfg:         f.Cfg,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.VerifyBotToken(ctx, tt.token)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.VerifyBotToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			assert.Equal(t, testBotId, got.Id.String())
+		})
+	}
+}
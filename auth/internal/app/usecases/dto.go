@@ -39,3 +39,24 @@ type UserInfo struct {
 	Email          string `json:"email"`
 	AvatarPhotoUrl string `json:"picture"`
 }
+
+type CreateBotRequest struct {
+	OwnerId     string
+	Name        string
+	Description string
+	Public      bool
+}
+
+type ListBotsRequest struct {
+	OwnerId string
+}
+
+type ResetBotTokenRequest struct {
+	BotId         string
+	CurrentUserId string
+}
+
+type RevokeBotTokenRequest struct {
+	BotId         string
+	CurrentUserId string
+}
@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	models "github.com/Nixonxp/discord/auth/internal/app/models"
-	mock "github.com/stretchr/testify/mock"
-
 	usecases "github.com/Nixonxp/discord/auth/internal/app/usecases"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // UserServiceInterface is an autogenerated mock type for the UserServiceInterface type
@@ -16,6 +15,36 @@ type UserServiceInterface struct {
 	mock.Mock
 }
 
+// CreateBot provides a mock function with given fields: ctx, req
+func (_m *UserServiceInterface) CreateBot(ctx context.Context, req usecases.CreateBotRequest) (*models.Bot, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBot")
+	}
+
+	var r0 *models.Bot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateBotRequest) (*models.Bot, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateBotRequest) *models.Bot); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Bot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CreateBotRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateOrCreateUser provides a mock function with given fields: ctx, userInfo
 func (_m *UserServiceInterface) CreateOrCreateUser(ctx context.Context, userInfo usecases.GetOrCreateUserRequest) (*models.User, error) {
 	ret := _m.Called(ctx, userInfo)
@@ -46,6 +75,66 @@ func (_m *UserServiceInterface) CreateOrCreateUser(ctx context.Context, userInfo
 	return r0, r1
 }
 
+// GetBot provides a mock function with given fields: ctx, botId
+func (_m *UserServiceInterface) GetBot(ctx context.Context, botId string) (*models.Bot, error) {
+	ret := _m.Called(ctx, botId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBot")
+	}
+
+	var r0 *models.Bot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Bot, error)); ok {
+		return rf(ctx, botId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Bot); ok {
+		r0 = rf(ctx, botId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Bot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, botId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBotsByOwner provides a mock function with given fields: ctx, ownerId
+func (_m *UserServiceInterface) GetBotsByOwner(ctx context.Context, ownerId string) ([]*models.Bot, error) {
+	ret := _m.Called(ctx, ownerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBotsByOwner")
+	}
+
+	var r0 []*models.Bot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.Bot, error)); ok {
+		return rf(ctx, ownerId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.Bot); ok {
+		r0 = rf(ctx, ownerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Bot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ownerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserForLogin provides a mock function with given fields: ctx, loginInfo
 func (_m *UserServiceInterface) GetUserForLogin(ctx context.Context, loginInfo usecases.LoginUserInfo) (*models.User, error) {
 	ret := _m.Called(ctx, loginInfo)
@@ -106,6 +195,24 @@ func (_m *UserServiceInterface) Register(ctx context.Context, registerInfo useca
 	return r0, r1
 }
 
+// SetBotTokenHash provides a mock function with given fields: ctx, botId, tokenHash
+func (_m *UserServiceInterface) SetBotTokenHash(ctx context.Context, botId string, tokenHash string) error {
+	ret := _m.Called(ctx, botId, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBotTokenHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, botId, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewUserServiceInterface creates a new instance of UserServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserServiceInterface(t interface {
@@ -11,6 +11,11 @@ type UsecaseInterface interface {
 	Refresh(ctx context.Context, refreshToken string) (string, error)
 	OauthLogin(ctx context.Context, req OauthLoginRequest) (*models.OauthLoginResult, error)
 	OauthLoginCallback(ctx context.Context, req OauthLoginCallbackRequest) (*models.LoginResult, error)
+	CreateBot(ctx context.Context, req CreateBotRequest) (*models.BotTokenResult, error)
+	ListBots(ctx context.Context, req ListBotsRequest) ([]*models.Bot, error)
+	ResetBotToken(ctx context.Context, req ResetBotTokenRequest) (*models.BotTokenResult, error)
+	RevokeBotToken(ctx context.Context, req RevokeBotTokenRequest) (*models.Bot, error)
+	VerifyBotToken(ctx context.Context, token string) (*models.Bot, error)
 }
 
 //go:generate mockery --name=UserServiceInterface --filename=users_service_mock.go --disable-version-string
@@ -18,6 +23,10 @@ type UserServiceInterface interface {
 	Register(ctx context.Context, registerInfo RegisterUserInfo) (*models.User, error)
 	GetUserForLogin(ctx context.Context, loginInfo LoginUserInfo) (*models.User, error)
 	CreateOrCreateUser(ctx context.Context, userInfo GetOrCreateUserRequest) (*models.User, error)
+	CreateBot(ctx context.Context, req CreateBotRequest) (*models.Bot, error)
+	GetBot(ctx context.Context, botId string) (*models.Bot, error)
+	GetBotsByOwner(ctx context.Context, ownerId string) ([]*models.Bot, error)
+	SetBotTokenHash(ctx context.Context, botId string, tokenHash string) error
 }
 
 //go:generate mockery --name=OAuthServiceInterface --filename=oauth_service_mock.go --disable-version-string
@@ -22,6 +22,10 @@ const (
 	UserService_CreateUser_FullMethodName      = "/github.com.Nixonxp.discord.user.api.v1.UserService/CreateUser"
 	UserService_GetUserForLogin_FullMethodName = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetUserForLogin"
 	UserService_CreateOrGetUser_FullMethodName = "/github.com.Nixonxp.discord.user.api.v1.UserService/CreateOrGetUser"
+	UserService_CreateBot_FullMethodName       = "/github.com.Nixonxp.discord.user.api.v1.UserService/CreateBot"
+	UserService_GetBot_FullMethodName          = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetBot"
+	UserService_GetBotsByOwner_FullMethodName  = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetBotsByOwner"
+	UserService_SetBotTokenHash_FullMethodName = "/github.com.Nixonxp.discord.user.api.v1.UserService/SetBotTokenHash"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -31,6 +35,10 @@ type UserServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
 	GetUserForLogin(ctx context.Context, in *GetUserForLoginRequest, opts ...grpc.CallOption) (*GetUserForLoginResponse, error)
 	CreateOrGetUser(ctx context.Context, in *CreateOrGetUserRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
+	CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*Bot, error)
+	GetBot(ctx context.Context, in *GetBotRequest, opts ...grpc.CallOption) (*Bot, error)
+	GetBotsByOwner(ctx context.Context, in *GetBotsByOwnerRequest, opts ...grpc.CallOption) (*GetBotsByOwnerResponse, error)
+	SetBotTokenHash(ctx context.Context, in *SetBotTokenHashRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 }
 
 type userServiceClient struct {
@@ -68,6 +76,42 @@ func (c *userServiceClient) CreateOrGetUser(ctx context.Context, in *CreateOrGet
 	return out, nil
 }
 
+func (c *userServiceClient) CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*Bot, error) {
+	out := new(Bot)
+	err := c.cc.Invoke(ctx, UserService_CreateBot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBot(ctx context.Context, in *GetBotRequest, opts ...grpc.CallOption) (*Bot, error) {
+	out := new(Bot)
+	err := c.cc.Invoke(ctx, UserService_GetBot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBotsByOwner(ctx context.Context, in *GetBotsByOwnerRequest, opts ...grpc.CallOption) (*GetBotsByOwnerResponse, error) {
+	out := new(GetBotsByOwnerResponse)
+	err := c.cc.Invoke(ctx, UserService_GetBotsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetBotTokenHash(ctx context.Context, in *SetBotTokenHashRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, UserService_SetBotTokenHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -75,6 +119,10 @@ type UserServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*UserDataResponse, error)
 	GetUserForLogin(context.Context, *GetUserForLoginRequest) (*GetUserForLoginResponse, error)
 	CreateOrGetUser(context.Context, *CreateOrGetUserRequest) (*UserDataResponse, error)
+	CreateBot(context.Context, *CreateBotRequest) (*Bot, error)
+	GetBot(context.Context, *GetBotRequest) (*Bot, error)
+	GetBotsByOwner(context.Context, *GetBotsByOwnerRequest) (*GetBotsByOwnerResponse, error)
+	SetBotTokenHash(context.Context, *SetBotTokenHashRequest) (*ActionResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -91,6 +139,18 @@ func (UnimplementedUserServiceServer) GetUserForLogin(context.Context, *GetUserF
 func (UnimplementedUserServiceServer) CreateOrGetUser(context.Context, *CreateOrGetUserRequest) (*UserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrGetUser not implemented")
 }
+func (UnimplementedUserServiceServer) CreateBot(context.Context, *CreateBotRequest) (*Bot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedUserServiceServer) GetBot(context.Context, *GetBotRequest) (*Bot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBot not implemented")
+}
+func (UnimplementedUserServiceServer) GetBotsByOwner(context.Context, *GetBotsByOwnerRequest) (*GetBotsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBotsByOwner not implemented")
+}
+func (UnimplementedUserServiceServer) SetBotTokenHash(context.Context, *SetBotTokenHashRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBotTokenHash not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +218,78 @@ func _UserService_CreateOrGetUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateBot(ctx, req.(*CreateBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBot(ctx, req.(*GetBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBotsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBotsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBotsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBotsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBotsByOwner(ctx, req.(*GetBotsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetBotTokenHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBotTokenHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetBotTokenHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetBotTokenHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetBotTokenHash(ctx, req.(*SetBotTokenHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +309,22 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrGetUser",
 			Handler:    _UserService_CreateOrGetUser_Handler,
 		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _UserService_CreateBot_Handler,
+		},
+		{
+			MethodName: "GetBot",
+			Handler:    _UserService_GetBot_Handler,
+		},
+		{
+			MethodName: "GetBotsByOwner",
+			Handler:    _UserService_GetBotsByOwner_Handler,
+		},
+		{
+			MethodName: "SetBotTokenHash",
+			Handler:    _UserService_SetBotTokenHash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/api/user/user.proto",
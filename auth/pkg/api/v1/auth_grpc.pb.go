@@ -24,6 +24,11 @@ const (
 	AuthService_Refresh_FullMethodName            = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/Refresh"
 	AuthService_OauthLogin_FullMethodName         = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/OauthLogin"
 	AuthService_OauthLoginCallback_FullMethodName = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/OauthLoginCallback"
+	AuthService_CreateBot_FullMethodName          = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/CreateBot"
+	AuthService_ListBots_FullMethodName           = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/ListBots"
+	AuthService_ResetBotToken_FullMethodName      = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/ResetBotToken"
+	AuthService_RevokeBotToken_FullMethodName     = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/RevokeBotToken"
+	AuthService_VerifyBotToken_FullMethodName     = "/github.com.Nixonxp.discord.auth.api.v1.AuthService/VerifyBotToken"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -35,6 +40,11 @@ type AuthServiceClient interface {
 	Refresh(ctx context.Context, in *RefreshRequest, opts ...grpc.CallOption) (*RefreshResponse, error)
 	OauthLogin(ctx context.Context, in *OauthLoginRequest, opts ...grpc.CallOption) (*OauthLoginResponse, error)
 	OauthLoginCallback(ctx context.Context, in *OauthLoginCallbackRequest, opts ...grpc.CallOption) (*OauthLoginCallbackResponse, error)
+	CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error)
+	ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*ListBotsResponse, error)
+	ResetBotToken(ctx context.Context, in *ResetBotTokenRequest, opts ...grpc.CallOption) (*ResetBotTokenResponse, error)
+	RevokeBotToken(ctx context.Context, in *RevokeBotTokenRequest, opts ...grpc.CallOption) (*RevokeBotTokenResponse, error)
+	VerifyBotToken(ctx context.Context, in *VerifyBotTokenRequest, opts ...grpc.CallOption) (*VerifyBotTokenResponse, error)
 }
 
 type authServiceClient struct {
@@ -90,6 +100,51 @@ func (c *authServiceClient) OauthLoginCallback(ctx context.Context, in *OauthLog
 	return out, nil
 }
 
+func (c *authServiceClient) CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error) {
+	out := new(CreateBotResponse)
+	err := c.cc.Invoke(ctx, AuthService_CreateBot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*ListBotsResponse, error) {
+	out := new(ListBotsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListBots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetBotToken(ctx context.Context, in *ResetBotTokenRequest, opts ...grpc.CallOption) (*ResetBotTokenResponse, error) {
+	out := new(ResetBotTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_ResetBotToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeBotToken(ctx context.Context, in *RevokeBotTokenRequest, opts ...grpc.CallOption) (*RevokeBotTokenResponse, error) {
+	out := new(RevokeBotTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeBotToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyBotToken(ctx context.Context, in *VerifyBotTokenRequest, opts ...grpc.CallOption) (*VerifyBotTokenResponse, error) {
+	out := new(VerifyBotTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyBotToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -99,6 +154,11 @@ type AuthServiceServer interface {
 	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
 	OauthLogin(context.Context, *OauthLoginRequest) (*OauthLoginResponse, error)
 	OauthLoginCallback(context.Context, *OauthLoginCallbackRequest) (*OauthLoginCallbackResponse, error)
+	CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error)
+	ListBots(context.Context, *ListBotsRequest) (*ListBotsResponse, error)
+	ResetBotToken(context.Context, *ResetBotTokenRequest) (*ResetBotTokenResponse, error)
+	RevokeBotToken(context.Context, *RevokeBotTokenRequest) (*RevokeBotTokenResponse, error)
+	VerifyBotToken(context.Context, *VerifyBotTokenRequest) (*VerifyBotTokenResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -121,6 +181,21 @@ func (UnimplementedAuthServiceServer) OauthLogin(context.Context, *OauthLoginReq
 func (UnimplementedAuthServiceServer) OauthLoginCallback(context.Context, *OauthLoginCallbackRequest) (*OauthLoginCallbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OauthLoginCallback not implemented")
 }
+func (UnimplementedAuthServiceServer) CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedAuthServiceServer) ListBots(context.Context, *ListBotsRequest) (*ListBotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBots not implemented")
+}
+func (UnimplementedAuthServiceServer) ResetBotToken(context.Context, *ResetBotTokenRequest) (*ResetBotTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetBotToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeBotToken(context.Context, *RevokeBotTokenRequest) (*RevokeBotTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBotToken not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyBotToken(context.Context, *VerifyBotTokenRequest) (*VerifyBotTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBotToken not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +299,96 @@ func _AuthService_OauthLoginCallback_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateBot(ctx, req.(*CreateBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListBots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListBots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListBots(ctx, req.(*ListBotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetBotTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ResetBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetBotToken(ctx, req.(*ResetBotTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBotTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeBotToken(ctx, req.(*RevokeBotTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBotTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyBotToken(ctx, req.(*VerifyBotTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +416,26 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OauthLoginCallback",
 			Handler:    _AuthService_OauthLoginCallback_Handler,
 		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _AuthService_CreateBot_Handler,
+		},
+		{
+			MethodName: "ListBots",
+			Handler:    _AuthService_ListBots_Handler,
+		},
+		{
+			MethodName: "ResetBotToken",
+			Handler:    _AuthService_ResetBotToken_Handler,
+		},
+		{
+			MethodName: "RevokeBotToken",
+			Handler:    _AuthService_RevokeBotToken_Handler,
+		},
+		{
+			MethodName: "VerifyBotToken",
+			Handler:    _AuthService_VerifyBotToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/auth.proto",
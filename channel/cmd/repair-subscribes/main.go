@@ -0,0 +1,65 @@
+// Command repair-subscribes removes duplicated channel subscriptions straight in Mongo,
+// then builds the unique membership index.
+//
+//	repair-subscribes
+package main
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/channel/configs"
+	subscribe_repository "github.com/Nixonxp/discord/channel/internal/app/repository/subscribe_storage"
+	logger "github.com/Nixonxp/discord/channel/pkg/logger"
+	mongoCollection "github.com/Nixonxp/discord/channel/pkg/mongo"
+	"log"
+)
+
+func main() {
+	if err := run(context.Background(), config.GetConfig()); err != nil {
+		log.Fatalf("repair subscribes error: %v", err)
+	}
+}
+
+func run(ctx context.Context, cfg *config.Config) error {
+	channelCollection, err := mongoCollection.NewCollection(ctx,
+		cfg.Application.ServiceCollection,
+		&mongoCollection.Config{
+			MongoHost:     cfg.Application.MongoHost,
+			MongoDb:       cfg.Application.MongoDb,
+			MongoPort:     cfg.Application.MongoPort,
+			MongoUser:     cfg.Application.MongoUser,
+			MongoPassword: cfg.Application.MongoPassword,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer channelCollection.DisconnectClient()
+
+	subscribeCollection, err := channelCollection.NewCollection(cfg.Application.ChannelSubscribeCollection)
+	if err != nil {
+		return err
+	}
+
+	l, err := logger.NewLogger(logger.NewDefaultConfig())
+	if err != nil {
+		return err
+	}
+
+	subscribeRepo := subscribe_repository.NewMongoSubscribeRepository(subscribeCollection, l)
+	duplicates, err := subscribeRepo.FindDuplicates(ctx)
+	if err != nil {
+		return err
+	}
+
+	removed := int64(0)
+	if len(duplicates) > 0 {
+		removed, err = subscribeRepo.DeleteByIds(ctx, duplicates)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("duplicates removed: %d", removed)
+
+	return subscribeRepo.EnsureIndexes(ctx)
+}
@@ -1,13 +1,24 @@
 package config
 
+import "time"
+
 type ApplicationConfig struct {
-	Port                       string `envconfig:"APP_PORT" default:":8580"`
-	MetricsPort                string `envconfig:"METRICS_PORT" default:":8582"`
-	MongoHost                  string `envconfig:"MONGO_HOST" default:"localhost"`
-	MongoDb                    string `envconfig:"MONGO_DB" default:"discord"`
-	MongoPort                  string `envconfig:"MONGO_PORT" default:"27117"`
-	MongoUser                  string `envconfig:"MONGO_USER" default:"discord"`
-	MongoPassword              string `envconfig:"MONGO_PASSWORD" default:"example"`
-	ServiceCollection          string `envconfig:"MONGO_SERVICE_COLLECTION" default:"channels"`
-	ChannelSubscribeCollection string `envconfig:"MONGO_CHANNEL_SUBSCRIBE_COLLECTION" default:"channel_subscribe"`
+	Port                       string        `envconfig:"APP_PORT" default:":8580"`
+	MetricsPort                string        `envconfig:"METRICS_PORT" default:":8582"`
+	MongoHost                  string        `envconfig:"MONGO_HOST" default:"localhost"`
+	MongoDb                    string        `envconfig:"MONGO_DB" default:"discord"`
+	MongoPort                  string        `envconfig:"MONGO_PORT" default:"27117"`
+	MongoUser                  string        `envconfig:"MONGO_USER" default:"discord"`
+	MongoPassword              string        `envconfig:"MONGO_PASSWORD" default:"example"`
+	ServiceCollection          string        `envconfig:"MONGO_SERVICE_COLLECTION" default:"channels"`
+	ChannelSubscribeCollection string        `envconfig:"MONGO_CHANNEL_SUBSCRIBE_COLLECTION" default:"channel_subscribe"`
+	ChannelAccessCollection    string        `envconfig:"MONGO_CHANNEL_ACCESS_COLLECTION" default:"channel_access"`
+	ChannelFollowCollection    string        `envconfig:"MONGO_CHANNEL_FOLLOW_COLLECTION" default:"channel_follow"`
+	ForumPostCollection        string        `envconfig:"MONGO_FORUM_POST_COLLECTION" default:"forum_post"`
+	ServerServiceHost          string        `envconfig:"SERVER_SERVICE_HOST" default:":8480"`
+	ChatServiceHost            string        `envconfig:"CHAT_SERVICE_HOST" default:":8680"`
+	KafkaAddress               string        `envconfig:"KAFKA_ADDRESS" default:"localhost:9092"`
+	KafkaServerEventsTopic     string        `envconfig:"KAFKA_SERVER_EVENTS_TOPIC" default:"server_events"`
+	KafkaChannelEventsTopic    string        `envconfig:"KAFKA_CHANNEL_EVENTS_TOPIC" default:"channel_events"`
+	ChannelPurgeInterval       time.Duration `envconfig:"CHANNEL_PURGE_INTERVAL" default:"10m"`
 }
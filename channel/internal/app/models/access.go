@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+type ChannelAccessStatus string
+
+const (
+	ChannelAccessGranted   ChannelAccessStatus = "granted"
+	ChannelAccessRequested ChannelAccessStatus = "requested"
+)
+
+// ChannelAccess - an entry of the access list of a private channel or a pending join request,
+// UpdatedAt is the time of the request or of the grant
+type ChannelAccess struct {
+	ChannelId ChannelID           `bson:"channel_id"`
+	UserId    UserID              `bson:"user_id"`
+	Status    ChannelAccessStatus `bson:"status"`
+	UpdatedAt time.Time           `bson:"updated_at"`
+}
+
+func (a *ChannelAccess) IsGranted() bool {
+	return a.Status == ChannelAccessGranted
+}
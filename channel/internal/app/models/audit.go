@@ -0,0 +1,16 @@
+package models
+
+// audit actions recorded in the server audit log
+const (
+	AuditActionChannelCreate       = "channel.create"
+	AuditActionChannelDelete       = "channel.delete"
+	AuditActionChannelUpdate       = "channel.update"
+	AuditActionChannelAccessGrant  = "channel.access_grant"
+	AuditActionChannelAccessRevoke = "channel.access_revoke"
+	AuditActionChannelFollow       = "channel.follow"
+	AuditActionChannelUnfollow     = "channel.unfollow"
+	AuditActionChannelArchive      = "channel.archive"
+	AuditActionChannelUnarchive    = "channel.unarchive"
+	AuditActionChannelRestore      = "channel.restore"
+	AuditTargetChannel             = "channel"
+)
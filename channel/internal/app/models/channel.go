@@ -1,6 +1,11 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+	"time"
+)
 
 type ActionInfo struct {
 	Success bool
@@ -18,8 +23,264 @@ func (v UserID) String() string {
 	return uuid.UUID(v).String()
 }
 
+type ServerID uuid.UUID
+
+func (v ServerID) String() string {
+	return uuid.UUID(v).String()
+}
+
+type ChannelType string
+
+const (
+	ChannelTypeText         ChannelType = "text"
+	ChannelTypeCategory     ChannelType = "category"
+	ChannelTypeAnnouncement ChannelType = "announcement"
+	ChannelTypeVoice        ChannelType = "voice"
+	ChannelTypeForum        ChannelType = "forum"
+)
+
+func (t ChannelType) Valid() bool {
+	switch t {
+	case ChannelTypeText, ChannelTypeCategory, ChannelTypeAnnouncement, ChannelTypeVoice, ChannelTypeForum:
+		return true
+	}
+
+	return false
+}
+
+// HasMessages - forum posts, voice channels and categories have no channel chat
+func (t ChannelType) HasMessages() bool {
+	return t == ChannelTypeText || t == ChannelTypeAnnouncement
+}
+
+// HasTopic - voice channels and categories show no topic
+func (t ChannelType) HasTopic() bool {
+	return t != ChannelTypeVoice && t != ChannelTypeCategory
+}
+
+const (
+	VoiceDefaultBitrate = 64000
+	VoiceMinBitrate     = 8000
+	VoiceMaxBitrate     = 96000
+	VoiceMaxUserLimit   = 99
+)
+
+type VoiceSettings struct {
+	Bitrate int `bson:"bitrate"`
+	// UserLimit - 0 means no limit
+	UserLimit int `bson:"user_limit"`
+}
+
+const (
+	// ChannelRestoreGracePeriod - time after deletion during which the owner can restore the channel,
+	// then the channel is purged and ChannelDeleted is published for other services
+	ChannelRestoreGracePeriod = 7 * 24 * time.Hour
+	ChannelPurgeBatchSize     = 100
+)
+
+type ForumSort string
+
+const (
+	ForumSortLatestActivity ForumSort = "latest_activity"
+	ForumSortCreationDate   ForumSort = "creation_date"
+)
+
+const (
+	ForumMaxTags      = 20
+	ForumMaxTagLength = 20
+)
+
+type ForumSettings struct {
+	DefaultSort ForumSort `bson:"default_sort"`
+	// Tags - tags posts of the forum can be marked with
+	Tags []string `bson:"tags"`
+}
+
 type Channel struct {
 	Id      ChannelID `bson:"_id"`
 	Name    string    `bson:"name"`
 	OwnerId UserID    `bson:"owner_id"`
+	// ServerId - empty for channels created before channels were linked to servers
+	ServerId ServerID `bson:"server_id"`
+	// Type - empty for channels created before types, they are text channels
+	Type ChannelType `bson:"type"`
+	// ParentId - category of the channel, empty for channels outside of categories
+	ParentId ChannelID `bson:"parent_id"`
+	// Position - order among channels with the same parent
+	Position int    `bson:"position"`
+	Topic    string `bson:"topic"`
+	Nsfw     bool   `bson:"nsfw"`
+	// Voice - set for voice channels only
+	Voice *VoiceSettings `bson:"voice,omitempty"`
+	// Forum - set for forum channels only
+	Forum *ForumSettings `bson:"forum,omitempty"`
+	// Private - only the owner and users granted access can join
+	Private bool `bson:"private"`
+	// ArchivedAt - set while the channel is archived, archived channels are read-only
+	ArchivedAt time.Time `bson:"archived_at,omitempty"`
+	// DeletedAt - set while the deleted channel waits for purge
+	DeletedAt time.Time `bson:"deleted_at,omitempty"`
+}
+
+func (c *Channel) HasServer() bool {
+	return c.ServerId != ServerID{}
+}
+
+func (c *Channel) HasParent() bool {
+	return c.ParentId != ChannelID{}
+}
+
+func (c *Channel) IsArchived() bool {
+	return !c.ArchivedAt.IsZero()
+}
+
+func (c *Channel) IsDeleted() bool {
+	return !c.DeletedAt.IsZero()
+}
+
+// RestoreDeadline - the channel is purged after this time
+func (c *Channel) RestoreDeadline() time.Time {
+	return c.DeletedAt.Add(ChannelRestoreGracePeriod)
+}
+
+func (c *Channel) IsAnnouncement() bool {
+	return c.Type == ChannelTypeAnnouncement
+}
+
+func (c *Channel) IsCategory() bool {
+	return c.Type == ChannelTypeCategory
+}
+
+func (c *Channel) ChannelType() ChannelType {
+	if c.Type == "" {
+		return ChannelTypeText
+	}
+
+	return c.Type
+}
+
+// SetDefaultSettings - fills settings of the channel type left empty by the creator
+func (c *Channel) SetDefaultSettings() {
+	switch c.ChannelType() {
+	case ChannelTypeVoice:
+		if c.Voice == nil {
+			c.Voice = &VoiceSettings{}
+		}
+		if c.Voice.Bitrate == 0 {
+			c.Voice.Bitrate = VoiceDefaultBitrate
+		}
+	case ChannelTypeForum:
+		if c.Forum == nil {
+			c.Forum = &ForumSettings{}
+		}
+		if c.Forum.DefaultSort == "" {
+			c.Forum.DefaultSort = ForumSortLatestActivity
+		}
+	}
+}
+
+// CheckSettings - type specific fields are only set for their type
+func (c *Channel) CheckSettings() error {
+	channelType := c.ChannelType()
+
+	if c.Topic != "" && !channelType.HasTopic() {
+		return fmt.Errorf("channel type has no topic: %w", ErrInvalidArgument)
+	}
+
+	if c.Voice != nil {
+		if channelType != ChannelTypeVoice {
+			return fmt.Errorf("voice settings are only for voice channels: %w", ErrInvalidArgument)
+		}
+		if c.Voice.Bitrate < VoiceMinBitrate || c.Voice.Bitrate > VoiceMaxBitrate {
+			return fmt.Errorf("bitrate is out of range: %w", ErrInvalidArgument)
+		}
+		if c.Voice.UserLimit < 0 || c.Voice.UserLimit > VoiceMaxUserLimit {
+			return fmt.Errorf("user limit is out of range: %w", ErrInvalidArgument)
+		}
+	}
+
+	if c.Forum != nil {
+		if channelType != ChannelTypeForum {
+			return fmt.Errorf("forum settings are only for forum channels: %w", ErrInvalidArgument)
+		}
+		if !c.Forum.DefaultSort.Valid() {
+			return fmt.Errorf("unknown forum sort: %w", ErrInvalidArgument)
+		}
+		if len(c.Forum.Tags) > ForumMaxTags {
+			return fmt.Errorf("too many forum tags: %w", ErrInvalidArgument)
+		}
+		seen := make(map[string]bool, len(c.Forum.Tags))
+		for _, tag := range c.Forum.Tags {
+			if tag == "" || len(tag) > ForumMaxTagLength || seen[tag] {
+				return fmt.Errorf("invalid forum tag: %w", ErrInvalidArgument)
+			}
+			seen[tag] = true
+		}
+	}
+
+	return nil
+}
+
+// ChannelDeletedEvent - published when a channel is purged
+type ChannelDeletedEvent struct {
+	ChannelId ChannelID
+	ServerId  ServerID
+	DeletedAt time.Time
+}
+
+// ChannelPosition - place of a channel in the server channel list
+type ChannelPosition struct {
+	ChannelId ChannelID
+	ParentId  ChannelID
+	Position  int
+}
+
+// SortChannelTree - display order of server channels: uncategorized channels first,
+// then every category followed by its channels, channels of a missing category count as uncategorized
+func SortChannelTree(channels []*Channel) []*Channel {
+	categories := make([]*Channel, 0)
+	isCategory := make(map[ChannelID]bool)
+	for _, channel := range channels {
+		if channel.IsCategory() {
+			categories = append(categories, channel)
+			isCategory[channel.Id] = true
+		}
+	}
+
+	roots := make([]*Channel, 0)
+	children := make(map[ChannelID][]*Channel)
+	for _, channel := range channels {
+		switch {
+		case channel.IsCategory():
+		case isCategory[channel.ParentId]:
+			children[channel.ParentId] = append(children[channel.ParentId], channel)
+		default:
+			roots = append(roots, channel)
+		}
+	}
+
+	sortByPosition(roots)
+	sortByPosition(categories)
+
+	result := make([]*Channel, 0, len(channels))
+	result = append(result, roots...)
+	for _, category := range categories {
+		list := children[category.Id]
+		sortByPosition(list)
+
+		result = append(result, category)
+		result = append(result, list...)
+	}
+
+	return result
+}
+
+// sortByPosition - channels with equal positions are ordered by name
+func sortByPosition(channels []*Channel) {
+	sort.SliceStable(channels, func(i, j int) bool {
+		if channels[i].Position != channels[j].Position {
+			return channels[i].Position < channels[j].Position
+		}
+		return channels[i].Name < channels[j].Name
+	})
 }
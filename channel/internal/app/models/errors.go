@@ -3,9 +3,10 @@ package models
 import "errors"
 
 var (
-	ErrAlreadyExists = errors.New("already exists")
-	ErrUnimplemented = errors.New("unimplemented")
-	ErrNotFound      = errors.New("not found")
-	ErrPermDenied    = errors.New("permission denied")
-	Unauthenticated  = errors.New("unauthenticated")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrUnimplemented   = errors.New("unimplemented")
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrPermDenied      = errors.New("permission denied")
+	Unauthenticated    = errors.New("unauthenticated")
 )
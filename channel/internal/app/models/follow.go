@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// ChannelFollow - target channel receives copies of messages published in the announcement source channel
+type ChannelFollow struct {
+	SourceChannelId ChannelID `bson:"source_channel_id"`
+	TargetChannelId ChannelID `bson:"target_channel_id"`
+	// CreatedBy - owner of the target channel
+	CreatedBy UserID    `bson:"created_by"`
+	CreatedAt time.Time `bson:"created_at"`
+}
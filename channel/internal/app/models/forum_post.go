@@ -0,0 +1,62 @@
+package models
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+type PostID uuid.UUID
+
+func (v PostID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const (
+	ForumPostMaxTitleLength = 100
+	ForumPostMaxTags        = 5
+)
+
+// ForumPost - titled thread of a forum channel, messages of the post are stored by chat service
+type ForumPost struct {
+	Id        PostID    `bson:"_id"`
+	ChannelId ChannelID `bson:"channel_id"`
+	OwnerId   UserID    `bson:"owner_id"`
+	Title     string    `bson:"title"`
+	// Tags - subset of the forum tags
+	Tags      []string  `bson:"tags"`
+	CreatedAt time.Time `bson:"created_at"`
+	// LastActivityAt - time of the latest message of the post
+	LastActivityAt time.Time `bson:"last_activity_at"`
+}
+
+func (s ForumSort) Valid() bool {
+	return s == ForumSortLatestActivity || s == ForumSortCreationDate
+}
+
+func (s *ForumSettings) HasTag(tag string) bool {
+	for _, t := range s.Tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckPostTags - posts are marked only with tags of the forum, each tag once
+func (s *ForumSettings) CheckPostTags(tags []string) error {
+	if len(tags) > ForumPostMaxTags {
+		return fmt.Errorf("too many post tags: %w", ErrInvalidArgument)
+	}
+
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if !s.HasTag(tag) || seen[tag] {
+			return fmt.Errorf("invalid post tag: %w", ErrInvalidArgument)
+		}
+		seen[tag] = true
+	}
+
+	return nil
+}
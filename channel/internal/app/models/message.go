@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// ChannelMessage - message of the channel chat, stored by chat service
+type ChannelMessage struct {
+	Id        string
+	Text      string
+	Timestamp time.Time
+	OwnerId   string
+	// Reference - original of a message cross-posted from a followed announcement channel
+	Reference *MessageReference
+}
+
+type MessageReference struct {
+	ChannelId string
+	MessageId string
+}
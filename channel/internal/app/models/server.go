@@ -0,0 +1,7 @@
+package models
+
+// ServerMember - membership of a user in a server, kept by the server service
+type ServerMember struct {
+	UserId  UserID
+	IsOwner bool
+}
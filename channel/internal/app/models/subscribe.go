@@ -1,6 +1,9 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type SubscribeID uuid.UUID
 
@@ -8,8 +11,20 @@ func (v SubscribeID) String() string {
 	return uuid.UUID(v).String()
 }
 
+// SubscribeInfo - JoinedAt is zero for subscriptions created before join time was recorded
 type SubscribeInfo struct {
 	Id        SubscribeID `bson:"_id"`
 	ChannelId ChannelID   `bson:"channel_id"`
 	UserId    UserID      `bson:"user_id"`
+	JoinedAt  time.Time   `bson:"joined_at"`
+}
+
+const (
+	MembersDefaultLimit = 50
+	MembersMaxLimit     = 200
+)
+
+type ListChannelMembersResult struct {
+	Members    []*SubscribeInfo
+	NextCursor string
 }
@@ -0,0 +1,157 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/pkg/api/chat"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/segmentio/kafka-go"
+	"time"
+)
+
+const (
+	minRetryBackoff = time.Second
+	maxRetryBackoff = time.Minute
+)
+
+type Deps struct {
+	QueueUsecase    usecases.QueueInterface
+	ConsumerService usecases.KafkaConsumerServiceInterface
+	Log             *log.Logger
+}
+
+type eventHandler func(ctx context.Context, event *chat.EventEnvelope) error
+
+type Queue struct {
+	Deps
+
+	handlers map[chat.EventType]eventHandler
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func NewQueue(d Deps) *Queue {
+	q := &Queue{
+		Deps:       d,
+		minBackoff: minRetryBackoff,
+		maxBackoff: maxRetryBackoff,
+	}
+
+	q.handlers = map[chat.EventType]eventHandler{
+		chat.EventType_EVENT_TYPE_SERVER_DELETED: q.deleteServer,
+	}
+
+	return q
+}
+
+// Run - consumes events until ctx is done, the offset is committed only after the event is handled,
+// failed events are retried with backoff, so nothing is skipped; fetch and commit errors are returned
+func (q *Queue) Run(ctx context.Context) error {
+	for {
+		m, err := q.ConsumerService.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return pkgErrors.Wrap("fetch message", err)
+		}
+
+		err = q.handleWithRetry(ctx, m)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+
+		err = q.ConsumerService.CommitMessages(ctx, m)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return pkgErrors.Wrap("commit message", err)
+		}
+	}
+}
+
+// handleWithRetry - handles message until it succeeds or ctx is done,
+// malformed events can never succeed, so they are logged and skipped
+func (q *Queue) handleWithRetry(ctx context.Context, m kafka.Message) error {
+	event, err := DecodeEvent(m)
+	if err != nil {
+		q.Log.WithContext(ctx).WithError(err).WithField("topic", m.Topic).Error("skip malformed message")
+		return nil
+	}
+
+	backoff := q.minBackoff
+	for {
+		err = q.dispatch(ctx, event)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, ErrUnexpectedEvent) {
+			q.Log.WithContext(ctx).WithError(err).WithField("topic", m.Topic).Error("skip malformed message")
+			return nil
+		}
+
+		q.Log.WithContext(ctx).
+			WithError(err).
+			WithField("topic", m.Topic).
+			WithField("event_id", event.GetEventId()).
+			WithField("retry_in", backoff.String()).
+			Error("failed to handle message")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, q.maxBackoff)
+	}
+}
+
+// HandleMessage - dispatches event by type, events unknown to this consumer are skipped
+func (q *Queue) HandleMessage(ctx context.Context, message kafka.Message) error {
+	event, err := DecodeEvent(message)
+	if err != nil {
+		return pkgErrors.Wrap("unmarshal message", err)
+	}
+
+	return q.dispatch(ctx, event)
+}
+
+func (q *Queue) dispatch(ctx context.Context, event *chat.EventEnvelope) error {
+	handler, ok := q.handlers[event.GetEventType()]
+	if !ok {
+		q.Log.WithContext(ctx).
+			WithField("event_id", event.GetEventId()).
+			WithField("event_type", event.GetEventType().String()).
+			Warn("skip unsupported event")
+		return nil
+	}
+
+	span, ctx := startEventSpan(ctx, event)
+	defer span.Finish()
+
+	return handler(ctx, event)
+}
+
+func (q *Queue) deleteServer(ctx context.Context, event *chat.EventEnvelope) error {
+	serverEvent := event.GetServerDeleted()
+	if serverEvent == nil {
+		return pkgErrors.Wrap("unmarshal message", ErrUnexpectedEvent)
+	}
+
+	_, err := q.QueueUsecase.DeleteServerChannels(ctx, usecases.DeleteServerChannelsRequest{
+		ServerId: serverEvent.GetServerId(),
+	})
+	if err != nil {
+		return pkgErrors.Wrap("fail delete server channels", err)
+	}
+
+	return nil
+}
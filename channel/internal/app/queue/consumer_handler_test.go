@@ -0,0 +1,380 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	"github.com/Nixonxp/discord/channel/pkg/api/chat"
+	logger "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ConsumerHandler_HandleMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx           = context.Background() // dummy
+		brokenPayload = []byte{0xff, 0xff, 0xff}
+		// proto randomizes whitespace of its error texts, so the expected one is taken from proto itself
+		brokenPayloadErr = proto.Unmarshal(brokenPayload, &chat.EventEnvelope{})
+	)
+	type fields struct {
+		QueueUsecase    *mocks.QueueInterface
+		ConsumerService *mocks.KafkaConsumerServiceInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req kafka.Message
+	}
+
+	encode := func(event *chat.EventEnvelope) kafka.Message {
+		value, err := proto.Marshal(event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return kafka.Message{Value: value}
+	}
+
+	serverDeletedMsg := encode(&chat.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b998",
+		EventType:     chat.EventType_EVENT_TYPE_SERVER_DELETED,
+		SchemaVersion: 1,
+		Payload: &chat.EventEnvelope_ServerDeleted{
+			ServerDeleted: &chat.ServerDeletedEvent{
+				ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+			},
+		},
+	})
+
+	messageCreatedMsg := encode(&chat.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b999",
+		EventType:     chat.EventType_EVENT_TYPE_MESSAGE_CREATED,
+		SchemaVersion: 1,
+	})
+
+	deleteRequest := usecases.DeleteServerChannelsRequest{
+		ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+	}
+
+	tests := []struct {
+		name string
+		args args
+
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Server deleted",
+			args: args{
+				ctx: ctx, // dummy
+				req: serverDeletedMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Return(&models.ActionInfo{Success: true}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteServerChannels", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Unsupported event is skipped",
+			args: args{
+				ctx: ctx, // dummy
+				req: messageCreatedMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChannels", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Broken payload",
+			args: args{
+				ctx: ctx, // dummy
+				req: kafka.Message{
+					Value: brokenPayload,
+				},
+			},
+
+			wantErr:     true,
+			errorString: "unmarshal message: " + brokenPayloadErr.Error(),
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChannels", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. DeleteServerChannels returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: serverDeletedMsg,
+			},
+
+			wantErr:     true,
+			errorString: "fail delete server channels: some error",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteServerChannels", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				QueueUsecase:    mocks.NewQueueInterface(t),
+				ConsumerService: mocks.NewKafkaConsumerServiceInterface(t),
+			}
+			log, err := logger.NewLogger(logger.NewDefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			au := NewQueue(Deps{
+				QueueUsecase:    f.QueueUsecase,
+				ConsumerService: f.ConsumerService,
+				Log:             log,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			err = au.HandleMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.HandleMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ConsumerHandler_Run(t *testing.T) {
+	// prepare
+	type fields struct {
+		QueueUsecase    *mocks.QueueInterface
+		ConsumerService *mocks.KafkaConsumerServiceInterface
+	}
+
+	value, err := proto.Marshal(&chat.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b998",
+		EventType:     chat.EventType_EVENT_TYPE_SERVER_DELETED,
+		SchemaVersion: 1,
+		Payload: &chat.EventEnvelope_ServerDeleted{
+			ServerDeleted: &chat.ServerDeletedEvent{
+				ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverDeletedMsg := kafka.Message{Value: value, Offset: 1}
+	brokenMsg := kafka.Message{Value: []byte{0xff, 0xff, 0xff}, Offset: 2}
+
+	deleteRequest := usecases.DeleteServerChannelsRequest{
+		ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+	}
+
+	tests := []struct {
+		name string
+
+		wantErr     bool
+		errorString string
+
+		on     func(*fields, context.CancelFunc)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Message is committed after it is handled",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, context.Canceled).Once()
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Return(&models.ActionInfo{Success: true}, nil)
+				f.ConsumerService.On("CommitMessages", mock.Anything, serverDeletedMsg).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ConsumerService.AssertNumberOfCalls(t, "CommitMessages", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Failed message is retried before commit",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, context.Canceled).Once()
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Return(nil, errors.New("some error")).Twice()
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Return(&models.ActionInfo{Success: true}, nil).Once()
+				f.ConsumerService.On("CommitMessages", mock.Anything, serverDeletedMsg).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteServerChannels", 3)
+				f.ConsumerService.AssertNumberOfCalls(t, "CommitMessages", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Broken message is skipped and committed",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(brokenMsg, nil).Once()
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, context.Canceled).Once()
+				f.ConsumerService.On("CommitMessages", mock.Anything, brokenMsg).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChannels", mock.Anything, mock.Anything)
+				f.ConsumerService.AssertNumberOfCalls(t, "CommitMessages", 1)
+			},
+		},
+		{
+			name: "Test 4. Positive. Stop while message is retried",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, cancel context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Run(func(mock.Arguments) { cancel() }).
+					Return(nil, errors.New("some error")).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ConsumerService.AssertNotCalled(t, "CommitMessages", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. FetchMessage returns error",
+
+			wantErr:     true,
+			errorString: "fetch message: some error",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, errors.New("some error")).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChannels", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. CommitMessages returns error",
+
+			wantErr:     true,
+			errorString: "commit message: some error",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.QueueUsecase.On("DeleteServerChannels", mock.Anything, deleteRequest).
+					Return(&models.ActionInfo{Success: true}, nil)
+				f.ConsumerService.On("CommitMessages", mock.Anything, serverDeletedMsg).
+					Return(errors.New("some error"))
+			},
+			assert: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			f := &fields{
+				QueueUsecase:    mocks.NewQueueInterface(t),
+				ConsumerService: mocks.NewKafkaConsumerServiceInterface(t),
+			}
+			log, err := logger.NewLogger(logger.NewDefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			au := NewQueue(Deps{
+				QueueUsecase:    f.QueueUsecase,
+				ConsumerService: f.ConsumerService,
+				Log:             log,
+			})
+			au.minBackoff = time.Millisecond
+			au.maxBackoff = time.Millisecond
+			if tt.on != nil {
+				tt.on(f, cancel)
+			}
+
+			// act
+			err = au.Run(ctx)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
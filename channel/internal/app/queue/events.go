@@ -0,0 +1,95 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/pkg/api/chat"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	// EventSchemaVersion - version of EventEnvelope written by this producer
+	EventSchemaVersion = 1
+
+	contentTypeHeader   = "content-type"
+	eventTypeHeader     = "event-type"
+	protobufContentType = "application/x-protobuf"
+)
+
+var ErrUnexpectedEvent = errors.New("unexpected event payload")
+
+// DecodeEvent - reads envelope written by the producing service
+func DecodeEvent(message kafka.Message) (*chat.EventEnvelope, error) {
+	event := &chat.EventEnvelope{}
+	if err := proto.Unmarshal(message.Value, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// startEventSpan - continues the producer trace when the envelope carries one
+func startEventSpan(ctx context.Context, event *chat.EventEnvelope) (opentracing.Span, context.Context) {
+	operation := "queue." + event.GetEventType().String()
+
+	parent, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, opentracing.TextMapCarrier(event.GetTraceContext()))
+	if err != nil || parent == nil {
+		return opentracing.StartSpanFromContext(ctx, operation)
+	}
+
+	return opentracing.StartSpanFromContext(ctx, operation, opentracing.FollowsFrom(parent))
+}
+
+// NewChannelDeletedEvent - wraps event into envelope and injects the span from ctx as trace context
+func NewChannelDeletedEvent(ctx context.Context, deleted models.ChannelDeletedEvent) *chat.EventEnvelope {
+	serverId := ""
+	if deleted.ServerId != (models.ServerID{}) {
+		serverId = deleted.ServerId.String()
+	}
+
+	event := &chat.EventEnvelope{
+		EventId:       uuid.New().String(),
+		EventType:     chat.EventType_EVENT_TYPE_CHANNEL_DELETED,
+		SchemaVersion: EventSchemaVersion,
+		OccurredAt:    timestamppb.New(deleted.DeletedAt),
+		ProducedAt:    timestamppb.New(time.Now()),
+		TraceContext:  map[string]string{},
+		Payload: &chat.EventEnvelope_ChannelDeleted{
+			ChannelDeleted: &chat.ChannelDeletedEvent{
+				ChannelId: deleted.ChannelId.String(),
+				ServerId:  serverId,
+				DeletedAt: timestamppb.New(deleted.DeletedAt),
+			},
+		},
+	}
+
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(event.TraceContext))
+	}
+
+	return event
+}
+
+// EncodeEvent - marshals envelope into kafka message keyed by channel id,
+// so events of one channel stay in one partition
+func EncodeEvent(key string, event *chat.EventEnvelope) (kafka.Message, error) {
+	value, err := proto.Marshal(event)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Key:   []byte(key),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: contentTypeHeader, Value: []byte(protobufContentType)},
+			{Key: eventTypeHeader, Value: []byte(event.GetEventType().String())},
+		},
+	}, nil
+}
@@ -0,0 +1,35 @@
+package queue
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaChannelEvents struct {
+	conn *kafka.Conn
+}
+
+var _ usecases.KafkaProducerServiceInterface = (*KafkaChannelEvents)(nil)
+
+func NewKafkaChannelEvents(conn *kafka.Conn) *KafkaChannelEvents {
+	return &KafkaChannelEvents{
+		conn: conn,
+	}
+}
+
+func (m *KafkaChannelEvents) PublishChannelDeleted(ctx context.Context, event models.ChannelDeletedEvent) error {
+	message, err := EncodeEvent(event.ChannelId.String(), NewChannelDeletedEvent(ctx, event))
+	if err != nil {
+		return pkgErrors.Wrap("failed to encode event", err)
+	}
+
+	_, err = m.conn.WriteMessages(message)
+	if err != nil {
+		return pkgErrors.Wrap("failed to send messages", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/channel/configs"
+	"github.com/segmentio/kafka-go"
+	"log"
+)
+
+type KafkaServerEventsConsumer struct {
+	r *kafka.Reader
+}
+
+func NewKafkaServerEventsConsumer(cfg *config.Config) *KafkaServerEventsConsumer {
+	log.Printf("start listening server events from %s", cfg.Application.KafkaAddress)
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  []string{cfg.Application.KafkaAddress},
+		Topic:    cfg.Application.KafkaServerEventsTopic,
+		GroupID:  "channel-server-events",
+		MaxBytes: 10e6, // 10MB
+	})
+
+	return &KafkaServerEventsConsumer{
+		r: r,
+	}
+}
+
+func (m *KafkaServerEventsConsumer) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	return m.r.FetchMessage(ctx)
+}
+
+func (m *KafkaServerEventsConsumer) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	return m.r.CommitMessages(ctx, msgs...)
+}
+
+func (m *KafkaServerEventsConsumer) Close() error {
+	return m.r.Close()
+}
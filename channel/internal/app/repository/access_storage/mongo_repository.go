@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	repository "github.com/Nixonxp/discord/channel/internal/app/repository/channel_storage"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type MongoAccessRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.AccessStorage = (*MongoAccessRepository)(nil)
+
+func NewMongoAccessRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoAccessRepository {
+	return &MongoAccessRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - unique channel_id_user_id keeps one entry per user in a channel,
+// channel_id_status_updated_at serves access lists filtered by status
+func (r *MongoAccessRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "channel_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("channel_id_user_id").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "channel_id", Value: 1}, {Key: "status", Value: 1}, {Key: "updated_at", Value: 1}},
+			Options: options.Index().SetName("channel_id_status_updated_at"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create access indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+// GrantAccess - creates the entry or turns a pending join request into granted access
+func (r *MongoAccessRepository) GrantAccess(ctx context.Context, channelId models.ChannelID, userId models.UserID, at time.Time) error {
+	filter := bson.M{
+		"channel_id": channelId,
+		"user_id":    userId,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{
+			"status":     models.ChannelAccessGranted,
+			"updated_at": at,
+		},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("grant access error repo")
+		return err
+	}
+
+	return nil
+}
+
+// CreateJoinRequest - an existing entry of the user is left as is
+func (r *MongoAccessRepository) CreateJoinRequest(ctx context.Context, channelId models.ChannelID, userId models.UserID, at time.Time) error {
+	filter := bson.M{
+		"channel_id": channelId,
+		"user_id":    userId,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, filter, bson.M{
+		"$setOnInsert": bson.M{
+			"status":     models.ChannelAccessRequested,
+			"updated_at": at,
+		},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("create join request error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoAccessRepository) GetAccess(ctx context.Context, channelId models.ChannelID, userId models.UserID) (*models.ChannelAccess, error) {
+	filter := bson.M{
+		"channel_id": channelId,
+		"user_id":    userId,
+	}
+
+	access := &models.ChannelAccess{}
+	err := r.mongo.FindOne(ctx, filter).Decode(access)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("get access error repo")
+		return nil, err
+	}
+
+	return access, nil
+}
+
+func (r *MongoAccessRepository) DeleteAccess(ctx context.Context, channelId models.ChannelID, userId models.UserID) error {
+	filter := bson.M{
+		"channel_id": channelId,
+		"user_id":    userId,
+	}
+
+	result, err := r.mongo.DeleteOne(ctx, filter)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("delete access error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListJoinRequests - pending requests of the channel, oldest first
+func (r *MongoAccessRepository) ListJoinRequests(ctx context.Context, channelId models.ChannelID) ([]*models.ChannelAccess, error) {
+	filter := bson.M{
+		"channel_id": channelId,
+		"status":     models.ChannelAccessRequested,
+	}
+	option := options.Find().SetSort(bson.D{{Key: "updated_at", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("list join requests error repo")
+		return nil, err
+	}
+
+	requests := make([]*models.ChannelAccess, 0)
+	err = cursor.All(ctx, &requests)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("list join requests error repo")
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (r *MongoAccessRepository) DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error) {
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"channel_id": bson.M{"$in": channelIds}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete channels access error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
 )
 
 type MongoCollectionInterface interface {
@@ -20,6 +21,14 @@ type MongoCollectionInterface interface {
 		opts ...*options.FindOneOptions) *mongo.SingleResult
 	DeleteOne(ctx context.Context, filter interface{},
 		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	DeleteMany(ctx context.Context, filter interface{},
+		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	BulkWrite(ctx context.Context, models []mongo.WriteModel,
+		opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error)
+	Aggregate(ctx context.Context, pipeline interface{},
+		opts ...*options.AggregateOptions) (*mongo.Cursor, error)
+	CreateIndexes(ctx context.Context, models []mongo.IndexModel,
+		opts ...*options.CreateIndexesOptions) ([]string, error)
 }
 
 type MongoChannelRepository struct {
@@ -43,8 +52,17 @@ func (r *MongoChannelRepository) CreateChannel(ctx context.Context, channel mode
 	option.Upsert = &upsert
 
 	addMessage := bson.M{
-		"name":     channel.Name,
-		"owner_id": channel.OwnerId,
+		"name":      channel.Name,
+		"owner_id":  channel.OwnerId,
+		"server_id": channel.ServerId,
+		"type":      channel.Type,
+		"parent_id": channel.ParentId,
+		"position":  channel.Position,
+		"topic":     channel.Topic,
+		"nsfw":      channel.Nsfw,
+		"voice":     channel.Voice,
+		"forum":     channel.Forum,
+		"private":   channel.Private,
 	}
 
 	_, err := r.mongo.UpdateOne(ctx, bson.D{{"_id", uuid.UUID(channel.Id)}}, bson.M{
@@ -61,8 +79,17 @@ func (r *MongoChannelRepository) CreateChannel(ctx context.Context, channel mode
 
 const notFoundErrorStr = "mongo: no documents in result"
 
+// GetChannelById - deleted channels waiting for purge are not found
 func (r *MongoChannelRepository) GetChannelById(ctx context.Context, id models.ChannelID) (*models.Channel, error) {
-	result := r.mongo.FindOne(context.Background(), bson.M{"_id": uuid.MustParse(id.String())})
+	return r.findChannel(ctx, id, bson.M{"_id": uuid.MustParse(id.String()), "deleted_at": bson.M{"$exists": false}})
+}
+
+func (r *MongoChannelRepository) GetDeletedChannelById(ctx context.Context, id models.ChannelID) (*models.Channel, error) {
+	return r.findChannel(ctx, id, bson.M{"_id": uuid.MustParse(id.String()), "deleted_at": bson.M{"$exists": true}})
+}
+
+func (r *MongoChannelRepository) findChannel(ctx context.Context, id models.ChannelID, filter bson.M) (*models.Channel, error) {
+	result := r.mongo.FindOne(context.Background(), filter)
 
 	channel := &models.Channel{}
 	err := result.Decode(channel)
@@ -78,6 +105,29 @@ func (r *MongoChannelRepository) GetChannelById(ctx context.Context, id models.C
 	return channel, nil
 }
 
+func (r *MongoChannelRepository) UpdateChannel(ctx context.Context, channel models.Channel) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": channel.Id}, bson.M{
+		"$set": bson.M{
+			"name":    channel.Name,
+			"topic":   channel.Topic,
+			"nsfw":    channel.Nsfw,
+			"voice":   channel.Voice,
+			"forum":   channel.Forum,
+			"private": channel.Private,
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channelId", channel.Id.String()).Error("update channel error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *MongoChannelRepository) DeleteChannel(ctx context.Context, channelId models.ChannelID) error {
 	filter := bson.M{
 		"_id": channelId,
@@ -95,3 +145,144 @@ func (r *MongoChannelRepository) DeleteChannel(ctx context.Context, channelId mo
 
 	return nil
 }
+
+// ArchiveChannel - archived channels stay readable but are hidden from default listings
+func (r *MongoChannelRepository) ArchiveChannel(ctx context.Context, id models.ChannelID, at time.Time) error {
+	return r.setState(ctx, id, bson.M{"_id": uuid.UUID(id), "archived_at": bson.M{"$exists": false}}, bson.M{
+		"$set": bson.M{"archived_at": at},
+	}, "archive channel error repo")
+}
+
+func (r *MongoChannelRepository) UnarchiveChannel(ctx context.Context, id models.ChannelID) error {
+	return r.setState(ctx, id, bson.M{"_id": uuid.UUID(id), "archived_at": bson.M{"$exists": true}}, bson.M{
+		"$unset": bson.M{"archived_at": ""},
+	}, "unarchive channel error repo")
+}
+
+// MarkDeleted - hides the channel until it is restored or purged
+func (r *MongoChannelRepository) MarkDeleted(ctx context.Context, id models.ChannelID, at time.Time) error {
+	return r.setState(ctx, id, bson.M{"_id": uuid.UUID(id), "deleted_at": bson.M{"$exists": false}}, bson.M{
+		"$set": bson.M{"deleted_at": at},
+	}, "mark channel deleted error repo")
+}
+
+func (r *MongoChannelRepository) RestoreChannel(ctx context.Context, id models.ChannelID) error {
+	return r.setState(ctx, id, bson.M{"_id": uuid.UUID(id), "deleted_at": bson.M{"$exists": true}}, bson.M{
+		"$unset": bson.M{"deleted_at": ""},
+	}, "restore channel error repo")
+}
+
+// setState - ErrNotFound when the channel is missing or already in the state
+func (r *MongoChannelRepository) setState(ctx context.Context, id models.ChannelID, filter bson.M, update bson.M, errorMessage string) error {
+	result, err := r.mongo.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channelId", id.String()).Error(errorMessage)
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListDeletedBefore - deleted channels whose deletion happened before the given time, oldest first
+func (r *MongoChannelRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.Channel, error) {
+	option := options.Find().
+		SetSort(bson.D{{Key: "deleted_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": before}}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list deleted channels error repo")
+		return nil, err
+	}
+
+	channels := make([]*models.Channel, 0)
+	err = cursor.All(ctx, &channels)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list deleted channels error repo")
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// ListByServerId - archived channels are listed, deleted channels waiting for purge are skipped
+func (r *MongoChannelRepository) ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Channel, error) {
+	return r.listByServerId(ctx, serverId, bson.M{"server_id": serverId, "deleted_at": bson.M{"$exists": false}})
+}
+
+// ListAllByServerId - deleted channels waiting for purge are listed too
+func (r *MongoChannelRepository) ListAllByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Channel, error) {
+	return r.listByServerId(ctx, serverId, bson.M{"server_id": serverId})
+}
+
+func (r *MongoChannelRepository) listByServerId(ctx context.Context, serverId models.ServerID, filter bson.M) ([]*models.Channel, error) {
+	option := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list server channels error repo")
+		return nil, err
+	}
+
+	channels := make([]*models.Channel, 0)
+	err = cursor.All(ctx, &channels)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list server channels error repo")
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// GetChannelsByIds - deleted channels and channels waiting for purge are skipped
+func (r *MongoChannelRepository) GetChannelsByIds(ctx context.Context, ids []models.ChannelID) ([]*models.Channel, error) {
+	cursor, err := r.mongo.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "deleted_at": bson.M{"$exists": false}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("get channels by ids error repo")
+		return nil, err
+	}
+
+	channels := make([]*models.Channel, 0)
+	err = cursor.All(ctx, &channels)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("get channels by ids error repo")
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+func (r *MongoChannelRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("delete server channels error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
+
+// SetPositions - one ordered bulk write, channels of other servers are not matched
+func (r *MongoChannelRepository) SetPositions(ctx context.Context, serverId models.ServerID, positions []models.ChannelPosition) error {
+	writes := make([]mongo.WriteModel, len(positions))
+	for i, position := range positions {
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": position.ChannelId, "server_id": serverId}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"parent_id": position.ParentId,
+				"position":  position.Position,
+			}})
+	}
+
+	_, err := r.mongo.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("set channel positions error repo")
+		return err
+	}
+
+	return nil
+}
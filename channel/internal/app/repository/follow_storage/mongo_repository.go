@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	repository "github.com/Nixonxp/discord/channel/internal/app/repository/channel_storage"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoFollowRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.FollowStorage = (*MongoFollowRepository)(nil)
+
+func NewMongoFollowRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoFollowRepository {
+	return &MongoFollowRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - unique source_channel_id_target_channel_id lets a channel follow a source once,
+// target_channel_id finds the sources followed by a channel
+func (r *MongoFollowRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "source_channel_id", Value: 1}, {Key: "target_channel_id", Value: 1}},
+			Options: options.Index().SetName("source_channel_id_target_channel_id").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "target_channel_id", Value: 1}},
+			Options: options.Index().SetName("target_channel_id"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create follow indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+// CreateFollow - following again keeps the first follow
+func (r *MongoFollowRepository) CreateFollow(ctx context.Context, follow models.ChannelFollow) error {
+	filter := bson.M{
+		"source_channel_id": follow.SourceChannelId,
+		"target_channel_id": follow.TargetChannelId,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, filter, bson.M{
+		"$setOnInsert": bson.M{
+			"created_by": follow.CreatedBy,
+			"created_at": follow.CreatedAt,
+		},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("source_channel_id", follow.SourceChannelId).Error("create follow error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoFollowRepository) DeleteFollow(ctx context.Context, sourceChannelId models.ChannelID, targetChannelId models.ChannelID) error {
+	filter := bson.M{
+		"source_channel_id": sourceChannelId,
+		"target_channel_id": targetChannelId,
+	}
+
+	result, err := r.mongo.DeleteOne(ctx, filter)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("source_channel_id", sourceChannelId).Error("delete follow error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoFollowRepository) ListBySourceId(ctx context.Context, sourceChannelId models.ChannelID) ([]*models.ChannelFollow, error) {
+	cursor, err := r.mongo.Find(ctx, bson.M{"source_channel_id": sourceChannelId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("source_channel_id", sourceChannelId).Error("list follows error repo")
+		return nil, err
+	}
+
+	follows := make([]*models.ChannelFollow, 0)
+	err = cursor.All(ctx, &follows)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("source_channel_id", sourceChannelId).Error("list follows error repo")
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+// DeleteByChannelIds - removes follows where the channels are either the source or the target
+func (r *MongoFollowRepository) DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error) {
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"$or": bson.A{
+		bson.M{"source_channel_id": bson.M{"$in": channelIds}},
+		bson.M{"target_channel_id": bson.M{"$in": channelIds}},
+	}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete channels follows error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
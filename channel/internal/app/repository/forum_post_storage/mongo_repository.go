@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	repository "github.com/Nixonxp/discord/channel/internal/app/repository/channel_storage"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type MongoForumPostRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.ForumPostStorage = (*MongoForumPostRepository)(nil)
+
+func NewMongoForumPostRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoForumPostRepository {
+	return &MongoForumPostRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - channel_id_last_activity_at and channel_id_created_at serve the two sorts of post lists
+func (r *MongoForumPostRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "channel_id", Value: 1}, {Key: "last_activity_at", Value: -1}},
+			Options: options.Index().SetName("channel_id_last_activity_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "channel_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("channel_id_created_at"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create forum post indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoForumPostRepository) CreatePost(ctx context.Context, post models.ForumPost) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(post.Id)}, bson.M{
+		"$setOnInsert": bson.M{
+			"channel_id":       post.ChannelId,
+			"owner_id":         post.OwnerId,
+			"title":            post.Title,
+			"tags":             post.Tags,
+			"created_at":       post.CreatedAt,
+			"last_activity_at": post.LastActivityAt,
+		},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("post_id", post.Id.String()).Error("create forum post error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoForumPostRepository) GetPostById(ctx context.Context, id models.PostID) (*models.ForumPost, error) {
+	result := r.mongo.FindOne(ctx, bson.M{"_id": uuid.UUID(id)})
+
+	post := &models.ForumPost{}
+	err := result.Decode(post)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("post_id", id.String()).Error("get forum post error repo")
+		return nil, err
+	}
+
+	return post, nil
+}
+
+// ListByChannelId - newest first by the sort field, only posts marked with the tag when it is set
+func (r *MongoForumPostRepository) ListByChannelId(ctx context.Context, channelId models.ChannelID, sort models.ForumSort, tag string) ([]*models.ForumPost, error) {
+	sortField := "last_activity_at"
+	if sort == models.ForumSortCreationDate {
+		sortField = "created_at"
+	}
+
+	filter := bson.M{"channel_id": channelId}
+	if tag != "" {
+		filter["tags"] = tag
+	}
+
+	option := options.Find().SetSort(bson.D{{Key: sortField, Value: -1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId.String()).Error("list forum posts error repo")
+		return nil, err
+	}
+
+	posts := make([]*models.ForumPost, 0)
+	err = cursor.All(ctx, &posts)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId.String()).Error("list forum posts error repo")
+		return nil, err
+	}
+
+	return posts, nil
+}
+
+// UpdateLastActivity - activity never moves back when messages are handled out of order
+func (r *MongoForumPostRepository) UpdateLastActivity(ctx context.Context, id models.PostID, at time.Time) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$max": bson.M{"last_activity_at": at},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("post_id", id.String()).Error("update forum post activity error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoForumPostRepository) DeletePost(ctx context.Context, id models.PostID) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(id)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("post_id", id.String()).Error("delete forum post error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoForumPostRepository) DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error) {
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"channel_id": bson.M{"$in": channelIds}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete channels forum posts error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
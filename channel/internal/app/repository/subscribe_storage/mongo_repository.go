@@ -8,6 +8,7 @@ import (
 	log "github.com/Nixonxp/discord/channel/pkg/logger"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
@@ -25,6 +26,31 @@ func NewMongoSubscribeRepository(mongo repository.MongoCollectionInterface, log
 	}
 }
 
+// EnsureIndexes - channel_id_id pages member lists, user_id_joined_at lists channels of a user,
+// unique channel_id_user_id keeps one subscription per user, it can not be built while duplicated subscriptions exist
+func (r *MongoSubscribeRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "channel_id", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("channel_id_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "channel_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("channel_id_user_id").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "joined_at", Value: 1}},
+			Options: options.Index().SetName("user_id_joined_at"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create subscribe indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
 func (r *MongoSubscribeRepository) CreateSubscribe(ctx context.Context, subscribe models.SubscribeInfo) error {
 	upsert := true
 
@@ -34,12 +60,16 @@ func (r *MongoSubscribeRepository) CreateSubscribe(ctx context.Context, subscrib
 	addSubscribe := bson.M{
 		"channel_id": subscribe.ChannelId,
 		"user_id":    subscribe.UserId,
+		"joined_at":  subscribe.JoinedAt,
 	}
 
 	_, err := r.mongo.UpdateOne(ctx, bson.D{{"_id", uuid.UUID(subscribe.Id)}}, bson.M{
 		"$set": addSubscribe,
 	}, option)
 
+	if mongo.IsDuplicateKeyError(err) {
+		return models.ErrAlreadyExists
+	}
 	if err != nil {
 		r.log.WithContext(ctx).WithError(err).WithField("channel_id", subscribe.ChannelId).Error("create subscribe error repo")
 		return err
@@ -66,3 +96,133 @@ func (r *MongoSubscribeRepository) DeleteSubscribe(ctx context.Context, channelI
 
 	return nil
 }
+
+func (r *MongoSubscribeRepository) DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error) {
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"channel_id": bson.M{"$in": channelIds}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete channels subscribes error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoSubscribeRepository) GetSubscribe(ctx context.Context, channelId models.ChannelID, userId models.UserID) (*models.SubscribeInfo, error) {
+	filter := bson.M{
+		"channel_id": channelId,
+		"user_id":    userId,
+	}
+
+	subscribe := &models.SubscribeInfo{}
+	err := r.mongo.FindOne(ctx, filter).Decode(subscribe)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("get subscribe error repo")
+		return nil, err
+	}
+
+	return subscribe, nil
+}
+
+// ListByChannelId - subscriptions of the channel ordered by id, after is the last id of the previous page
+func (r *MongoSubscribeRepository) ListByChannelId(ctx context.Context, channelId models.ChannelID, after *models.SubscribeID, limit int) ([]*models.SubscribeInfo, error) {
+	filter := bson.M{"channel_id": channelId}
+	if after != nil {
+		filter["_id"] = bson.M{"$gt": uuid.UUID(*after)}
+	}
+
+	option := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("list subscribes error repo")
+		return nil, err
+	}
+
+	var subscribes []*models.SubscribeInfo
+	err = cursor.All(ctx, &subscribes)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("channel_id", channelId).Error("list subscribes error repo")
+		return nil, err
+	}
+
+	return subscribes, nil
+}
+
+// ListByUserId - subscriptions of the user in join order
+func (r *MongoSubscribeRepository) ListByUserId(ctx context.Context, userId models.UserID) ([]*models.SubscribeInfo, error) {
+	option := options.Find().SetSort(bson.D{{Key: "joined_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"user_id": userId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("user_id", userId.String()).Error("list user subscribes error repo")
+		return nil, err
+	}
+
+	var subscribes []*models.SubscribeInfo
+	err = cursor.All(ctx, &subscribes)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("user_id", userId.String()).Error("list user subscribes error repo")
+		return nil, err
+	}
+
+	return subscribes, nil
+}
+
+// FindDuplicates - ids of extra subscriptions for every (channel_id, user_id) pair,
+// the earliest subscription of the pair is kept
+func (r *MongoSubscribeRepository) FindDuplicates(ctx context.Context) ([]models.SubscribeID, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "joined_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"channel_id": "$channel_id", "user_id": "$user_id"},
+			"ids":   bson.M{"$push": "$_id"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	}
+
+	cursor, err := r.mongo.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("find duplicated subscribes error repo")
+		return nil, err
+	}
+
+	var groups []struct {
+		Ids []models.SubscribeID `bson:"ids"`
+	}
+	err = cursor.All(ctx, &groups)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("find duplicated subscribes error repo")
+		return nil, err
+	}
+
+	duplicates := make([]models.SubscribeID, 0)
+	for _, group := range groups {
+		duplicates = append(duplicates, group.Ids[1:]...)
+	}
+
+	return duplicates, nil
+}
+
+func (r *MongoSubscribeRepository) DeleteByIds(ctx context.Context, ids []models.SubscribeID) (int64, error) {
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		uuids[i] = uuid.UUID(id)
+	}
+
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": uuids}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete subscribes error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
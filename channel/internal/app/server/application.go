@@ -38,6 +38,10 @@ func NewApplication(cfg *config.Config) *Application {
 			&srv.tracer,
 			&srv.logger,
 			&srv.mongo,
+			&srv.serverSvcClient,
+			&srv.chatSvcClient,
+			&srv.serverEvents,
+			&srv.channelEvents,
 		},
 		ShutdownTimeout: terminationTimeout,
 		Cfg:             cfg,
@@ -23,6 +23,14 @@ func (s *ChannelServer) AddChannel(ctx context.Context, req *pb.AddChannelReques
 
 	result, err := s.ChannelUsecase.AddChannel(ctx, usecases.AddChannelRequest{
 		Name:          req.GetName(),
+		ServerId:      req.GetServerId(),
+		Type:          req.GetType(),
+		ParentId:      req.GetParentId(),
+		Topic:         req.GetTopic(),
+		Nsfw:          req.GetNsfw(),
+		Voice:         voiceSettingsFromPb(req.GetVoice()),
+		Forum:         forumSettingsFromPb(req.GetForum()),
+		Private:       req.GetPrivate(),
 		CurrentUserId: userId,
 	})
 	if err != nil {
@@ -34,6 +42,35 @@ func (s *ChannelServer) AddChannel(ctx context.Context, req *pb.AddChannelReques
 	}, nil
 }
 
+func (s *ChannelServer) UpdateChannel(ctx context.Context, req *pb.UpdateChannelRequest) (*pb.Channel, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Update channel: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.UpdateChannel(ctx, usecases.UpdateChannelRequest{
+		ChannelId:     req.GetChannelId(),
+		Name:          req.Name,
+		Topic:         req.Topic,
+		Nsfw:          req.Nsfw,
+		Voice:         voiceSettingsFromPb(req.GetVoice()),
+		Forum:         forumSettingsFromPb(req.GetForum()),
+		Private:       req.Private,
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channelToPb(result), nil
+}
+
 func (s *ChannelServer) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.ActionResponse, error) {
 	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Delete channel: received")
 
@@ -59,6 +96,81 @@ func (s *ChannelServer) DeleteChannel(ctx context.Context, req *pb.DeleteChannel
 	}, nil
 }
 
+func (s *ChannelServer) RestoreChannel(ctx context.Context, req *pb.RestoreChannelRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Restore channel: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.RestoreChannel(ctx, usecases.RestoreChannelRequest{
+		ChannelId:     req.GetChannelId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) ArchiveChannel(ctx context.Context, req *pb.ArchiveChannelRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Archive channel: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.ArchiveChannel(ctx, usecases.ArchiveChannelRequest{
+		ChannelId:     req.GetChannelId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) UnarchiveChannel(ctx context.Context, req *pb.ArchiveChannelRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Unarchive channel: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.UnarchiveChannel(ctx, usecases.ArchiveChannelRequest{
+		ChannelId:     req.GetChannelId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
 func (s *ChannelServer) JoinChannel(ctx context.Context, req *pb.JoinChannelRequest) (*pb.ActionResponse, error) {
 	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Join channel: received")
 
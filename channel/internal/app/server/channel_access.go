@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/channel/pkg/api/v1"
+	"github.com/Nixonxp/discord/channel/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/channel/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ChannelServer) GrantChannelAccess(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Grant channel access: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.GrantChannelAccess(ctx, usecases.ChannelAccessRequest{
+		ChannelId:     req.GetChannelId(),
+		UserId:        req.GetUserId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) RevokeChannelAccess(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Revoke channel access: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.RevokeChannelAccess(ctx, usecases.ChannelAccessRequest{
+		ChannelId:     req.GetChannelId(),
+		UserId:        req.GetUserId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) RequestChannelAccess(ctx context.Context, req *pb.RequestChannelAccessRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Request channel access: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.RequestChannelAccess(ctx, usecases.RequestChannelAccessRequest{
+		ChannelId:     req.GetChannelId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) ListChannelJoinRequests(ctx context.Context, req *pb.ListChannelJoinRequestsRequest) (*pb.ListChannelJoinRequestsResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("List channel join requests: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	requests, err := s.ChannelUsecase.ListChannelJoinRequests(ctx, usecases.ListChannelJoinRequestsRequest{
+		ChannelId:     req.GetChannelId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.ChannelJoinRequest, len(requests))
+	for i, request := range requests {
+		result[i] = &pb.ChannelJoinRequest{
+			UserId:      request.UserId.String(),
+			RequestedAt: timestamppb.New(request.UpdatedAt),
+		}
+	}
+
+	return &pb.ListChannelJoinRequestsResponse{
+		Requests: result,
+	}, nil
+}
+
+func (s *ChannelServer) ApproveChannelJoinRequest(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Approve channel join request: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.ApproveChannelJoinRequest(ctx, usecases.ChannelAccessRequest{
+		ChannelId:     req.GetChannelId(),
+		UserId:        req.GetUserId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
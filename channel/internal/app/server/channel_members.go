@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/channel/pkg/api/v1"
+	"github.com/Nixonxp/discord/channel/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/channel/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ChannelServer) ListMyChannels(ctx context.Context, req *pb.ListMyChannelsRequest) (*pb.ListMyChannelsResponse, error) {
+	s.Log.WithContext(ctx).Info("List my channels: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	channels, err := s.ChannelUsecase.ListMyChannels(ctx, usecases.ListMyChannelsRequest{
+		IncludeArchived: req.GetIncludeArchived(),
+		CurrentUserId:   userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMyChannelsResponse{
+		Channels: channelsToPb(channels),
+	}, nil
+}
+
+func (s *ChannelServer) ListChannelMembers(ctx context.Context, req *pb.ListChannelMembersRequest) (*pb.ListChannelMembersResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("List channel members: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.ListChannelMembers(ctx, usecases.ListChannelMembersRequest{
+		ChannelId:     req.GetChannelId(),
+		Cursor:        req.GetCursor(),
+		Limit:         int(req.GetLimit()),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*pb.ChannelMember, len(result.Members))
+	for i, member := range result.Members {
+		members[i] = channelMemberToPb(member)
+	}
+
+	return &pb.ListChannelMembersResponse{
+		Members:    members,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func (s *ChannelServer) IsChannelMember(ctx context.Context, req *pb.IsChannelMemberRequest) (*pb.IsChannelMemberResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Is channel member: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	isMember, err := s.ChannelUsecase.IsChannelMember(ctx, usecases.IsChannelMemberRequest{
+		ChannelId:     req.GetChannelId(),
+		UserId:        req.GetUserId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.IsChannelMemberResponse{
+		IsMember: isMember,
+	}, nil
+}
+
+func channelMemberToPb(member *models.SubscribeInfo) *pb.ChannelMember {
+	result := &pb.ChannelMember{
+		UserId: member.UserId.String(),
+	}
+	if !member.JoinedAt.IsZero() {
+		result.JoinedAt = timestamppb.New(member.JoinedAt)
+	}
+
+	return result
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/channel/pkg/api/v1"
+	"github.com/Nixonxp/discord/channel/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/channel/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ChannelServer) SendChannelMessage(ctx context.Context, req *pb.SendChannelMessageRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Send channel message: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.SendChannelMessage(ctx, usecases.SendChannelMessageRequest{
+		ChannelId:     req.GetChannelId(),
+		Text:          req.GetText(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) GetChannelMessages(ctx context.Context, req *pb.GetChannelMessagesRequest) (*pb.GetChannelMessagesResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Get channel messages: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.GetChannelMessages(ctx, usecases.GetChannelMessagesRequest{
+		ChannelId:     req.GetChannelId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*pb.ChannelMessage, len(result))
+	for i, message := range result {
+		messages[i] = channelMessageToPb(message)
+	}
+
+	return &pb.GetChannelMessagesResponse{
+		Messages: messages,
+	}, nil
+}
+
+func (s *ChannelServer) FollowChannel(ctx context.Context, req *pb.FollowChannelRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetSourceChannelId()).Info("Follow channel: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.FollowChannel(ctx, usecases.FollowChannelRequest{
+		SourceChannelId: req.GetSourceChannelId(),
+		TargetChannelId: req.GetTargetChannelId(),
+		CurrentUserId:   userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) UnfollowChannel(ctx context.Context, req *pb.FollowChannelRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetSourceChannelId()).Info("Unfollow channel: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.UnfollowChannel(ctx, usecases.FollowChannelRequest{
+		SourceChannelId: req.GetSourceChannelId(),
+		TargetChannelId: req.GetTargetChannelId(),
+		CurrentUserId:   userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) PublishChannelMessage(ctx context.Context, req *pb.PublishChannelMessageRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Publish channel message: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.PublishChannelMessage(ctx, usecases.PublishChannelMessageRequest{
+		ChannelId:     req.GetChannelId(),
+		MessageId:     req.GetMessageId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func channelMessageToPb(message *models.ChannelMessage) *pb.ChannelMessage {
+	result := &pb.ChannelMessage{
+		Id:        message.Id,
+		Text:      message.Text,
+		Timestamp: timestamppb.New(message.Timestamp),
+		OwnerId:   message.OwnerId,
+	}
+	if message.Reference != nil {
+		result.Reference = &pb.MessageReference{
+			ChannelId: message.Reference.ChannelId,
+			MessageId: message.Reference.MessageId,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/channel/pkg/api/v1"
+	"github.com/Nixonxp/discord/channel/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/channel/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ChannelServer) CreateForumPost(ctx context.Context, req *pb.CreateForumPostRequest) (*pb.ForumPost, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("Create forum post: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	post, err := s.ChannelUsecase.CreateForumPost(ctx, usecases.CreateForumPostRequest{
+		ChannelId:     req.GetChannelId(),
+		Title:         req.GetTitle(),
+		Text:          req.GetText(),
+		Tags:          req.GetTags(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forumPostToPb(post), nil
+}
+
+func (s *ChannelServer) ListForumPosts(ctx context.Context, req *pb.ListForumPostsRequest) (*pb.ListForumPostsResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetChannelId()).Info("List forum posts: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.ListForumPosts(ctx, usecases.ListForumPostsRequest{
+		ChannelId:     req.GetChannelId(),
+		Sort:          req.GetSort(),
+		Tag:           req.GetTag(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*pb.ForumPost, len(result))
+	for i, post := range result {
+		posts[i] = forumPostToPb(post)
+	}
+
+	return &pb.ListForumPostsResponse{
+		Posts: posts,
+	}, nil
+}
+
+func (s *ChannelServer) SendForumPostMessage(ctx context.Context, req *pb.SendForumPostMessageRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetPostId()).Info("Send forum post message: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.SendForumPostMessage(ctx, usecases.SendForumPostMessageRequest{
+		PostId:        req.GetPostId(),
+		Text:          req.GetText(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChannelServer) GetForumPostMessages(ctx context.Context, req *pb.GetForumPostMessagesRequest) (*pb.GetChannelMessagesResponse, error) {
+	s.Log.WithContext(ctx).WithField("id", req.GetPostId()).Info("Get forum post messages: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.GetForumPostMessages(ctx, usecases.GetForumPostMessagesRequest{
+		PostId:        req.GetPostId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*pb.ChannelMessage, len(result))
+	for i, message := range result {
+		messages[i] = channelMessageToPb(message)
+	}
+
+	return &pb.GetChannelMessagesResponse{
+		Messages: messages,
+	}, nil
+}
+
+func forumPostToPb(post *models.ForumPost) *pb.ForumPost {
+	return &pb.ForumPost{
+		Id:             post.Id.String(),
+		ChannelId:      post.ChannelId.String(),
+		OwnerId:        post.OwnerId.String(),
+		Title:          post.Title,
+		Tags:           post.Tags,
+		CreatedAt:      timestamppb.New(post.CreatedAt),
+		LastActivityAt: timestamppb.New(post.LastActivityAt),
+	}
+}
@@ -3,7 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/Nixonxp/discord/channel/internal/app/queue"
+	access_repository "github.com/Nixonxp/discord/channel/internal/app/repository/access_storage"
 	repository "github.com/Nixonxp/discord/channel/internal/app/repository/channel_storage"
+	follow_repository "github.com/Nixonxp/discord/channel/internal/app/repository/follow_storage"
+	post_repository "github.com/Nixonxp/discord/channel/internal/app/repository/forum_post_storage"
 	sub_repository "github.com/Nixonxp/discord/channel/internal/app/repository/subscribe_storage"
 	"github.com/Nixonxp/discord/channel/internal/app/usecases"
 	channel_usc "github.com/Nixonxp/discord/channel/internal/app/usecases/channel"
@@ -63,9 +67,30 @@ func NewChannelServer(ctx context.Context, s *MainServer) (*ChannelServer, error
 			protovalidate.WithDisableLazy(true),
 			protovalidate.WithMessages(
 				&pb.AddChannelRequest{},
+				&pb.UpdateChannelRequest{},
 				&pb.DeleteChannelRequest{},
+				&pb.RestoreChannelRequest{},
+				&pb.ArchiveChannelRequest{},
 				&pb.JoinChannelRequest{},
 				&pb.LeaveChannelRequest{},
+				&pb.ListMyChannelsRequest{},
+				&pb.ListChannelMembersRequest{},
+				&pb.IsChannelMemberRequest{},
+				&pb.ChannelAccessRequest{},
+				&pb.RequestChannelAccessRequest{},
+				&pb.ListChannelJoinRequestsRequest{},
+				&pb.SendChannelMessageRequest{},
+				&pb.GetChannelMessagesRequest{},
+				&pb.FollowChannelRequest{},
+				&pb.PublishChannelMessageRequest{},
+				&pb.CreateForumPostRequest{},
+				&pb.ListForumPostsRequest{},
+				&pb.SendForumPostMessageRequest{},
+				&pb.GetForumPostMessagesRequest{},
+				&pb.ReorderChannelsRequest{},
+				&pb.ListServerChannelsRequest{},
+				&pb.CreateServerChannelsRequest{},
+				&pb.DeleteServerChannelsRequest{},
 			),
 		)
 		if err != nil {
@@ -80,12 +105,68 @@ func NewChannelServer(ctx context.Context, s *MainServer) (*ChannelServer, error
 		return nil, fmt.Errorf("failed to connect mongo: %v", err)
 	}
 
+	accessCollection, err := mainCollection.NewCollection(s.cfg.Application.ChannelAccessCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	followCollection, err := mainCollection.NewCollection(s.cfg.Application.ChannelFollowCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	postCollection, err := mainCollection.NewCollection(s.cfg.Application.ForumPostCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	// indexes are created on every start, creating an index that already exists is a no-op
 	channelRepo := repository.NewMongoChannelRepository(mainCollection, s.logger.GetInstance())
 	subscribeRepo := sub_repository.NewMongoSubscribeRepository(subscribeCollection, s.logger.GetInstance())
+	err = subscribeRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscribe indexes, duplicated subscribes are removed by repair-subscribes: %v", err)
+	}
+	accessRepo := access_repository.NewMongoAccessRepository(accessCollection, s.logger.GetInstance())
+	err = accessRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access indexes: %v", err)
+	}
+	followRepo := follow_repository.NewMongoFollowRepository(followCollection, s.logger.GetInstance())
+	err = followRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create follow indexes: %v", err)
+	}
+	postRepo := post_repository.NewMongoForumPostRepository(postCollection, s.logger.GetInstance())
+	err = postRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create forum post indexes: %v", err)
+	}
 	authUsecase := channel_usc.NewChannelUsecase(channel_usc.Deps{
 		ChannelRepo:   channelRepo,
 		SubscribeRepo: subscribeRepo,
+		AccessRepo:    accessRepo,
+		FollowRepo:    followRepo,
+		PostRepo:      postRepo,
+		ServerService: s.serverSvcClient.GetInstance(),
+		ChatService:   s.chatSvcClient.GetInstance(),
+		ChannelEvents: s.channelEvents.GetInstance(),
+		Log:           s.logger.GetInstance(),
+	})
+	go runChannelPurge(ctx, authUsecase, s.cfg.Application.ChannelPurgeInterval, s.logger.GetInstance())
+
+	queueHandler := queue.NewQueue(queue.Deps{
+		QueueUsecase:    authUsecase,
+		ConsumerService: s.serverEvents.GetInstance(),
+		Log:             s.logger.GetInstance(),
 	})
+	go func() {
+		err := queueHandler.Run(ctx)
+		if err != nil {
+			// offsets of unhandled events are not committed, the restarted service consumes them again
+			s.logger.GetInstance().Fatalf("server events consumer stopped: %v", err)
+		}
+	}()
 
 	globalLimiter := rate_limiter.NewRateLimiter(1000)
 	grpcConfig := Config{
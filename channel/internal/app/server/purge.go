@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"time"
+)
+
+// runChannelPurge - purges deleted channels with expired grace period until ctx is done
+func runChannelPurge(ctx context.Context, channelUsecase usecases.UsecaseInterface, interval time.Duration, l *log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := channelUsecase.PurgeDeletedChannels(ctx)
+			if err != nil {
+				l.WithContext(ctx).WithError(err).Error("purge deleted channels error")
+			}
+			if purged > 0 {
+				l.WithContext(ctx).Infof("purged deleted channels: %d", purged)
+			}
+		}
+	}
+}
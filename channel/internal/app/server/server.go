@@ -4,6 +4,9 @@ import (
 	"context"
 	config "github.com/Nixonxp/discord/channel/configs"
 	"github.com/Nixonxp/discord/channel/internal/app/services"
+	chat_svc "github.com/Nixonxp/discord/channel/internal/app/services/chat"
+	kafka_svc "github.com/Nixonxp/discord/channel/internal/app/services/kafka"
+	server_svc "github.com/Nixonxp/discord/channel/internal/app/services/server"
 	"github.com/Nixonxp/discord/channel/pkg/servers"
 	"sync"
 	"time"
@@ -19,11 +22,15 @@ type Server interface {
 var terminationTimeout = time.Second * 10
 
 type MainServer struct {
-	tracer  services.Tracing
-	logger  services.Logger
-	mongo   services.Mongo
-	servers []Server
-	cfg     *config.Config
+	tracer          services.Tracing
+	logger          services.Logger
+	mongo           services.Mongo
+	serverSvcClient server_svc.ServerClient
+	chatSvcClient   chat_svc.ChatClient
+	serverEvents    kafka_svc.KafkaServerEventsConsumer
+	channelEvents   kafka_svc.KafkaChannelEventsProducer
+	servers         []Server
+	cfg             *config.Config
 }
 
 func (s *MainServer) AddServer(srv Server) {
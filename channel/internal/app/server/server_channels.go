@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/channel/pkg/api/v1"
+	"github.com/Nixonxp/discord/channel/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/channel/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ChannelServer) ListServerChannels(ctx context.Context, req *pb.ListServerChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	s.Log.WithContext(ctx).WithField("server_id", req.GetServerId()).Info("List server channels: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ChannelUsecase.ListServerChannels(ctx, usecases.ListServerChannelsRequest{
+		ServerId:        req.GetServerId(),
+		IncludeArchived: req.GetIncludeArchived(),
+		CurrentUserId:   userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListServerChannelsResponse{
+		Channels: channelsToPb(result),
+	}, nil
+}
+
+func (s *ChannelServer) ReorderChannels(ctx context.Context, req *pb.ReorderChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	s.Log.WithContext(ctx).WithField("server_id", req.GetServerId()).Info("Reorder channels: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	positions := make([]usecases.ChannelPosition, len(req.GetPositions()))
+	for i, position := range req.GetPositions() {
+		positions[i] = usecases.ChannelPosition{
+			ChannelId: position.GetChannelId(),
+			ParentId:  position.GetParentId(),
+			Position:  int(position.GetPosition()),
+		}
+	}
+
+	result, err := s.ChannelUsecase.ReorderChannels(ctx, usecases.ReorderChannelsRequest{
+		ServerId:      req.GetServerId(),
+		Positions:     positions,
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListServerChannelsResponse{
+		Channels: channelsToPb(result),
+	}, nil
+}
+
+func (s *ChannelServer) CreateServerChannels(ctx context.Context, req *pb.CreateServerChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	s.Log.WithContext(ctx).WithField("server_id", req.GetServerId()).Info("Create server channels: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ChannelUsecase.CreateServerChannels(ctx, usecases.CreateServerChannelsRequest{
+		ServerId: req.GetServerId(),
+		OwnerId:  req.GetOwnerId(),
+		Channels: serverChannelsFromPb(req.GetChannels()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListServerChannelsResponse{
+		Channels: channelsToPb(result),
+	}, nil
+}
+
+func (s *ChannelServer) DeleteServerChannels(ctx context.Context, req *pb.DeleteServerChannelsRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("server_id", req.GetServerId()).Info("Delete server channels: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ChannelUsecase.DeleteServerChannels(ctx, usecases.DeleteServerChannelsRequest{
+		ServerId: req.GetServerId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func channelsToPb(channels []*models.Channel) []*pb.Channel {
+	result := make([]*pb.Channel, len(channels))
+	for i, channel := range channels {
+		result[i] = channelToPb(channel)
+	}
+
+	return result
+}
+
+func channelToPb(channel *models.Channel) *pb.Channel {
+	result := &pb.Channel{
+		Id:       channel.Id.String(),
+		Name:     channel.Name,
+		OwnerId:  channel.OwnerId.String(),
+		ServerId: channel.ServerId.String(),
+		Type:     string(channel.ChannelType()),
+		Position: int32(channel.Position),
+		Topic:    channel.Topic,
+		Nsfw:     channel.Nsfw,
+		Private:  channel.Private,
+	}
+	if channel.HasParent() {
+		result.ParentId = channel.ParentId.String()
+	}
+	if channel.Voice != nil {
+		result.Voice = &pb.VoiceSettings{
+			Bitrate:   int32(channel.Voice.Bitrate),
+			UserLimit: int32(channel.Voice.UserLimit),
+		}
+	}
+	if channel.Forum != nil {
+		result.Forum = &pb.ForumSettings{
+			DefaultSort: string(channel.Forum.DefaultSort),
+			Tags:        channel.Forum.Tags,
+		}
+	}
+	if channel.IsArchived() {
+		result.ArchivedAt = timestamppb.New(channel.ArchivedAt)
+	}
+
+	return result
+}
+
+func serverChannelsFromPb(channels []*pb.ServerChannel) []usecases.ServerChannel {
+	result := make([]usecases.ServerChannel, len(channels))
+	for i, channel := range channels {
+		result[i] = usecases.ServerChannel{
+			Name:    channel.GetName(),
+			Type:    channel.GetType(),
+			Topic:   channel.GetTopic(),
+			Nsfw:    channel.GetNsfw(),
+			Voice:   voiceSettingsFromPb(channel.GetVoice()),
+			Forum:   forumSettingsFromPb(channel.GetForum()),
+			Private: channel.GetPrivate(),
+		}
+		if channel.ParentIndex != nil {
+			parentIndex := int(channel.GetParentIndex())
+			result[i].ParentIndex = &parentIndex
+		}
+	}
+
+	return result
+}
+
+func voiceSettingsFromPb(settings *pb.VoiceSettings) *models.VoiceSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &models.VoiceSettings{
+		Bitrate:   int(settings.GetBitrate()),
+		UserLimit: int(settings.GetUserLimit()),
+	}
+}
+
+func forumSettingsFromPb(settings *pb.ForumSettings) *models.ForumSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &models.ForumSettings{
+		DefaultSort: models.ForumSort(settings.GetDefaultSort()),
+		Tags:        append([]string{}, settings.GetTags()...),
+	}
+}
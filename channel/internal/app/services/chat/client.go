@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/channel/configs"
+	"github.com/Nixonxp/discord/channel/internal/app/services"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/pkg/api/chat"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	grpc_opentracing "github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"time"
+)
+
+type ChatClient struct {
+	client chat.ChatServiceClient
+	log    *log.Logger
+}
+
+var _ usecases.ServiceChatInterface = (*ChatClient)(nil)
+
+func (s *ChatClient) Init(ctx context.Context, cfg *config.Config) error {
+	chatConn, err := grpc.DialContext(ctx,
+		cfg.Application.ChatServiceHost,
+		grpc.WithIdleTimeout(10*time.Second),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			grpc_opentracing.OpenTracingClientInterceptor(opentracing.GlobalTracer(), grpc_opentracing.LogPayloads()),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	logger := services.Logger{}
+	err = logger.Init(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	s.client = chat.NewChatServiceClient(chatConn)
+	s.log = logger.GetInstance()
+
+	return nil
+}
+
+func (s *ChatClient) Ident() string {
+	return "chat service"
+}
+
+func (s *ChatClient) GetInstance() *ChatClient {
+	return s
+}
+
+func (s *ChatClient) Close(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,103 @@
+package chat
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/pkg/api/chat"
+	"github.com/opentracing/opentracing-go"
+	"time"
+)
+
+func (s *ChatClient) SendChannelMessage(ctx context.Context, req usecases.SendChannelMessageRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.SendChannelMessage")
+	defer span.Finish()
+
+	_, err := s.client.SendChannelMessage(ctx, &chat.SendChannelMessageRequest{
+		ChannelId: req.ChannelId,
+		Text:      req.Text,
+		UserId:    req.CurrentUserId,
+	})
+
+	return err
+}
+
+func (s *ChatClient) GetChannelMessages(ctx context.Context, channelId models.ChannelID) ([]*models.ChannelMessage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.GetChannelMessages")
+	defer span.Finish()
+
+	response, err := s.client.GetChannelMessages(ctx, &chat.GetChannelMessagesRequest{
+		ChannelId: channelId.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messagesFromPb(response.GetMessages()), nil
+}
+
+func (s *ChatClient) PublishChannelMessage(ctx context.Context, req usecases.PublishChannelMessageRequest, targetChannelIds []models.ChannelID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.PublishChannelMessage")
+	defer span.Finish()
+
+	targets := make([]string, len(targetChannelIds))
+	for i, id := range targetChannelIds {
+		targets[i] = id.String()
+	}
+
+	_, err := s.client.PublishChannelMessage(ctx, &chat.PublishChannelMessageRequest{
+		ChannelId:        req.ChannelId,
+		MessageId:        req.MessageId,
+		TargetChannelIds: targets,
+	})
+
+	return err
+}
+
+func (s *ChatClient) SendPostMessage(ctx context.Context, post *models.ForumPost, text string, userId models.UserID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.SendPostMessage")
+	defer span.Finish()
+
+	_, err := s.client.SendPostMessage(ctx, &chat.SendPostMessageRequest{
+		PostId:    post.Id.String(),
+		ChannelId: post.ChannelId.String(),
+		Text:      text,
+		UserId:    userId.String(),
+	})
+
+	return err
+}
+
+func (s *ChatClient) GetPostMessages(ctx context.Context, postId models.PostID) ([]*models.ChannelMessage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.GetPostMessages")
+	defer span.Finish()
+
+	response, err := s.client.GetPostMessages(ctx, &chat.GetPostMessagesRequest{
+		PostId: postId.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messagesFromPb(response.GetMessages()), nil
+}
+
+func messagesFromPb(data []*chat.Message) []*models.ChannelMessage {
+	messages := make([]*models.ChannelMessage, len(data))
+	for k, v := range data {
+		messages[k] = &models.ChannelMessage{
+			Id:        v.GetId(),
+			Text:      v.GetText(),
+			OwnerId:   v.GetOwnerId(),
+			Timestamp: time.Unix(v.GetTimestamp().GetSeconds(), int64(v.GetTimestamp().GetNanos())),
+		}
+		if v.GetReference() != nil {
+			messages[k].Reference = &models.MessageReference{
+				ChannelId: v.GetReference().GetChannelId(),
+				MessageId: v.GetReference().GetMessageId(),
+			}
+		}
+	}
+
+	return messages
+}
@@ -0,0 +1,42 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	config "github.com/Nixonxp/discord/channel/configs"
+	"github.com/Nixonxp/discord/channel/internal/app/queue"
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaChannelEventsProducer struct {
+	conn   *kafka.Conn
+	events *queue.KafkaChannelEvents
+}
+
+func (k *KafkaChannelEventsProducer) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+	k.conn, err = kafka.DialLeader(ctx, "tcp", cfg.Application.KafkaAddress, cfg.Application.KafkaChannelEventsTopic, 0)
+	if err != nil {
+		return fmt.Errorf("failed to dial leader: %w", err)
+	}
+
+	k.events = queue.NewKafkaChannelEvents(k.conn)
+
+	return nil
+}
+
+func (k *KafkaChannelEventsProducer) GetInstance() *queue.KafkaChannelEvents {
+	return k.events
+}
+
+func (k *KafkaChannelEventsProducer) Ident() string {
+	return "kafka channel events producer"
+}
+
+func (k *KafkaChannelEventsProducer) Close(_ context.Context) error {
+	err := k.conn.Close()
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/pkg/api/server"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (s *ServerClient) RecordAuditEntry(ctx context.Context, req usecases.RecordAuditEntryRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.RecordAuditEntry")
+	defer span.Finish()
+
+	_, err := s.client.RecordAuditEntry(ctx, &server.RecordAuditEntryRequest{
+		ServerId:   req.ServerId,
+		ActorId:    req.ActorId,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Before:     req.Before,
+		After:      req.After,
+	})
+
+	return err
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/channel/configs"
+	"github.com/Nixonxp/discord/channel/internal/app/services"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/pkg/api/server"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	grpc_opentracing "github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"time"
+)
+
+type ServerClient struct {
+	client server.ServerServiceClient
+	log    *log.Logger
+}
+
+var _ usecases.ServiceServerInterface = (*ServerClient)(nil)
+
+func (s *ServerClient) Init(ctx context.Context, cfg *config.Config) error {
+	serverConn, err := grpc.DialContext(ctx,
+		cfg.Application.ServerServiceHost,
+		grpc.WithIdleTimeout(10*time.Second),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			grpc_opentracing.OpenTracingClientInterceptor(opentracing.GlobalTracer(), grpc_opentracing.LogPayloads()),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	logger := services.Logger{}
+	err = logger.Init(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	s.client = server.NewServerServiceClient(serverConn)
+	s.log = logger.GetInstance()
+
+	return nil
+}
+
+func (s *ServerClient) Ident() string {
+	return "server service"
+}
+
+func (s *ServerClient) GetInstance() *ServerClient {
+	return s
+}
+
+func (s *ServerClient) Close(_ context.Context) error {
+	return nil
+}
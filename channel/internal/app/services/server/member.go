@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/pkg/api/server"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GetMember - asks on behalf of the user, ErrNotFound when the user is not a member of the server
+func (s *ServerClient) GetMember(ctx context.Context, serverId models.ServerID, userId models.UserID) (*models.ServerMember, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetMember")
+	defer span.Finish()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "userId", userId.String())
+	response, err := s.client.GetMember(ctx, &server.GetMemberRequest{
+		ServerId: serverId.String(),
+		UserId:   userId.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &models.ServerMember{
+		UserId:  userId,
+		IsOwner: response.GetIsOwner(),
+	}, nil
+}
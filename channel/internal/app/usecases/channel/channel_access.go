@@ -0,0 +1,226 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// GrantChannelAccess - access can be granted before the channel is made private
+func (u *ChannelUsecase) GrantChannelAccess(ctx context.Context, req usecases.ChannelAccessRequest) (*models.ActionInfo, error) {
+	channel, userID, err := u.getAccessTarget(ctx, req)
+	if err != nil {
+		return nil, pkgErrors.Wrap("grant channel access", err)
+	}
+
+	err = u.AccessRepo.GrantAccess(ctx, channel.Id, userID, time.Now())
+	if err != nil {
+		return nil, pkgErrors.Wrap("grant channel access", err)
+	}
+
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelAccessGrant,
+		After:  map[string]string{"user_id": userID.String()},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// RevokeChannelAccess - removes access or declines a join request, the user also leaves the channel
+func (u *ChannelUsecase) RevokeChannelAccess(ctx context.Context, req usecases.ChannelAccessRequest) (*models.ActionInfo, error) {
+	channel, userID, err := u.getAccessTarget(ctx, req)
+	if err != nil {
+		return nil, pkgErrors.Wrap("revoke channel access", err)
+	}
+
+	accessErr := u.AccessRepo.DeleteAccess(ctx, channel.Id, userID)
+	if accessErr != nil && !errors.Is(accessErr, models.ErrNotFound) {
+		return nil, pkgErrors.Wrap("revoke channel access", accessErr)
+	}
+
+	subscribeErr := u.SubscribeRepo.DeleteSubscribe(ctx, channel.Id, userID)
+	if subscribeErr != nil && !errors.Is(subscribeErr, models.ErrNotFound) {
+		return nil, pkgErrors.Wrap("revoke channel access", subscribeErr)
+	}
+
+	if accessErr != nil && subscribeErr != nil {
+		return nil, pkgErrors.Wrap("revoke channel access: access", models.ErrNotFound)
+	}
+
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelAccessRevoke,
+		Before: map[string]string{"user_id": userID.String()},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// RequestChannelAccess - a repeated request keeps its place in the queue
+func (u *ChannelUsecase) RequestChannelAccess(ctx context.Context, req usecases.RequestChannelAccessRequest) (*models.ActionInfo, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+	channelID := models.ChannelID(uuid.MustParse(req.ChannelId))
+
+	channel, err := u.ChannelRepo.GetChannelById(ctx, channelID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("request channel access", err)
+	}
+
+	if !channel.Private {
+		return nil, pkgErrors.Wrap("request channel access: channel is not private", models.ErrInvalidArgument)
+	}
+	if channel.OwnerId == userID {
+		return nil, pkgErrors.Wrap("request channel access: owner has access", models.ErrInvalidArgument)
+	}
+
+	access, err := u.AccessRepo.GetAccess(ctx, channelID, userID)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return nil, pkgErrors.Wrap("request channel access", err)
+	}
+	if access != nil && access.IsGranted() {
+		return nil, pkgErrors.Wrap("request channel access: access", models.ErrAlreadyExists)
+	}
+
+	err = u.AccessRepo.CreateJoinRequest(ctx, channelID, userID, time.Now())
+	if err != nil {
+		return nil, pkgErrors.Wrap("request channel access", err)
+	}
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+func (u *ChannelUsecase) ListChannelJoinRequests(ctx context.Context, req usecases.ListChannelJoinRequestsRequest) ([]*models.ChannelAccess, error) {
+	channel, err := u.getOwnedChannel(ctx, req.ChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list channel join requests", err)
+	}
+
+	requests, err := u.AccessRepo.ListJoinRequests(ctx, channel.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list channel join requests", err)
+	}
+
+	return requests, nil
+}
+
+// ApproveChannelJoinRequest - grants access and joins the user to the channel
+func (u *ChannelUsecase) ApproveChannelJoinRequest(ctx context.Context, req usecases.ChannelAccessRequest) (*models.ActionInfo, error) {
+	channel, userID, err := u.getAccessTarget(ctx, req)
+	if err != nil {
+		return nil, pkgErrors.Wrap("approve channel join request", err)
+	}
+
+	access, err := u.AccessRepo.GetAccess(ctx, channel.Id, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("approve channel join request: join request", err)
+	}
+	if access.IsGranted() {
+		return nil, pkgErrors.Wrap("approve channel join request: access", models.ErrAlreadyExists)
+	}
+
+	now := time.Now()
+	err = u.AccessRepo.GrantAccess(ctx, channel.Id, userID, now)
+	if err != nil {
+		return nil, pkgErrors.Wrap("approve channel join request", err)
+	}
+
+	err = u.SubscribeRepo.CreateSubscribe(ctx, models.SubscribeInfo{
+		Id:        models.SubscribeID(uuid.New()),
+		ChannelId: channel.Id,
+		UserId:    userID,
+		JoinedAt:  now,
+	})
+	if err != nil && !errors.Is(err, models.ErrAlreadyExists) {
+		return nil, pkgErrors.Wrap("approve channel join request", err)
+	}
+
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelAccessGrant,
+		After:  map[string]string{"user_id": userID.String()},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// checkAccess - everyone can access a public channel, a private one only its owner and users granted access
+func (u *ChannelUsecase) checkAccess(ctx context.Context, channel *models.Channel, userID models.UserID) error {
+	if !channel.Private || channel.OwnerId == userID {
+		return nil
+	}
+
+	access, err := u.AccessRepo.GetAccess(ctx, channel.Id, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return pkgErrors.Wrap("private channel", models.ErrPermDenied)
+		}
+		return err
+	}
+	if !access.IsGranted() {
+		return pkgErrors.Wrap("private channel", models.ErrPermDenied)
+	}
+
+	return nil
+}
+
+// removeMembersWithoutAccess - a channel made private keeps only the subscribers granted access
+func (u *ChannelUsecase) removeMembersWithoutAccess(ctx context.Context, channel *models.Channel) error {
+	var after *models.SubscribeID
+	for {
+		subscribes, err := u.SubscribeRepo.ListByChannelId(ctx, channel.Id, after, models.MembersMaxLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, subscribe := range subscribes {
+			err = u.checkAccess(ctx, channel, subscribe.UserId)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, models.ErrPermDenied) {
+				return err
+			}
+
+			err = u.SubscribeRepo.DeleteSubscribe(ctx, channel.Id, subscribe.UserId)
+			if err != nil && !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+		}
+
+		if len(subscribes) < models.MembersMaxLimit {
+			return nil
+		}
+		last := subscribes[len(subscribes)-1].Id
+		after = &last
+	}
+}
+
+func (u *ChannelUsecase) getOwnedChannel(ctx context.Context, channelId string, currentUserId string) (*models.Channel, error) {
+	channel, err := u.ChannelRepo.GetChannelById(ctx, models.ChannelID(uuid.MustParse(channelId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.OwnerId != models.UserID(uuid.MustParse(currentUserId)) {
+		return nil, models.ErrPermDenied
+	}
+
+	return channel, nil
+}
+
+// getAccessTarget - the owned channel and the managed user, the owner can not manage own access
+func (u *ChannelUsecase) getAccessTarget(ctx context.Context, req usecases.ChannelAccessRequest) (*models.Channel, models.UserID, error) {
+	channel, err := u.getOwnedChannel(ctx, req.ChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, models.UserID{}, err
+	}
+
+	userID := models.UserID(uuid.MustParse(req.UserId))
+	if userID == channel.OwnerId {
+		return nil, models.UserID{}, pkgErrors.Wrap("owner access", models.ErrInvalidArgument)
+	}
+
+	return channel, userID, nil
+}
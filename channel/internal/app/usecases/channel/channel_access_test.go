@@ -0,0 +1,827 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var (
+	accessChannelID = models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	accessServerID  = models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	accessOwnerID   = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	accessUserID    = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796"))
+)
+
+func privateChannel() *models.Channel {
+	return &models.Channel{
+		Id:       accessChannelID,
+		Name:     "staff",
+		OwnerId:  accessOwnerID,
+		ServerId: accessServerID,
+		Private:  true,
+	}
+}
+
+func Test_usecase_ChannelUsecase_GrantChannelAccess(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ChannelAccessRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("GrantAccess", ctx, accessChannelID, accessUserID, mock.Anything).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+					return req.Action == models.AuditActionChannelAccessGrant &&
+						req.TargetId == accessChannelID.String() &&
+						(req.After["user_id"] == accessUserID.String() || req.Before["user_id"] == accessUserID.String())
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNumberOfCalls(t, "GrantAccess", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not the owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessOwnerID.String(),
+					CurrentUserId: accessUserID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "grant channel access: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNotCalled(t, "GrantAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Owner access",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessOwnerID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "grant channel access: owner access: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+			},
+		},
+		{
+			name: "Test 4. Negative. Channel not found",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "grant channel access: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GrantChannelAccess(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GrantChannelAccess() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_RevokeChannelAccess(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ChannelAccessRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Access removed, user leaves the channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("DeleteAccess", ctx, accessChannelID, accessUserID).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteSubscribe", ctx, accessChannelID, accessUserID).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+					return req.Action == models.AuditActionChannelAccessRevoke &&
+						req.TargetId == accessChannelID.String() &&
+						(req.After["user_id"] == accessUserID.String() || req.Before["user_id"] == accessUserID.String())
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteSubscribe", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Member without access entry",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("DeleteAccess", ctx, accessChannelID, accessUserID).
+					Return(models.ErrNotFound)
+
+				f.SubscribeRepo.On("DeleteSubscribe", ctx, accessChannelID, accessUserID).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+					return req.Action == models.AuditActionChannelAccessRevoke &&
+						req.TargetId == accessChannelID.String() &&
+						(req.After["user_id"] == accessUserID.String() || req.Before["user_id"] == accessUserID.String())
+				})).
+					Return(nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. Neither access nor membership",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "revoke channel access: access: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("DeleteAccess", ctx, accessChannelID, accessUserID).
+					Return(models.ErrNotFound)
+
+				f.SubscribeRepo.On("DeleteSubscribe", ctx, accessChannelID, accessUserID).
+					Return(models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerService.AssertNotCalled(t, "RecordAuditEntry", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. DeleteAccess returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "revoke channel access: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("DeleteAccess", ctx, accessChannelID, accessUserID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteSubscribe", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RevokeChannelAccess(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RevokeChannelAccess() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_RequestChannelAccess(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RequestChannelAccessRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.RequestChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					CurrentUserId: accessUserID.String(),
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("GetAccess", ctx, accessChannelID, accessUserID).
+					Return(nil, models.ErrNotFound)
+
+				f.AccessRepo.On("CreateJoinRequest", ctx, accessChannelID, accessUserID, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNumberOfCalls(t, "CreateJoinRequest", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Channel is not private",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.RequestChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					CurrentUserId: accessUserID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "request channel access: channel is not private: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(&models.Channel{Id: accessChannelID, OwnerId: accessOwnerID}, nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. Access already granted",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.RequestChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					CurrentUserId: accessUserID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "request channel access: access: already exists",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("GetAccess", ctx, accessChannelID, accessUserID).
+					Return(&models.ChannelAccess{Status: models.ChannelAccessGranted}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNotCalled(t, "CreateJoinRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Owner requests access",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.RequestChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "request channel access: owner has access: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RequestChannelAccess(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RequestChannelAccess() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_ListChannelJoinRequests(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListChannelJoinRequestsRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.ChannelAccess
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelJoinRequestsRequest{
+					ChannelId:     accessChannelID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			want: []*models.ChannelAccess{
+				{ChannelId: accessChannelID, UserId: accessUserID, Status: models.ChannelAccessRequested},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("ListJoinRequests", ctx, accessChannelID).
+					Return([]*models.ChannelAccess{
+						{ChannelId: accessChannelID, UserId: accessUserID, Status: models.ChannelAccessRequested},
+					}, nil)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not the owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelJoinRequestsRequest{
+					ChannelId:     accessChannelID.String(),
+					CurrentUserId: accessUserID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list channel join requests: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNotCalled(t, "ListJoinRequests", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ListChannelJoinRequests(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ListChannelJoinRequests() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_ApproveChannelJoinRequest(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ChannelAccessRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Access granted, user joins the channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("GetAccess", ctx, accessChannelID, accessUserID).
+					Return(&models.ChannelAccess{ChannelId: accessChannelID, UserId: accessUserID, Status: models.ChannelAccessRequested}, nil)
+
+				f.AccessRepo.On("GrantAccess", ctx, accessChannelID, accessUserID, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.MatchedBy(func(info models.SubscribeInfo) bool {
+					return info.ChannelId == accessChannelID &&
+						info.UserId == accessUserID &&
+						!info.JoinedAt.IsZero()
+				})).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+					return req.Action == models.AuditActionChannelAccessGrant &&
+						req.TargetId == accessChannelID.String() &&
+						(req.After["user_id"] == accessUserID.String() || req.Before["user_id"] == accessUserID.String())
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNumberOfCalls(t, "GrantAccess", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. No join request",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "approve channel join request: join request: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("GetAccess", ctx, accessChannelID, accessUserID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AccessRepo.AssertNotCalled(t, "GrantAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Access already granted",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ChannelAccessRequest{
+					ChannelId:     accessChannelID.String(),
+					UserId:        accessUserID.String(),
+					CurrentUserId: accessOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "approve channel join request: access: already exists",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, accessChannelID).
+					Return(privateChannel(), nil)
+
+				f.AccessRepo.On("GetAccess", ctx, accessChannelID, accessUserID).
+					Return(&models.ChannelAccess{Status: models.ChannelAccessGranted}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ApproveChannelJoinRequest(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ApproveChannelJoinRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
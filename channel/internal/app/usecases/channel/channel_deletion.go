@@ -0,0 +1,161 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// ArchiveChannel - archived channel is read-only and hidden from listings unless archived channels are asked for
+func (u *ChannelUsecase) ArchiveChannel(ctx context.Context, req usecases.ArchiveChannelRequest) (*models.ActionInfo, error) {
+	channel, err := u.getOwnedChannel(ctx, req.ChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgErrors.Wrap("archive channel", err)
+	}
+
+	if channel.IsArchived() {
+		return nil, pkgErrors.Wrap("archive channel: channel is archived", models.ErrInvalidArgument)
+	}
+
+	err = u.ChannelRepo.ArchiveChannel(ctx, channel.Id, time.Now())
+	if err != nil {
+		return nil, pkgErrors.Wrap("archive channel", err)
+	}
+
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelArchive,
+		Before: map[string]string{"name": channel.Name},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+func (u *ChannelUsecase) UnarchiveChannel(ctx context.Context, req usecases.ArchiveChannelRequest) (*models.ActionInfo, error) {
+	channel, err := u.getOwnedChannel(ctx, req.ChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgErrors.Wrap("unarchive channel", err)
+	}
+
+	if !channel.IsArchived() {
+		return nil, pkgErrors.Wrap("unarchive channel: channel is not archived", models.ErrInvalidArgument)
+	}
+
+	err = u.ChannelRepo.UnarchiveChannel(ctx, channel.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("unarchive channel", err)
+	}
+
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelUnarchive,
+		After:  map[string]string{"name": channel.Name},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+func (u *ChannelUsecase) RestoreChannel(ctx context.Context, req usecases.RestoreChannelRequest) (*models.ActionInfo, error) {
+	channel, err := u.ChannelRepo.GetDeletedChannelById(ctx, models.ChannelID(uuid.MustParse(req.ChannelId)))
+	if err != nil {
+		return nil, pkgErrors.Wrap("restore channel", err)
+	}
+
+	if channel.OwnerId != models.UserID(uuid.MustParse(req.CurrentUserId)) {
+		return nil, pkgErrors.Wrap("restore channel", models.ErrPermDenied)
+	}
+
+	// the channel is waiting for purge
+	if time.Now().After(channel.RestoreDeadline()) {
+		return nil, pkgErrors.Wrap("restore channel: grace period is over", models.ErrNotFound)
+	}
+
+	err = u.ChannelRepo.RestoreChannel(ctx, channel.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("restore channel", err)
+	}
+
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelRestore,
+		After:  map[string]string{"name": channel.Name},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// PurgeDeletedChannels - removes one batch of channels whose grace period is over,
+// returns the number of purged channels
+func (u *ChannelUsecase) PurgeDeletedChannels(ctx context.Context) (int, error) {
+	channels, err := u.ChannelRepo.ListDeletedBefore(ctx, time.Now().Add(-models.ChannelRestoreGracePeriod), models.ChannelPurgeBatchSize)
+	if err != nil {
+		return 0, pkgErrors.Wrap("purge deleted channels", err)
+	}
+
+	for i, channel := range channels {
+		err = u.purgeChannel(ctx, channel)
+		if err != nil {
+			return i, pkgErrors.Wrap("purge deleted channels", err)
+		}
+	}
+
+	return len(channels), nil
+}
+
+// purgeChannel - the event is published before the channel is removed,
+// a failed purge is retried on the next run and consumers get the event again
+func (u *ChannelUsecase) purgeChannel(ctx context.Context, channel *models.Channel) error {
+	err := u.ChannelEvents.PublishChannelDeleted(ctx, models.ChannelDeletedEvent{
+		ChannelId: channel.Id,
+		ServerId:  channel.ServerId,
+		DeletedAt: channel.DeletedAt,
+	})
+	if err != nil {
+		return pkgErrors.Wrap("publish channel deleted", err)
+	}
+
+	channelIds := []models.ChannelID{channel.Id}
+	_, err = u.SubscribeRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return pkgErrors.Wrap("delete channel subscribes", err)
+	}
+
+	_, err = u.AccessRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return pkgErrors.Wrap("delete channel access", err)
+	}
+
+	_, err = u.FollowRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return pkgErrors.Wrap("delete channel follows", err)
+	}
+
+	_, err = u.PostRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return pkgErrors.Wrap("delete channel forum posts", err)
+	}
+
+	err = u.ChannelRepo.DeleteChannel(ctx, channel.Id)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return pkgErrors.Wrap("delete channel", err)
+	}
+
+	return nil
+}
+
+// visibleChannels - archived channels are listed only when asked for
+func visibleChannels(channels []*models.Channel, includeArchived bool) []*models.Channel {
+	if includeArchived {
+		return channels
+	}
+
+	result := make([]*models.Channel, 0, len(channels))
+	for _, channel := range channels {
+		if !channel.IsArchived() {
+			result = append(result, channel)
+		}
+	}
+
+	return result
+}
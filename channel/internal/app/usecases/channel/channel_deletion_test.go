@@ -0,0 +1,662 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func archivedChannel() *models.Channel {
+	channel := followingChannel()
+	channel.ArchivedAt = time.Now().Add(-time.Hour)
+	return channel
+}
+
+func deletedChannel(deletedAt time.Time) *models.Channel {
+	channel := followingChannel()
+	channel.DeletedAt = deletedAt
+	return channel
+}
+
+func Test_usecase_ChannelUsecase_ArchiveChannel(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ArchiveChannelRequest
+	}
+	ownerRequest := usecases.ArchiveChannelRequest{
+		ChannelId:     followTargetID.String(),
+		CurrentUserId: followTargetOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.ChannelRepo.On("ArchiveChannel", ctx, followTargetID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, usecases.RecordAuditEntryRequest{
+					ServerId:   followTargetServerID.String(),
+					ActorId:    followTargetOwnerID.String(),
+					Action:     models.AuditActionChannelArchive,
+					TargetType: models.AuditTargetChannel,
+					TargetId:   followTargetID.String(),
+					Before:     map[string]string{"name": "general"},
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "ArchiveChannel", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Channel is already archived",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			wantErr:     true,
+			errorString: "archive channel: channel is archived: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(archivedChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "ArchiveChannel", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Not the owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ArchiveChannelRequest{
+					ChannelId:     followTargetID.String(),
+					CurrentUserId: followSourceOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "archive channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "ArchiveChannel", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. ArchiveChannel returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			wantErr:     true,
+			errorString: "archive channel: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.ChannelRepo.On("ArchiveChannel", ctx, followTargetID, mock.AnythingOfType("time.Time")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerService.AssertNotCalled(t, "RecordAuditEntry", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ArchiveChannel(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ArchiveChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_UnarchiveChannel(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ArchiveChannelRequest
+	}
+	ownerRequest := usecases.ArchiveChannelRequest{
+		ChannelId:     followTargetID.String(),
+		CurrentUserId: followTargetOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(archivedChannel(), nil)
+				f.ChannelRepo.On("UnarchiveChannel", ctx, followTargetID).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, mock.AnythingOfType("usecases.RecordAuditEntryRequest")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "UnarchiveChannel", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Channel is not archived",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			wantErr:     true,
+			errorString: "unarchive channel: channel is not archived: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "UnarchiveChannel", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Channel is not found",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			wantErr:     true,
+			errorString: "unarchive channel: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "UnarchiveChannel", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.UnarchiveChannel(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.UnarchiveChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_RestoreChannel(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RestoreChannelRequest
+	}
+	ownerRequest := usecases.RestoreChannelRequest{
+		ChannelId:     followTargetID.String(),
+		CurrentUserId: followTargetOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetDeletedChannelById", ctx, followTargetID).
+					Return(deletedChannel(time.Now().Add(-time.Hour)), nil)
+				f.ChannelRepo.On("RestoreChannel", ctx, followTargetID).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, usecases.RecordAuditEntryRequest{
+					ServerId:   followTargetServerID.String(),
+					ActorId:    followTargetOwnerID.String(),
+					Action:     models.AuditActionChannelRestore,
+					TargetType: models.AuditTargetChannel,
+					TargetId:   followTargetID.String(),
+					After:      map[string]string{"name": "general"},
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "RestoreChannel", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Grace period is over",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			wantErr:     true,
+			errorString: "restore channel: grace period is over: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetDeletedChannelById", ctx, followTargetID).
+					Return(deletedChannel(time.Now().Add(-models.ChannelRestoreGracePeriod-time.Hour)), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "RestoreChannel", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Not the owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.RestoreChannelRequest{
+					ChannelId:     followTargetID.String(),
+					CurrentUserId: followSourceOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "restore channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetDeletedChannelById", ctx, followTargetID).
+					Return(deletedChannel(time.Now().Add(-time.Hour)), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "RestoreChannel", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Channel is not deleted",
+			args: args{
+				ctx: ctx, // dummy
+				req: ownerRequest,
+			},
+			wantErr:     true,
+			errorString: "restore channel: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetDeletedChannelById", ctx, followTargetID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "RestoreChannel", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RestoreChannel(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RestoreChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_PurgeDeletedChannels(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		FollowRepo    *mocks.FollowStorage
+		PostRepo      *mocks.ForumPostStorage
+		ChannelEvents *mocks.KafkaProducerServiceInterface
+	}
+
+	deletedAt := time.Now().Add(-models.ChannelRestoreGracePeriod - time.Hour)
+	channelIds := []models.ChannelID{followTargetID}
+	tests := []struct {
+		name        string
+		want        int
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name:    "Test 1. Positive.",
+			want:    1,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ChannelPurgeBatchSize).
+					Return([]*models.Channel{deletedChannel(deletedAt)}, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, models.ChannelDeletedEvent{
+					ChannelId: followTargetID,
+					ServerId:  followTargetServerID,
+					DeletedAt: deletedAt,
+				}).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(2), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.FollowRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.PostRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.ChannelRepo.On("DeleteChannel", ctx, followTargetID).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelEvents.AssertNumberOfCalls(t, "PublishChannelDeleted", 1)
+				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteChannel", 1)
+			},
+		},
+		{
+			name:    "Test 2. Positive. Nothing to purge",
+			want:    0,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ChannelPurgeBatchSize).
+					Return([]*models.Channel{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelEvents.AssertNotCalled(t, "PublishChannelDeleted", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:    "Test 3. Positive. Channel document is already removed",
+			want:    1,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ChannelPurgeBatchSize).
+					Return([]*models.Channel{deletedChannel(deletedAt)}, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.FollowRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.PostRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.ChannelRepo.On("DeleteChannel", ctx, followTargetID).
+					Return(models.ErrNotFound)
+			},
+		},
+		{
+			name:        "Test 4. Negative. PublishChannelDeleted returns error",
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted channels: publish channel deleted: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ChannelPurgeBatchSize).
+					Return([]*models.Channel{deletedChannel(deletedAt)}, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteByChannelIds", mock.Anything, mock.Anything)
+				f.ChannelRepo.AssertNotCalled(t, "DeleteChannel", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:        "Test 5. Negative. Subscribes are not deleted",
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted channels: delete channel subscribes: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ChannelPurgeBatchSize).
+					Return([]*models.Channel{deletedChannel(deletedAt)}, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "DeleteChannel", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name:        "Test 6. Negative. ListDeletedBefore returns error",
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted channels: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ChannelPurgeBatchSize).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				FollowRepo:    mocks.NewFollowStorage(t),
+				PostRepo:      mocks.NewForumPostStorage(t),
+				ChannelEvents: mocks.NewKafkaProducerServiceInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				FollowRepo:    f.FollowRepo,
+				PostRepo:      f.PostRepo,
+				ChannelEvents: f.ChannelEvents,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PurgeDeletedChannels(ctx)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.PurgeDeletedChannels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package channel
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// FollowChannel - the owner of the target channel subscribes it to an announcement channel of another server,
+// following again keeps the first follow
+func (u *ChannelUsecase) FollowChannel(ctx context.Context, req usecases.FollowChannelRequest) (*models.ActionInfo, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	source, err := u.ChannelRepo.GetChannelById(ctx, models.ChannelID(uuid.MustParse(req.SourceChannelId)))
+	if err != nil {
+		return nil, pkgErrors.Wrap("follow channel: source", err)
+	}
+
+	if !source.IsAnnouncement() {
+		return nil, pkgErrors.Wrap("follow channel: source is not an announcement channel", models.ErrInvalidArgument)
+	}
+
+	err = u.checkAccess(ctx, source, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("follow channel", err)
+	}
+
+	target, err := u.getOwnedChannel(ctx, req.TargetChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgErrors.Wrap("follow channel: target", err)
+	}
+
+	if !target.ChannelType().HasMessages() {
+		return nil, pkgErrors.Wrap("follow channel: target channel type has no messages", models.ErrInvalidArgument)
+	}
+	if source.IsArchived() || target.IsArchived() {
+		return nil, pkgErrors.Wrap("follow channel: channel is archived", models.ErrPermDenied)
+	}
+	if target.ServerId == source.ServerId {
+		return nil, pkgErrors.Wrap("follow channel: channels of the same server", models.ErrInvalidArgument)
+	}
+
+	err = u.FollowRepo.CreateFollow(ctx, models.ChannelFollow{
+		SourceChannelId: source.Id,
+		TargetChannelId: target.Id,
+		CreatedBy:       userID,
+		CreatedAt:       time.Now(),
+	})
+	if err != nil {
+		return nil, pkgErrors.Wrap("follow channel", err)
+	}
+
+	u.recordAudit(ctx, target, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelFollow,
+		After:  map[string]string{"source_channel_id": source.Id.String()},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+func (u *ChannelUsecase) UnfollowChannel(ctx context.Context, req usecases.FollowChannelRequest) (*models.ActionInfo, error) {
+	target, err := u.getOwnedChannel(ctx, req.TargetChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgErrors.Wrap("unfollow channel: target", err)
+	}
+
+	sourceID := models.ChannelID(uuid.MustParse(req.SourceChannelId))
+	err = u.FollowRepo.DeleteFollow(ctx, sourceID, target.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("unfollow channel: follow", err)
+	}
+
+	u.recordAudit(ctx, target, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelUnfollow,
+		Before: map[string]string{"source_channel_id": sourceID.String()},
+	})
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// PublishChannelMessage - the owner of an announcement channel sends its message to every following channel
+// that is not archived, copies are written by chat service in background
+func (u *ChannelUsecase) PublishChannelMessage(ctx context.Context, req usecases.PublishChannelMessageRequest) (*models.ActionInfo, error) {
+	channel, err := u.getOwnedChannel(ctx, req.ChannelId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgErrors.Wrap("publish channel message", err)
+	}
+
+	if !channel.IsAnnouncement() {
+		return nil, pkgErrors.Wrap("publish channel message: channel is not an announcement channel", models.ErrInvalidArgument)
+	}
+	if channel.IsArchived() {
+		return nil, pkgErrors.Wrap("publish channel message: channel is archived", models.ErrPermDenied)
+	}
+
+	targetIds, err := u.followerIds(ctx, channel.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("publish channel message: followers", err)
+	}
+
+	err = u.ChatService.PublishChannelMessage(ctx, req, targetIds)
+	if err != nil {
+		return nil, pkgErrors.Wrap("publish channel message", err)
+	}
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// followerIds - following channels in follow order, archived and deleted ones are skipped
+func (u *ChannelUsecase) followerIds(ctx context.Context, sourceId models.ChannelID) ([]models.ChannelID, error) {
+	follows, err := u.FollowRepo.ListBySourceId(ctx, sourceId)
+	if err != nil {
+		return nil, err
+	}
+	if len(follows) == 0 {
+		return []models.ChannelID{}, nil
+	}
+
+	ids := make([]models.ChannelID, len(follows))
+	for i, follow := range follows {
+		ids[i] = follow.TargetChannelId
+	}
+
+	channels, err := u.ChannelRepo.GetChannelsByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	writable := make(map[models.ChannelID]bool, len(channels))
+	for _, channel := range channels {
+		writable[channel.Id] = !channel.IsArchived()
+	}
+
+	targetIds := make([]models.ChannelID, 0, len(ids))
+	for _, id := range ids {
+		if writable[id] {
+			targetIds = append(targetIds, id)
+		}
+	}
+
+	return targetIds, nil
+}
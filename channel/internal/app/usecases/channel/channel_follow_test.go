@@ -0,0 +1,636 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var (
+	followSourceID       = models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	followTargetID       = models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001"))
+	followSourceServerID = models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	followTargetServerID = models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b112"))
+	followSourceOwnerID  = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	followTargetOwnerID  = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796"))
+)
+
+func announcementChannel() *models.Channel {
+	return &models.Channel{
+		Id:       followSourceID,
+		Name:     "news",
+		OwnerId:  followSourceOwnerID,
+		ServerId: followSourceServerID,
+		Type:     models.ChannelTypeAnnouncement,
+	}
+}
+
+func followingChannel() *models.Channel {
+	return &models.Channel{
+		Id:       followTargetID,
+		Name:     "general",
+		OwnerId:  followTargetOwnerID,
+		ServerId: followTargetServerID,
+		Type:     models.ChannelTypeText,
+	}
+}
+
+func Test_usecase_ChannelUsecase_FollowChannel(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		AccessRepo    *mocks.AccessStorage
+		FollowRepo    *mocks.FollowStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.FollowChannelRequest
+	}
+	followRequest := usecases.FollowChannelRequest{
+		SourceChannelId: followSourceID.String(),
+		TargetChannelId: followTargetID.String(),
+		CurrentUserId:   followTargetOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+
+				f.FollowRepo.On("CreateFollow", ctx, mock.MatchedBy(func(follow models.ChannelFollow) bool {
+					return follow.SourceChannelId == followSourceID &&
+						follow.TargetChannelId == followTargetID &&
+						follow.CreatedBy == followTargetOwnerID &&
+						!follow.CreatedAt.IsZero()
+				})).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, usecases.RecordAuditEntryRequest{
+					ServerId:   followTargetServerID.String(),
+					ActorId:    followTargetOwnerID.String(),
+					Action:     models.AuditActionChannelFollow,
+					TargetType: models.AuditTargetChannel,
+					TargetId:   followTargetID.String(),
+					After:      map[string]string{"source_channel_id": followSourceID.String()},
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNumberOfCalls(t, "CreateFollow", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Source is not an announcement channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "follow channel: source is not an announcement channel: invalid argument",
+
+			on: func(f *fields) {
+				source := announcementChannel()
+				source.Type = models.ChannelTypeText
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(source, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "CreateFollow", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Private source without access",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "follow channel: private channel: permission denied",
+
+			on: func(f *fields) {
+				source := announcementChannel()
+				source.Private = true
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(source, nil)
+				f.AccessRepo.On("GetAccess", ctx, followSourceID, followTargetOwnerID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "CreateFollow", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Target of another owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "follow channel: target: permission denied",
+
+			on: func(f *fields) {
+				target := followingChannel()
+				target.OwnerId = followSourceOwnerID
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(target, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "CreateFollow", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Channels of the same server",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "follow channel: channels of the same server: invalid argument",
+
+			on: func(f *fields) {
+				target := followingChannel()
+				target.ServerId = followSourceServerID
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(target, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "CreateFollow", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Target without messages",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "follow channel: target channel type has no messages: invalid argument",
+
+			on: func(f *fields) {
+				target := followingChannel()
+				target.Type = models.ChannelTypeVoice
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(target, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "CreateFollow", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. Source not found",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "follow channel: source: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "CreateFollow", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				AccessRepo:    mocks.NewAccessStorage(t),
+				FollowRepo:    mocks.NewFollowStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				AccessRepo:    f.AccessRepo,
+				FollowRepo:    f.FollowRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.FollowChannel(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.FollowChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_UnfollowChannel(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		FollowRepo    *mocks.FollowStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.FollowChannelRequest
+	}
+	followRequest := usecases.FollowChannelRequest{
+		SourceChannelId: followSourceID.String(),
+		TargetChannelId: followTargetID.String(),
+		CurrentUserId:   followTargetOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.FollowRepo.On("DeleteFollow", ctx, followSourceID, followTargetID).
+					Return(nil)
+				f.ServerService.On("RecordAuditEntry", ctx, mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+					return req.Action == models.AuditActionChannelUnfollow &&
+						req.TargetId == followTargetID.String() &&
+						req.Before["source_channel_id"] == followSourceID.String()
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNumberOfCalls(t, "DeleteFollow", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not following",
+			args: args{
+				ctx: ctx, // dummy
+				req: followRequest,
+			},
+			wantErr:     true,
+			errorString: "unfollow channel: follow: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.FollowRepo.On("DeleteFollow", ctx, followSourceID, followTargetID).
+					Return(models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerService.AssertNotCalled(t, "RecordAuditEntry", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Target of another owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.FollowChannelRequest{
+					SourceChannelId: followSourceID.String(),
+					TargetChannelId: followTargetID.String(),
+					CurrentUserId:   followSourceOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "unfollow channel: target: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "DeleteFollow", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				FollowRepo:    mocks.NewFollowStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				FollowRepo:    f.FollowRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.UnfollowChannel(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.UnfollowChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_PublishChannelMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo *mocks.ChannelStorage
+		Log         *log.Logger
+		FollowRepo  *mocks.FollowStorage
+		ChatService *mocks.ServiceChatInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.PublishChannelMessageRequest
+	}
+	publishRequest := usecases.PublishChannelMessageRequest{
+		ChannelId:     followSourceID.String(),
+		MessageId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+		CurrentUserId: followSourceOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.FollowRepo.On("ListBySourceId", ctx, followSourceID).
+					Return([]*models.ChannelFollow{
+						{SourceChannelId: followSourceID, TargetChannelId: followTargetID},
+					}, nil)
+				f.ChannelRepo.On("GetChannelsByIds", ctx, []models.ChannelID{followTargetID}).
+					Return([]*models.Channel{followingChannel()}, nil)
+				f.ChatService.On("PublishChannelMessage", ctx, publishRequest, []models.ChannelID{followTargetID}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "PublishChannelMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not an announcement channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishRequest,
+			},
+			wantErr:     true,
+			errorString: "publish channel message: channel is not an announcement channel: invalid argument",
+
+			on: func(f *fields) {
+				channel := announcementChannel()
+				channel.Type = ""
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(channel, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "PublishChannelMessage", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Not the owner",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.PublishChannelMessageRequest{
+					ChannelId:     followSourceID.String(),
+					MessageId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					CurrentUserId: followTargetOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "publish channel message: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "ListBySourceId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. PublishChannelMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishRequest,
+			},
+			wantErr:     true,
+			errorString: "publish channel message: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.FollowRepo.On("ListBySourceId", ctx, followSourceID).
+					Return([]*models.ChannelFollow{}, nil)
+				f.ChatService.On("PublishChannelMessage", ctx, publishRequest, []models.ChannelID{}).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "PublishChannelMessage", 1)
+			},
+		},
+		{
+			name: "Test 5. Positive. Archived followers are skipped",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				target := followingChannel()
+				target.ArchivedAt = time.Now()
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.FollowRepo.On("ListBySourceId", ctx, followSourceID).
+					Return([]*models.ChannelFollow{
+						{SourceChannelId: followSourceID, TargetChannelId: followTargetID},
+					}, nil)
+				f.ChannelRepo.On("GetChannelsByIds", ctx, []models.ChannelID{followTargetID}).
+					Return([]*models.Channel{target}, nil)
+				f.ChatService.On("PublishChannelMessage", ctx, publishRequest, []models.ChannelID{}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "PublishChannelMessage", 1)
+			},
+		},
+		{
+			name: "Test 6. Negative. Archived channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishRequest,
+			},
+			wantErr:     true,
+			errorString: "publish channel message: channel is archived: permission denied",
+
+			on: func(f *fields) {
+				channel := announcementChannel()
+				channel.ArchivedAt = time.Now()
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(channel, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.FollowRepo.AssertNotCalled(t, "ListBySourceId", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo: mocks.NewChannelStorage(t),
+				Log:         &log.Logger{},
+				FollowRepo:  mocks.NewFollowStorage(t),
+				ChatService: mocks.NewServiceChatInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo: f.ChannelRepo,
+				Log:         f.Log,
+				FollowRepo:  f.FollowRepo,
+				ChatService: f.ChatService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PublishChannelMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.PublishChannelMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// ListMyChannels - joined channels in join order, subscriptions of deleted channels are skipped,
+// archived channels are listed only when asked for
+func (u *ChannelUsecase) ListMyChannels(ctx context.Context, req usecases.ListMyChannelsRequest) ([]*models.Channel, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	subscribes, err := u.SubscribeRepo.ListByUserId(ctx, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list my channels", err)
+	}
+	if len(subscribes) == 0 {
+		return []*models.Channel{}, nil
+	}
+
+	ids := make([]models.ChannelID, len(subscribes))
+	for i, subscribe := range subscribes {
+		ids[i] = subscribe.ChannelId
+	}
+
+	channels, err := u.ChannelRepo.GetChannelsByIds(ctx, ids)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list my channels", err)
+	}
+
+	byId := make(map[models.ChannelID]*models.Channel, len(channels))
+	for _, channel := range channels {
+		byId[channel.Id] = channel
+	}
+
+	result := make([]*models.Channel, 0, len(channels))
+	for _, id := range ids {
+		if channel, ok := byId[id]; ok {
+			result = append(result, channel)
+		}
+	}
+
+	return visibleChannels(result, req.IncludeArchived), nil
+}
+
+// ListChannelMembers - members of a private channel are visible to users with access only
+func (u *ChannelUsecase) ListChannelMembers(ctx context.Context, req usecases.ListChannelMembersRequest) (*models.ListChannelMembersResult, error) {
+	channelID := models.ChannelID(uuid.MustParse(req.ChannelId))
+
+	channel, err := u.ChannelRepo.GetChannelById(ctx, channelID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list channel members", err)
+	}
+
+	err = u.checkAccess(ctx, channel, models.UserID(uuid.MustParse(req.CurrentUserId)))
+	if err != nil {
+		return nil, pkgErrors.Wrap("list channel members", err)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = models.MembersDefaultLimit
+	}
+	if limit > models.MembersMaxLimit {
+		limit = models.MembersMaxLimit
+	}
+
+	var after *models.SubscribeID
+	if req.Cursor != "" {
+		id, err := uuid.Parse(req.Cursor)
+		if err != nil {
+			return nil, pkgErrors.Wrap("list channel members: bad cursor", models.ErrInvalidArgument)
+		}
+		subscribeID := models.SubscribeID(id)
+		after = &subscribeID
+	}
+
+	// one extra subscription shows whether there is a next page
+	subscribes, err := u.SubscribeRepo.ListByChannelId(ctx, channelID, after, limit+1)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list channel members", err)
+	}
+
+	result := &models.ListChannelMembersResult{
+		Members: subscribes,
+	}
+	if len(subscribes) > limit {
+		result.Members = subscribes[:limit]
+		result.NextCursor = subscribes[limit-1].Id.String()
+	}
+
+	return result, nil
+}
+
+func (u *ChannelUsecase) IsChannelMember(ctx context.Context, req usecases.IsChannelMemberRequest) (bool, error) {
+	channelID := models.ChannelID(uuid.MustParse(req.ChannelId))
+	userId := req.UserId
+	if userId == "" {
+		userId = req.CurrentUserId
+	}
+
+	_, err := u.SubscribeRepo.GetSubscribe(ctx, channelID, models.UserID(uuid.MustParse(userId)))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return false, nil
+		}
+		return false, pkgErrors.Wrap("is channel member", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,523 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ChannelUsecase_ListMyChannels(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	firstID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001"))
+	secondID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b002"))
+	deletedID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b003"))
+	first := &models.Channel{Id: firstID, Name: "first", OwnerId: userID}
+	second := &models.Channel{Id: secondID, Name: "second", OwnerId: userID}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListMyChannelsRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.Channel
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Join order, deleted channels are skipped",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListMyChannelsRequest{
+					CurrentUserId: userID.String(),
+				},
+			},
+			want:    []*models.Channel{second, first},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("ListByUserId", ctx, userID).
+					Return([]*models.SubscribeInfo{
+						{Id: models.SubscribeID(uuid.New()), ChannelId: secondID, UserId: userID},
+						{Id: models.SubscribeID(uuid.New()), ChannelId: deletedID, UserId: userID},
+						{Id: models.SubscribeID(uuid.New()), ChannelId: firstID, UserId: userID},
+					}, nil)
+
+				f.ChannelRepo.On("GetChannelsByIds", ctx, []models.ChannelID{secondID, deletedID, firstID}).
+					Return([]*models.Channel{first, second}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "ListByUserId", 1)
+				f.ChannelRepo.AssertNumberOfCalls(t, "GetChannelsByIds", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. No subscriptions",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListMyChannelsRequest{
+					CurrentUserId: userID.String(),
+				},
+			},
+			want:    []*models.Channel{},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("ListByUserId", ctx, userID).
+					Return([]*models.SubscribeInfo{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "GetChannelsByIds")
+			},
+		},
+		{
+			name: "Test 3. Negative. ListByUserId returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListMyChannelsRequest{
+					CurrentUserId: userID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list my channels: some error",
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("ListByUserId", ctx, userID).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ListMyChannels(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ListMyChannels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_ListChannelMembers(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	channelID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	channel := &models.Channel{Id: channelID, Name: "general"}
+	private := &models.Channel{Id: channelID, Name: "staff", OwnerId: models.UserID(uuid.New()), Private: true}
+	joinedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	subscribes := []*models.SubscribeInfo{
+		{Id: models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3c001")), ChannelId: channelID, UserId: models.UserID(uuid.New()), JoinedAt: joinedAt},
+		{Id: models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3c002")), ChannelId: channelID, UserId: models.UserID(uuid.New())},
+		{Id: models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3c003")), ChannelId: channelID, UserId: models.UserID(uuid.New()), JoinedAt: joinedAt},
+	}
+	after := models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3c000"))
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListChannelMembersRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ListChannelMembersResult
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Next page exists",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					Cursor:        after.String(),
+					Limit:         2,
+					CurrentUserId: userID.String(),
+				},
+			},
+			want: &models.ListChannelMembersResult{
+				Members:    subscribes[:2],
+				NextCursor: "284fef68-7e3e-4d1d-96a0-8c96f7b3c002",
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(channel, nil)
+
+				f.SubscribeRepo.On("ListByChannelId", ctx, channelID, &after, 3).
+					Return(subscribes, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "ListByChannelId", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Last page, default limit",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					CurrentUserId: userID.String(),
+				},
+			},
+			want: &models.ListChannelMembersResult{
+				Members: subscribes,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(channel, nil)
+
+				f.SubscribeRepo.On("ListByChannelId", ctx, channelID, (*models.SubscribeID)(nil), models.MembersDefaultLimit+1).
+					Return(subscribes, nil)
+			},
+		},
+		{
+			name: "Test 3. Positive. Limit is capped",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					Limit:         1000,
+					CurrentUserId: userID.String(),
+				},
+			},
+			want: &models.ListChannelMembersResult{
+				Members: subscribes,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(channel, nil)
+
+				f.SubscribeRepo.On("ListByChannelId", ctx, channelID, (*models.SubscribeID)(nil), models.MembersMaxLimit+1).
+					Return(subscribes, nil)
+			},
+		},
+		{
+			name: "Test 4. Negative. Bad cursor",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					Cursor:        "bad",
+					CurrentUserId: userID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list channel members: bad cursor: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(channel, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "ListByChannelId")
+			},
+		},
+		{
+			name: "Test 5. Negative. Channel not found",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					CurrentUserId: userID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list channel members: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 6. Positive. Private channel, access granted",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					CurrentUserId: userID.String(),
+				},
+			},
+			want: &models.ListChannelMembersResult{
+				Members: subscribes,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(private, nil)
+
+				f.AccessRepo.On("GetAccess", ctx, channelID, userID).
+					Return(&models.ChannelAccess{ChannelId: channelID, UserId: userID, Status: models.ChannelAccessGranted}, nil)
+
+				f.SubscribeRepo.On("ListByChannelId", ctx, channelID, (*models.SubscribeID)(nil), models.MembersDefaultLimit+1).
+					Return(subscribes, nil)
+			},
+		},
+		{
+			name: "Test 7. Negative. Private channel, only requested access",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListChannelMembersRequest{
+					ChannelId:     channelID.String(),
+					CurrentUserId: userID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list channel members: private channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(private, nil)
+
+				f.AccessRepo.On("GetAccess", ctx, channelID, userID).
+					Return(&models.ChannelAccess{ChannelId: channelID, UserId: userID, Status: models.ChannelAccessRequested}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "ListByChannelId")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ListChannelMembers(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ListChannelMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_IsChannelMember(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	channelID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	currentUserID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	otherUserID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796"))
+
+	type args struct {
+		ctx context.Context
+		req usecases.IsChannelMemberRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        bool
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Current user is a member",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.IsChannelMemberRequest{
+					ChannelId:     channelID.String(),
+					CurrentUserId: currentUserID.String(),
+				},
+			},
+			want:    true,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("GetSubscribe", ctx, channelID, currentUserID).
+					Return(&models.SubscribeInfo{ChannelId: channelID, UserId: currentUserID}, nil)
+			},
+		},
+		{
+			name: "Test 2. Positive. Other user is not a member",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.IsChannelMemberRequest{
+					ChannelId:     channelID.String(),
+					UserId:        otherUserID.String(),
+					CurrentUserId: currentUserID.String(),
+				},
+			},
+			want:    false,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("GetSubscribe", ctx, channelID, otherUserID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 3. Negative. GetSubscribe returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.IsChannelMemberRequest{
+					ChannelId:     channelID.String(),
+					CurrentUserId: currentUserID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "is channel member: some error",
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("GetSubscribe", ctx, channelID, currentUserID).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.IsChannelMember(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.IsChannelMember() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// SendChannelMessage - members post to text channels, only the owner posts to an announcement channel
+func (u *ChannelUsecase) SendChannelMessage(ctx context.Context, req usecases.SendChannelMessageRequest) (*models.ActionInfo, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	channel, err := u.getMemberChannel(ctx, req.ChannelId, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("send channel message", err)
+	}
+
+	if channel.IsArchived() {
+		return nil, pkgErrors.Wrap("send channel message: channel is archived", models.ErrPermDenied)
+	}
+	if channel.IsAnnouncement() && channel.OwnerId != userID {
+		return nil, pkgErrors.Wrap("send channel message: announcement channel", models.ErrPermDenied)
+	}
+
+	err = u.ChatService.SendChannelMessage(ctx, req)
+	if err != nil {
+		return nil, pkgErrors.Wrap("send channel message", err)
+	}
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+func (u *ChannelUsecase) GetChannelMessages(ctx context.Context, req usecases.GetChannelMessagesRequest) ([]*models.ChannelMessage, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	channel, err := u.getMemberChannel(ctx, req.ChannelId, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("get channel messages", err)
+	}
+
+	messages, err := u.ChatService.GetChannelMessages(ctx, channel.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("get channel messages", err)
+	}
+
+	return messages, nil
+}
+
+// getMemberChannel - channel with a chat the user is the owner or a member of
+func (u *ChannelUsecase) getMemberChannel(ctx context.Context, channelId string, userID models.UserID) (*models.Channel, error) {
+	channel, err := u.ChannelRepo.GetChannelById(ctx, models.ChannelID(uuid.MustParse(channelId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !channel.ChannelType().HasMessages() {
+		return nil, pkgErrors.Wrap("channel type has no messages", models.ErrInvalidArgument)
+	}
+
+	err = u.checkMember(ctx, channel, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// checkMember - the owner, or a subscriber who can access the channel, a private channel needs granted access
+func (u *ChannelUsecase) checkMember(ctx context.Context, channel *models.Channel, userID models.UserID) error {
+	if channel.OwnerId == userID {
+		return nil
+	}
+
+	err := u.checkAccess(ctx, channel, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.SubscribeRepo.GetSubscribe(ctx, channel.Id, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return pkgErrors.Wrap("channel member", models.ErrPermDenied)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,393 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ChannelUsecase_SendChannelMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ChatService   *mocks.ServiceChatInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.SendChannelMessageRequest
+	}
+	memberRequest := usecases.SendChannelMessageRequest{
+		ChannelId:     followTargetID.String(),
+		Text:          "text",
+		CurrentUserId: followSourceOwnerID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Member posts to a text channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, followTargetID, followSourceOwnerID).
+					Return(&models.SubscribeInfo{ChannelId: followTargetID, UserId: followSourceOwnerID}, nil)
+				f.ChatService.On("SendChannelMessage", ctx, memberRequest).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendChannelMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Owner posts to an announcement channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.SendChannelMessageRequest{
+					ChannelId:     followSourceID.String(),
+					Text:          "text",
+					CurrentUserId: followSourceOwnerID.String(),
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.ChatService.On("SendChannelMessage", ctx, mock.AnythingOfType("usecases.SendChannelMessageRequest")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "GetSubscribe", mock.Anything, mock.Anything, mock.Anything)
+				f.ChatService.AssertNumberOfCalls(t, "SendChannelMessage", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. Member posts to an announcement channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.SendChannelMessageRequest{
+					ChannelId:     followSourceID.String(),
+					Text:          "text",
+					CurrentUserId: followTargetOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "send channel message: announcement channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followSourceID).
+					Return(announcementChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, followSourceID, followTargetOwnerID).
+					Return(&models.SubscribeInfo{ChannelId: followSourceID, UserId: followTargetOwnerID}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendChannelMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Not a member",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send channel message: channel member: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, followTargetID, followSourceOwnerID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendChannelMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Voice channel has no messages",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send channel message: channel type has no messages: invalid argument",
+
+			on: func(f *fields) {
+				channel := followingChannel()
+				channel.Type = models.ChannelTypeVoice
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(channel, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendChannelMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. SendChannelMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send channel message: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, followTargetID, followSourceOwnerID).
+					Return(&models.SubscribeInfo{ChannelId: followTargetID, UserId: followSourceOwnerID}, nil)
+				f.ChatService.On("SendChannelMessage", ctx, memberRequest).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendChannelMessage", 1)
+			},
+		},
+		{
+			name: "Test 7. Negative. Archived channel is read-only",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send channel message: channel is archived: permission denied",
+
+			on: func(f *fields) {
+				channel := followingChannel()
+				channel.ArchivedAt = time.Now()
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(channel, nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, followTargetID, followSourceOwnerID).
+					Return(&models.SubscribeInfo{ChannelId: followTargetID, UserId: followSourceOwnerID}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendChannelMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 8. Negative. Member without access to a private channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send channel message: private channel: permission denied",
+
+			on: func(f *fields) {
+				channel := followingChannel()
+				channel.Private = true
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(channel, nil)
+				f.AccessRepo.On("GetAccess", ctx, followTargetID, followSourceOwnerID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "GetSubscribe", mock.Anything, mock.Anything, mock.Anything)
+				f.ChatService.AssertNotCalled(t, "SendChannelMessage", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ChatService:   f.ChatService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.SendChannelMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.SendChannelMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_GetChannelMessages(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetChannelMessagesRequest
+	}
+	messages := []*models.ChannelMessage{
+		{
+			Id:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+			Text:    "text",
+			OwnerId: followSourceOwnerID.String(),
+			Reference: &models.MessageReference{
+				ChannelId: followSourceID.String(),
+				MessageId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.ChannelMessage
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Owner reads cross-posted messages",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetChannelMessagesRequest{
+					ChannelId:     followTargetID.String(),
+					CurrentUserId: followTargetOwnerID.String(),
+				},
+			},
+			want:    messages,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.ChatService.On("GetChannelMessages", ctx, followTargetID).
+					Return(messages, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "GetChannelMessages", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not a member",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetChannelMessagesRequest{
+					ChannelId:     followTargetID.String(),
+					CurrentUserId: followSourceOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "get channel messages: channel member: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, followTargetID).
+					Return(followingChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, followTargetID, followSourceOwnerID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "GetChannelMessages", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetChannelMessages(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GetChannelMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
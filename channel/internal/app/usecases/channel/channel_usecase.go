@@ -8,11 +8,20 @@ import (
 	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
 	log "github.com/Nixonxp/discord/channel/pkg/logger"
 	"github.com/google/uuid"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Deps struct {
 	ChannelRepo   usecases.ChannelStorage
 	SubscribeRepo usecases.SubscribeStorage
+	AccessRepo    usecases.AccessStorage
+	FollowRepo    usecases.FollowStorage
+	PostRepo      usecases.ForumPostStorage
+	ServerService usecases.ServiceServerInterface
+	ChatService   usecases.ServiceChatInterface
+	ChannelEvents usecases.KafkaProducerServiceInterface
 	Log           *log.Logger
 }
 
@@ -28,22 +37,147 @@ func NewChannelUsecase(d Deps) usecases.UsecaseInterface {
 	}
 }
 
+// AddChannel - a channel of a server is placed after its siblings, a category only groups channels of its server
 func (u *ChannelUsecase) AddChannel(ctx context.Context, req usecases.AddChannelRequest) (*models.ActionInfo, error) {
 	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
 	channelID := models.ChannelID(uuid.New())
 
-	err := u.ChannelRepo.CreateChannel(ctx, models.Channel{
+	channel := models.Channel{
 		Id:      channelID,
 		Name:    req.Name,
 		OwnerId: userID,
-	})
+		Type:    models.ChannelTypeText,
+		Topic:   req.Topic,
+		Nsfw:    req.Nsfw,
+		Voice:   req.Voice,
+		Forum:   req.Forum,
+		Private: req.Private,
+	}
+	if req.Type != "" {
+		channel.Type = models.ChannelType(req.Type)
+	}
+	if !channel.Type.Valid() {
+		return nil, pkgErrors.Wrap("create channel: unknown type", models.ErrInvalidArgument)
+	}
+
+	channel.SetDefaultSettings()
+	if err := channel.CheckSettings(); err != nil {
+		return nil, pkgErrors.Wrap("create channel", err)
+	}
+
+	if req.ServerId != "" {
+		channel.ServerId = models.ServerID(uuid.MustParse(req.ServerId))
+	}
+	if req.ParentId != "" {
+		channel.ParentId = models.ChannelID(uuid.MustParse(req.ParentId))
+	}
+
+	if channel.HasServer() {
+		member, err := u.getServerMember(ctx, channel.ServerId, userID)
+		if err != nil {
+			return nil, pkgErrors.Wrap("create channel", err)
+		}
+		if !member.IsOwner {
+			return nil, pkgErrors.Wrap("create channel: not the server owner", models.ErrPermDenied)
+		}
+
+		position, err := u.placeChannel(ctx, &channel)
+		if err != nil {
+			return nil, pkgErrors.Wrap("create channel", err)
+		}
+		channel.Position = position
+	} else if channel.HasParent() {
+		return nil, pkgErrors.Wrap("create channel: category without server", models.ErrInvalidArgument)
+	}
+
+	err := u.ChannelRepo.CreateChannel(ctx, channel)
 	if err != nil {
 		return nil, pkgErrors.Wrap("create channel", err)
 	}
 
+	u.recordAudit(ctx, &channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelCreate,
+		After: map[string]string{
+			"name": channel.Name,
+			"type": string(channel.Type),
+		},
+	})
+
 	return &models.ActionInfo{Success: true}, nil
 }
 
+// UpdateChannel - the type of a channel never changes, settings of another type are rejected
+func (u *ChannelUsecase) UpdateChannel(ctx context.Context, req usecases.UpdateChannelRequest) (*models.Channel, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+	channelID := models.ChannelID(uuid.MustParse(req.ChannelId))
+
+	channel, err := u.ChannelRepo.GetChannelById(ctx, channelID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("update channel", err)
+	}
+
+	if channel.OwnerId != userID {
+		return nil, pkgErrors.Wrap("update channel", models.ErrPermDenied)
+	}
+	if channel.IsArchived() {
+		return nil, pkgErrors.Wrap("update channel: channel is archived", models.ErrPermDenied)
+	}
+	before := channelAuditValues(channel)
+
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, pkgErrors.Wrap("update channel: empty name", models.ErrInvalidArgument)
+		}
+		channel.Name = *req.Name
+	}
+	if req.Topic != nil {
+		channel.Topic = *req.Topic
+	}
+	if req.Nsfw != nil {
+		channel.Nsfw = *req.Nsfw
+	}
+	if req.Voice != nil {
+		channel.Voice = req.Voice
+	}
+	if req.Forum != nil {
+		channel.Forum = req.Forum
+	}
+	if req.Private != nil {
+		channel.Private = *req.Private
+	}
+
+	channel.SetDefaultSettings()
+	if err = channel.CheckSettings(); err != nil {
+		return nil, pkgErrors.Wrap("update channel", err)
+	}
+
+	err = u.ChannelRepo.UpdateChannel(ctx, *channel)
+	if err != nil {
+		return nil, pkgErrors.Wrap("update channel", err)
+	}
+
+	// done on every request that sets the channel private, so a failed removal is finished by a retry
+	if req.Private != nil && *req.Private {
+		err = u.removeMembersWithoutAccess(ctx, channel)
+		if err != nil {
+			return nil, pkgErrors.Wrap("update channel: remove members without access", err)
+		}
+	}
+
+	changedBefore, changedAfter := auditChanges(before, channelAuditValues(channel))
+	if changedAfter != nil {
+		u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+			Action: models.AuditActionChannelUpdate,
+			Before: changedBefore,
+			After:  changedAfter,
+		})
+	}
+
+	return channel, nil
+}
+
+// DeleteChannel - the channel is hidden and can be restored until its grace period is over,
+// subscriptions, follows and messages are removed when the channel is purged
 func (u *ChannelUsecase) DeleteChannel(ctx context.Context, req usecases.DeleteChannelRequest) (*models.ActionInfo, error) {
 	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
 	channelID := models.ChannelID(uuid.MustParse(req.ChannelId))
@@ -60,11 +194,16 @@ func (u *ChannelUsecase) DeleteChannel(ctx context.Context, req usecases.DeleteC
 		return nil, pkgErrors.Wrap("delete channel error", models.ErrPermDenied)
 	}
 
-	err = u.ChannelRepo.DeleteChannel(ctx, channelID)
+	err = u.ChannelRepo.MarkDeleted(ctx, channelID, time.Now())
 	if err != nil {
 		return nil, pkgErrors.Wrap("delete channel error", err)
 	}
 
+	u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+		Action: models.AuditActionChannelDelete,
+		Before: map[string]string{"name": channel.Name},
+	})
+
 	return &models.ActionInfo{Success: true}, nil
 }
 
@@ -72,7 +211,7 @@ func (u *ChannelUsecase) JoinChannel(ctx context.Context, req usecases.JoinChann
 	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
 	channelID := models.ChannelID(uuid.MustParse(req.ChannelId))
 
-	_, err := u.ChannelRepo.GetChannelById(ctx, channelID)
+	channel, err := u.ChannelRepo.GetChannelById(ctx, channelID)
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, pkgErrors.Wrap("channel", err)
@@ -80,14 +219,20 @@ func (u *ChannelUsecase) JoinChannel(ctx context.Context, req usecases.JoinChann
 		return nil, pkgErrors.Wrap("search channel error", err)
 	}
 
+	err = u.checkAccess(ctx, channel, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("join channel", err)
+	}
+
 	newSubscribe := models.SubscribeInfo{
 		Id:        models.SubscribeID(uuid.New()),
 		ChannelId: channelID,
 		UserId:    userID,
+		JoinedAt:  time.Now(),
 	}
-	// todo create index to unique subscribes
+	// rejoining keeps the first subscription
 	err = u.SubscribeRepo.CreateSubscribe(ctx, newSubscribe)
-	if err != nil {
+	if err != nil && !errors.Is(err, models.ErrAlreadyExists) {
 		return nil, pkgErrors.Wrap("create subscribe channel error", err)
 	}
 
@@ -112,3 +257,113 @@ func (u *ChannelUsecase) LeaveChannel(ctx context.Context, req usecases.LeaveCha
 		Success: true,
 	}, nil
 }
+
+// placeChannel - checks the category of a new server channel and returns the position after its siblings
+func (u *ChannelUsecase) placeChannel(ctx context.Context, channel *models.Channel) (int, error) {
+	channels, err := u.ChannelRepo.ListByServerId(ctx, channel.ServerId)
+	if err != nil {
+		return 0, err
+	}
+
+	if channel.HasParent() {
+		if channel.IsCategory() {
+			return 0, pkgErrors.Wrap("category in category", models.ErrInvalidArgument)
+		}
+
+		parent := findChannel(channels, channel.ParentId)
+		if parent == nil {
+			return 0, pkgErrors.Wrap("category", models.ErrNotFound)
+		}
+		if !parent.IsCategory() {
+			return 0, pkgErrors.Wrap("parent is not a category", models.ErrInvalidArgument)
+		}
+		if parent.OwnerId != channel.OwnerId {
+			return 0, pkgErrors.Wrap("category", models.ErrPermDenied)
+		}
+	}
+
+	position := 0
+	for _, sibling := range channels {
+		if sibling.ParentId == channel.ParentId && sibling.IsCategory() == channel.IsCategory() && sibling.Position >= position {
+			position = sibling.Position + 1
+		}
+	}
+
+	return position, nil
+}
+
+// getServerMember - memberships are kept by the server service, users outside of the server are denied
+func (u *ChannelUsecase) getServerMember(ctx context.Context, serverID models.ServerID, userID models.UserID) (*models.ServerMember, error) {
+	member, err := u.ServerService.GetMember(ctx, serverID, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, pkgErrors.Wrap("not a server member", models.ErrPermDenied)
+		}
+		return nil, pkgErrors.Wrap("get server member", err)
+	}
+
+	return member, nil
+}
+
+func findChannel(channels []*models.Channel, id models.ChannelID) *models.Channel {
+	for _, channel := range channels {
+		if channel.Id == id {
+			return channel
+		}
+	}
+
+	return nil
+}
+
+func channelAuditValues(channel *models.Channel) map[string]string {
+	values := map[string]string{
+		"name":    channel.Name,
+		"topic":   channel.Topic,
+		"nsfw":    strconv.FormatBool(channel.Nsfw),
+		"private": strconv.FormatBool(channel.Private),
+	}
+	if channel.Voice != nil {
+		values["bitrate"] = strconv.Itoa(channel.Voice.Bitrate)
+		values["user_limit"] = strconv.Itoa(channel.Voice.UserLimit)
+	}
+	if channel.Forum != nil {
+		values["default_sort"] = string(channel.Forum.DefaultSort)
+		values["tags"] = strings.Join(channel.Forum.Tags, ",")
+	}
+
+	return values
+}
+
+// auditChanges - only the values that differ, nil maps when nothing changed
+func auditChanges(before map[string]string, after map[string]string) (map[string]string, map[string]string) {
+	var changedBefore, changedAfter map[string]string
+	for key, value := range after {
+		if before[key] == value {
+			continue
+		}
+		if changedBefore == nil {
+			changedBefore = make(map[string]string)
+			changedAfter = make(map[string]string)
+		}
+		changedBefore[key] = before[key]
+		changedAfter[key] = value
+	}
+
+	return changedBefore, changedAfter
+}
+
+// recordAudit - writes channel action to the audit log of its server, failures are only logged
+func (u *ChannelUsecase) recordAudit(ctx context.Context, channel *models.Channel, req usecases.RecordAuditEntryRequest) {
+	if !channel.HasServer() {
+		return
+	}
+
+	req.ServerId = channel.ServerId.String()
+	req.ActorId = channel.OwnerId.String()
+	req.TargetType = models.AuditTargetChannel
+	req.TargetId = channel.Id.String()
+
+	if err := u.ServerService.RecordAuditEntry(ctx, req); err != nil {
+		u.Log.WithContext(ctx).WithError(err).Error("record audit entry error")
+	}
+}
@@ -22,8 +22,14 @@ func Test_usecase_ChannelUsecase_AddChannel(t *testing.T) {
 		ChannelRepo   *mocks.ChannelStorage
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
 	}
 
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	memberID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796"))
+	categoryID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b222"))
+
 	type args struct {
 		ctx context.Context
 		req usecases.AddChannelRequest
@@ -95,6 +101,285 @@ func Test_usecase_ChannelUsecase_AddChannel(t *testing.T) {
 				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 1)
 			},
 		},
+		{
+			name: "Test 3. Positive. Channel of a server is recorded to audit log",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, ownerID).
+					Return(&models.ServerMember{UserId: ownerID, IsOwner: true}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return([]*models.Channel{}, nil)
+
+				f.ChannelRepo.On("CreateChannel",
+					ctx,
+					mock.MatchedBy(func(channel models.Channel) bool {
+						return channel.Name == "channel name" &&
+							channel.ServerId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b111" &&
+							channel.OwnerId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
+					})).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry",
+					ctx,
+					mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+						return req.ServerId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b111" &&
+							req.ActorId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795" &&
+							req.Action == models.AuditActionChannelCreate &&
+							req.TargetType == models.AuditTargetChannel &&
+							req.TargetId != "" &&
+							req.After["name"] == "channel name"
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "ListByServerId", 1)
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 4. Positive. Channel is placed into the category after its channels",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					ParentId:      categoryID.String(),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, ownerID).
+					Return(&models.ServerMember{UserId: ownerID, IsOwner: true}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return([]*models.Channel{
+						{Id: categoryID, Name: "category", OwnerId: ownerID, ServerId: serverID, Type: models.ChannelTypeCategory, Position: 0},
+						{Id: models.ChannelID(uuid.New()), Name: "first", OwnerId: ownerID, ServerId: serverID, ParentId: categoryID, Position: 0},
+						{Id: models.ChannelID(uuid.New()), Name: "second", OwnerId: ownerID, ServerId: serverID, ParentId: categoryID, Position: 3},
+						{Id: models.ChannelID(uuid.New()), Name: "outside", OwnerId: ownerID, ServerId: serverID, Position: 7},
+					}, nil)
+
+				f.ChannelRepo.On("CreateChannel",
+					ctx,
+					mock.MatchedBy(func(channel models.Channel) bool {
+						return channel.Type == models.ChannelTypeText &&
+							channel.ParentId == categoryID &&
+							channel.Position == 4
+					})).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 1)
+			},
+		},
+		{
+			name: "Test 5. Negative. Parent is not a category",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					ParentId:      categoryID.String(),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: parent is not a category: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, ownerID).
+					Return(&models.ServerMember{UserId: ownerID, IsOwner: true}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return([]*models.Channel{
+						{Id: categoryID, Name: "text", OwnerId: ownerID, ServerId: serverID, Type: models.ChannelTypeText},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+		{
+			name: "Test 6. Negative. Category can not be placed into a category",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					Type:          string(models.ChannelTypeCategory),
+					ParentId:      categoryID.String(),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: category in category: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, ownerID).
+					Return(&models.ServerMember{UserId: ownerID, IsOwner: true}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return([]*models.Channel{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+		{
+			name: "Test 7. Negative. Unknown type",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					Type:          "stage",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: unknown type: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+		{
+			name: "Test 8. Positive. Voice channel gets default settings",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "voice",
+					Type:          string(models.ChannelTypeVoice),
+					Voice:         &models.VoiceSettings{UserLimit: 10},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("CreateChannel",
+					ctx,
+					mock.MatchedBy(func(channel models.Channel) bool {
+						return channel.Type == models.ChannelTypeVoice &&
+							channel.Voice.Bitrate == models.VoiceDefaultBitrate &&
+							channel.Voice.UserLimit == 10 &&
+							channel.Forum == nil
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 1)
+			},
+		},
+		{
+			name: "Test 9. Negative. Voice channel has no topic",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "voice",
+					Type:          string(models.ChannelTypeVoice),
+					Topic:         "topic",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: channel type has no topic: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+		{
+			name: "Test 10. Negative. Forum settings of a text channel",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "text",
+					Forum:         &models.ForumSettings{Tags: []string{"help"}},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: forum settings are only for forum channels: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+		{
+			name: "Test 11. Negative. Member who does not own the server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: not the server owner: permission denied",
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, memberID).
+					Return(&models.ServerMember{UserId: memberID}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "ListByServerId", 0)
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+		{
+			name: "Test 12. Negative. User outside of the server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddChannelRequest{
+					Name:          "channel name",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create channel: not a server member: permission denied",
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, memberID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -104,11 +389,13 @@ func Test_usecase_ChannelUsecase_AddChannel(t *testing.T) {
 				ChannelRepo:   mocks.NewChannelStorage(t),
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
 			}
 			au := NewChannelUsecase(Deps{
 				ChannelRepo:   f.ChannelRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -137,6 +424,336 @@ func Test_usecase_ChannelUsecase_AddChannel(t *testing.T) {
 	}
 }
 
+func Test_usecase_ChannelUsecase_UpdateChannel(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	channelID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	name := "renamed"
+	topic := "new topic"
+	nsfw := true
+	private := true
+	grantedID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796"))
+	memberID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b797"))
+
+	forumChannel := func() *models.Channel {
+		return &models.Channel{
+			Id:       channelID,
+			Name:     "forum",
+			OwnerId:  ownerID,
+			ServerId: serverID,
+			Type:     models.ChannelTypeForum,
+			Forum: &models.ForumSettings{
+				DefaultSort: models.ForumSortLatestActivity,
+				Tags:        []string{"help"},
+			},
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.UpdateChannelRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.Channel
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Only set fields are changed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId: channelID.String(),
+					Name:      &name,
+					Topic:     &topic,
+					Nsfw:      &nsfw,
+					Forum: &models.ForumSettings{
+						Tags: []string{"help", "bug"},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			want: &models.Channel{
+				Id:       channelID,
+				Name:     "renamed",
+				OwnerId:  ownerID,
+				ServerId: serverID,
+				Type:     models.ChannelTypeForum,
+				Topic:    "new topic",
+				Nsfw:     true,
+				Forum: &models.ForumSettings{
+					DefaultSort: models.ForumSortLatestActivity,
+					Tags:        []string{"help", "bug"},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(forumChannel(), nil)
+
+				f.ChannelRepo.On("UpdateChannel", ctx, mock.MatchedBy(func(channel models.Channel) bool {
+					return channel.Name == "renamed" &&
+						channel.Topic == "new topic" &&
+						channel.Nsfw
+				})).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry",
+					ctx,
+					mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+						return req.Action == models.AuditActionChannelUpdate &&
+							req.TargetId == channelID.String() &&
+							req.Before["name"] == "forum" &&
+							req.After["name"] == "renamed" &&
+							req.After["tags"] == "help,bug" &&
+							len(req.After) == 4
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "UpdateChannel", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Settings of another type",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId:     channelID.String(),
+					Voice:         &models.VoiceSettings{Bitrate: 64000},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "update channel: voice settings are only for voice channels: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(forumChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "UpdateChannel", 0)
+			},
+		},
+		{
+			name: "Test 3. Negative. Not owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId:     channelID.String(),
+					Name:          &name,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "update channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(forumChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "UpdateChannel", 0)
+			},
+		},
+		{
+			name: "Test 4. Negative. GetChannelById returns not found error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId:     channelID.String(),
+					Name:          &name,
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "update channel: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 5. Negative. UpdateChannel returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId:     channelID.String(),
+					Name:          &name,
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "update channel: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(forumChannel(), nil)
+
+				f.ChannelRepo.On("UpdateChannel", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 0)
+			},
+		},
+		{
+			name: "Test 6. Positive. Members without access leave the channel made private",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId:     channelID.String(),
+					Private:       &private,
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			want: &models.Channel{
+				Id:       channelID,
+				Name:     "forum",
+				OwnerId:  ownerID,
+				ServerId: serverID,
+				Type:     models.ChannelTypeForum,
+				Private:  true,
+				Forum: &models.ForumSettings{
+					DefaultSort: models.ForumSortLatestActivity,
+					Tags:        []string{"help"},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(forumChannel(), nil)
+
+				f.ChannelRepo.On("UpdateChannel", ctx, mock.MatchedBy(func(channel models.Channel) bool {
+					return channel.Private
+				})).
+					Return(nil)
+
+				f.SubscribeRepo.On("ListByChannelId", ctx, channelID, (*models.SubscribeID)(nil), models.MembersMaxLimit).
+					Return([]*models.SubscribeInfo{
+						{ChannelId: channelID, UserId: ownerID},
+						{ChannelId: channelID, UserId: grantedID},
+						{ChannelId: channelID, UserId: memberID},
+					}, nil)
+
+				f.AccessRepo.On("GetAccess", ctx, channelID, grantedID).
+					Return(&models.ChannelAccess{ChannelId: channelID, UserId: grantedID, Status: models.ChannelAccessGranted}, nil)
+				f.AccessRepo.On("GetAccess", ctx, channelID, memberID).
+					Return(nil, models.ErrNotFound)
+
+				f.SubscribeRepo.On("DeleteSubscribe", ctx, channelID, memberID).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteSubscribe", 1)
+				f.AccessRepo.AssertNotCalled(t, "GetAccess", mock.Anything, channelID, ownerID)
+			},
+		},
+		{
+			name: "Test 7. Negative. Members listing returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateChannelRequest{
+					ChannelId:     channelID.String(),
+					Private:       &private,
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "update channel: remove members without access: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, channelID).
+					Return(forumChannel(), nil)
+
+				f.ChannelRepo.On("UpdateChannel", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("ListByChannelId", ctx, channelID, (*models.SubscribeID)(nil), models.MembersMaxLimit).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteSubscribe", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.UpdateChannel(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.UpdateChannel() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
 func Test_usecase_ChannelUsecase_DeleteChannel(t *testing.T) {
 	// prepare
 	var (
@@ -146,6 +763,8 @@ func Test_usecase_ChannelUsecase_DeleteChannel(t *testing.T) {
 		ChannelRepo   *mocks.ChannelStorage
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
+		FollowRepo    *mocks.FollowStorage
+		ServerService *mocks.ServiceServerInterface
 	}
 
 	type args struct {
@@ -186,18 +805,19 @@ func Test_usecase_ChannelUsecase_DeleteChannel(t *testing.T) {
 						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
 					}, nil)
 
-				f.ChannelRepo.On("DeleteChannel",
+				f.ChannelRepo.On("MarkDeleted",
 					ctx,
-					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					mock.AnythingOfType("time.Time")).
 					Return(nil)
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.ChannelRepo.AssertNumberOfCalls(t, "GetChannelById", 1)
-				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteChannel", 1)
+				f.ChannelRepo.AssertNumberOfCalls(t, "MarkDeleted", 1)
 			},
 		},
 		{
-			name: "Test 2. Negative. DeleteChannel returns error",
+			name: "Test 2. Negative. MarkDeleted returns error",
 			args: args{
 				ctx: ctx, // dumm
 				req: usecases.DeleteChannelRequest{
@@ -221,14 +841,15 @@ func Test_usecase_ChannelUsecase_DeleteChannel(t *testing.T) {
 						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
 					}, nil)
 
-				f.ChannelRepo.On("DeleteChannel",
+				f.ChannelRepo.On("MarkDeleted",
 					ctx,
-					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					mock.AnythingOfType("time.Time")).
 					Return(errors.New("some error"))
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.ChannelRepo.AssertNumberOfCalls(t, "GetChannelById", 1)
-				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteChannel", 1)
+				f.ChannelRepo.AssertNumberOfCalls(t, "MarkDeleted", 1)
 			},
 		},
 		{
@@ -321,6 +942,89 @@ func Test_usecase_ChannelUsecase_DeleteChannel(t *testing.T) {
 				f.ChannelRepo.AssertNumberOfCalls(t, "GetChannelById", 1)
 			},
 		},
+		{
+			name: "Test 6. Positive. Channel of a server is recorded to audit log",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteChannelRequest{
+					ChannelId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					Return(&models.Channel{
+						Id:       models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:     "name",
+						OwnerId:  models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						ServerId: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111")),
+					}, nil)
+
+				f.ChannelRepo.On("MarkDeleted",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry",
+					ctx,
+					usecases.RecordAuditEntryRequest{
+						ServerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+						ActorId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						Action:     models.AuditActionChannelDelete,
+						TargetType: models.AuditTargetChannel,
+						TargetId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						Before:     map[string]string{"name": "name"},
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "MarkDeleted", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 7. Positive. Follows are kept until the channel is purged",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteChannelRequest{
+					ChannelId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					Return(&models.Channel{
+						Id:      models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "name",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChannelRepo.On("MarkDeleted",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					mock.AnythingOfType("time.Time")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "DeleteChannel", mock.Anything, mock.Anything)
+				f.FollowRepo.AssertNotCalled(t, "DeleteByChannelIds", mock.Anything, mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -330,11 +1034,15 @@ func Test_usecase_ChannelUsecase_DeleteChannel(t *testing.T) {
 				ChannelRepo:   mocks.NewChannelStorage(t),
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				FollowRepo:    mocks.NewFollowStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
 			}
 			au := NewChannelUsecase(Deps{
 				ChannelRepo:   f.ChannelRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
+				FollowRepo:    f.FollowRepo,
+				ServerService: f.ServerService,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -372,6 +1080,8 @@ func Test_usecase_ChannelUsecase_JoinChannel(t *testing.T) {
 		ChannelRepo   *mocks.ChannelStorage
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		ServerService *mocks.ServiceServerInterface
 	}
 
 	type args struct {
@@ -525,6 +1235,115 @@ func Test_usecase_ChannelUsecase_JoinChannel(t *testing.T) {
 				f.ChannelRepo.AssertNumberOfCalls(t, "GetChannelById", 1)
 			},
 		},
+		{
+			name: "Test 6. Positive. Rejoin keeps the subscription",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.JoinChannelRequest{
+					ChannelId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					Return(&models.Channel{
+						Id:      models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "name",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796")),
+					}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe",
+					ctx,
+					mock.MatchedBy(func(info models.SubscribeInfo) bool {
+						return !info.JoinedAt.IsZero() &&
+							info.ChannelId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b000" &&
+							info.UserId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
+					})).
+					Return(models.ErrAlreadyExists)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "GetChannelById", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+			},
+		},
+		{
+			name: "Test 7. Negative. Private channel without access",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.JoinChannelRequest{
+					ChannelId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "join channel: private channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					Return(&models.Channel{
+						Id:      models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "name",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796")),
+						Private: true,
+					}, nil)
+
+				f.AccessRepo.On("GetAccess",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 8. Positive. Private channel with granted access",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.JoinChannelRequest{
+					ChannelId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))).
+					Return(&models.Channel{
+						Id:      models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "name",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796")),
+						Private: true,
+					}, nil)
+
+				f.AccessRepo.On("GetAccess",
+					ctx,
+					models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))).
+					Return(&models.ChannelAccess{Status: models.ChannelAccessGranted}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -534,11 +1353,15 @@ func Test_usecase_ChannelUsecase_JoinChannel(t *testing.T) {
 				ChannelRepo:   mocks.NewChannelStorage(t),
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
 			}
 			au := NewChannelUsecase(Deps{
 				ChannelRepo:   f.ChannelRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				ServerService: f.ServerService,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -576,6 +1399,7 @@ func Test_usecase_ChannelUsecase_LeaveChannel(t *testing.T) {
 		ChannelRepo   *mocks.ChannelStorage
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
 	}
 
 	type args struct {
@@ -681,11 +1505,13 @@ func Test_usecase_ChannelUsecase_LeaveChannel(t *testing.T) {
 				ChannelRepo:   mocks.NewChannelStorage(t),
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
 			}
 			au := NewChannelUsecase(Deps{
 				ChannelRepo:   f.ChannelRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
 			})
 			if tt.on != nil {
 				tt.on(f)
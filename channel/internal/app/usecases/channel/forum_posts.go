@@ -0,0 +1,186 @@
+package channel
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+// deletePostTimeout - time given to remove a post whose opening message was not sent
+const deletePostTimeout = 10 * time.Second
+
+// CreateForumPost - the post is stored before its opening message and removed again when the message is not sent
+func (u *ChannelUsecase) CreateForumPost(ctx context.Context, req usecases.CreateForumPostRequest) (*models.ForumPost, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	channel, err := u.getForumChannel(ctx, models.ChannelID(uuid.MustParse(req.ChannelId)), userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("create forum post", err)
+	}
+
+	if channel.IsArchived() {
+		return nil, pkgErrors.Wrap("create forum post: channel is archived", models.ErrPermDenied)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" || len(title) > models.ForumPostMaxTitleLength {
+		return nil, pkgErrors.Wrap("create forum post: invalid title", models.ErrInvalidArgument)
+	}
+
+	tags := req.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	err = channel.Forum.CheckPostTags(tags)
+	if err != nil {
+		return nil, pkgErrors.Wrap("create forum post", err)
+	}
+
+	now := time.Now()
+	post := &models.ForumPost{
+		Id:             models.PostID(uuid.New()),
+		ChannelId:      channel.Id,
+		OwnerId:        userID,
+		Title:          title,
+		Tags:           tags,
+		CreatedAt:      now,
+		LastActivityAt: now,
+	}
+
+	err = u.PostRepo.CreatePost(ctx, *post)
+	if err != nil {
+		return nil, pkgErrors.Wrap("create forum post", err)
+	}
+
+	err = u.ChatService.SendPostMessage(ctx, post, req.Text, userID)
+	if err != nil {
+		u.deletePost(ctx, post.Id)
+		return nil, pkgErrors.Wrap("create forum post: send message", err)
+	}
+
+	return post, nil
+}
+
+// ListForumPosts - newest first by latest activity or by creation, the forum default sort is used when none is asked
+func (u *ChannelUsecase) ListForumPosts(ctx context.Context, req usecases.ListForumPostsRequest) ([]*models.ForumPost, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	channel, err := u.getForumChannel(ctx, models.ChannelID(uuid.MustParse(req.ChannelId)), userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list forum posts", err)
+	}
+
+	sort := channel.Forum.DefaultSort
+	if req.Sort != "" {
+		sort = models.ForumSort(req.Sort)
+	}
+	if !sort.Valid() {
+		return nil, pkgErrors.Wrap("list forum posts: unknown sort", models.ErrInvalidArgument)
+	}
+
+	if req.Tag != "" && !channel.Forum.HasTag(req.Tag) {
+		return nil, pkgErrors.Wrap("list forum posts: unknown tag", models.ErrInvalidArgument)
+	}
+
+	posts, err := u.PostRepo.ListByChannelId(ctx, channel.Id, sort, req.Tag)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list forum posts", err)
+	}
+
+	return posts, nil
+}
+
+// SendForumPostMessage - members of the forum answer posts, every message moves the post up in the latest activity sort
+func (u *ChannelUsecase) SendForumPostMessage(ctx context.Context, req usecases.SendForumPostMessageRequest) (*models.ActionInfo, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	post, channel, err := u.getForumPost(ctx, req.PostId, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("send forum post message", err)
+	}
+
+	if channel.IsArchived() {
+		return nil, pkgErrors.Wrap("send forum post message: channel is archived", models.ErrPermDenied)
+	}
+
+	err = u.ChatService.SendPostMessage(ctx, post, req.Text, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("send forum post message", err)
+	}
+
+	err = u.PostRepo.UpdateLastActivity(ctx, post.Id, time.Now())
+	if err != nil {
+		return nil, pkgErrors.Wrap("send forum post message: update activity", err)
+	}
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+func (u *ChannelUsecase) GetForumPostMessages(ctx context.Context, req usecases.GetForumPostMessagesRequest) ([]*models.ChannelMessage, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	post, _, err := u.getForumPost(ctx, req.PostId, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("get forum post messages", err)
+	}
+
+	messages, err := u.ChatService.GetPostMessages(ctx, post.Id)
+	if err != nil {
+		return nil, pkgErrors.Wrap("get forum post messages", err)
+	}
+
+	return messages, nil
+}
+
+// deletePost - runs detached from the request context, which may be the cause of the failure, errors are only logged
+func (u *ChannelUsecase) deletePost(ctx context.Context, postId models.PostID) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deletePostTimeout)
+	defer cancel()
+
+	err := u.PostRepo.DeletePost(ctx, postId)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("post_id", postId.String()).Error("delete forum post error")
+	}
+}
+
+// getForumChannel - forum channel the user is the owner or a member of
+func (u *ChannelUsecase) getForumChannel(ctx context.Context, channelId models.ChannelID, userID models.UserID) (*models.Channel, error) {
+	channel, err := u.ChannelRepo.GetChannelById(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.ChannelType() != models.ChannelTypeForum {
+		return nil, pkgErrors.Wrap("channel is not a forum", models.ErrInvalidArgument)
+	}
+	// forums created before settings were stored
+	if channel.Forum == nil {
+		channel.SetDefaultSettings()
+	}
+
+	err = u.checkMember(ctx, channel, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// getForumPost - posts of deleted forums are not found
+func (u *ChannelUsecase) getForumPost(ctx context.Context, postId string, userID models.UserID) (*models.ForumPost, *models.Channel, error) {
+	post, err := u.PostRepo.GetPostById(ctx, models.PostID(uuid.MustParse(postId)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channel, err := u.getForumChannel(ctx, post.ChannelId, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return post, channel, nil
+}
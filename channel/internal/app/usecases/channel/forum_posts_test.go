@@ -0,0 +1,844 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+var (
+	forumID       = models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b010"))
+	forumOwnerID  = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	forumMemberID = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b797"))
+	forumPostID   = models.PostID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b910"))
+)
+
+func forumChannel() *models.Channel {
+	return &models.Channel{
+		Id:      forumID,
+		Name:    "help",
+		OwnerId: forumOwnerID,
+		Type:    models.ChannelTypeForum,
+		Forum: &models.ForumSettings{
+			DefaultSort: models.ForumSortLatestActivity,
+			Tags:        []string{"bug", "question"},
+		},
+	}
+}
+
+func forumPost() *models.ForumPost {
+	return &models.ForumPost{
+		Id:        forumPostID,
+		ChannelId: forumID,
+		OwnerId:   forumMemberID,
+		Title:     "title",
+		Tags:      []string{"bug"},
+	}
+}
+
+func memberSubscribe() *models.SubscribeInfo {
+	return &models.SubscribeInfo{ChannelId: forumID, UserId: forumMemberID}
+}
+
+func Test_usecase_ChannelUsecase_CreateForumPost(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		PostRepo      *mocks.ForumPostStorage
+		ChatService   *mocks.ServiceChatInterface
+	}
+
+	// the post is removed even when the request is already cancelled
+	detachedCtx := mock.MatchedBy(func(c context.Context) bool {
+		_, hasDeadline := c.Deadline()
+		return c.Err() == nil && hasDeadline
+	})
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateForumPostRequest
+	}
+	memberRequest := usecases.CreateForumPostRequest{
+		ChannelId:     forumID.String(),
+		Title:         " title ",
+		Text:          "text",
+		Tags:          []string{"bug"},
+		CurrentUserId: forumMemberID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields, *models.ForumPost)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.PostRepo.On("CreatePost", ctx, mock.AnythingOfType("models.ForumPost")).
+					Return(nil)
+				f.ChatService.On("SendPostMessage", ctx, mock.AnythingOfType("*models.ForumPost"), "text", forumMemberID).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields, post *models.ForumPost) {
+				assert.Equal(t, "title", post.Title)
+				assert.Equal(t, forumID, post.ChannelId)
+				assert.Equal(t, forumMemberID, post.OwnerId)
+				assert.Equal(t, []string{"bug"}, post.Tags)
+				assert.Equal(t, post.CreatedAt, post.LastActivityAt)
+				f.PostRepo.AssertNumberOfCalls(t, "CreatePost", 1)
+				f.ChatService.AssertNumberOfCalls(t, "SendPostMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not a forum",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "create forum post: channel is not a forum: invalid argument",
+
+			on: func(f *fields) {
+				channel := forumChannel()
+				channel.Type = models.ChannelTypeText
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(channel, nil)
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				f.PostRepo.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Tag is not in the forum tags",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.CreateForumPostRequest{
+					ChannelId:     forumID.String(),
+					Title:         "title",
+					Text:          "text",
+					Tags:          []string{"feature"},
+					CurrentUserId: forumMemberID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "create forum post: invalid post tag: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				f.PostRepo.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Empty title",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.CreateForumPostRequest{
+					ChannelId:     forumID.String(),
+					Title:         "  ",
+					Text:          "text",
+					CurrentUserId: forumMemberID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "create forum post: invalid title: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				f.PostRepo.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Not a member",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "create forum post: channel member: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				f.PostRepo.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Archived forum",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "create forum post: channel is archived: permission denied",
+
+			on: func(f *fields) {
+				channel := forumChannel()
+				channel.ArchivedAt = time.Now()
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(channel, nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				f.PostRepo.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. SendPostMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "create forum post: send message: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.PostRepo.On("CreatePost", ctx, mock.AnythingOfType("models.ForumPost")).
+					Return(nil)
+				f.ChatService.On("SendPostMessage", ctx, mock.AnythingOfType("*models.ForumPost"), "text", forumMemberID).
+					Return(errors.New("some error"))
+				f.PostRepo.On("DeletePost", mock.Anything, mock.AnythingOfType("models.PostID")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				created := f.PostRepo.Calls[0].Arguments.Get(1).(models.ForumPost)
+				f.PostRepo.AssertCalled(t, "DeletePost", mock.Anything, created.Id)
+			},
+		},
+		{
+			name: "Test 8. Negative. Post is removed after the request is cancelled",
+			args: args{
+				ctx: cancelledCtx,
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "create forum post: send message: context canceled",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", cancelledCtx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", cancelledCtx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.PostRepo.On("CreatePost", cancelledCtx, mock.AnythingOfType("models.ForumPost")).
+					Return(nil)
+				f.ChatService.On("SendPostMessage", cancelledCtx, mock.AnythingOfType("*models.ForumPost"), "text", forumMemberID).
+					Return(context.Canceled)
+				f.PostRepo.On("DeletePost", detachedCtx, mock.AnythingOfType("models.PostID")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields, _ *models.ForumPost) {
+				f.PostRepo.AssertNumberOfCalls(t, "DeletePost", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			logger, err := log.NewLogger(log.NewDefaultConfig())
+			assert.NoError(t, err)
+
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           logger,
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				PostRepo:      mocks.NewForumPostStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				PostRepo:      f.PostRepo,
+				ChatService:   f.ChatService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateForumPost(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateForumPost() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f, got)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_ListForumPosts(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		PostRepo      *mocks.ForumPostStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListForumPostsRequest
+	}
+	posts := []*models.ForumPost{forumPost()}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.ForumPost
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Forum default sort",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListForumPostsRequest{
+					ChannelId:     forumID.String(),
+					CurrentUserId: forumOwnerID.String(),
+				},
+			},
+			want:    posts,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.PostRepo.On("ListByChannelId", ctx, forumID, models.ForumSortLatestActivity, "").
+					Return(posts, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "GetSubscribe", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 2. Positive. Sort by creation filtered by tag",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListForumPostsRequest{
+					ChannelId:     forumID.String(),
+					Sort:          "creation_date",
+					Tag:           "bug",
+					CurrentUserId: forumMemberID.String(),
+				},
+			},
+			want:    posts,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.PostRepo.On("ListByChannelId", ctx, forumID, models.ForumSortCreationDate, "bug").
+					Return(posts, nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. Unknown sort",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListForumPostsRequest{
+					ChannelId:     forumID.String(),
+					Sort:          "title",
+					CurrentUserId: forumOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list forum posts: unknown sort: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.PostRepo.AssertNotCalled(t, "ListByChannelId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Unknown tag",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListForumPostsRequest{
+					ChannelId:     forumID.String(),
+					Tag:           "feature",
+					CurrentUserId: forumOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list forum posts: unknown tag: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.PostRepo.AssertNotCalled(t, "ListByChannelId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. ListByChannelId returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.ListForumPostsRequest{
+					ChannelId:     forumID.String(),
+					CurrentUserId: forumOwnerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "list forum posts: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.PostRepo.On("ListByChannelId", ctx, forumID, models.ForumSortLatestActivity, "").
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				PostRepo:      mocks.NewForumPostStorage(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				PostRepo:      f.PostRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ListForumPosts(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ListForumPosts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_SendForumPostMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		PostRepo      *mocks.ForumPostStorage
+		ChatService   *mocks.ServiceChatInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.SendForumPostMessageRequest
+	}
+	memberRequest := usecases.SendForumPostMessageRequest{
+		PostId:        forumPostID.String(),
+		Text:          "text",
+		CurrentUserId: forumMemberID.String(),
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Message moves the post up",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.ChatService.On("SendPostMessage", ctx, forumPost(), "text", forumMemberID).
+					Return(nil)
+				f.PostRepo.On("UpdateLastActivity", ctx, forumPostID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendPostMessage", 1)
+				f.PostRepo.AssertNumberOfCalls(t, "UpdateLastActivity", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Post is not found",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send forum post message: not found",
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendPostMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Archived forum",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send forum post message: channel is archived: permission denied",
+
+			on: func(f *fields) {
+				channel := forumChannel()
+				channel.ArchivedAt = time.Now()
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(channel, nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendPostMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. SendPostMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: memberRequest,
+			},
+			wantErr:     true,
+			errorString: "send forum post message: some error",
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.ChatService.On("SendPostMessage", ctx, forumPost(), "text", forumMemberID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.PostRepo.AssertNotCalled(t, "UpdateLastActivity", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				PostRepo:      mocks.NewForumPostStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				PostRepo:      f.PostRepo,
+				ChatService:   f.ChatService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.SendForumPostMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.SendForumPostMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_GetForumPostMessages(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		PostRepo      *mocks.ForumPostStorage
+		ChatService   *mocks.ServiceChatInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetForumPostMessagesRequest
+	}
+	messages := []*models.ChannelMessage{
+		{
+			Id:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+			Text:    "text",
+			OwnerId: forumMemberID.String(),
+		},
+	}
+	privateForum := func() *models.Channel {
+		channel := forumChannel()
+		channel.Private = true
+		return channel
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.ChannelMessage
+		wantErr     bool
+		errorString string
+
+		on func(*fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetForumPostMessagesRequest{
+					PostId:        forumPostID.String(),
+					CurrentUserId: forumOwnerID.String(),
+				},
+			},
+			want:    messages,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.ChatService.On("GetPostMessages", ctx, forumPostID).
+					Return(messages, nil)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not a member",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetForumPostMessagesRequest{
+					PostId:        forumPostID.String(),
+					CurrentUserId: forumMemberID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "get forum post messages: channel member: permission denied",
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(forumChannel(), nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 3. Positive. Member granted access to a private forum",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetForumPostMessagesRequest{
+					PostId:        forumPostID.String(),
+					CurrentUserId: forumMemberID.String(),
+				},
+			},
+			want:    messages,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(privateForum(), nil)
+				f.AccessRepo.On("GetAccess", ctx, forumID, forumMemberID).
+					Return(&models.ChannelAccess{ChannelId: forumID, UserId: forumMemberID, Status: models.ChannelAccessGranted}, nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, forumID, forumMemberID).
+					Return(memberSubscribe(), nil)
+				f.ChatService.On("GetPostMessages", ctx, forumPostID).
+					Return(messages, nil)
+			},
+		},
+		{
+			name: "Test 4. Negative. Member without access to a private forum",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetForumPostMessagesRequest{
+					PostId:        forumPostID.String(),
+					CurrentUserId: forumMemberID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "get forum post messages: private channel: permission denied",
+
+			on: func(f *fields) {
+				f.PostRepo.On("GetPostById", ctx, forumPostID).
+					Return(forumPost(), nil)
+				f.ChannelRepo.On("GetChannelById", ctx, forumID).
+					Return(privateForum(), nil)
+				f.AccessRepo.On("GetAccess", ctx, forumID, forumMemberID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				PostRepo:      mocks.NewForumPostStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				PostRepo:      f.PostRepo,
+				ChatService:   f.ChatService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetForumPostMessages(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GetForumPostMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
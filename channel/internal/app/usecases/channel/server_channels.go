@@ -0,0 +1,295 @@
+package channel
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	pkgErrors "github.com/Nixonxp/discord/channel/pkg/errors"
+	"github.com/google/uuid"
+	"strconv"
+	"time"
+)
+
+// ListServerChannels - channels in display order, see models.SortChannelTree,
+// archived channels are listed only when asked for
+func (u *ChannelUsecase) ListServerChannels(ctx context.Context, req usecases.ListServerChannelsRequest) ([]*models.Channel, error) {
+	serverID := models.ServerID(uuid.MustParse(req.ServerId))
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	_, err := u.getServerMember(ctx, serverID, userID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list server channels", err)
+	}
+
+	channels, err := u.ChannelRepo.ListByServerId(ctx, serverID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("list server channels", err)
+	}
+
+	return models.SortChannelTree(visibleChannels(channels, req.IncludeArchived)), nil
+}
+
+// ReorderChannels - moves channels between categories and changes their positions in one call,
+// the whole request is checked before the write and the old layout is written back when the write fails
+func (u *ChannelUsecase) ReorderChannels(ctx context.Context, req usecases.ReorderChannelsRequest) ([]*models.Channel, error) {
+	serverID := models.ServerID(uuid.MustParse(req.ServerId))
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	if len(req.Positions) == 0 {
+		return nil, pkgErrors.Wrap("reorder channels: no positions", models.ErrInvalidArgument)
+	}
+
+	channels, err := u.ChannelRepo.ListByServerId(ctx, serverID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("reorder channels", err)
+	}
+
+	positions := make([]models.ChannelPosition, len(req.Positions))
+	listed := make(map[models.ChannelID]bool, len(req.Positions))
+	for i, item := range req.Positions {
+		position := models.ChannelPosition{
+			ChannelId: models.ChannelID(uuid.MustParse(item.ChannelId)),
+			Position:  item.Position,
+		}
+		if item.ParentId != "" {
+			position.ParentId = models.ChannelID(uuid.MustParse(item.ParentId))
+		}
+
+		if listed[position.ChannelId] {
+			return nil, pkgErrors.Wrap("reorder channels: channel is listed twice", models.ErrInvalidArgument)
+		}
+		listed[position.ChannelId] = true
+
+		err = checkChannelPosition(channels, position, userID)
+		if err != nil {
+			return nil, pkgErrors.Wrap("reorder channels", err)
+		}
+
+		positions[i] = position
+	}
+
+	previous := make([]models.ChannelPosition, len(positions))
+	for i, position := range positions {
+		channel := findChannel(channels, position.ChannelId)
+		previous[i] = models.ChannelPosition{
+			ChannelId: channel.Id,
+			ParentId:  channel.ParentId,
+			Position:  channel.Position,
+		}
+	}
+
+	err = u.ChannelRepo.SetPositions(ctx, serverID, positions)
+	if err != nil {
+		u.restorePositions(ctx, serverID, previous)
+		return nil, pkgErrors.Wrap("reorder channels", err)
+	}
+
+	for i, position := range positions {
+		channel := findChannel(channels, position.ChannelId)
+		channel.ParentId = position.ParentId
+		channel.Position = position.Position
+
+		if previous[i] != position {
+			u.recordAudit(ctx, channel, usecases.RecordAuditEntryRequest{
+				Action: models.AuditActionChannelUpdate,
+				Before: positionAudit(previous[i]),
+				After:  positionAudit(position),
+			})
+		}
+	}
+
+	return models.SortChannelTree(channels), nil
+}
+
+// CreateServerChannels - creates all channels or none, channels created before a failure are deleted,
+// channels keep the order of the request within their category
+func (u *ChannelUsecase) CreateServerChannels(ctx context.Context, req usecases.CreateServerChannelsRequest) ([]*models.Channel, error) {
+	serverID := models.ServerID(uuid.MustParse(req.ServerId))
+	ownerID := models.UserID(uuid.MustParse(req.OwnerId))
+
+	// every channel is checked before the first one is created
+	channels := make([]*models.Channel, len(req.Channels))
+	positions := make(map[models.ChannelID]int)
+	for i, spec := range req.Channels {
+		channel := &models.Channel{
+			Id:       models.ChannelID(uuid.New()),
+			Name:     spec.Name,
+			OwnerId:  ownerID,
+			ServerId: serverID,
+			Type:     models.ChannelTypeText,
+			Topic:    spec.Topic,
+			Nsfw:     spec.Nsfw,
+			Voice:    spec.Voice,
+			Forum:    spec.Forum,
+			Private:  spec.Private,
+		}
+		if spec.Type != "" {
+			channel.Type = models.ChannelType(spec.Type)
+		}
+		if !channel.Type.Valid() {
+			return nil, pkgErrors.Wrap("create server channels: unknown type", models.ErrInvalidArgument)
+		}
+
+		channel.SetDefaultSettings()
+		if err := channel.CheckSettings(); err != nil {
+			return nil, pkgErrors.Wrap("create server channels", err)
+		}
+
+		if spec.ParentIndex != nil {
+			parentIndex := *spec.ParentIndex
+			if parentIndex < 0 || parentIndex >= i || !channels[parentIndex].IsCategory() || channel.IsCategory() {
+				return nil, pkgErrors.Wrap("create server channels: parent is not an earlier category", models.ErrInvalidArgument)
+			}
+			channel.ParentId = channels[parentIndex].Id
+		}
+
+		channel.Position = positions[channel.ParentId]
+		positions[channel.ParentId]++
+
+		channels[i] = channel
+	}
+
+	for i, channel := range channels {
+		err := u.ChannelRepo.CreateChannel(ctx, *channel)
+		if err != nil {
+			u.deleteChannels(ctx, channels[:i])
+			return nil, pkgErrors.Wrap("create server channels", err)
+		}
+	}
+
+	return models.SortChannelTree(channels), nil
+}
+
+// DeleteServerChannels - removes channels of the server with their subscribes, deleted channels waiting for purge
+// included, repeated calls are no-op
+func (u *ChannelUsecase) DeleteServerChannels(ctx context.Context, req usecases.DeleteServerChannelsRequest) (*models.ActionInfo, error) {
+	serverID := models.ServerID(uuid.MustParse(req.ServerId))
+
+	channels, err := u.ChannelRepo.ListAllByServerId(ctx, serverID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("delete server channels", err)
+	}
+
+	if len(channels) == 0 {
+		return &models.ActionInfo{Success: true}, nil
+	}
+
+	channelIds := make([]models.ChannelID, len(channels))
+	for i, channel := range channels {
+		channelIds[i] = channel.Id
+	}
+
+	// events, subscribes, access lists, follows and forum posts go first, channels left after a failure are found again on retry
+	deletedAt := time.Now()
+	for _, channel := range channels {
+		err = u.ChannelEvents.PublishChannelDeleted(ctx, models.ChannelDeletedEvent{
+			ChannelId: channel.Id,
+			ServerId:  serverID,
+			DeletedAt: deletedAt,
+		})
+		if err != nil {
+			return nil, pkgErrors.Wrap("delete server channels: publish channel deleted", err)
+		}
+	}
+
+	_, err = u.SubscribeRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return nil, pkgErrors.Wrap("delete server channels subscribes", err)
+	}
+
+	_, err = u.AccessRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return nil, pkgErrors.Wrap("delete server channels access", err)
+	}
+
+	_, err = u.FollowRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return nil, pkgErrors.Wrap("delete server channels follows", err)
+	}
+
+	_, err = u.PostRepo.DeleteByChannelIds(ctx, channelIds)
+	if err != nil {
+		return nil, pkgErrors.Wrap("delete server channels forum posts", err)
+	}
+
+	_, err = u.ChannelRepo.DeleteByServerId(ctx, serverID)
+	if err != nil {
+		return nil, pkgErrors.Wrap("delete server channels", err)
+	}
+
+	return &models.ActionInfo{Success: true}, nil
+}
+
+// checkChannelPosition - the channel and its new category belong to the server and to the user,
+// categories stay at the top level
+func checkChannelPosition(channels []*models.Channel, position models.ChannelPosition, userID models.UserID) error {
+	if position.Position < 0 {
+		return pkgErrors.Wrap("negative position", models.ErrInvalidArgument)
+	}
+
+	channel := findChannel(channels, position.ChannelId)
+	if channel == nil {
+		return pkgErrors.Wrap("channel", models.ErrNotFound)
+	}
+	if channel.OwnerId != userID {
+		return pkgErrors.Wrap("channel", models.ErrPermDenied)
+	}
+
+	if position.ParentId == (models.ChannelID{}) {
+		return nil
+	}
+
+	if channel.IsCategory() {
+		return pkgErrors.Wrap("category in category", models.ErrInvalidArgument)
+	}
+
+	parent := findChannel(channels, position.ParentId)
+	if parent == nil {
+		return pkgErrors.Wrap("category", models.ErrNotFound)
+	}
+	if !parent.IsCategory() {
+		return pkgErrors.Wrap("parent is not a category", models.ErrInvalidArgument)
+	}
+	if parent.OwnerId != userID {
+		return pkgErrors.Wrap("category", models.ErrPermDenied)
+	}
+
+	return nil
+}
+
+// restorePositionsTimeout - time given to the rollback of a failed reorder
+const restorePositionsTimeout = 30 * time.Second
+
+// restorePositions - best effort rollback of a failed reorder, the caller is already failing,
+// it runs detached from the request context, which may already be cancelled or past its deadline
+func (u *ChannelUsecase) restorePositions(ctx context.Context, serverID models.ServerID, positions []models.ChannelPosition) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restorePositionsTimeout)
+	defer cancel()
+
+	err := u.ChannelRepo.SetPositions(ctx, serverID, positions)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverID.String()).Error("rollback channel positions error")
+	}
+}
+
+func positionAudit(position models.ChannelPosition) map[string]string {
+	parentId := ""
+	if position.ParentId != (models.ChannelID{}) {
+		parentId = position.ParentId.String()
+	}
+
+	return map[string]string{
+		"parent_id": parentId,
+		"position":  strconv.Itoa(position.Position),
+	}
+}
+
+// deleteChannels - best effort rollback, the caller is already failing
+func (u *ChannelUsecase) deleteChannels(ctx context.Context, channels []*models.Channel) {
+	for _, channel := range channels {
+		err := u.ChannelRepo.DeleteChannel(ctx, channel.Id)
+		if err != nil {
+			u.Log.WithContext(ctx).WithError(err).WithField("channel_id", channel.Id.String()).Error("rollback channel error")
+		}
+	}
+}
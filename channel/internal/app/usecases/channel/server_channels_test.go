@@ -0,0 +1,1041 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases"
+	"github.com/Nixonxp/discord/channel/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/channel/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_usecase_ChannelUsecase_ListServerChannels(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	memberID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	channels := []*models.Channel{
+		{
+			Id:       models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+			Name:     "general",
+			OwnerId:  models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+			ServerId: serverID,
+		},
+	}
+
+	categoryA := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b00a"))
+	categoryB := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b00b"))
+	tree := []*models.Channel{
+		{Id: models.ChannelID(uuid.New()), Name: "b-text", ServerId: serverID, ParentId: categoryB, Position: 0},
+		{Id: categoryA, Name: "a", ServerId: serverID, Type: models.ChannelTypeCategory, Position: 0},
+		{Id: categoryB, Name: "b", ServerId: serverID, Type: models.ChannelTypeCategory, Position: 1},
+		{Id: models.ChannelID(uuid.New()), Name: "outside", ServerId: serverID, Position: 1},
+		{Id: models.ChannelID(uuid.New()), Name: "general", ServerId: serverID, Position: 0},
+		{Id: models.ChannelID(uuid.New()), Name: "a-text", ServerId: serverID, ParentId: categoryA, Position: 2},
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListServerChannelsRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.Channel
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerChannelsRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    channels,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, memberID).
+					Return(&models.ServerMember{UserId: memberID}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(channels, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "ListByServerId", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. ListByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerChannelsRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "list server channels: some error",
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, memberID).
+					Return(&models.ServerMember{UserId: memberID}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "ListByServerId", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Channels are returned in display order",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerChannelsRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    []*models.Channel{tree[4], tree[3], tree[1], tree[5], tree[2], tree[0]},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, memberID).
+					Return(&models.ServerMember{UserId: memberID}, nil)
+
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(append([]*models.Channel{}, tree...), nil)
+			},
+		},
+		{
+			name: "Test 4. Negative. User outside of the server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerChannelsRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "list server channels: not a server member: permission denied",
+
+			on: func(f *fields) {
+				f.ServerService.On("GetMember", ctx, serverID, memberID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ListServerChannels(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ListServerChannels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_CreateServerChannels(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	categoryIndex, textIndex := 1, 0
+
+	channelMatcher := func(name string) interface{} {
+		return mock.MatchedBy(func(channel models.Channel) bool {
+			return channel.Name == name &&
+				channel.OwnerId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795" &&
+				channel.ServerId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b111"
+		})
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateServerChannelsRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantNames   []string
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Channels: []usecases.ServerChannel{{Name: "general"}, {Name: "random"}},
+				},
+			},
+			wantNames: []string{"general", "random"},
+			wantErr:   false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("general")).
+					Return(nil)
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("random")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 2)
+				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteChannel", 0)
+			},
+		},
+		{
+			name: "Test 2. Negative. CreateChannel returns error, created channels are deleted",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Channels: []usecases.ServerChannel{{Name: "general"}, {Name: "random"}},
+				},
+			},
+			wantErr:     true,
+			errorString: "create server channels: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("general")).
+					Return(nil)
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("random")).
+					Return(errors.New("some error"))
+				f.ChannelRepo.On("DeleteChannel", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 2)
+				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteChannel", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Categories, types and settings are kept",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Channels: []usecases.ServerChannel{
+						{Name: "general", Topic: "hello"},
+						{Name: "games", Type: "category"},
+						{Name: "lobby", Type: "voice", ParentIndex: &categoryIndex, Voice: &models.VoiceSettings{Bitrate: 32000, UserLimit: 5}},
+						{Name: "ideas", Type: "forum", ParentIndex: &categoryIndex, Private: true},
+					},
+				},
+			},
+			wantNames: []string{"general", "games", "lobby", "ideas"},
+			wantErr:   false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("general")).
+					Return(nil)
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("games")).
+					Return(nil)
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("lobby")).
+					Return(nil)
+				f.ChannelRepo.On("CreateChannel", ctx, channelMatcher("ideas")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 4)
+
+				created := make(map[string]models.Channel)
+				for _, call := range f.ChannelRepo.Calls {
+					channel := call.Arguments.Get(1).(models.Channel)
+					created[channel.Name] = channel
+				}
+				assert.Equal(t, "hello", created["general"].Topic)
+				assert.Equal(t, models.ChannelID{}, created["general"].ParentId)
+				assert.Equal(t, models.ChannelTypeCategory, created["games"].Type)
+				assert.Equal(t, created["games"].Id, created["lobby"].ParentId)
+				assert.Equal(t, models.ChannelTypeVoice, created["lobby"].Type)
+				assert.Equal(t, &models.VoiceSettings{Bitrate: 32000, UserLimit: 5}, created["lobby"].Voice)
+				assert.Equal(t, 0, created["lobby"].Position)
+				assert.Equal(t, created["games"].Id, created["ideas"].ParentId)
+				assert.Equal(t, models.ForumSortLatestActivity, created["ideas"].Forum.DefaultSort)
+				assert.True(t, created["ideas"].Private)
+				assert.Equal(t, 1, created["ideas"].Position)
+			},
+		},
+		{
+			name: "Test 4. Negative. Parent is not a category, nothing is created",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+					OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Channels: []usecases.ServerChannel{
+						{Name: "general"},
+						{Name: "random", ParentIndex: &textIndex},
+					},
+				},
+			},
+			wantErr:     true,
+			errorString: "create server channels: parent is not an earlier category: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "CreateChannel", 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateServerChannels(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateServerChannels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			names := make([]string, len(got))
+			for i, channel := range got {
+				names[i] = channel.Name
+			}
+			assert.Equal(t, tt.wantNames, names)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_DeleteServerChannels(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AccessRepo    *mocks.AccessStorage
+		FollowRepo    *mocks.FollowStorage
+		PostRepo      *mocks.ForumPostStorage
+		ServerService *mocks.ServiceServerInterface
+		ChannelEvents *mocks.KafkaProducerServiceInterface
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	channels := []*models.Channel{
+		{
+			Id:       models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+			Name:     "general",
+			ServerId: serverID,
+		},
+		{
+			Id:       models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001")),
+			Name:     "random",
+			ServerId: serverID,
+		},
+	}
+	channelIds := []models.ChannelID{channels[0].Id, channels[1].Id}
+
+	type args struct {
+		ctx context.Context
+		req usecases.DeleteServerChannelsRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(3), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.FollowRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.PostRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.ChannelRepo.On("DeleteByServerId", ctx, serverID).
+					Return(int64(2), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelEvents.AssertNumberOfCalls(t, "PublishChannelDeleted", 2)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteByChannelIds", 1)
+				f.AccessRepo.AssertNumberOfCalls(t, "DeleteByChannelIds", 1)
+				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteByServerId", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Channels are already deleted",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return([]*models.Channel{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelEvents.AssertNotCalled(t, "PublishChannelDeleted", mock.Anything, mock.Anything)
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteByChannelIds", mock.Anything, mock.Anything)
+				f.ChannelRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. DeleteByChannelIds returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete server channels subscribes: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. DeleteByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete server channels: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(3), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.FollowRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.PostRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), nil)
+				f.ChannelRepo.On("DeleteByServerId", ctx, serverID).
+					Return(int64(0), errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "DeleteByServerId", 1)
+			},
+		},
+		{
+			name: "Test 5. Negative. Access DeleteByChannelIds returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete server channels access: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(3), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Follows DeleteByChannelIds returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete server channels follows: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(3), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.FollowRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. PublishChannelDeleted returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete server channels: publish channel deleted: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteByChannelIds", mock.Anything, mock.Anything)
+				f.ChannelRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 8. Negative. Forum posts DeleteByChannelIds returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerChannelsRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete server channels forum posts: some error",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListAllByServerId", ctx, serverID).
+					Return(channels, nil)
+				f.ChannelEvents.On("PublishChannelDeleted", ctx, mock.AnythingOfType("models.ChannelDeletedEvent")).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(3), nil)
+				f.AccessRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.FollowRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(1), nil)
+				f.PostRepo.On("DeleteByChannelIds", ctx, channelIds).
+					Return(int64(0), errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AccessRepo:    mocks.NewAccessStorage(t),
+				FollowRepo:    mocks.NewFollowStorage(t),
+				PostRepo:      mocks.NewForumPostStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+				ChannelEvents: mocks.NewKafkaProducerServiceInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AccessRepo:    f.AccessRepo,
+				FollowRepo:    f.FollowRepo,
+				PostRepo:      f.PostRepo,
+				ServerService: f.ServerService,
+				ChannelEvents: f.ChannelEvents,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.DeleteServerChannels(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.DeleteServerChannels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChannelUsecase_ReorderChannels(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ChannelRepo   *mocks.ChannelStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ServerService *mocks.ServiceServerInterface
+	}
+
+	// the rollback runs even when the request is already cancelled
+	detachedCtx := mock.MatchedBy(func(c context.Context) bool {
+		_, hasDeadline := c.Deadline()
+		return c.Err() == nil && hasDeadline
+	})
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	logger, err := log.NewLogger(log.NewDefaultConfig())
+	assert.NoError(t, err)
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	categoryID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b00a"))
+	generalID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001"))
+	randomID := models.ChannelID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b002"))
+
+	serverChannels := func() []*models.Channel {
+		return []*models.Channel{
+			{Id: categoryID, Name: "category", OwnerId: ownerID, ServerId: serverID, Type: models.ChannelTypeCategory, Position: 0},
+			{Id: generalID, Name: "general", OwnerId: ownerID, ServerId: serverID, Position: 0},
+			{Id: randomID, Name: "random", OwnerId: ownerID, ServerId: serverID, Position: 1},
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ReorderChannelsRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantNames   []string
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Channel is moved into the category in one call",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), ParentId: categoryID.String(), Position: 0},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantNames: []string{"random", "category", "general"},
+			wantErr:   false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+
+				f.ChannelRepo.On("SetPositions", ctx, serverID, []models.ChannelPosition{
+					{ChannelId: generalID, ParentId: categoryID, Position: 0},
+				}).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry",
+					ctx,
+					mock.MatchedBy(func(req usecases.RecordAuditEntryRequest) bool {
+						return req.Action == models.AuditActionChannelUpdate &&
+							req.TargetId == generalID.String() &&
+							req.Before["parent_id"] == "" &&
+							req.After["parent_id"] == categoryID.String()
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 1)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Unchanged positions are not recorded to audit log",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), Position: 1},
+						{ChannelId: randomID.String(), Position: 0},
+						{ChannelId: categoryID.String(), Position: 0},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantNames: []string{"random", "general", "category"},
+			wantErr:   false,
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+
+				f.ChannelRepo.On("SetPositions", ctx, serverID, mock.Anything).
+					Return(nil)
+
+				f.ServerService.On("RecordAuditEntry", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 2)
+			},
+		},
+		{
+			name: "Test 3. Negative. Category can not be placed into a category",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), ParentId: categoryID.String(), Position: 0},
+						{ChannelId: categoryID.String(), ParentId: categoryID.String(), Position: 0},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "reorder channels: category in category: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 0)
+			},
+		},
+		{
+			name: "Test 4. Negative. Parent is not a category",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), ParentId: randomID.String(), Position: 0},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "reorder channels: parent is not a category: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 0)
+			},
+		},
+		{
+			name: "Test 5. Negative. Channel of another server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b999", Position: 0},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "reorder channels: channel: not found",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 0)
+			},
+		},
+		{
+			name: "Test 6. Negative. Channel of another user",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), Position: 3},
+					},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+				},
+			},
+			wantErr:     true,
+			errorString: "reorder channels: channel: permission denied",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 0)
+			},
+		},
+		{
+			name: "Test 7. Negative. Channel is listed twice",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), Position: 0},
+						{ChannelId: generalID.String(), Position: 1},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "reorder channels: channel is listed twice: invalid argument",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", ctx, serverID).
+					Return(serverChannels(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 0)
+			},
+		},
+		{
+			name: "Test 8. Negative. SetPositions fails with the cancelled request, old positions are written back",
+			args: args{
+				ctx: cancelledCtx,
+				req: usecases.ReorderChannelsRequest{
+					ServerId: serverID.String(),
+					Positions: []usecases.ChannelPosition{
+						{ChannelId: generalID.String(), ParentId: categoryID.String(), Position: 0},
+						{ChannelId: randomID.String(), Position: 0},
+					},
+					CurrentUserId: ownerID.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "reorder channels: context canceled",
+
+			on: func(f *fields) {
+				f.ChannelRepo.On("ListByServerId", cancelledCtx, serverID).
+					Return(serverChannels(), nil)
+
+				f.ChannelRepo.On("SetPositions", cancelledCtx, serverID, []models.ChannelPosition{
+					{ChannelId: generalID, ParentId: categoryID, Position: 0},
+					{ChannelId: randomID, Position: 0},
+				}).
+					Return(context.Canceled)
+
+				f.ChannelRepo.On("SetPositions", detachedCtx, serverID, []models.ChannelPosition{
+					{ChannelId: generalID, Position: 0},
+					{ChannelId: randomID, Position: 1},
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelRepo.AssertNumberOfCalls(t, "SetPositions", 2)
+				f.ServerService.AssertNumberOfCalls(t, "RecordAuditEntry", 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ChannelRepo:   mocks.NewChannelStorage(t),
+				Log:           logger,
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ServerService: mocks.NewServiceServerInterface(t),
+			}
+			au := NewChannelUsecase(Deps{
+				ChannelRepo:   f.ChannelRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ServerService: f.ServerService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ReorderChannels(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ReorderChannels() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			names := make([]string, len(got))
+			for i, channel := range got {
+				names[i] = channel.Name
+			}
+			assert.Equal(t, tt.wantNames, names)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -1,7 +1,28 @@
 package usecases
 
+import "github.com/Nixonxp/discord/channel/internal/app/models"
+
 type AddChannelRequest struct {
 	Name          string
+	ServerId      string
+	Type          string
+	ParentId      string
+	Topic         string
+	Nsfw          bool
+	Voice         *models.VoiceSettings
+	Forum         *models.ForumSettings
+	Private       bool
+	CurrentUserId string
+}
+
+type UpdateChannelRequest struct {
+	ChannelId     string
+	Name          *string
+	Topic         *string
+	Nsfw          *bool
+	Voice         *models.VoiceSettings
+	Forum         *models.ForumSettings
+	Private       *bool
 	CurrentUserId string
 }
 
@@ -10,6 +31,17 @@ type DeleteChannelRequest struct {
 	CurrentUserId string
 }
 
+type RestoreChannelRequest struct {
+	ChannelId     string
+	CurrentUserId string
+}
+
+// ArchiveChannelRequest - used to archive and to unarchive a channel
+type ArchiveChannelRequest struct {
+	ChannelId     string
+	CurrentUserId string
+}
+
 type JoinChannelRequest struct {
 	ChannelId     string
 	CurrentUserId string
@@ -19,3 +51,140 @@ type LeaveChannelRequest struct {
 	ChannelId     string
 	CurrentUserId string
 }
+
+type ListMyChannelsRequest struct {
+	IncludeArchived bool
+	CurrentUserId   string
+}
+
+type ListChannelMembersRequest struct {
+	ChannelId     string
+	Cursor        string
+	Limit         int
+	CurrentUserId string
+}
+
+// IsChannelMemberRequest - the current user is checked when UserId is empty
+type IsChannelMemberRequest struct {
+	ChannelId     string
+	UserId        string
+	CurrentUserId string
+}
+
+// ChannelAccessRequest - UserId is the user whose access is managed by the channel owner
+type ChannelAccessRequest struct {
+	ChannelId     string
+	UserId        string
+	CurrentUserId string
+}
+
+type RequestChannelAccessRequest struct {
+	ChannelId     string
+	CurrentUserId string
+}
+
+type ListChannelJoinRequestsRequest struct {
+	ChannelId     string
+	CurrentUserId string
+}
+
+type SendChannelMessageRequest struct {
+	ChannelId     string
+	Text          string
+	CurrentUserId string
+}
+
+type GetChannelMessagesRequest struct {
+	ChannelId     string
+	CurrentUserId string
+}
+
+type FollowChannelRequest struct {
+	SourceChannelId string
+	TargetChannelId string
+	CurrentUserId   string
+}
+
+type PublishChannelMessageRequest struct {
+	ChannelId     string
+	MessageId     string
+	CurrentUserId string
+}
+
+// CreateForumPostRequest - Text is the opening message of the post
+type CreateForumPostRequest struct {
+	ChannelId     string
+	Title         string
+	Text          string
+	Tags          []string
+	CurrentUserId string
+}
+
+// ListForumPostsRequest - empty Sort uses the forum default, empty Tag lists all posts
+type ListForumPostsRequest struct {
+	ChannelId     string
+	Sort          string
+	Tag           string
+	CurrentUserId string
+}
+
+type SendForumPostMessageRequest struct {
+	PostId        string
+	Text          string
+	CurrentUserId string
+}
+
+type GetForumPostMessagesRequest struct {
+	PostId        string
+	CurrentUserId string
+}
+
+type ChannelPosition struct {
+	ChannelId string
+	ParentId  string
+	Position  int
+}
+
+type ReorderChannelsRequest struct {
+	ServerId      string
+	Positions     []ChannelPosition
+	CurrentUserId string
+}
+
+type ListServerChannelsRequest struct {
+	ServerId        string
+	IncludeArchived bool
+	CurrentUserId   string
+}
+
+type CreateServerChannelsRequest struct {
+	ServerId string
+	OwnerId  string
+	Channels []ServerChannel
+}
+
+// ServerChannel - channel created with the server, ParentIndex points to a category earlier in the request
+type ServerChannel struct {
+	Name        string
+	Type        string
+	ParentIndex *int
+	Topic       string
+	Nsfw        bool
+	Voice       *models.VoiceSettings
+	Forum       *models.ForumSettings
+	Private     bool
+}
+
+type DeleteServerChannelsRequest struct {
+	ServerId string
+}
+
+type RecordAuditEntryRequest struct {
+	ServerId   string
+	ActorId    string
+	Action     string
+	TargetType string
+	TargetId   string
+	Before     map[string]string
+	After      map[string]string
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	models "github.com/Nixonxp/discord/channel/internal/app/models"
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,24 @@ type ChannelStorage struct {
 	mock.Mock
 }
 
+// ArchiveChannel provides a mock function with given fields: ctx, id, at
+func (_m *ChannelStorage) ArchiveChannel(ctx context.Context, id models.ChannelID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateChannel provides a mock function with given fields: ctx, channel
 func (_m *ChannelStorage) CreateChannel(ctx context.Context, channel models.Channel) error {
 	ret := _m.Called(ctx, channel)
@@ -32,6 +51,34 @@ func (_m *ChannelStorage) CreateChannel(ctx context.Context, channel models.Chan
 	return r0
 }
 
+// DeleteByServerId provides a mock function with given fields: ctx, serverId
+func (_m *ChannelStorage) DeleteByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	ret := _m.Called(ctx, serverId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByServerId")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) (int64, error)); ok {
+		return rf(ctx, serverId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) int64); ok {
+		r0 = rf(ctx, serverId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID) error); ok {
+		r1 = rf(ctx, serverId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteChannel provides a mock function with given fields: ctx, channelId
 func (_m *ChannelStorage) DeleteChannel(ctx context.Context, channelId models.ChannelID) error {
 	ret := _m.Called(ctx, channelId)
@@ -80,6 +127,246 @@ func (_m *ChannelStorage) GetChannelById(ctx context.Context, id models.ChannelI
 	return r0, r1
 }
 
+// GetChannelsByIds provides a mock function with given fields: ctx, ids
+func (_m *ChannelStorage) GetChannelsByIds(ctx context.Context, ids []models.ChannelID) ([]*models.Channel, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChannelsByIds")
+	}
+
+	var r0 []*models.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ChannelID) ([]*models.Channel, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ChannelID) []*models.Channel); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Channel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.ChannelID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedChannelById provides a mock function with given fields: ctx, id
+func (_m *ChannelStorage) GetDeletedChannelById(ctx context.Context, id models.ChannelID) (*models.Channel, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedChannelById")
+	}
+
+	var r0 *models.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID) (*models.Channel, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID) *models.Channel); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Channel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ChannelID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllByServerId provides a mock function with given fields: ctx, serverId
+func (_m *ChannelStorage) ListAllByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Channel, error) {
+	ret := _m.Called(ctx, serverId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllByServerId")
+	}
+
+	var r0 []*models.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) ([]*models.Channel, error)); ok {
+		return rf(ctx, serverId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) []*models.Channel); ok {
+		r0 = rf(ctx, serverId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Channel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID) error); ok {
+		r1 = rf(ctx, serverId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByServerId provides a mock function with given fields: ctx, serverId
+func (_m *ChannelStorage) ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Channel, error) {
+	ret := _m.Called(ctx, serverId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByServerId")
+	}
+
+	var r0 []*models.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) ([]*models.Channel, error)); ok {
+		return rf(ctx, serverId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) []*models.Channel); ok {
+		r0 = rf(ctx, serverId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Channel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID) error); ok {
+		r1 = rf(ctx, serverId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeletedBefore provides a mock function with given fields: ctx, before, limit
+func (_m *ChannelStorage) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.Channel, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeletedBefore")
+	}
+
+	var r0 []*models.Channel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*models.Channel, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*models.Channel); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Channel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkDeleted provides a mock function with given fields: ctx, id, at
+func (_m *ChannelStorage) MarkDeleted(ctx context.Context, id models.ChannelID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreChannel provides a mock function with given fields: ctx, id
+func (_m *ChannelStorage) RestoreChannel(ctx context.Context, id models.ChannelID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetPositions provides a mock function with given fields: ctx, serverId, positions
+func (_m *ChannelStorage) SetPositions(ctx context.Context, serverId models.ServerID, positions []models.ChannelPosition) error {
+	ret := _m.Called(ctx, serverId, positions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, []models.ChannelPosition) error); ok {
+		r0 = rf(ctx, serverId, positions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnarchiveChannel provides a mock function with given fields: ctx, id
+func (_m *ChannelStorage) UnarchiveChannel(ctx context.Context, id models.ChannelID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateChannel provides a mock function with given fields: ctx, channel
+func (_m *ChannelStorage) UpdateChannel(ctx context.Context, channel models.Channel) error {
+	ret := _m.Called(ctx, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Channel) error); ok {
+		r0 = rf(ctx, channel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewChannelStorage creates a new instance of ChannelStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChannelStorage(t interface {
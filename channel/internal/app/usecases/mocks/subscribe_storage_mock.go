@@ -32,6 +32,34 @@ func (_m *SubscribeStorage) CreateSubscribe(ctx context.Context, subscribe model
 	return r0
 }
 
+// DeleteByChannelIds provides a mock function with given fields: ctx, channelIds
+func (_m *SubscribeStorage) DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error) {
+	ret := _m.Called(ctx, channelIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByChannelIds")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ChannelID) (int64, error)); ok {
+		return rf(ctx, channelIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ChannelID) int64); ok {
+		r0 = rf(ctx, channelIds)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.ChannelID) error); ok {
+		r1 = rf(ctx, channelIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteSubscribe provides a mock function with given fields: ctx, channelId, userId
 func (_m *SubscribeStorage) DeleteSubscribe(ctx context.Context, channelId models.ChannelID, userId models.UserID) error {
 	ret := _m.Called(ctx, channelId, userId)
@@ -50,6 +78,96 @@ func (_m *SubscribeStorage) DeleteSubscribe(ctx context.Context, channelId model
 	return r0
 }
 
+// GetSubscribe provides a mock function with given fields: ctx, channelId, userId
+func (_m *SubscribeStorage) GetSubscribe(ctx context.Context, channelId models.ChannelID, userId models.UserID) (*models.SubscribeInfo, error) {
+	ret := _m.Called(ctx, channelId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscribe")
+	}
+
+	var r0 *models.SubscribeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID, models.UserID) (*models.SubscribeInfo, error)); ok {
+		return rf(ctx, channelId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID, models.UserID) *models.SubscribeInfo); ok {
+		r0 = rf(ctx, channelId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SubscribeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ChannelID, models.UserID) error); ok {
+		r1 = rf(ctx, channelId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByChannelId provides a mock function with given fields: ctx, channelId, after, limit
+func (_m *SubscribeStorage) ListByChannelId(ctx context.Context, channelId models.ChannelID, after *models.SubscribeID, limit int) ([]*models.SubscribeInfo, error) {
+	ret := _m.Called(ctx, channelId, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByChannelId")
+	}
+
+	var r0 []*models.SubscribeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID, *models.SubscribeID, int) ([]*models.SubscribeInfo, error)); ok {
+		return rf(ctx, channelId, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChannelID, *models.SubscribeID, int) []*models.SubscribeInfo); ok {
+		r0 = rf(ctx, channelId, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SubscribeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ChannelID, *models.SubscribeID, int) error); ok {
+		r1 = rf(ctx, channelId, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByUserId provides a mock function with given fields: ctx, userId
+func (_m *SubscribeStorage) ListByUserId(ctx context.Context, userId models.UserID) ([]*models.SubscribeInfo, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUserId")
+	}
+
+	var r0 []*models.SubscribeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserID) ([]*models.SubscribeInfo, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.UserID) []*models.SubscribeInfo); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SubscribeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.UserID) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewSubscribeStorage creates a new instance of SubscribeStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscribeStorage(t interface {
@@ -3,24 +3,128 @@ package usecases
 import (
 	"context"
 	"github.com/Nixonxp/discord/channel/internal/app/models"
+	"github.com/segmentio/kafka-go"
+	"time"
 )
 
 type UsecaseInterface interface {
 	AddChannel(ctx context.Context, req AddChannelRequest) (*models.ActionInfo, error)
+	UpdateChannel(ctx context.Context, req UpdateChannelRequest) (*models.Channel, error)
 	DeleteChannel(ctx context.Context, req DeleteChannelRequest) (*models.ActionInfo, error)
+	RestoreChannel(ctx context.Context, req RestoreChannelRequest) (*models.ActionInfo, error)
+	ArchiveChannel(ctx context.Context, req ArchiveChannelRequest) (*models.ActionInfo, error)
+	UnarchiveChannel(ctx context.Context, req ArchiveChannelRequest) (*models.ActionInfo, error)
+	PurgeDeletedChannels(ctx context.Context) (int, error)
 	JoinChannel(ctx context.Context, req JoinChannelRequest) (*models.ActionInfo, error)
 	LeaveChannel(ctx context.Context, req LeaveChannelRequest) (*models.ActionInfo, error)
+	ListMyChannels(ctx context.Context, req ListMyChannelsRequest) ([]*models.Channel, error)
+	ListChannelMembers(ctx context.Context, req ListChannelMembersRequest) (*models.ListChannelMembersResult, error)
+	IsChannelMember(ctx context.Context, req IsChannelMemberRequest) (bool, error)
+	GrantChannelAccess(ctx context.Context, req ChannelAccessRequest) (*models.ActionInfo, error)
+	RevokeChannelAccess(ctx context.Context, req ChannelAccessRequest) (*models.ActionInfo, error)
+	RequestChannelAccess(ctx context.Context, req RequestChannelAccessRequest) (*models.ActionInfo, error)
+	ListChannelJoinRequests(ctx context.Context, req ListChannelJoinRequestsRequest) ([]*models.ChannelAccess, error)
+	ApproveChannelJoinRequest(ctx context.Context, req ChannelAccessRequest) (*models.ActionInfo, error)
+	SendChannelMessage(ctx context.Context, req SendChannelMessageRequest) (*models.ActionInfo, error)
+	GetChannelMessages(ctx context.Context, req GetChannelMessagesRequest) ([]*models.ChannelMessage, error)
+	FollowChannel(ctx context.Context, req FollowChannelRequest) (*models.ActionInfo, error)
+	UnfollowChannel(ctx context.Context, req FollowChannelRequest) (*models.ActionInfo, error)
+	PublishChannelMessage(ctx context.Context, req PublishChannelMessageRequest) (*models.ActionInfo, error)
+	CreateForumPost(ctx context.Context, req CreateForumPostRequest) (*models.ForumPost, error)
+	ListForumPosts(ctx context.Context, req ListForumPostsRequest) ([]*models.ForumPost, error)
+	SendForumPostMessage(ctx context.Context, req SendForumPostMessageRequest) (*models.ActionInfo, error)
+	GetForumPostMessages(ctx context.Context, req GetForumPostMessagesRequest) ([]*models.ChannelMessage, error)
+	ReorderChannels(ctx context.Context, req ReorderChannelsRequest) ([]*models.Channel, error)
+	ListServerChannels(ctx context.Context, req ListServerChannelsRequest) ([]*models.Channel, error)
+	CreateServerChannels(ctx context.Context, req CreateServerChannelsRequest) ([]*models.Channel, error)
+	DeleteServerChannels(ctx context.Context, req DeleteServerChannelsRequest) (*models.ActionInfo, error)
+}
+
+//go:generate mockery --name=QueueInterface --filename=queue_mock.go --disable-version-string
+type QueueInterface interface {
+	DeleteServerChannels(ctx context.Context, req DeleteServerChannelsRequest) (*models.ActionInfo, error)
 }
 
 //go:generate mockery --name=ChannelStorage --filename=channel_storage_mock.go --disable-version-string
 type ChannelStorage interface {
 	CreateChannel(ctx context.Context, channel models.Channel) error
 	GetChannelById(ctx context.Context, id models.ChannelID) (*models.Channel, error)
+	GetDeletedChannelById(ctx context.Context, id models.ChannelID) (*models.Channel, error)
+	GetChannelsByIds(ctx context.Context, ids []models.ChannelID) ([]*models.Channel, error)
+	UpdateChannel(ctx context.Context, channel models.Channel) error
+	ArchiveChannel(ctx context.Context, id models.ChannelID, at time.Time) error
+	UnarchiveChannel(ctx context.Context, id models.ChannelID) error
+	MarkDeleted(ctx context.Context, id models.ChannelID, at time.Time) error
+	RestoreChannel(ctx context.Context, id models.ChannelID) error
+	ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.Channel, error)
 	DeleteChannel(ctx context.Context, channelId models.ChannelID) error
+	ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Channel, error)
+	ListAllByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Channel, error)
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) (int64, error)
+	SetPositions(ctx context.Context, serverId models.ServerID, positions []models.ChannelPosition) error
 }
 
 //go:generate mockery --name=SubscribeStorage --filename=subscribe_storage_mock.go --disable-version-string
 type SubscribeStorage interface {
 	CreateSubscribe(ctx context.Context, subscribe models.SubscribeInfo) error
 	DeleteSubscribe(ctx context.Context, channelId models.ChannelID, userId models.UserID) error
+	GetSubscribe(ctx context.Context, channelId models.ChannelID, userId models.UserID) (*models.SubscribeInfo, error)
+	ListByChannelId(ctx context.Context, channelId models.ChannelID, after *models.SubscribeID, limit int) ([]*models.SubscribeInfo, error)
+	ListByUserId(ctx context.Context, userId models.UserID) ([]*models.SubscribeInfo, error)
+	DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error)
+}
+
+//go:generate mockery --name=AccessStorage --filename=access_storage_mock.go --disable-version-string
+type AccessStorage interface {
+	GrantAccess(ctx context.Context, channelId models.ChannelID, userId models.UserID, at time.Time) error
+	CreateJoinRequest(ctx context.Context, channelId models.ChannelID, userId models.UserID, at time.Time) error
+	GetAccess(ctx context.Context, channelId models.ChannelID, userId models.UserID) (*models.ChannelAccess, error)
+	DeleteAccess(ctx context.Context, channelId models.ChannelID, userId models.UserID) error
+	ListJoinRequests(ctx context.Context, channelId models.ChannelID) ([]*models.ChannelAccess, error)
+	DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error)
+}
+
+//go:generate mockery --name=FollowStorage --filename=follow_storage_mock.go --disable-version-string
+type FollowStorage interface {
+	CreateFollow(ctx context.Context, follow models.ChannelFollow) error
+	DeleteFollow(ctx context.Context, sourceChannelId models.ChannelID, targetChannelId models.ChannelID) error
+	ListBySourceId(ctx context.Context, sourceChannelId models.ChannelID) ([]*models.ChannelFollow, error)
+	DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error)
+}
+
+//go:generate mockery --name=ForumPostStorage --filename=forum_post_storage_mock.go --disable-version-string
+type ForumPostStorage interface {
+	CreatePost(ctx context.Context, post models.ForumPost) error
+	GetPostById(ctx context.Context, id models.PostID) (*models.ForumPost, error)
+	ListByChannelId(ctx context.Context, channelId models.ChannelID, sort models.ForumSort, tag string) ([]*models.ForumPost, error)
+	UpdateLastActivity(ctx context.Context, id models.PostID, at time.Time) error
+	DeletePost(ctx context.Context, id models.PostID) error
+	DeleteByChannelIds(ctx context.Context, channelIds []models.ChannelID) (int64, error)
+}
+
+//go:generate mockery --name=ServiceServerInterface --filename=service_server_mock.go --disable-version-string
+type ServiceServerInterface interface {
+	RecordAuditEntry(ctx context.Context, req RecordAuditEntryRequest) error
+	GetMember(ctx context.Context, serverId models.ServerID, userId models.UserID) (*models.ServerMember, error)
+}
+
+//go:generate mockery --name=ServiceChatInterface --filename=service_chat_mock.go --disable-version-string
+type ServiceChatInterface interface {
+	SendChannelMessage(ctx context.Context, req SendChannelMessageRequest) error
+	GetChannelMessages(ctx context.Context, channelId models.ChannelID) ([]*models.ChannelMessage, error)
+	PublishChannelMessage(ctx context.Context, req PublishChannelMessageRequest, targetChannelIds []models.ChannelID) error
+	SendPostMessage(ctx context.Context, post *models.ForumPost, text string, userId models.UserID) error
+	GetPostMessages(ctx context.Context, postId models.PostID) ([]*models.ChannelMessage, error)
+}
+
+//go:generate mockery --name=KafkaProducerServiceInterface --filename=kafka_producer_service_mock.go --disable-version-string
+type KafkaProducerServiceInterface interface {
+	PublishChannelDeleted(ctx context.Context, event models.ChannelDeletedEvent) error
+}
+
+//go:generate mockery --name=KafkaConsumerServiceInterface --filename=kafka_consumer_service_mock.go --disable-version-string
+type KafkaConsumerServiceInterface interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
 }
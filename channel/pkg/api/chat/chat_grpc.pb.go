@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: internal/app/api/chat/chat.proto
+
+package chat
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ChatService_SendChannelMessage_FullMethodName    = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/SendChannelMessage"
+	ChatService_GetChannelMessages_FullMethodName    = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetChannelMessages"
+	ChatService_PublishChannelMessage_FullMethodName = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/PublishChannelMessage"
+	ChatService_SendPostMessage_FullMethodName       = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/SendPostMessage"
+	ChatService_GetPostMessages_FullMethodName       = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetPostMessages"
+)
+
+// ChatServiceClient is the client API for ChatService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChatServiceClient interface {
+	SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	SendPostMessage(ctx context.Context, in *SendPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetPostMessages(ctx context.Context, in *GetPostMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+}
+
+type chatServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChatServiceClient(cc grpc.ClientConnInterface) ChatServiceClient {
+	return &chatServiceClient{cc}
+}
+
+func (c *chatServiceClient) SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_SendChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetChannelMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_PublishChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SendPostMessage(ctx context.Context, in *SendPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_SendPostMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetPostMessages(ctx context.Context, in *GetPostMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetPostMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServiceServer is the server API for ChatService service.
+// All implementations must embed UnimplementedChatServiceServer
+// for forward compatibility
+type ChatServiceServer interface {
+	SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error)
+	GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetMessagesResponse, error)
+	PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error)
+	SendPostMessage(context.Context, *SendPostMessageRequest) (*ActionResponse, error)
+	GetPostMessages(context.Context, *GetPostMessagesRequest) (*GetMessagesResponse, error)
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+// UnimplementedChatServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedChatServiceServer struct {
+}
+
+func (UnimplementedChatServiceServer) SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChannelMessage not implemented")
+}
+func (UnimplementedChatServiceServer) GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMessages not implemented")
+}
+func (UnimplementedChatServiceServer) PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishChannelMessage not implemented")
+}
+func (UnimplementedChatServiceServer) SendPostMessage(context.Context, *SendPostMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPostMessage not implemented")
+}
+func (UnimplementedChatServiceServer) GetPostMessages(context.Context, *GetPostMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostMessages not implemented")
+}
+func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
+
+// UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatServiceServer will
+// result in compilation errors.
+type UnsafeChatServiceServer interface {
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
+	s.RegisterService(&ChatService_ServiceDesc, srv)
+}
+
+func _ChatService_SendChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendChannelMessage(ctx, req.(*SendChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChannelMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChannelMessages(ctx, req.(*GetChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PublishChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PublishChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PublishChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PublishChannelMessage(ctx, req.(*PublishChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SendPostMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPostMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendPostMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendPostMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendPostMessage(ctx, req.(*SendPostMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetPostMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetPostMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetPostMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetPostMessages(ctx, req.(*GetPostMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChatService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.Nixonxp.discord.chat.api.v1.ChatService",
+	HandlerType: (*ChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendChannelMessage",
+			Handler:    _ChatService_SendChannelMessage_Handler,
+		},
+		{
+			MethodName: "GetChannelMessages",
+			Handler:    _ChatService_GetChannelMessages_Handler,
+		},
+		{
+			MethodName: "PublishChannelMessage",
+			Handler:    _ChatService_PublishChannelMessage_Handler,
+		},
+		{
+			MethodName: "SendPostMessage",
+			Handler:    _ChatService_SendPostMessage_Handler,
+		},
+		{
+			MethodName: "GetPostMessages",
+			Handler:    _ChatService_GetPostMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/api/chat/chat.proto",
+}
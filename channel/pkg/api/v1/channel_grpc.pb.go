@@ -19,10 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ChannelService_AddChannel_FullMethodName    = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/AddChannel"
-	ChannelService_DeleteChannel_FullMethodName = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/DeleteChannel"
-	ChannelService_JoinChannel_FullMethodName   = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/JoinChannel"
-	ChannelService_LeaveChannel_FullMethodName  = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/LeaveChannel"
+	ChannelService_AddChannel_FullMethodName                = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/AddChannel"
+	ChannelService_UpdateChannel_FullMethodName             = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/UpdateChannel"
+	ChannelService_DeleteChannel_FullMethodName             = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/DeleteChannel"
+	ChannelService_RestoreChannel_FullMethodName            = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/RestoreChannel"
+	ChannelService_ArchiveChannel_FullMethodName            = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ArchiveChannel"
+	ChannelService_UnarchiveChannel_FullMethodName          = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/UnarchiveChannel"
+	ChannelService_JoinChannel_FullMethodName               = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/JoinChannel"
+	ChannelService_LeaveChannel_FullMethodName              = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/LeaveChannel"
+	ChannelService_ListMyChannels_FullMethodName            = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ListMyChannels"
+	ChannelService_ListChannelMembers_FullMethodName        = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ListChannelMembers"
+	ChannelService_IsChannelMember_FullMethodName           = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/IsChannelMember"
+	ChannelService_GrantChannelAccess_FullMethodName        = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/GrantChannelAccess"
+	ChannelService_RevokeChannelAccess_FullMethodName       = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/RevokeChannelAccess"
+	ChannelService_RequestChannelAccess_FullMethodName      = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/RequestChannelAccess"
+	ChannelService_ListChannelJoinRequests_FullMethodName   = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ListChannelJoinRequests"
+	ChannelService_ApproveChannelJoinRequest_FullMethodName = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ApproveChannelJoinRequest"
+	ChannelService_SendChannelMessage_FullMethodName        = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/SendChannelMessage"
+	ChannelService_GetChannelMessages_FullMethodName        = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/GetChannelMessages"
+	ChannelService_FollowChannel_FullMethodName             = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/FollowChannel"
+	ChannelService_UnfollowChannel_FullMethodName           = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/UnfollowChannel"
+	ChannelService_PublishChannelMessage_FullMethodName     = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/PublishChannelMessage"
+	ChannelService_CreateForumPost_FullMethodName           = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/CreateForumPost"
+	ChannelService_ListForumPosts_FullMethodName            = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ListForumPosts"
+	ChannelService_SendForumPostMessage_FullMethodName      = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/SendForumPostMessage"
+	ChannelService_GetForumPostMessages_FullMethodName      = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/GetForumPostMessages"
+	ChannelService_ListServerChannels_FullMethodName        = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ListServerChannels"
+	ChannelService_ReorderChannels_FullMethodName           = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ReorderChannels"
+	ChannelService_CreateServerChannels_FullMethodName      = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/CreateServerChannels"
+	ChannelService_DeleteServerChannels_FullMethodName      = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/DeleteServerChannels"
 )
 
 // ChannelServiceClient is the client API for ChannelService service.
@@ -30,9 +55,35 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChannelServiceClient interface {
 	AddChannel(ctx context.Context, in *AddChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error)
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	RestoreChannel(ctx context.Context, in *RestoreChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	UnarchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	LeaveChannel(ctx context.Context, in *LeaveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	ListMyChannels(ctx context.Context, in *ListMyChannelsRequest, opts ...grpc.CallOption) (*ListMyChannelsResponse, error)
+	ListChannelMembers(ctx context.Context, in *ListChannelMembersRequest, opts ...grpc.CallOption) (*ListChannelMembersResponse, error)
+	IsChannelMember(ctx context.Context, in *IsChannelMemberRequest, opts ...grpc.CallOption) (*IsChannelMemberResponse, error)
+	GrantChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	RevokeChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	RequestChannelAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	ListChannelJoinRequests(ctx context.Context, in *ListChannelJoinRequestsRequest, opts ...grpc.CallOption) (*ListChannelJoinRequestsResponse, error)
+	ApproveChannelJoinRequest(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error)
+	FollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	UnfollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	CreateForumPost(ctx context.Context, in *CreateForumPostRequest, opts ...grpc.CallOption) (*ForumPost, error)
+	ListForumPosts(ctx context.Context, in *ListForumPostsRequest, opts ...grpc.CallOption) (*ListForumPostsResponse, error)
+	SendForumPostMessage(ctx context.Context, in *SendForumPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetForumPostMessages(ctx context.Context, in *GetForumPostMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error)
+	ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	ReorderChannels(ctx context.Context, in *ReorderChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	// internal, used by server service to copy and clean up channels of a server
+	CreateServerChannels(ctx context.Context, in *CreateServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	DeleteServerChannels(ctx context.Context, in *DeleteServerChannelsRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 }
 
 type channelServiceClient struct {
@@ -52,6 +103,15 @@ func (c *channelServiceClient) AddChannel(ctx context.Context, in *AddChannelReq
 	return out, nil
 }
 
+func (c *channelServiceClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
+	out := new(Channel)
+	err := c.cc.Invoke(ctx, ChannelService_UpdateChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *channelServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
 	out := new(ActionResponse)
 	err := c.cc.Invoke(ctx, ChannelService_DeleteChannel_FullMethodName, in, out, opts...)
@@ -61,6 +121,33 @@ func (c *channelServiceClient) DeleteChannel(ctx context.Context, in *DeleteChan
 	return out, nil
 }
 
+func (c *channelServiceClient) RestoreChannel(ctx context.Context, in *RestoreChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_RestoreChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ArchiveChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) UnarchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_UnarchiveChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *channelServiceClient) JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
 	out := new(ActionResponse)
 	err := c.cc.Invoke(ctx, ChannelService_JoinChannel_FullMethodName, in, out, opts...)
@@ -79,14 +166,229 @@ func (c *channelServiceClient) LeaveChannel(ctx context.Context, in *LeaveChanne
 	return out, nil
 }
 
+func (c *channelServiceClient) ListMyChannels(ctx context.Context, in *ListMyChannelsRequest, opts ...grpc.CallOption) (*ListMyChannelsResponse, error) {
+	out := new(ListMyChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ListMyChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ListChannelMembers(ctx context.Context, in *ListChannelMembersRequest, opts ...grpc.CallOption) (*ListChannelMembersResponse, error) {
+	out := new(ListChannelMembersResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ListChannelMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) IsChannelMember(ctx context.Context, in *IsChannelMemberRequest, opts ...grpc.CallOption) (*IsChannelMemberResponse, error) {
+	out := new(IsChannelMemberResponse)
+	err := c.cc.Invoke(ctx, ChannelService_IsChannelMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GrantChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GrantChannelAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) RevokeChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_RevokeChannelAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) RequestChannelAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_RequestChannelAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ListChannelJoinRequests(ctx context.Context, in *ListChannelJoinRequestsRequest, opts ...grpc.CallOption) (*ListChannelJoinRequestsResponse, error) {
+	out := new(ListChannelJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ListChannelJoinRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ApproveChannelJoinRequest(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ApproveChannelJoinRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_SendChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error) {
+	out := new(GetChannelMessagesResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetChannelMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) FollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_FollowChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) UnfollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_UnfollowChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_PublishChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) CreateForumPost(ctx context.Context, in *CreateForumPostRequest, opts ...grpc.CallOption) (*ForumPost, error) {
+	out := new(ForumPost)
+	err := c.cc.Invoke(ctx, ChannelService_CreateForumPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ListForumPosts(ctx context.Context, in *ListForumPostsRequest, opts ...grpc.CallOption) (*ListForumPostsResponse, error) {
+	out := new(ListForumPostsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ListForumPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) SendForumPostMessage(ctx context.Context, in *SendForumPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_SendForumPostMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) GetForumPostMessages(ctx context.Context, in *GetForumPostMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error) {
+	out := new(GetChannelMessagesResponse)
+	err := c.cc.Invoke(ctx, ChannelService_GetForumPostMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ListServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ReorderChannels(ctx context.Context, in *ReorderChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ReorderChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) CreateServerChannels(ctx context.Context, in *CreateServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_CreateServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) DeleteServerChannels(ctx context.Context, in *DeleteServerChannelsRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_DeleteServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChannelServiceServer is the server API for ChannelService service.
 // All implementations must embed UnimplementedChannelServiceServer
 // for forward compatibility
 type ChannelServiceServer interface {
 	AddChannel(context.Context, *AddChannelRequest) (*ActionResponse, error)
+	UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error)
 	DeleteChannel(context.Context, *DeleteChannelRequest) (*ActionResponse, error)
+	RestoreChannel(context.Context, *RestoreChannelRequest) (*ActionResponse, error)
+	ArchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error)
+	UnarchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error)
 	JoinChannel(context.Context, *JoinChannelRequest) (*ActionResponse, error)
 	LeaveChannel(context.Context, *LeaveChannelRequest) (*ActionResponse, error)
+	ListMyChannels(context.Context, *ListMyChannelsRequest) (*ListMyChannelsResponse, error)
+	ListChannelMembers(context.Context, *ListChannelMembersRequest) (*ListChannelMembersResponse, error)
+	IsChannelMember(context.Context, *IsChannelMemberRequest) (*IsChannelMemberResponse, error)
+	GrantChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error)
+	RevokeChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error)
+	RequestChannelAccess(context.Context, *RequestChannelAccessRequest) (*ActionResponse, error)
+	ListChannelJoinRequests(context.Context, *ListChannelJoinRequestsRequest) (*ListChannelJoinRequestsResponse, error)
+	ApproveChannelJoinRequest(context.Context, *ChannelAccessRequest) (*ActionResponse, error)
+	SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error)
+	GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetChannelMessagesResponse, error)
+	FollowChannel(context.Context, *FollowChannelRequest) (*ActionResponse, error)
+	UnfollowChannel(context.Context, *FollowChannelRequest) (*ActionResponse, error)
+	PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error)
+	CreateForumPost(context.Context, *CreateForumPostRequest) (*ForumPost, error)
+	ListForumPosts(context.Context, *ListForumPostsRequest) (*ListForumPostsResponse, error)
+	SendForumPostMessage(context.Context, *SendForumPostMessageRequest) (*ActionResponse, error)
+	GetForumPostMessages(context.Context, *GetForumPostMessagesRequest) (*GetChannelMessagesResponse, error)
+	ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error)
+	ReorderChannels(context.Context, *ReorderChannelsRequest) (*ListServerChannelsResponse, error)
+	// internal, used by server service to copy and clean up channels of a server
+	CreateServerChannels(context.Context, *CreateServerChannelsRequest) (*ListServerChannelsResponse, error)
+	DeleteServerChannels(context.Context, *DeleteServerChannelsRequest) (*ActionResponse, error)
 	mustEmbedUnimplementedChannelServiceServer()
 }
 
@@ -97,15 +399,90 @@ type UnimplementedChannelServiceServer struct {
 func (UnimplementedChannelServiceServer) AddChannel(context.Context, *AddChannelRequest) (*ActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddChannel not implemented")
 }
+func (UnimplementedChannelServiceServer) UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
 func (UnimplementedChannelServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*ActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
 }
+func (UnimplementedChannelServiceServer) RestoreChannel(context.Context, *RestoreChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) ArchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) UnarchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveChannel not implemented")
+}
 func (UnimplementedChannelServiceServer) JoinChannel(context.Context, *JoinChannelRequest) (*ActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
 }
 func (UnimplementedChannelServiceServer) LeaveChannel(context.Context, *LeaveChannelRequest) (*ActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveChannel not implemented")
 }
+func (UnimplementedChannelServiceServer) ListMyChannels(context.Context, *ListMyChannelsRequest) (*ListMyChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) ListChannelMembers(context.Context, *ListChannelMembersRequest) (*ListChannelMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelMembers not implemented")
+}
+func (UnimplementedChannelServiceServer) IsChannelMember(context.Context, *IsChannelMemberRequest) (*IsChannelMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsChannelMember not implemented")
+}
+func (UnimplementedChannelServiceServer) GrantChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantChannelAccess not implemented")
+}
+func (UnimplementedChannelServiceServer) RevokeChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeChannelAccess not implemented")
+}
+func (UnimplementedChannelServiceServer) RequestChannelAccess(context.Context, *RequestChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestChannelAccess not implemented")
+}
+func (UnimplementedChannelServiceServer) ListChannelJoinRequests(context.Context, *ListChannelJoinRequestsRequest) (*ListChannelJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelJoinRequests not implemented")
+}
+func (UnimplementedChannelServiceServer) ApproveChannelJoinRequest(context.Context, *ChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChannelJoinRequest not implemented")
+}
+func (UnimplementedChannelServiceServer) SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChannelMessage not implemented")
+}
+func (UnimplementedChannelServiceServer) GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetChannelMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMessages not implemented")
+}
+func (UnimplementedChannelServiceServer) FollowChannel(context.Context, *FollowChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) UnfollowChannel(context.Context, *FollowChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishChannelMessage not implemented")
+}
+func (UnimplementedChannelServiceServer) CreateForumPost(context.Context, *CreateForumPostRequest) (*ForumPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForumPost not implemented")
+}
+func (UnimplementedChannelServiceServer) ListForumPosts(context.Context, *ListForumPostsRequest) (*ListForumPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForumPosts not implemented")
+}
+func (UnimplementedChannelServiceServer) SendForumPostMessage(context.Context, *SendForumPostMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendForumPostMessage not implemented")
+}
+func (UnimplementedChannelServiceServer) GetForumPostMessages(context.Context, *GetForumPostMessagesRequest) (*GetChannelMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForumPostMessages not implemented")
+}
+func (UnimplementedChannelServiceServer) ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) ReorderChannels(context.Context, *ReorderChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) CreateServerChannels(context.Context, *CreateServerChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) DeleteServerChannels(context.Context, *DeleteServerChannelsRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServerChannels not implemented")
+}
 func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
 
 // UnsafeChannelServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -137,6 +514,24 @@ func _ChannelService_AddChannel_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).UpdateChannel(ctx, req.(*UpdateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChannelService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteChannelRequest)
 	if err := dec(in); err != nil {
@@ -155,6 +550,60 @@ func _ChannelService_DeleteChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_RestoreChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).RestoreChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_RestoreChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).RestoreChannel(ctx, req.(*RestoreChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ArchiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ArchiveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ArchiveChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ArchiveChannel(ctx, req.(*ArchiveChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_UnarchiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).UnarchiveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_UnarchiveChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).UnarchiveChannel(ctx, req.(*ArchiveChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChannelService_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinChannelRequest)
 	if err := dec(in); err != nil {
@@ -191,22 +640,416 @@ func _ChannelService_LeaveChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ChannelService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "github.com.Nixonxp.discord.channel.api.v1.ChannelService",
-	HandlerType: (*ChannelServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddChannel",
-			Handler:    _ChannelService_AddChannel_Handler,
-		},
-		{
-			MethodName: "DeleteChannel",
-			Handler:    _ChannelService_DeleteChannel_Handler,
-		},
-		{
+func _ChannelService_ListMyChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListMyChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ListMyChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListMyChannels(ctx, req.(*ListMyChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListChannelMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListChannelMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ListChannelMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListChannelMembers(ctx, req.(*ListChannelMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_IsChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).IsChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_IsChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).IsChannelMember(ctx, req.(*IsChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GrantChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GrantChannelAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GrantChannelAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GrantChannelAccess(ctx, req.(*ChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_RevokeChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).RevokeChannelAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_RevokeChannelAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).RevokeChannelAccess(ctx, req.(*ChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_RequestChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).RequestChannelAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_RequestChannelAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).RequestChannelAccess(ctx, req.(*RequestChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListChannelJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListChannelJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ListChannelJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListChannelJoinRequests(ctx, req.(*ListChannelJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ApproveChannelJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ApproveChannelJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ApproveChannelJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ApproveChannelJoinRequest(ctx, req.(*ChannelAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_SendChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).SendChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_SendChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).SendChannelMessage(ctx, req.(*SendChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetChannelMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetChannelMessages(ctx, req.(*GetChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_FollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).FollowChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_FollowChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).FollowChannel(ctx, req.(*FollowChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_UnfollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).UnfollowChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_UnfollowChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).UnfollowChannel(ctx, req.(*FollowChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_PublishChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).PublishChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_PublishChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).PublishChannelMessage(ctx, req.(*PublishChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_CreateForumPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateForumPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).CreateForumPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_CreateForumPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).CreateForumPost(ctx, req.(*CreateForumPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListForumPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForumPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListForumPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ListForumPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListForumPosts(ctx, req.(*ListForumPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_SendForumPostMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendForumPostMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).SendForumPostMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_SendForumPostMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).SendForumPostMessage(ctx, req.(*SendForumPostMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_GetForumPostMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForumPostMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetForumPostMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_GetForumPostMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetForumPostMessages(ctx, req.(*GetForumPostMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListServerChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ListServerChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListServerChannels(ctx, req.(*ListServerChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ReorderChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ReorderChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ReorderChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ReorderChannels(ctx, req.(*ReorderChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_CreateServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).CreateServerChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_CreateServerChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).CreateServerChannels(ctx, req.(*CreateServerChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_DeleteServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServerChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).DeleteServerChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_DeleteServerChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).DeleteServerChannels(ctx, req.(*DeleteServerChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChannelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.Nixonxp.discord.channel.api.v1.ChannelService",
+	HandlerType: (*ChannelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddChannel",
+			Handler:    _ChannelService_AddChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _ChannelService_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _ChannelService_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "RestoreChannel",
+			Handler:    _ChannelService_RestoreChannel_Handler,
+		},
+		{
+			MethodName: "ArchiveChannel",
+			Handler:    _ChannelService_ArchiveChannel_Handler,
+		},
+		{
+			MethodName: "UnarchiveChannel",
+			Handler:    _ChannelService_UnarchiveChannel_Handler,
+		},
+		{
 			MethodName: "JoinChannel",
 			Handler:    _ChannelService_JoinChannel_Handler,
 		},
@@ -214,6 +1057,90 @@ var ChannelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveChannel",
 			Handler:    _ChannelService_LeaveChannel_Handler,
 		},
+		{
+			MethodName: "ListMyChannels",
+			Handler:    _ChannelService_ListMyChannels_Handler,
+		},
+		{
+			MethodName: "ListChannelMembers",
+			Handler:    _ChannelService_ListChannelMembers_Handler,
+		},
+		{
+			MethodName: "IsChannelMember",
+			Handler:    _ChannelService_IsChannelMember_Handler,
+		},
+		{
+			MethodName: "GrantChannelAccess",
+			Handler:    _ChannelService_GrantChannelAccess_Handler,
+		},
+		{
+			MethodName: "RevokeChannelAccess",
+			Handler:    _ChannelService_RevokeChannelAccess_Handler,
+		},
+		{
+			MethodName: "RequestChannelAccess",
+			Handler:    _ChannelService_RequestChannelAccess_Handler,
+		},
+		{
+			MethodName: "ListChannelJoinRequests",
+			Handler:    _ChannelService_ListChannelJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveChannelJoinRequest",
+			Handler:    _ChannelService_ApproveChannelJoinRequest_Handler,
+		},
+		{
+			MethodName: "SendChannelMessage",
+			Handler:    _ChannelService_SendChannelMessage_Handler,
+		},
+		{
+			MethodName: "GetChannelMessages",
+			Handler:    _ChannelService_GetChannelMessages_Handler,
+		},
+		{
+			MethodName: "FollowChannel",
+			Handler:    _ChannelService_FollowChannel_Handler,
+		},
+		{
+			MethodName: "UnfollowChannel",
+			Handler:    _ChannelService_UnfollowChannel_Handler,
+		},
+		{
+			MethodName: "PublishChannelMessage",
+			Handler:    _ChannelService_PublishChannelMessage_Handler,
+		},
+		{
+			MethodName: "CreateForumPost",
+			Handler:    _ChannelService_CreateForumPost_Handler,
+		},
+		{
+			MethodName: "ListForumPosts",
+			Handler:    _ChannelService_ListForumPosts_Handler,
+		},
+		{
+			MethodName: "SendForumPostMessage",
+			Handler:    _ChannelService_SendForumPostMessage_Handler,
+		},
+		{
+			MethodName: "GetForumPostMessages",
+			Handler:    _ChannelService_GetForumPostMessages_Handler,
+		},
+		{
+			MethodName: "ListServerChannels",
+			Handler:    _ChannelService_ListServerChannels_Handler,
+		},
+		{
+			MethodName: "ReorderChannels",
+			Handler:    _ChannelService_ReorderChannels_Handler,
+		},
+		{
+			MethodName: "CreateServerChannels",
+			Handler:    _ChannelService_CreateServerChannels_Handler,
+		},
+		{
+			MethodName: "DeleteServerChannels",
+			Handler:    _ChannelService_DeleteServerChannels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/channel.proto",
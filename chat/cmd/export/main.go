@@ -0,0 +1,112 @@
+// Command export writes chat history straight from Mongo, without the chat service running.
+//
+//	export -chat-id <uuid> -format csv -from 2024-01-01T00:00:00Z -out chat.csv
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	config "github.com/Nixonxp/discord/chat/configs"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	chat_repository "github.com/Nixonxp/discord/chat/internal/app/repository/chat_storage"
+	repository "github.com/Nixonxp/discord/chat/internal/app/repository/messages_storage"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	chat_usc "github.com/Nixonxp/discord/chat/internal/app/usecases/chat"
+	logger "github.com/Nixonxp/discord/chat/pkg/logger"
+	mongoCollection "github.com/Nixonxp/discord/chat/pkg/mongo"
+	"io"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+	var (
+		chatId   = flag.String("chat-id", "", "chat id")
+		serverId = flag.String("server-id", "", "server id, exports the server chat")
+		format   = flag.String("format", enum.ExportFormatJSONL, "jsonl, csv or html")
+		from     = flag.String("from", "", "RFC3339 start of the range, inclusive")
+		to       = flag.String("to", "", "RFC3339 end of the range, exclusive")
+		out      = flag.String("out", "", "output file, stdout by default")
+	)
+	flag.Parse()
+
+	if *chatId == "" && *serverId == "" {
+		log.Fatal("chat-id or server-id is required")
+	}
+
+	req := usecases.ExportChatRequest{
+		ChatId:   *chatId,
+		ServerId: *serverId,
+		Format:   *format,
+		From:     parseTime("from", *from),
+		To:       parseTime("to", *to),
+	}
+
+	if err := run(context.Background(), config.GetConfig(), req, *out); err != nil {
+		log.Fatalf("export error: %v", err)
+	}
+}
+
+func run(ctx context.Context, cfg *config.Config, req usecases.ExportChatRequest, out string) error {
+	chatCollection, err := mongoCollection.NewCollection(ctx,
+		cfg.Application.ServiceCollection,
+		&mongoCollection.Config{
+			MongoHost:     cfg.Application.MongoHost,
+			MongoDb:       cfg.Application.MongoDb,
+			MongoPort:     cfg.Application.MongoPort,
+			MongoUser:     cfg.Application.MongoUser,
+			MongoPassword: cfg.Application.MongoPassword,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer chatCollection.DisconnectClient()
+
+	messagesCollection, err := chatCollection.NewCollection(cfg.Application.MessagesCollection)
+	if err != nil {
+		return err
+	}
+
+	l, err := logger.NewLogger(logger.NewDefaultConfig())
+	if err != nil {
+		return err
+	}
+
+	chatUsecase := chat_usc.NewChatUsecase(chat_usc.Deps{
+		MessagesRepo: repository.NewMongoMessagesRepository(messagesCollection),
+		ChatRepo:     chat_repository.NewMongoChatRepository(chatCollection, l),
+	})
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	buf := bufio.NewWriter(w)
+	if err := chatUsecase.ExportChat(ctx, req, buf); err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
+
+func parseTime(name string, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", name, err)
+	}
+
+	return t
+}
@@ -0,0 +1,77 @@
+// Command repair-chats merges chats sharing metadata and type straight in Mongo, messages of the
+// duplicates are moved to the kept chat before the duplicates are removed, then it builds the unique
+// chat index. Run it before deploying a chat service that creates the index.
+//
+//	repair-chats
+package main
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/chat/configs"
+	chat_repository "github.com/Nixonxp/discord/chat/internal/app/repository/chat_storage"
+	repository "github.com/Nixonxp/discord/chat/internal/app/repository/messages_storage"
+	logger "github.com/Nixonxp/discord/chat/pkg/logger"
+	mongoCollection "github.com/Nixonxp/discord/chat/pkg/mongo"
+	"log"
+)
+
+func main() {
+	if err := run(context.Background(), config.GetConfig()); err != nil {
+		log.Fatalf("repair chats error: %v", err)
+	}
+}
+
+func run(ctx context.Context, cfg *config.Config) error {
+	chatCollection, err := mongoCollection.NewCollection(ctx,
+		cfg.Application.ServiceCollection,
+		&mongoCollection.Config{
+			MongoHost:     cfg.Application.MongoHost,
+			MongoDb:       cfg.Application.MongoDb,
+			MongoPort:     cfg.Application.MongoPort,
+			MongoUser:     cfg.Application.MongoUser,
+			MongoPassword: cfg.Application.MongoPassword,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer chatCollection.DisconnectClient()
+
+	messagesCollection, err := chatCollection.NewCollection(cfg.Application.MessagesCollection)
+	if err != nil {
+		return err
+	}
+
+	l, err := logger.NewLogger(logger.NewDefaultConfig())
+	if err != nil {
+		return err
+	}
+
+	chatRepo := chat_repository.NewMongoChatRepository(chatCollection, l)
+	messagesRepo := repository.NewMongoMessagesRepository(messagesCollection)
+
+	duplicates, err := chatRepo.FindDuplicates(ctx)
+	if err != nil {
+		return err
+	}
+
+	// messages go first, a chat left after a failure is found again on the next run
+	moved, removed := int64(0), int64(0)
+	for _, group := range duplicates {
+		count, err := messagesRepo.MoveToChat(ctx, group.DuplicateIds, group.KeepId)
+		if err != nil {
+			return err
+		}
+		moved += count
+
+		count, err = chatRepo.DeleteByIds(ctx, group.DuplicateIds)
+		if err != nil {
+			return err
+		}
+		removed += count
+	}
+
+	log.Printf("duplicated chats removed: %d, messages moved: %d", removed, moved)
+
+	return chatRepo.EnsureIndexes(ctx)
+}
@@ -2,3 +2,30 @@ package enum
 
 const PrivateChatType = "private"
 const ServerChatType = "server"
+const ChannelChatType = "channel"
+const ForumPostChatType = "forum_post"
+
+// moderation rule types
+const (
+	ModerationRuleBlockedWords    = "blocked_words"
+	ModerationRuleRegex           = "regex"
+	ModerationRuleLinks           = "links"
+	ModerationRuleInvites         = "invites"
+	ModerationRuleMentionSpam     = "mention_spam"
+	ModerationRuleRepeatedMessage = "repeated_message"
+)
+
+// moderation actions, ordered by severity
+const (
+	ModerationActionAllow   = "allow"
+	ModerationActionFlag    = "flag"
+	ModerationActionBlock   = "block"
+	ModerationActionTimeout = "timeout"
+)
+
+// chat export formats
+const (
+	ExportFormatJSONL = "jsonl"
+	ExportFormatCSV   = "csv"
+	ExportFormatHTML  = "html"
+)
@@ -0,0 +1,40 @@
+package export
+
+import (
+	"encoding/csv"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"io"
+)
+
+var csvHeader = []string{"id", "chat_id", "author_id", "timestamp", "text"}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{
+		w: csv.NewWriter(w),
+	}
+}
+
+func (w *csvWriter) WriteHeader(_ *models.Chat) error {
+	return w.w.Write(csvHeader)
+}
+
+func (w *csvWriter) WriteMessage(message *models.Message) error {
+	m := newExportMessage(message)
+
+	return w.w.Write([]string{
+		m.Id,
+		m.ChatId,
+		m.AuthorId,
+		m.Timestamp,
+		m.Text,
+	})
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,63 @@
+package export
+
+import (
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"html/template"
+	"io"
+)
+
+var htmlHeader = template.Must(template.New("header").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Chat {{.Id}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #2e3338; }
+h1 { font-size: 1.2em; }
+.message { border-bottom: 1px solid #e3e5e8; padding: .5em 0; }
+.meta { color: #747f8d; font-size: .8em; }
+.text { white-space: pre-wrap; margin-top: .25em; }
+</style>
+</head>
+<body>
+<h1>Chat {{.Id}} ({{.Type}})</h1>
+`))
+
+var htmlMessage = template.Must(template.New("message").Parse(`<div class="message" id="{{.Id}}">
+<div class="meta"><span class="author">{{.AuthorId}}</span> &middot; <time datetime="{{.Timestamp}}">{{.Timestamp}}</time></div>
+<div class="text">{{.Text}}</div>
+</div>
+`))
+
+const htmlFooter = `</body>
+</html>
+`
+
+type htmlWriter struct {
+	w io.Writer
+}
+
+func newHTMLWriter(w io.Writer) *htmlWriter {
+	return &htmlWriter{
+		w: w,
+	}
+}
+
+func (w *htmlWriter) WriteHeader(chat *models.Chat) error {
+	return htmlHeader.Execute(w.w, struct {
+		Id   string
+		Type string
+	}{
+		Id:   chat.Id.String(),
+		Type: chat.Type,
+	})
+}
+
+func (w *htmlWriter) WriteMessage(message *models.Message) error {
+	return htmlMessage.Execute(w.w, newExportMessage(message))
+}
+
+func (w *htmlWriter) Close() error {
+	_, err := io.WriteString(w.w, htmlFooter)
+	return err
+}
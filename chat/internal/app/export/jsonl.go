@@ -0,0 +1,32 @@
+package export
+
+import (
+	"encoding/json"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"io"
+)
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	return &jsonlWriter{
+		enc: enc,
+	}
+}
+
+func (w *jsonlWriter) WriteHeader(_ *models.Chat) error {
+	return nil
+}
+
+func (w *jsonlWriter) WriteMessage(message *models.Message) error {
+	return w.enc.Encode(newExportMessage(message))
+}
+
+func (w *jsonlWriter) Close() error {
+	return nil
+}
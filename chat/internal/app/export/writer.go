@@ -0,0 +1,55 @@
+package export
+
+import (
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"io"
+	"time"
+)
+
+// Writer - streams chat messages in one of the export formats
+type Writer interface {
+	WriteHeader(chat *models.Chat) error
+	WriteMessage(message *models.Message) error
+	Close() error
+}
+
+// NewWriter - returns writer for the format, models.ErrExportFormat if the format is unknown
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case enum.ExportFormatJSONL:
+		return newJSONLWriter(w), nil
+	case enum.ExportFormatCSV:
+		return newCSVWriter(w), nil
+	case enum.ExportFormatHTML:
+		return newHTMLWriter(w), nil
+	}
+
+	return nil, models.ErrExportFormat
+}
+
+type exportMessage struct {
+	Id        string `json:"id"`
+	ChatId    string `json:"chat_id"`
+	AuthorId  string `json:"author_id"`
+	Text      string `json:"text"`
+	Timestamp string `json:"timestamp"`
+}
+
+func newExportMessage(message *models.Message) exportMessage {
+	return exportMessage{
+		Id:        message.Id.String(),
+		ChatId:    message.ChatId.String(),
+		AuthorId:  message.OwnerId.String(),
+		Text:      message.Text,
+		Timestamp: formatTime(message.Timestamp),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
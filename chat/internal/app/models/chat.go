@@ -36,12 +36,52 @@ type Chat struct {
 	MetaData string  `bson:"metadata"`
 }
 
+// ChatDuplicates - chats sharing metadata and type, only KeepId survives the repair
+type ChatDuplicates struct {
+	KeepId       ChatID
+	DuplicateIds []ChatID
+}
+
 type Message struct {
 	Id        MessageID `bson:"_id"`
 	Text      string    `bson:"text"`
 	ChatId    ChatID    `bson:"chat_id"`
 	OwnerId   OwnerID   `bson:"owner_id"`
 	Timestamp time.Time `bson:"timestamp"`
+	// WebhookId - webhook that posted the message, Username and AvatarUrl override the author then
+	WebhookId string `bson:"webhook_id,omitempty"`
+	Username  string `bson:"username,omitempty"`
+	AvatarUrl string `bson:"avatar_url,omitempty"`
+	// VisibleTo - ephemeral message is returned only to this user and is not exported
+	VisibleTo string `bson:"visible_to,omitempty"`
+	// Reference - original of a message cross-posted from a followed announcement channel
+	Reference *MessageReference `bson:"reference,omitempty"`
+}
+
+type MessageReference struct {
+	ChannelId string    `bson:"channel_id"`
+	MessageId MessageID `bson:"message_id"`
+}
+
+func (m *Message) IsEphemeral() bool {
+	return m.VisibleTo != ""
+}
+
+// VisibleFor - public messages are visible to everybody
+func (m *Message) VisibleFor(userId string) bool {
+	return !m.IsEphemeral() || m.VisibleTo == userId
+}
+
+// Original - reference to the first message of a cross-post chain, copies of copies point to the same original
+func (m *Message) Original(channelId string) *MessageReference {
+	if m.Reference != nil {
+		return m.Reference
+	}
+
+	return &MessageReference{
+		ChannelId: channelId,
+		MessageId: m.Id,
+	}
 }
 
 type Messages struct {
@@ -10,4 +10,8 @@ var (
 	ErrNotFound      = errors.New("not found")
 	Unauthenticated  = errors.New("unauthenticated")
 	PermissionDenied = errors.New("permission denied")
+	ErrInvalidRule   = errors.New("invalid moderation rule")
+	ErrBlocked       = errors.New("message blocked by moderation")
+	ErrTimedOut      = errors.New("author is timed out")
+	ErrExportFormat  = errors.New("unsupported export format")
 )
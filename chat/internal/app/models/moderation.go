@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ModerationRuleID uuid.UUID
+
+func (v ModerationRuleID) String() string {
+	return uuid.UUID(v).String()
+}
+
+type ModerationActionID uuid.UUID
+
+func (v ModerationActionID) String() string {
+	return uuid.UUID(v).String()
+}
+
+type ModerationRule struct {
+	Id             ModerationRuleID `bson:"_id"`
+	ServerId       string           `bson:"server_id"`
+	Type           string           `bson:"type"`
+	Words          []string         `bson:"words"`
+	Pattern        string           `bson:"pattern"`
+	Limit          int              `bson:"limit"`
+	Action         string           `bson:"action"`
+	TimeoutSeconds int64            `bson:"timeout_seconds"`
+	CreatedAt      time.Time        `bson:"created_at"`
+}
+
+type ModerationAction struct {
+	Id        ModerationActionID `bson:"_id"`
+	ServerId  string             `bson:"server_id"`
+	RuleId    ModerationRuleID   `bson:"rule_id"`
+	RuleType  string             `bson:"rule_type"`
+	Action    string             `bson:"action"`
+	AuthorId  string             `bson:"author_id"`
+	ChatId    string             `bson:"chat_id"`
+	Text      string             `bson:"text"`
+	CreatedAt time.Time          `bson:"created_at"`
+	ExpiresAt time.Time          `bson:"expires_at,omitempty"`
+}
+
+// ModerationVerdict - result of evaluating server rules against a message
+type ModerationVerdict struct {
+	Action    string
+	Triggered []*ModerationAction
+}
@@ -6,9 +6,16 @@ import (
 	"errors"
 	config "github.com/Nixonxp/discord/chat/configs"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/chat/pkg/api/v1"
 	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
 	log "github.com/Nixonxp/discord/chat/pkg/logger"
 	"github.com/segmentio/kafka-go"
+	"time"
+)
+
+const (
+	minRetryBackoff = time.Second
+	maxRetryBackoff = time.Minute
 )
 
 type Deps struct {
@@ -18,38 +25,101 @@ type Deps struct {
 	Log             *log.Logger
 }
 
+type eventHandler func(ctx context.Context, event *pb.EventEnvelope) error
+
 type Queue struct {
 	KafkaReader *kafka.Reader
 	Deps
+
+	handlers map[pb.EventType]eventHandler
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
 }
 
 func NewQueue(d Deps) *Queue {
-	return &Queue{
-		Deps: d,
+	q := &Queue{
+		Deps:       d,
+		minBackoff: minRetryBackoff,
+		maxBackoff: maxRetryBackoff,
 	}
+
+	q.handlers = map[pb.EventType]eventHandler{
+		pb.EventType_EVENT_TYPE_MESSAGE_CREATED:   q.createMessage,
+		pb.EventType_EVENT_TYPE_SERVER_DELETED:    q.deleteServer,
+		pb.EventType_EVENT_TYPE_MESSAGE_PUBLISHED: q.crossPostMessage,
+		pb.EventType_EVENT_TYPE_CHANNEL_DELETED:   q.deleteChannel,
+	}
+
+	return q
 }
 
+// Run - consumes events until ctx is done, the offset is committed only after the event is handled,
+// failed events are retried with backoff, so nothing is skipped; fetch and commit errors are returned
 func (q *Queue) Run(ctx context.Context) error {
 	for {
-		m, err := q.ConsumerService.ReadMessage(ctx)
+		m, err := q.ConsumerService.FetchMessage(ctx)
 		if err != nil {
 			if errors.Is(err, context.Canceled) {
-				break
+				return nil
 			}
-			q.Log.WithContext(ctx).WithError(err).Error("failed to read messages")
-			break
+			return pkgerrors.Wrap("fetch message", err)
 		}
 
 		q.Log.WithContext(ctx).Info("get  message from kafka")
-		if m.Topic == "messages" {
-			err = q.CreateMessage(ctx, m)
-			if err != nil {
-				break
+		err = q.handleWithRetry(ctx, m)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+
+		err = q.ConsumerService.CommitMessages(ctx, m)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
 			}
+			return pkgerrors.Wrap("commit message", err)
 		}
 	}
+}
 
-	return nil
+// handleWithRetry - handles message until it succeeds or ctx is done,
+// malformed events can never succeed, so they are logged and skipped
+func (q *Queue) handleWithRetry(ctx context.Context, m kafka.Message) error {
+	event, err := DecodeEvent(m)
+	if err != nil {
+		q.Log.WithContext(ctx).WithError(err).WithField("topic", m.Topic).Error("skip malformed message")
+		return nil
+	}
+
+	backoff := q.minBackoff
+	for {
+		err = q.dispatch(ctx, event)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, ErrUnexpectedEvent) {
+			q.Log.WithContext(ctx).WithError(err).WithField("topic", m.Topic).Error("skip malformed message")
+			return nil
+		}
+
+		q.Log.WithContext(ctx).
+			WithError(err).
+			WithField("topic", m.Topic).
+			WithField("event_id", event.GetEventId()).
+			WithField("retry_in", backoff.String()).
+			Error("failed to handle message")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, q.maxBackoff)
+	}
 }
 
 func (q *Queue) ReadInMessage(message kafka.Message, in any) error {
@@ -61,19 +131,58 @@ func (q *Queue) ReadInMessage(message kafka.Message, in any) error {
 	return nil
 }
 
+// HandleMessage - decodes event envelope (or legacy json payload) and dispatches it by event type,
+// events unknown to this consumer are skipped
+func (q *Queue) HandleMessage(ctx context.Context, message kafka.Message) error {
+	event, err := DecodeEvent(message)
+	if err != nil {
+		return pkgerrors.Wrap("unmarshal message", err)
+	}
+
+	return q.dispatch(ctx, event)
+}
+
+func (q *Queue) dispatch(ctx context.Context, event *pb.EventEnvelope) error {
+	handler, ok := q.handlers[event.GetEventType()]
+	if !ok {
+		q.Log.WithContext(ctx).
+			WithField("event_id", event.GetEventId()).
+			WithField("event_type", event.GetEventType().String()).
+			Warn("skip unsupported event")
+		return nil
+	}
+
+	span, ctx := startEventSpan(ctx, event)
+	defer span.Finish()
+
+	return handler(ctx, event)
+}
+
 func (q *Queue) CreateMessage(ctx context.Context, message kafka.Message) error {
 	q.Log.WithContext(ctx).Infof("get message from kafka topic - %s", q.Cfg.Application.KafkaMessagesTopic)
-	msgDto := MessageKafkaMessage{}
-	err := q.ReadInMessage(message, &msgDto)
+	event, err := DecodeEvent(message)
 	if err != nil {
 		return pkgerrors.Wrap("unmarshal message", err)
 	}
 
-	_, err = q.QueueUsecase.CreateMessage(ctx, usecases.MessageDto{
-		Id:      msgDto.Id,
-		Text:    msgDto.Text,
-		ChatId:  msgDto.ChatId,
-		OwnerId: msgDto.OwnerId,
+	return q.createMessage(ctx, event)
+}
+
+func (q *Queue) createMessage(ctx context.Context, event *pb.EventEnvelope) error {
+	msgEvent := event.GetMessageCreated()
+	if msgEvent == nil {
+		return pkgerrors.Wrap("unmarshal message", ErrUnexpectedEvent)
+	}
+
+	_, err := q.QueueUsecase.CreateMessage(ctx, usecases.MessageDto{
+		Id:        msgEvent.GetId(),
+		Text:      msgEvent.GetText(),
+		ChatId:    msgEvent.GetChatId(),
+		OwnerId:   msgEvent.GetOwnerId(),
+		WebhookId: msgEvent.GetWebhookId(),
+		Username:  msgEvent.GetUsername(),
+		AvatarUrl: msgEvent.GetAvatarUrl(),
+		VisibleTo: msgEvent.GetVisibleTo(),
 	})
 	if err != nil {
 		return pkgerrors.Wrap("fail create message", err)
@@ -81,3 +190,49 @@ func (q *Queue) CreateMessage(ctx context.Context, message kafka.Message) error
 
 	return nil
 }
+
+func (q *Queue) deleteServer(ctx context.Context, event *pb.EventEnvelope) error {
+	serverEvent := event.GetServerDeleted()
+	if serverEvent == nil {
+		return pkgerrors.Wrap("unmarshal message", ErrUnexpectedEvent)
+	}
+
+	_, err := q.QueueUsecase.DeleteServerChat(ctx, serverEvent.GetServerId())
+	if err != nil {
+		return pkgerrors.Wrap("fail delete server chat", err)
+	}
+
+	return nil
+}
+
+func (q *Queue) deleteChannel(ctx context.Context, event *pb.EventEnvelope) error {
+	channelEvent := event.GetChannelDeleted()
+	if channelEvent == nil {
+		return pkgerrors.Wrap("unmarshal message", ErrUnexpectedEvent)
+	}
+
+	_, err := q.QueueUsecase.DeleteChannelChat(ctx, channelEvent.GetChannelId())
+	if err != nil {
+		return pkgerrors.Wrap("fail delete channel chat", err)
+	}
+
+	return nil
+}
+
+func (q *Queue) crossPostMessage(ctx context.Context, event *pb.EventEnvelope) error {
+	publishedEvent := event.GetMessagePublished()
+	if publishedEvent == nil {
+		return pkgerrors.Wrap("unmarshal message", ErrUnexpectedEvent)
+	}
+
+	_, err := q.QueueUsecase.CrossPostMessage(ctx, usecases.PublishedMessageDto{
+		MessageId:        publishedEvent.GetMessageId(),
+		SourceChannelId:  publishedEvent.GetSourceChannelId(),
+		TargetChannelIds: publishedEvent.GetTargetChannelIds(),
+	})
+	if err != nil {
+		return pkgerrors.Wrap("fail cross-post message", err)
+	}
+
+	return nil
+}
@@ -5,11 +5,17 @@ import (
 	"encoding/json"
 	"errors"
 	config "github.com/Nixonxp/discord/chat/configs"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases/mocks"
+	pb "github.com/Nixonxp/discord/chat/pkg/api/v1"
+	logger "github.com/Nixonxp/discord/chat/pkg/logger"
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 	"testing"
+	"time"
 )
 
 func Test_usecase_ConsumerHandler_CreateMessage(t *testing.T) {
@@ -115,17 +121,23 @@ func Test_usecase_ConsumerHandler_CreateMessage(t *testing.T) {
 				Cfg:             &config.Config{},
 				ConsumerService: mocks.NewKafkaConsumerServiceInterface(t),
 			}
+			log, err := logger.NewLogger(logger.NewDefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
 			au := NewQueue(Deps{
 				QueueUsecase:    f.QueueUsecase,
 				Cfg:             f.Cfg,
 				ConsumerService: f.ConsumerService,
+				Log:             log,
 			})
 			if tt.on != nil {
 				tt.on(f)
 			}
 
 			// act
-			err := au.CreateMessage(tt.args.ctx, tt.args.req)
+			err = au.CreateMessage(tt.args.ctx, tt.args.req)
 
 			// assert
 			if (err != nil) != tt.wantErr {
@@ -144,3 +156,544 @@ func Test_usecase_ConsumerHandler_CreateMessage(t *testing.T) {
 		})
 	}
 }
+
+func Test_usecase_ConsumerHandler_HandleMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx           = context.Background() // dummy
+		brokenPayload = []byte{0xff, 0xff, 0xff}
+		// proto randomizes whitespace of its error texts, so the expected one is taken from proto itself
+		brokenPayloadErr = proto.Unmarshal(brokenPayload, &pb.EventEnvelope{})
+	)
+	type fields struct {
+		QueueUsecase    *mocks.QueueInterface
+		Cfg             *config.Config
+		ConsumerService *mocks.KafkaConsumerServiceInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req kafka.Message
+	}
+
+	msgDto := usecases.MessageDto{
+		Id:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+		Text:    "text",
+		ChatId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+		OwnerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+	}
+
+	protoMsg, err := EncodeEvent(NewMessageCreatedEvent(ctx, msgDto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	legacyBytes, _ := json.Marshal(MessageKafkaMessage{
+		Id:      msgDto.Id,
+		Text:    msgDto.Text,
+		ChatId:  msgDto.ChatId,
+		OwnerId: msgDto.OwnerId,
+	})
+
+	serverDeletedMsg, err := EncodeEvent(&pb.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b998",
+		EventType:     pb.EventType_EVENT_TYPE_SERVER_DELETED,
+		SchemaVersion: EventSchemaVersion,
+		Payload: &pb.EventEnvelope_ServerDeleted{
+			ServerDeleted: &pb.ServerDeletedEvent{
+				ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+				OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publishedDto := usecases.PublishedMessageDto{
+		MessageId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+		SourceChannelId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+		TargetChannelIds: []string{"284fef68-7e3e-4d1d-96a0-8c96f7b3b301", "284fef68-7e3e-4d1d-96a0-8c96f7b3b302"},
+	}
+
+	publishedMsg, err := EncodeEvent(NewMessagePublishedEvent(ctx, publishedDto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channelDeletedMsg, err := EncodeEvent(&pb.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b997",
+		EventType:     pb.EventType_EVENT_TYPE_CHANNEL_DELETED,
+		SchemaVersion: EventSchemaVersion,
+		Payload: &pb.EventEnvelope_ChannelDeleted{
+			ChannelDeleted: &pb.ChannelDeletedEvent{
+				ChannelId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+				ServerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unknownMsg, err := EncodeEvent(&pb.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b999",
+		EventType:     pb.EventType(100),
+		SchemaVersion: EventSchemaVersion,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args args
+
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Protobuf envelope",
+			args: args{
+				ctx: ctx, // dummy
+				req: protoMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("CreateMessage", mock.Anything, msgDto).
+					Return(nil, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "CreateMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Legacy json payload",
+			args: args{
+				ctx: ctx, // dummy
+				req: kafka.Message{
+					Topic: "messages",
+					Value: legacyBytes,
+				},
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("CreateMessage", mock.Anything, msgDto).
+					Return(nil, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "CreateMessage", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Unknown event type is skipped",
+			args: args{
+				ctx: ctx, // dummy
+				req: unknownMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "CreateMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Broken payload",
+			args: args{
+				ctx: ctx, // dummy
+				req: kafka.Message{
+					Value: brokenPayload,
+					Headers: []kafka.Header{
+						{Key: contentTypeHeader, Value: []byte(protobufContentType)},
+					},
+				},
+			},
+
+			wantErr:     true,
+			errorString: "unmarshal message: " + brokenPayloadErr.Error(),
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "CreateMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. CreateMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: protoMsg,
+			},
+
+			wantErr:     true,
+			errorString: "fail create message: some error",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("CreateMessage", mock.Anything, msgDto).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "CreateMessage", 1)
+			},
+		},
+		{
+			name: "Test 6. Positive. Server deleted",
+			args: args{
+				ctx: ctx, // dummy
+				req: serverDeletedMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, "284fef68-7e3e-4d1d-96a0-8c96f7b3b200").
+					Return(&models.ActionInfo{Success: true}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteServerChat", 1)
+				f.QueueUsecase.AssertNotCalled(t, "CreateMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. DeleteServerChat returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: serverDeletedMsg,
+			},
+
+			wantErr:     true,
+			errorString: "fail delete server chat: some error",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, "284fef68-7e3e-4d1d-96a0-8c96f7b3b200").
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteServerChat", 1)
+			},
+		},
+		{
+			name: "Test 8. Positive. Message published",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishedMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("CrossPostMessage", mock.Anything, publishedDto).
+					Return(&models.ActionInfo{Success: true}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "CrossPostMessage", 1)
+				f.QueueUsecase.AssertNotCalled(t, "CreateMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 9. Negative. CrossPostMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: publishedMsg,
+			},
+
+			wantErr:     true,
+			errorString: "fail cross-post message: some error",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("CrossPostMessage", mock.Anything, publishedDto).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "CrossPostMessage", 1)
+			},
+		},
+		{
+			name: "Test 10. Positive. Channel deleted",
+			args: args{
+				ctx: ctx, // dummy
+				req: channelDeletedMsg,
+			},
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("DeleteChannelChat", mock.Anything, "284fef68-7e3e-4d1d-96a0-8c96f7b3b300").
+					Return(&models.ActionInfo{Success: true}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteChannelChat", 1)
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChat", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 11. Negative. DeleteChannelChat returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: channelDeletedMsg,
+			},
+
+			wantErr:     true,
+			errorString: "fail delete channel chat: some error",
+
+			on: func(f *fields) {
+				f.QueueUsecase.On("DeleteChannelChat", mock.Anything, "284fef68-7e3e-4d1d-96a0-8c96f7b3b300").
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteChannelChat", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				QueueUsecase:    mocks.NewQueueInterface(t),
+				Cfg:             &config.Config{},
+				ConsumerService: mocks.NewKafkaConsumerServiceInterface(t),
+			}
+			log, err := logger.NewLogger(logger.NewDefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			au := NewQueue(Deps{
+				QueueUsecase:    f.QueueUsecase,
+				Cfg:             f.Cfg,
+				ConsumerService: f.ConsumerService,
+				Log:             log,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			err = au.HandleMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.HandleMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ConsumerHandler_Run(t *testing.T) {
+	// prepare
+	type fields struct {
+		QueueUsecase    *mocks.QueueInterface
+		Cfg             *config.Config
+		ConsumerService *mocks.KafkaConsumerServiceInterface
+	}
+
+	serverDeletedMsg, err := EncodeEvent(&pb.EventEnvelope{
+		EventId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b998",
+		EventType:     pb.EventType_EVENT_TYPE_SERVER_DELETED,
+		SchemaVersion: EventSchemaVersion,
+		Payload: &pb.EventEnvelope_ServerDeleted{
+			ServerDeleted: &pb.ServerDeletedEvent{
+				ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+				OwnerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverDeletedMsg.Offset = 1
+	brokenMsg := kafka.Message{Value: []byte{0xff, 0xff, 0xff}, Offset: 2}
+
+	serverId := "284fef68-7e3e-4d1d-96a0-8c96f7b3b200"
+
+	tests := []struct {
+		name string
+
+		wantErr     bool
+		errorString string
+
+		on     func(*fields, context.CancelFunc)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Message is committed after it is handled",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, context.Canceled).Once()
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, serverId).
+					Return(&models.ActionInfo{Success: true}, nil)
+				f.ConsumerService.On("CommitMessages", mock.Anything, serverDeletedMsg).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ConsumerService.AssertNumberOfCalls(t, "CommitMessages", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Failed message is retried before commit",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, context.Canceled).Once()
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, serverId).
+					Return(nil, errors.New("some error")).Twice()
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, serverId).
+					Return(&models.ActionInfo{Success: true}, nil).Once()
+				f.ConsumerService.On("CommitMessages", mock.Anything, serverDeletedMsg).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNumberOfCalls(t, "DeleteServerChat", 3)
+				f.ConsumerService.AssertNumberOfCalls(t, "CommitMessages", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Broken message is skipped and committed",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(brokenMsg, nil).Once()
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, context.Canceled).Once()
+				f.ConsumerService.On("CommitMessages", mock.Anything, brokenMsg).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChat", mock.Anything, mock.Anything)
+				f.ConsumerService.AssertNumberOfCalls(t, "CommitMessages", 1)
+			},
+		},
+		{
+			name: "Test 4. Positive. Stop while message is retried",
+
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields, cancel context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, serverId).
+					Run(func(mock.Arguments) { cancel() }).
+					Return(nil, errors.New("some error")).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ConsumerService.AssertNotCalled(t, "CommitMessages", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. FetchMessage returns error",
+
+			wantErr:     true,
+			errorString: "fetch message: some error",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(kafka.Message{}, errors.New("some error")).Once()
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.QueueUsecase.AssertNotCalled(t, "DeleteServerChat", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. CommitMessages returns error",
+
+			wantErr:     true,
+			errorString: "commit message: some error",
+
+			on: func(f *fields, _ context.CancelFunc) {
+				f.ConsumerService.On("FetchMessage", mock.Anything).
+					Return(serverDeletedMsg, nil).Once()
+				f.QueueUsecase.On("DeleteServerChat", mock.Anything, serverId).
+					Return(&models.ActionInfo{Success: true}, nil)
+				f.ConsumerService.On("CommitMessages", mock.Anything, serverDeletedMsg).
+					Return(errors.New("some error"))
+			},
+			assert: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			f := &fields{
+				QueueUsecase:    mocks.NewQueueInterface(t),
+				Cfg:             &config.Config{},
+				ConsumerService: mocks.NewKafkaConsumerServiceInterface(t),
+			}
+			log, err := logger.NewLogger(logger.NewDefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			au := NewQueue(Deps{
+				QueueUsecase:    f.QueueUsecase,
+				Cfg:             f.Cfg,
+				ConsumerService: f.ConsumerService,
+				Log:             log,
+			})
+			au.minBackoff = time.Millisecond
+			au.maxBackoff = time.Millisecond
+			if tt.on != nil {
+				tt.on(f, cancel)
+			}
+
+			// act
+			err = au.Run(ctx)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
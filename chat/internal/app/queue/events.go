@@ -0,0 +1,173 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/chat/pkg/api/v1"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	// EventSchemaVersion - version of EventEnvelope written by this producer
+	EventSchemaVersion = 1
+	// legacySchemaVersion - version assigned to decoded legacy JSON payloads
+	legacySchemaVersion = 0
+
+	contentTypeHeader   = "content-type"
+	eventTypeHeader     = "event-type"
+	protobufContentType = "application/x-protobuf"
+)
+
+var ErrUnexpectedEvent = errors.New("unexpected event payload")
+
+// NewMessageCreatedEvent - wraps message into envelope and injects the span from ctx as trace context
+func NewMessageCreatedEvent(ctx context.Context, msgData usecases.MessageDto) *pb.EventEnvelope {
+	now := timestamppb.New(time.Now())
+
+	event := &pb.EventEnvelope{
+		EventId:       uuid.New().String(),
+		EventType:     pb.EventType_EVENT_TYPE_MESSAGE_CREATED,
+		SchemaVersion: EventSchemaVersion,
+		OccurredAt:    now,
+		ProducedAt:    now,
+		TraceContext:  map[string]string{},
+		Payload: &pb.EventEnvelope_MessageCreated{
+			MessageCreated: &pb.MessageCreatedEvent{
+				Id:        msgData.Id,
+				Text:      msgData.Text,
+				ChatId:    msgData.ChatId,
+				OwnerId:   msgData.OwnerId,
+				WebhookId: msgData.WebhookId,
+				Username:  msgData.Username,
+				AvatarUrl: msgData.AvatarUrl,
+				VisibleTo: msgData.VisibleTo,
+			},
+		},
+	}
+
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(event.TraceContext))
+	}
+
+	return event
+}
+
+// NewMessagePublishedEvent - wraps published announcement message into envelope, trace context is injected as for created messages
+func NewMessagePublishedEvent(ctx context.Context, msgData usecases.PublishedMessageDto) *pb.EventEnvelope {
+	now := timestamppb.New(time.Now())
+
+	event := &pb.EventEnvelope{
+		EventId:       uuid.New().String(),
+		EventType:     pb.EventType_EVENT_TYPE_MESSAGE_PUBLISHED,
+		SchemaVersion: EventSchemaVersion,
+		OccurredAt:    now,
+		ProducedAt:    now,
+		TraceContext:  map[string]string{},
+		Payload: &pb.EventEnvelope_MessagePublished{
+			MessagePublished: &pb.MessagePublishedEvent{
+				MessageId:        msgData.MessageId,
+				SourceChannelId:  msgData.SourceChannelId,
+				TargetChannelIds: msgData.TargetChannelIds,
+			},
+		},
+	}
+
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(event.TraceContext))
+	}
+
+	return event
+}
+
+// EncodeEvent - marshals envelope into kafka message, event type is duplicated in headers for routing
+func EncodeEvent(event *pb.EventEnvelope) (kafka.Message, error) {
+	value, err := proto.Marshal(event)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Key:   []byte(event.GetEventId()),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: contentTypeHeader, Value: []byte(protobufContentType)},
+			{Key: eventTypeHeader, Value: []byte(event.GetEventType().String())},
+		},
+	}, nil
+}
+
+// DecodeEvent - reads envelope from kafka message,
+// payloads without protobuf content type that look like JSON are decoded as legacy MessageKafkaMessage
+func DecodeEvent(message kafka.Message) (*pb.EventEnvelope, error) {
+	if !isProtobufMessage(message) && isLegacyJSON(message.Value) {
+		return decodeLegacyEvent(message)
+	}
+
+	event := &pb.EventEnvelope{}
+	if err := proto.Unmarshal(message.Value, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func isProtobufMessage(message kafka.Message) bool {
+	for _, header := range message.Headers {
+		if header.Key == contentTypeHeader {
+			return string(header.Value) == protobufContentType
+		}
+	}
+
+	return false
+}
+
+func isLegacyJSON(value []byte) bool {
+	trimmed := bytes.TrimSpace(value)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
+
+func decodeLegacyEvent(message kafka.Message) (*pb.EventEnvelope, error) {
+	msgDto := MessageKafkaMessage{}
+	if err := json.Unmarshal(message.Value, &msgDto); err != nil {
+		return nil, err
+	}
+
+	event := &pb.EventEnvelope{
+		EventId:       msgDto.Id,
+		EventType:     pb.EventType_EVENT_TYPE_MESSAGE_CREATED,
+		SchemaVersion: legacySchemaVersion,
+		Payload: &pb.EventEnvelope_MessageCreated{
+			MessageCreated: &pb.MessageCreatedEvent{
+				Id:      msgDto.Id,
+				Text:    msgDto.Text,
+				ChatId:  msgDto.ChatId,
+				OwnerId: msgDto.OwnerId,
+			},
+		},
+	}
+	if !message.Time.IsZero() {
+		event.ProducedAt = timestamppb.New(message.Time)
+	}
+
+	return event, nil
+}
+
+// startEventSpan - continues the producer trace when the envelope carries one
+func startEventSpan(ctx context.Context, event *pb.EventEnvelope) (opentracing.Span, context.Context) {
+	operation := "queue." + event.GetEventType().String()
+
+	parent, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, opentracing.TextMapCarrier(event.GetTraceContext()))
+	if err != nil || parent == nil {
+		return opentracing.StartSpanFromContext(ctx, operation)
+	}
+
+	return opentracing.StartSpanFromContext(ctx, operation, opentracing.FollowsFrom(parent))
+}
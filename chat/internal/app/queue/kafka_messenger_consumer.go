@@ -25,8 +25,42 @@ func NewKafkaMessengerConsumer(cfg *config.Config) *KafkaMessengerConsumer {
 	}
 }
 
-func (m *KafkaMessengerConsumer) ReadMessage(ctx context.Context) (kafka.Message, error) {
-	return m.r.ReadMessage(ctx)
+// NewKafkaServerEventsConsumer - reads events of the server service, the chat has its own consumer group
+func NewKafkaServerEventsConsumer(cfg *config.Config) *KafkaMessengerConsumer {
+	log.Printf("start listening server events from %s", cfg.Application.KafkaAddress)
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  []string{cfg.Application.KafkaAddress},
+		Topic:    cfg.Application.KafkaServerEventsTopic,
+		GroupID:  "chat-server-events",
+		MaxBytes: 10e6, // 10MB
+	})
+
+	return &KafkaMessengerConsumer{
+		r: r,
+	}
+}
+
+// NewKafkaChannelEventsConsumer - reads events of the channel service, the chat has its own consumer group
+func NewKafkaChannelEventsConsumer(cfg *config.Config) *KafkaMessengerConsumer {
+	log.Printf("start listening channel events from %s", cfg.Application.KafkaAddress)
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  []string{cfg.Application.KafkaAddress},
+		Topic:    cfg.Application.KafkaChannelEventsTopic,
+		GroupID:  "chat-channel-events",
+		MaxBytes: 10e6, // 10MB
+	})
+
+	return &KafkaMessengerConsumer{
+		r: r,
+	}
+}
+
+func (m *KafkaMessengerConsumer) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	return m.r.FetchMessage(ctx)
+}
+
+func (m *KafkaMessengerConsumer) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	return m.r.CommitMessages(ctx, msgs...)
 }
 
 func (m *KafkaMessengerConsumer) Close() error {
@@ -1,7 +1,7 @@
 package queue
 
 import (
-	"encoding/json"
+	"context"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases"
 	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
 	"github.com/segmentio/kafka-go"
@@ -17,12 +17,27 @@ func NewKafkaMessenger(conn *kafka.Conn) *KafkaMessenger {
 	}
 }
 
-func (m *KafkaMessenger) SendMessage(msgData usecases.MessageDto) error {
-	msgBytes, _ := json.Marshal(msgData)
+func (m *KafkaMessenger) SendMessage(ctx context.Context, msgData usecases.MessageDto) error {
+	message, err := EncodeEvent(NewMessageCreatedEvent(ctx, msgData))
+	if err != nil {
+		return pkgerrors.Wrap("failed to encode event", err)
+	}
+
+	_, err = m.conn.WriteMessages(message)
+	if err != nil {
+		return pkgerrors.Wrap("failed to send messages", err)
+	}
+
+	return nil
+}
+
+func (m *KafkaMessenger) PublishMessage(ctx context.Context, msgData usecases.PublishedMessageDto) error {
+	message, err := EncodeEvent(NewMessagePublishedEvent(ctx, msgData))
+	if err != nil {
+		return pkgerrors.Wrap("failed to encode event", err)
+	}
 
-	_, err := m.conn.WriteMessages(
-		kafka.Message{Value: msgBytes},
-	)
+	_, err = m.conn.WriteMessages(message)
 	if err != nil {
 		return pkgerrors.Wrap("failed to send messages", err)
 	}
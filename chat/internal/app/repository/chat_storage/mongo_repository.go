@@ -18,6 +18,14 @@ type MongoCollectionInterface interface {
 		opts ...*options.FindOptions) (cur *mongo.Cursor, err error)
 	FindOne(ctx context.Context, filter interface{},
 		opts ...*options.FindOneOptions) *mongo.SingleResult
+	DeleteOne(ctx context.Context, filter interface{},
+		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	DeleteMany(ctx context.Context, filter interface{},
+		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	Aggregate(ctx context.Context, pipeline interface{},
+		opts ...*options.AggregateOptions) (*mongo.Cursor, error)
+	CreateIndexes(ctx context.Context, models []mongo.IndexModel,
+		opts ...*options.CreateIndexesOptions) ([]string, error)
 }
 
 type MongoChatRepository struct {
@@ -36,6 +44,22 @@ func NewMongoChatRepository(mongo MongoCollectionInterface, log *logger.Logger)
 	}
 }
 
+// EnsureIndexes - unique metadata_type keeps one chat per metadata of a type
+func (r *MongoChatRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "metadata", Value: 1}, {Key: "type", Value: 1}},
+			Options: options.Index().SetName("metadata_type").SetUnique(true),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create chat indexes error")
+		return err
+	}
+
+	return nil
+}
+
 func (r *MongoChatRepository) CreateChat(ctx context.Context, chat *models.Chat) error {
 	upsert := true
 
@@ -48,11 +72,15 @@ func (r *MongoChatRepository) CreateChat(ctx context.Context, chat *models.Chat)
 		"metadata": chat.MetaData,
 	}
 
-	_, err := r.mongo.UpdateOne(ctx, bson.D{{"_id", uuid.UUID(chat.Id)}}, bson.M{
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(chat.Id)}, bson.M{
 		"$set": addMessage,
 	}, option)
 
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ErrAlreadyExists
+		}
+
 		r.log.WithContext(ctx).WithError(err).WithField("Id", chat.Id).Error("create chat error")
 		return err
 	}
@@ -93,3 +121,83 @@ func (r *MongoChatRepository) GetChatByMetadataAndType(ctx context.Context, meta
 
 	return chat, nil
 }
+
+func (r *MongoChatRepository) GetChatsByOwnerAndType(ctx context.Context, ownerId models.OwnerID, chatType string) ([]*models.Chat, error) {
+	cursor, err := r.mongo.Find(ctx, bson.M{"owner_id": uuid.UUID(ownerId), "type": chatType})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("ownerId", ownerId).Error("get chats error")
+		return nil, err
+	}
+
+	chats := make([]*models.Chat, 0)
+	err = cursor.All(ctx, &chats)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("ownerId", ownerId).Error("get chats error")
+		return nil, err
+	}
+
+	return chats, nil
+}
+
+func (r *MongoChatRepository) DeleteChat(ctx context.Context, chatId models.ChatID) error {
+	_, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(chatId)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("chatId", chatId).Error("delete chat error")
+		return err
+	}
+
+	return nil
+}
+
+// FindDuplicates - chats of every (metadata, type) pair with more than one chat, the first chat
+// in natural order is kept, it is the one GetChatByMetadataAndType found before the unique index
+func (r *MongoChatRepository) FindDuplicates(ctx context.Context) ([]models.ChatDuplicates, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"metadata": "$metadata", "type": "$type"},
+			"ids":   bson.M{"$push": "$_id"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	}
+
+	cursor, err := r.mongo.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("find duplicated chats error repo")
+		return nil, err
+	}
+
+	var groups []struct {
+		Ids []models.ChatID `bson:"ids"`
+	}
+	err = cursor.All(ctx, &groups)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("find duplicated chats error repo")
+		return nil, err
+	}
+
+	duplicates := make([]models.ChatDuplicates, len(groups))
+	for i, group := range groups {
+		duplicates[i] = models.ChatDuplicates{
+			KeepId:       group.Ids[0],
+			DuplicateIds: group.Ids[1:],
+		}
+	}
+
+	return duplicates, nil
+}
+
+func (r *MongoChatRepository) DeleteByIds(ctx context.Context, ids []models.ChatID) (int64, error) {
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		uuids[i] = uuid.UUID(id)
+	}
+
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": uuids}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete chats error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
@@ -17,12 +17,20 @@ type MongoCollectionInterface interface {
 		opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
 	Find(ctx context.Context, filter interface{},
 		opts ...*options.FindOptions) (cur *mongo.Cursor, err error)
+	FindOne(ctx context.Context, filter interface{},
+		opts ...*options.FindOneOptions) *mongo.SingleResult
+	DeleteMany(ctx context.Context, filter interface{},
+		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	UpdateMany(ctx context.Context, filter interface{}, update interface{},
+		opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
 }
 
 type MongoMessagesRepository struct {
 	mongo MongoCollectionInterface
 }
 
+const notFoundErrorStr = "mongo: no documents in result"
+
 var _ usecases.MessagesStorage = (*MongoMessagesRepository)(nil)
 
 func NewMongoMessagesRepository(mongo MongoCollectionInterface) *MongoMessagesRepository {
@@ -43,8 +51,14 @@ func (r *MongoMessagesRepository) CreateMessage(ctx context.Context, message *mo
 		"owner_id":  uuid.UUID(message.OwnerId),
 		"timestamp": primitive.Timestamp{T: uint32(time.Now().Unix())},
 	}
+	if message.Reference != nil {
+		addMessage["reference"] = bson.M{
+			"channel_id": message.Reference.ChannelId,
+			"message_id": uuid.UUID(message.Reference.MessageId),
+		}
+	}
 
-	_, err := r.mongo.UpdateOne(ctx, bson.D{{"_id", uuid.UUID(message.Id)}}, bson.M{
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(message.Id)}, bson.M{
 		"$set": addMessage,
 	}, option)
 
@@ -56,7 +70,7 @@ func (r *MongoMessagesRepository) CreateMessage(ctx context.Context, message *mo
 }
 
 func (r *MongoMessagesRepository) GetMessages(ctx context.Context, chatId models.ChatID) ([]*models.Message, error) {
-	filter := bson.D{{"chat_id", uuid.UUID(chatId)}}
+	filter := bson.M{"chat_id": uuid.UUID(chatId)}
 	cursor, err := r.mongo.Find(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -70,3 +84,108 @@ func (r *MongoMessagesRepository) GetMessages(ctx context.Context, chatId models
 
 	return messages, nil
 }
+
+func (r *MongoMessagesRepository) GetMessageById(ctx context.Context, id models.MessageID) (*models.Message, error) {
+	message := &models.Message{}
+	err := r.mongo.FindOne(ctx, bson.M{"_id": uuid.UUID(id)}).Decode(message)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (r *MongoMessagesRepository) GetLastMessagesByOwner(ctx context.Context, chatId models.ChatID, ownerId models.OwnerID, limit int64) ([]*models.Message, error) {
+	filter := bson.M{
+		"chat_id":  uuid.UUID(chatId),
+		"owner_id": uuid.UUID(ownerId),
+	}
+
+	option := options.Find().
+		SetSort(bson.M{"timestamp": -1}).
+		SetLimit(limit)
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*models.Message
+	err = cursor.All(ctx, &messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// IterateMessages - walks chat messages in chronological order without loading the whole history,
+// zero from/to leave the range open
+func (r *MongoMessagesRepository) IterateMessages(ctx context.Context, chatId models.ChatID, from time.Time, to time.Time, fn func(message *models.Message) error) error {
+	filter := bson.M{"chat_id": uuid.UUID(chatId)}
+
+	timestampFilter := bson.M{}
+	if !from.IsZero() {
+		timestampFilter["$gte"] = primitive.Timestamp{T: uint32(from.Unix())}
+	}
+	if !to.IsZero() {
+		timestampFilter["$lt"] = primitive.Timestamp{T: uint32(to.Unix())}
+	}
+	if len(timestampFilter) > 0 {
+		filter["timestamp"] = timestampFilter
+	}
+
+	option := options.Find().SetSort(bson.D{
+		{Key: "timestamp", Value: 1},
+		{Key: "_id", Value: 1},
+	})
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		message := &models.Message{}
+		if err := cursor.Decode(message); err != nil {
+			return err
+		}
+
+		if err := fn(message); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func (r *MongoMessagesRepository) DeleteByChatId(ctx context.Context, chatId models.ChatID) (int64, error) {
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"chat_id": uuid.UUID(chatId)})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
+
+// MoveToChat - moves messages of the chats to another chat, the message ids stay the same
+func (r *MongoMessagesRepository) MoveToChat(ctx context.Context, fromChatIds []models.ChatID, toChatId models.ChatID) (int64, error) {
+	uuids := make([]uuid.UUID, len(fromChatIds))
+	for i, id := range fromChatIds {
+		uuids[i] = uuid.UUID(id)
+	}
+
+	result, err := r.mongo.UpdateMany(ctx,
+		bson.M{"chat_id": bson.M{"$in": uuids}},
+		bson.M{"$set": bson.M{"chat_id": uuid.UUID(toChatId)}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	logger "github.com/Nixonxp/discord/chat/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type MongoCollectionInterface interface {
+	UpdateOne(ctx context.Context, filter interface{}, update interface{},
+		opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
+	Find(ctx context.Context, filter interface{},
+		opts ...*options.FindOptions) (cur *mongo.Cursor, err error)
+	FindOne(ctx context.Context, filter interface{},
+		opts ...*options.FindOneOptions) *mongo.SingleResult
+}
+
+type MongoModerationActionsRepository struct {
+	mongo MongoCollectionInterface
+	log   *logger.Logger
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+var _ usecases.ModerationActionsStorage = (*MongoModerationActionsRepository)(nil)
+
+func NewMongoModerationActionsRepository(mongo MongoCollectionInterface, log *logger.Logger) *MongoModerationActionsRepository {
+	return &MongoModerationActionsRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+func (r *MongoModerationActionsRepository) CreateAction(ctx context.Context, action *models.ModerationAction) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addAction := bson.M{
+		"server_id":  action.ServerId,
+		"rule_id":    uuid.UUID(action.RuleId),
+		"rule_type":  action.RuleType,
+		"action":     action.Action,
+		"author_id":  action.AuthorId,
+		"chat_id":    action.ChatId,
+		"text":       action.Text,
+		"created_at": action.CreatedAt,
+	}
+	if !action.ExpiresAt.IsZero() {
+		addAction["expires_at"] = action.ExpiresAt
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(action.Id)}, bson.M{
+		"$set": addAction,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("Id", action.Id).Error("create moderation action error")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoModerationActionsRepository) GetActionsByServerId(ctx context.Context, serverId string) ([]*models.ModerationAction, error) {
+	option := options.Find().SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"server_id": serverId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("serverId", serverId).Error("get moderation actions error")
+		return nil, err
+	}
+
+	var actions []*models.ModerationAction
+	err = cursor.All(ctx, &actions)
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+func (r *MongoModerationActionsRepository) GetActiveTimeout(ctx context.Context, serverId string, authorId string, now time.Time) (*models.ModerationAction, error) {
+	filter := bson.M{
+		"server_id":  serverId,
+		"author_id":  authorId,
+		"action":     enum.ModerationActionTimeout,
+		"expires_at": bson.M{"$gt": now},
+	}
+	option := options.FindOne().SetSort(bson.M{"expires_at": -1})
+
+	action := &models.ModerationAction{}
+	err := r.mongo.FindOne(ctx, filter, option).Decode(action)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("authorId", authorId).Error("get active timeout error")
+		return nil, err
+	}
+
+	return action, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	logger "github.com/Nixonxp/discord/chat/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoCollectionInterface interface {
+	UpdateOne(ctx context.Context, filter interface{}, update interface{},
+		opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
+	Find(ctx context.Context, filter interface{},
+		opts ...*options.FindOptions) (cur *mongo.Cursor, err error)
+	DeleteOne(ctx context.Context, filter interface{},
+		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+}
+
+type MongoModerationRulesRepository struct {
+	mongo MongoCollectionInterface
+	log   *logger.Logger
+}
+
+var _ usecases.ModerationRulesStorage = (*MongoModerationRulesRepository)(nil)
+
+func NewMongoModerationRulesRepository(mongo MongoCollectionInterface, log *logger.Logger) *MongoModerationRulesRepository {
+	return &MongoModerationRulesRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+func (r *MongoModerationRulesRepository) CreateRule(ctx context.Context, rule *models.ModerationRule) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addRule := bson.M{
+		"server_id":       rule.ServerId,
+		"type":            rule.Type,
+		"words":           rule.Words,
+		"pattern":         rule.Pattern,
+		"limit":           rule.Limit,
+		"action":          rule.Action,
+		"timeout_seconds": rule.TimeoutSeconds,
+		"created_at":      rule.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(rule.Id)}, bson.M{
+		"$set": addRule,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("Id", rule.Id).Error("create moderation rule error")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoModerationRulesRepository) DeleteRule(ctx context.Context, serverId string, ruleId models.ModerationRuleID) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(ruleId), "server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("Id", ruleId).Error("delete moderation rule error")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoModerationRulesRepository) GetRulesByServerId(ctx context.Context, serverId string) ([]*models.ModerationRule, error) {
+	option := options.Find().SetSort(bson.M{"created_at": 1})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"server_id": serverId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("serverId", serverId).Error("get moderation rules error")
+		return nil, err
+	}
+
+	var rules []*models.ModerationRule
+	err = cursor.All(ctx, &rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
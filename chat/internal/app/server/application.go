@@ -40,6 +40,8 @@ func NewApplication(cfg *config.Config) *Application {
 			&srv.mongo,
 			&srv.kafkaProducer,
 			&srv.kafkaConsumer,
+			&srv.serverEvents,
+			&srv.channelEvents,
 		},
 		ShutdownTimeout: terminationTimeout,
 		Cfg:             cfg,
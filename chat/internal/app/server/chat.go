@@ -37,7 +37,7 @@ func (s *ChatServer) SendUserPrivateMessage(ctx context.Context, req *pb.SendUse
 }
 
 func (s *ChatServer) GetUserPrivateMessages(ctx context.Context, req *pb.GetUserPrivateMessagesRequest) (*pb.GetMessagesResponse, error) {
-	log.Printf("Send private message: received: %d", req.GetUserId())
+	log.Printf("Send private message: received: %s", req.GetUserId())
 
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
@@ -64,8 +64,12 @@ func (s *ChatServer) GetUserPrivateMessages(ctx context.Context, req *pb.GetUser
 			Timestamp: &timestamppb.Timestamp{
 				Seconds: v.Timestamp.Unix(),
 			},
-			OwnerId: v.OwnerId.String(),
-			ChatId:  v.ChatId.String(),
+			OwnerId:   v.OwnerId.String(),
+			ChatId:    v.ChatId.String(),
+			WebhookId: v.WebhookId,
+			Username:  v.Username,
+			AvatarUrl: v.AvatarUrl,
+			VisibleTo: v.VisibleTo,
 		}
 	}
 
@@ -105,9 +109,24 @@ func (s *ChatServer) SendServerMessage(ctx context.Context, req *pb.SendServerMe
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
+	// author is forwarded by server service, direct callers are authenticated by metadata
+	userId := req.GetUserId()
+	if userId == "" {
+		var err error
+		userId, err = auth.GetUserIdFromContext(ctx)
+		if err != nil {
+			return nil, models.Unauthenticated
+		}
+	}
+
 	_, err := s.ChatUsecase.SendServerMessage(ctx, usecases.SendServerMessageRequest{
-		ServerId: req.ServerId,
-		Text:     req.Text,
+		ServerId:  req.ServerId,
+		Text:      req.Text,
+		UserId:    userId,
+		WebhookId: req.GetWebhookId(),
+		Username:  req.GetUsername(),
+		AvatarUrl: req.GetAvatarUrl(),
+		VisibleTo: req.GetVisibleTo(),
 	})
 	if err != nil {
 		return nil, err
@@ -124,8 +143,19 @@ func (s *ChatServer) GetServerMessages(ctx context.Context, req *pb.GetServerMes
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
+	// viewer is forwarded by server service, direct callers are authenticated by metadata
+	userId := req.GetUserId()
+	if userId == "" {
+		var err error
+		userId, err = auth.GetUserIdFromContext(ctx)
+		if err != nil {
+			return nil, models.Unauthenticated
+		}
+	}
+
 	result, err := s.ChatUsecase.GetServerMessagesRequest(ctx, usecases.GetServerMessageRequest{
 		ServerId: req.ServerId,
+		UserId:   userId,
 	})
 	if err != nil {
 		return nil, err
@@ -139,8 +169,12 @@ func (s *ChatServer) GetServerMessages(ctx context.Context, req *pb.GetServerMes
 			Timestamp: &timestamppb.Timestamp{
 				Seconds: v.Timestamp.Unix(),
 			},
-			OwnerId: v.OwnerId.String(),
-			ChatId:  v.ChatId.String(),
+			OwnerId:   v.OwnerId.String(),
+			ChatId:    v.ChatId.String(),
+			WebhookId: v.WebhookId,
+			Username:  v.Username,
+			AvatarUrl: v.AvatarUrl,
+			VisibleTo: v.VisibleTo,
 		}
 	}
 
@@ -148,3 +182,126 @@ func (s *ChatServer) GetServerMessages(ctx context.Context, req *pb.GetServerMes
 		Messages: messages,
 	}, nil
 }
+
+func (s *ChatServer) SendChannelMessage(ctx context.Context, req *pb.SendChannelMessageRequest) (*pb.ActionResponse, error) {
+	log.Printf("send channel message: received: %s", req.GetChannelId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	_, err := s.ChatUsecase.SendChannelMessage(ctx, usecases.SendChannelMessageRequest{
+		ChannelId: req.GetChannelId(),
+		Text:      req.GetText(),
+		UserId:    req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *ChatServer) GetChannelMessages(ctx context.Context, req *pb.GetChannelMessagesRequest) (*pb.GetMessagesResponse, error) {
+	log.Printf("get channel messages received: %s", req.GetChannelId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ChatUsecase.GetChannelMessages(ctx, usecases.GetChannelMessagesRequest{
+		ChannelId: req.GetChannelId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMessagesResponse{
+		Messages: messagesToPb(result.Data),
+	}, nil
+}
+
+func (s *ChatServer) PublishChannelMessage(ctx context.Context, req *pb.PublishChannelMessageRequest) (*pb.ActionResponse, error) {
+	log.Printf("publish channel message: received: %s", req.GetChannelId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	_, err := s.ChatUsecase.PublishChannelMessage(ctx, usecases.PublishChannelMessageRequest{
+		ChannelId:        req.GetChannelId(),
+		MessageId:        req.GetMessageId(),
+		TargetChannelIds: req.GetTargetChannelIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *ChatServer) SendPostMessage(ctx context.Context, req *pb.SendPostMessageRequest) (*pb.ActionResponse, error) {
+	log.Printf("send post message: received: %s", req.GetPostId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	_, err := s.ChatUsecase.SendPostMessage(ctx, usecases.SendPostMessageRequest{
+		PostId:    req.GetPostId(),
+		ChannelId: req.GetChannelId(),
+		Text:      req.GetText(),
+		UserId:    req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *ChatServer) GetPostMessages(ctx context.Context, req *pb.GetPostMessagesRequest) (*pb.GetMessagesResponse, error) {
+	log.Printf("get post messages received: %s", req.GetPostId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ChatUsecase.GetPostMessages(ctx, usecases.GetPostMessagesRequest{
+		PostId: req.GetPostId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMessagesResponse{
+		Messages: messagesToPb(result.Data),
+	}, nil
+}
+
+func messagesToPb(data []*models.Message) []*pb.Message {
+	messages := make([]*pb.Message, len(data))
+	for k, v := range data {
+		messages[k] = &pb.Message{
+			Id:   v.Id.String(),
+			Text: v.Text,
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: v.Timestamp.Unix(),
+			},
+			OwnerId:   v.OwnerId.String(),
+			ChatId:    v.ChatId.String(),
+			WebhookId: v.WebhookId,
+			Username:  v.Username,
+			AvatarUrl: v.AvatarUrl,
+		}
+		if v.Reference != nil {
+			messages[k].Reference = &pb.MessageReference{
+				ChannelId: v.Reference.ChannelId,
+				MessageId: v.Reference.MessageId.String(),
+			}
+		}
+	}
+
+	return messages
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"bufio"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/chat/pkg/api/v1"
+	"github.com/Nixonxp/discord/chat/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/chat/pkg/grpc_utils"
+	"log"
+)
+
+const exportChunkSize = 32 * 1024
+
+// exportChunkWriter - sends everything written to it as stream chunks
+type exportChunkWriter struct {
+	stream pb.ChatService_ExportChatServer
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	err := w.stream.Send(&pb.ExportChatChunk{
+		Data: data,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (s *ChatServer) ExportChat(req *pb.ExportChatRequest, stream pb.ChatService_ExportChatServer) error {
+	log.Printf("export chat: received: %s %s", req.GetServerId(), req.GetUserId())
+	if err := s.validator.Validate(req); err != nil {
+		return grpcutils.RPCValidationError(err)
+	}
+
+	ctx := stream.Context()
+
+	request := usecases.ExportChatRequest{
+		ServerId: req.GetServerId(),
+		UserId:   req.GetUserId(),
+		Format:   req.GetFormat(),
+	}
+	if req.GetFrom() != nil {
+		request.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		request.To = req.GetTo().AsTime()
+	}
+
+	if request.ServerId == "" {
+		userId, err := auth.GetUserIdFromContext(ctx)
+		if err != nil {
+			return models.Unauthenticated
+		}
+		request.CurrentUser = userId
+	}
+
+	buf := bufio.NewWriterSize(&exportChunkWriter{stream: stream}, exportChunkSize)
+	err := s.ChatUsecase.ExportChat(ctx, request, buf)
+	if err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
@@ -6,8 +6,11 @@ import (
 	"github.com/Nixonxp/discord/chat/internal/app/queue"
 	chat_repository "github.com/Nixonxp/discord/chat/internal/app/repository/chat_storage"
 	repository "github.com/Nixonxp/discord/chat/internal/app/repository/messages_storage"
+	moderation_actions_repository "github.com/Nixonxp/discord/chat/internal/app/repository/moderation_actions_storage"
+	moderation_rules_repository "github.com/Nixonxp/discord/chat/internal/app/repository/moderation_rules_storage"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases"
 	chat_usc "github.com/Nixonxp/discord/chat/internal/app/usecases/chat"
+	moderation_usc "github.com/Nixonxp/discord/chat/internal/app/usecases/moderation"
 	queue_usc "github.com/Nixonxp/discord/chat/internal/app/usecases/queue"
 	middleware "github.com/Nixonxp/discord/chat/internal/middleware/errors"
 	middleware_metrics "github.com/Nixonxp/discord/chat/internal/middleware/metrics"
@@ -28,11 +31,13 @@ import (
 type Config struct {
 	ChainUnaryInterceptors []grpc.UnaryServerInterceptor
 	UnaryInterceptors      []grpc.UnaryServerInterceptor
+	StreamInterceptors     []grpc.StreamServerInterceptor
 }
 
 // Deps - server deps
 type Deps struct {
-	ChatUsecase usecases.UsecaseInterface
+	ChatUsecase       usecases.UsecaseInterface
+	ModerationUsecase usecases.ModerationUsecaseInterface
 }
 
 type ChatServer struct {
@@ -67,6 +72,16 @@ func NewChatServer(ctx context.Context, s *MainServer) (*ChatServer, error) {
 				&pb.CreatePrivateChatRequest{},
 				&pb.SendServerMessageRequest{},
 				&pb.GetServerMessagesRequest{},
+				&pb.SendChannelMessageRequest{},
+				&pb.GetChannelMessagesRequest{},
+				&pb.PublishChannelMessageRequest{},
+				&pb.SendPostMessageRequest{},
+				&pb.GetPostMessagesRequest{},
+				&pb.CreateModerationRuleRequest{},
+				&pb.DeleteModerationRuleRequest{},
+				&pb.GetModerationRulesRequest{},
+				&pb.GetModerationActionsRequest{},
+				&pb.ExportChatRequest{},
 			),
 		)
 		if err != nil {
@@ -81,16 +96,38 @@ func NewChatServer(ctx context.Context, s *MainServer) (*ChatServer, error) {
 		return nil, fmt.Errorf("failed to connect mongo: %v", err)
 	}
 
+	moderationRulesCollection, err := chatCollection.NewCollection(s.cfg.Application.ModerationRulesCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	moderationActionsCollection, err := chatCollection.NewCollection(s.cfg.Application.ModerationActionsCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
 	messagesMongoRepo := repository.NewMongoMessagesRepository(messagesCollection)
 
+	moderationUsecase := moderation_usc.NewModerationUsecase(moderation_usc.Deps{
+		RulesRepo:    moderation_rules_repository.NewMongoModerationRulesRepository(moderationRulesCollection, s.logger.GetInstance()),
+		ActionsRepo:  moderation_actions_repository.NewMongoModerationActionsRepository(moderationActionsCollection, s.logger.GetInstance()),
+		MessagesRepo: messagesMongoRepo,
+	})
+
+	// indexes are created on every start, creating an index that already exists is a no-op
 	chatMongoRepo := chat_repository.NewMongoChatRepository(chatCollection, s.logger.GetInstance())
+	err = chatMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat indexes, duplicated chats are merged by repair-chats: %v", err)
+	}
 	chatUsecase := chat_usc.NewChatUsecase(chat_usc.Deps{
 		MessagesRepo: messagesMongoRepo,
 		ChatRepo:     chatMongoRepo,
 		KafkaConn:    s.kafkaProducer.GetInstance(),
+		Moderation:   moderationUsecase,
 	})
 
-	queueUsecase := queue_usc.NewQueueUsecase(messagesMongoRepo)
+	queueUsecase := queue_usc.NewQueueUsecase(messagesMongoRepo, chatMongoRepo)
 	queueHandler := queue.NewQueue(queue.Deps{
 		QueueUsecase:    queueUsecase,
 		Cfg:             s.cfg,
@@ -100,11 +137,39 @@ func NewChatServer(ctx context.Context, s *MainServer) (*ChatServer, error) {
 	go func() {
 		err := queueHandler.Run(ctx)
 		if err != nil {
-			return
+			// offsets of unhandled events are not committed, the restarted service consumes them again
+			s.logger.GetInstance().Fatalf("messages consumer stopped: %v", err)
+		}
+	}()
+
+	serverEventsHandler := queue.NewQueue(queue.Deps{
+		QueueUsecase:    queueUsecase,
+		Cfg:             s.cfg,
+		ConsumerService: s.serverEvents.GetInstance(),
+		Log:             s.logger.GetInstance(),
+	})
+	go func() {
+		err := serverEventsHandler.Run(ctx)
+		if err != nil {
+			s.logger.GetInstance().Fatalf("server events consumer stopped: %v", err)
+		}
+	}()
+
+	channelEventsHandler := queue.NewQueue(queue.Deps{
+		QueueUsecase:    queueUsecase,
+		Cfg:             s.cfg,
+		ConsumerService: s.channelEvents.GetInstance(),
+		Log:             s.logger.GetInstance(),
+	})
+	go func() {
+		err := channelEventsHandler.Run(ctx)
+		if err != nil {
+			s.logger.GetInstance().Fatalf("channel events consumer stopped: %v", err)
 		}
 	}()
 
 	srv.ChatUsecase = chatUsecase
+	srv.ModerationUsecase = moderationUsecase
 
 	globalLimiter := rate_limiter.NewRateLimiter(10000)
 	grpcConfig := Config{
@@ -118,10 +183,15 @@ func NewChatServer(ctx context.Context, s *MainServer) (*ChatServer, error) {
 		UnaryInterceptors: []grpc.UnaryServerInterceptor{
 			middleware.ErrorsUnaryInterceptor(),
 		},
+		StreamInterceptors: []grpc.StreamServerInterceptor{
+			grpc_opentracing.OpenTracingStreamServerInterceptor(opentracing.GlobalTracer()),
+			middleware.ErrorsStreamInterceptor(),
+		},
 	}
 	grpcServerOptions := UnaryInterceptorsToGrpcServerOptions(grpcConfig.UnaryInterceptors...)
 	grpcServerOptions = append(grpcServerOptions,
 		grpc.ChainUnaryInterceptor(grpcConfig.ChainUnaryInterceptors...),
+		grpc.ChainStreamInterceptor(grpcConfig.StreamInterceptors...),
 	)
 
 	grpcServer := grpc.NewServer(grpcServerOptions...)
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/chat/pkg/api/v1"
+	grpcutils "github.com/Nixonxp/discord/chat/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+)
+
+func (s *ChatServer) CreateModerationRule(ctx context.Context, req *pb.CreateModerationRuleRequest) (*pb.ModerationRule, error) {
+	log.Printf("create moderation rule: received: %s", req.GetServerId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ModerationUsecase.CreateRule(ctx, usecases.CreateModerationRuleRequest{
+		ServerId:       req.GetServerId(),
+		Type:           req.GetType(),
+		Words:          req.GetWords(),
+		Pattern:        req.GetPattern(),
+		Limit:          int(req.GetLimit()),
+		Action:         req.GetAction(),
+		TimeoutSeconds: req.GetTimeoutSeconds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moderationRuleToPb(result), nil
+}
+
+func (s *ChatServer) DeleteModerationRule(ctx context.Context, req *pb.DeleteModerationRuleRequest) (*pb.ActionResponse, error) {
+	log.Printf("delete moderation rule: received: %s", req.GetRuleId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ModerationUsecase.DeleteRule(ctx, usecases.DeleteModerationRuleRequest{
+		ServerId: req.GetServerId(),
+		RuleId:   req.GetRuleId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ChatServer) GetModerationRules(ctx context.Context, req *pb.GetModerationRulesRequest) (*pb.GetModerationRulesResponse, error) {
+	log.Printf("get moderation rules: received: %s", req.GetServerId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ModerationUsecase.GetRules(ctx, usecases.GetModerationRulesRequest{
+		ServerId: req.GetServerId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*pb.ModerationRule, len(result))
+	for k, v := range result {
+		rules[k] = moderationRuleToPb(v)
+	}
+
+	return &pb.GetModerationRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+func (s *ChatServer) GetModerationActions(ctx context.Context, req *pb.GetModerationActionsRequest) (*pb.GetModerationActionsResponse, error) {
+	log.Printf("get moderation actions: received: %s", req.GetServerId())
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ModerationUsecase.GetActions(ctx, usecases.GetModerationActionsRequest{
+		ServerId: req.GetServerId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]*pb.ModerationAction, len(result))
+	for k, v := range result {
+		actions[k] = &pb.ModerationAction{
+			Id:       v.Id.String(),
+			ServerId: v.ServerId,
+			RuleId:   v.RuleId.String(),
+			RuleType: v.RuleType,
+			Action:   v.Action,
+			AuthorId: v.AuthorId,
+			ChatId:   v.ChatId,
+			Text:     v.Text,
+			CreatedAt: &timestamppb.Timestamp{
+				Seconds: v.CreatedAt.Unix(),
+			},
+		}
+		if !v.ExpiresAt.IsZero() {
+			actions[k].ExpiresAt = &timestamppb.Timestamp{
+				Seconds: v.ExpiresAt.Unix(),
+			}
+		}
+	}
+
+	return &pb.GetModerationActionsResponse{
+		Actions: actions,
+	}, nil
+}
+
+func moderationRuleToPb(rule *models.ModerationRule) *pb.ModerationRule {
+	return &pb.ModerationRule{
+		Id:             rule.Id.String(),
+		ServerId:       rule.ServerId,
+		Type:           rule.Type,
+		Words:          rule.Words,
+		Pattern:        rule.Pattern,
+		Limit:          int32(rule.Limit),
+		Action:         rule.Action,
+		TimeoutSeconds: rule.TimeoutSeconds,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: rule.CreatedAt.Unix(),
+		},
+	}
+}
@@ -25,6 +25,8 @@ type MainServer struct {
 	mongo         services.Mongo
 	kafkaProducer kafka_svc.KafkaMessengerProducer
 	kafkaConsumer kafka_svc.KafkaMessengerConsumer
+	serverEvents  kafka_svc.KafkaServerEventsConsumer
+	channelEvents kafka_svc.KafkaChannelEventsConsumer
 	servers       []Server
 	cfg           *config.Config
 }
@@ -0,0 +1,33 @@
+package kafka
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/chat/configs"
+	"github.com/Nixonxp/discord/chat/internal/app/queue"
+)
+
+type KafkaChannelEventsConsumer struct {
+	s *queue.KafkaMessengerConsumer
+}
+
+func (k *KafkaChannelEventsConsumer) Init(_ context.Context, cfg *config.Config) error {
+	k.s = queue.NewKafkaChannelEventsConsumer(cfg)
+
+	return nil
+}
+
+func (k *KafkaChannelEventsConsumer) GetInstance() *queue.KafkaMessengerConsumer {
+	return k.s
+}
+
+func (k *KafkaChannelEventsConsumer) Ident() string {
+	return "kafka channel events consumer"
+}
+
+func (k *KafkaChannelEventsConsumer) Close(_ context.Context) error {
+	err := k.s.Close()
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -17,7 +17,7 @@ func (k *KafkaMessengerProducer) Init(ctx context.Context, cfg *config.Config) e
 	var err error
 	k.conn, err = kafka.DialLeader(ctx, "tcp", cfg.Application.KafkaAddress, cfg.Application.KafkaMessagesTopic, 0)
 	if err != nil {
-		return fmt.Errorf("failed to dial leader:", err)
+		return fmt.Errorf("failed to dial leader: %w", err)
 	}
 
 	k.mess = queue.NewKafkaMessenger(k.conn)
@@ -0,0 +1,33 @@
+package kafka
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/chat/configs"
+	"github.com/Nixonxp/discord/chat/internal/app/queue"
+)
+
+type KafkaServerEventsConsumer struct {
+	s *queue.KafkaMessengerConsumer
+}
+
+func (k *KafkaServerEventsConsumer) Init(_ context.Context, cfg *config.Config) error {
+	k.s = queue.NewKafkaServerEventsConsumer(cfg)
+
+	return nil
+}
+
+func (k *KafkaServerEventsConsumer) GetInstance() *queue.KafkaMessengerConsumer {
+	return k.s
+}
+
+func (k *KafkaServerEventsConsumer) Ident() string {
+	return "kafka server events consumer"
+}
+
+func (k *KafkaServerEventsConsumer) Close(_ context.Context) error {
+	err := k.s.Close()
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func (u *ChatUsecase) SendChannelMessage(ctx context.Context, req usecases.SendChannelMessageRequest) (*models.ActionInfo, error) {
+	currentChat, err := u.ChatRepo.GetChatByMetadataAndType(ctx, req.ChannelId, enum.ChannelChatType)
+	if err != nil {
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, pkgerrors.Wrap("chat search error", err)
+		}
+
+		currentChat = &models.Chat{
+			Id:       models.ChatID(uuid.New()),
+			Type:     enum.ChannelChatType,
+			OwnerId:  models.OwnerID(uuid.MustParse(req.ChannelId)),
+			MetaData: req.ChannelId,
+		}
+		err := u.ChatRepo.CreateChat(ctx, currentChat)
+		if errors.Is(err, models.ErrAlreadyExists) {
+			// the chat was created by a concurrent first message
+			currentChat, err = u.ChatRepo.GetChatByMetadataAndType(ctx, req.ChannelId, enum.ChannelChatType)
+		}
+		if err != nil {
+			return nil, pkgerrors.Wrap("create new chat for channel", err)
+		}
+	}
+
+	err = u.KafkaConn.SendMessage(ctx, usecases.MessageDto{
+		ChatId:  currentChat.Id.String(),
+		OwnerId: req.UserId,
+		Text:    req.Text,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("send message to channel", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// GetChannelMessages - channel without chat has no messages yet
+func (u *ChatUsecase) GetChannelMessages(ctx context.Context, req usecases.GetChannelMessagesRequest) (*models.Messages, error) {
+	existChat, err := u.ChatRepo.GetChatByMetadataAndType(ctx, req.ChannelId, enum.ChannelChatType)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return &models.Messages{}, nil
+		}
+		return nil, pkgerrors.Wrap("get channel chat error", err)
+	}
+
+	messages, err := u.MessagesRepo.GetMessages(ctx, existChat.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get channel messages error", err)
+	}
+
+	return &models.Messages{
+		Data: messages,
+	}, nil
+}
+
+// PublishChannelMessage - checks the message belongs to the channel and leaves copying to the queue,
+// so publishing does not wait for every follower
+func (u *ChatUsecase) PublishChannelMessage(ctx context.Context, req usecases.PublishChannelMessageRequest) (*models.ActionInfo, error) {
+	existChat, err := u.ChatRepo.GetChatByMetadataAndType(ctx, req.ChannelId, enum.ChannelChatType)
+	if err != nil {
+		return nil, pkgerrors.Wrap("publish channel message", err)
+	}
+
+	messageId, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("publish channel message", models.ErrNotFound)
+	}
+
+	message, err := u.MessagesRepo.GetMessageById(ctx, models.MessageID(messageId))
+	if err != nil {
+		return nil, pkgerrors.Wrap("publish channel message", err)
+	}
+
+	if message.ChatId != existChat.Id || message.IsEphemeral() {
+		return nil, pkgerrors.Wrap("publish channel message", models.ErrNotFound)
+	}
+
+	if len(req.TargetChannelIds) == 0 {
+		return &models.ActionInfo{
+			Success: true,
+		}, nil
+	}
+
+	err = u.KafkaConn.PublishMessage(ctx, usecases.PublishedMessageDto{
+		MessageId:        message.Id.String(),
+		SourceChannelId:  req.ChannelId,
+		TargetChannelIds: req.TargetChannelIds,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("publish channel message", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
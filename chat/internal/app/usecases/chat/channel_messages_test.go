@@ -0,0 +1,235 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_usecase_ChatUsecase_PublishChannelMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx       = context.Background() // dummy
+		channelId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b800"
+		messageId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b700"
+		targetIds = []string{
+			"284fef68-7e3e-4d1d-96a0-8c96f7b3b801",
+			"284fef68-7e3e-4d1d-96a0-8c96f7b3b802",
+		}
+		chat = &models.Chat{
+			Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+			Type:     enum.ChannelChatType,
+			OwnerId:  models.OwnerID(uuid.MustParse(channelId)),
+			MetaData: channelId,
+		}
+		message = &models.Message{
+			Id:      models.MessageID(uuid.MustParse(messageId)),
+			Text:    "text",
+			ChatId:  chat.Id,
+			OwnerId: models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+		}
+	)
+	type fields struct {
+		MessagesRepo *mocks.MessagesStorage
+		ChatRepo     *mocks.ChatStorage
+		KafkaConn    *mocks.KafkaServiceInterface
+		Moderation   *mocks.ModerationCheckerInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.PublishChannelMessageRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.PublishChannelMessageRequest{
+					ChannelId:        channelId,
+					MessageId:        messageId,
+					TargetChannelIds: targetIds,
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, channelId, enum.ChannelChatType).
+					Return(chat, nil)
+				f.MessagesRepo.On("GetMessageById", ctx, message.Id).
+					Return(message, nil)
+				f.KafkaConn.On("PublishMessage", ctx, usecases.PublishedMessageDto{
+					MessageId:        messageId,
+					SourceChannelId:  channelId,
+					TargetChannelIds: targetIds,
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNumberOfCalls(t, "PublishMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. No followers, nothing is sent",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.PublishChannelMessageRequest{
+					ChannelId: channelId,
+					MessageId: messageId,
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, channelId, enum.ChannelChatType).
+					Return(chat, nil)
+				f.MessagesRepo.On("GetMessageById", ctx, message.Id).
+					Return(message, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNotCalled(t, "PublishMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Channel has no chat",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.PublishChannelMessageRequest{
+					ChannelId:        channelId,
+					MessageId:        messageId,
+					TargetChannelIds: targetIds,
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "publish channel message: not found",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, channelId, enum.ChannelChatType).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.MessagesRepo.AssertNotCalled(t, "GetMessageById", mock.Anything, mock.Anything)
+				f.KafkaConn.AssertNotCalled(t, "PublishMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Message of another chat",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.PublishChannelMessageRequest{
+					ChannelId:        channelId,
+					MessageId:        messageId,
+					TargetChannelIds: targetIds,
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "publish channel message: not found",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, channelId, enum.ChannelChatType).
+					Return(chat, nil)
+				f.MessagesRepo.On("GetMessageById", ctx, message.Id).
+					Return(&models.Message{
+						Id:     message.Id,
+						ChatId: models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001")),
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNotCalled(t, "PublishMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. PublishMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.PublishChannelMessageRequest{
+					ChannelId:        channelId,
+					MessageId:        messageId,
+					TargetChannelIds: targetIds,
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "publish channel message: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, channelId, enum.ChannelChatType).
+					Return(chat, nil)
+				f.MessagesRepo.On("GetMessageById", ctx, message.Id).
+					Return(message, nil)
+				f.KafkaConn.On("PublishMessage", ctx, mock.AnythingOfType("usecases.PublishedMessageDto")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNumberOfCalls(t, "PublishMessage", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				MessagesRepo: mocks.NewMessagesStorage(t),
+				ChatRepo:     mocks.NewChatStorage(t),
+				KafkaConn:    mocks.NewKafkaServiceInterface(t),
+				Moderation:   mocks.NewModerationCheckerInterface(t),
+			}
+			au := NewChatUsecase(Deps{
+				MessagesRepo: f.MessagesRepo,
+				ChatRepo:     f.ChatRepo,
+				KafkaConn:    f.KafkaConn,
+				Moderation:   f.Moderation,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PublishChannelMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.PublishChannelMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
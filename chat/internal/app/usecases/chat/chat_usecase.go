@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/export"
 	"github.com/Nixonxp/discord/chat/internal/app/models"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases"
 	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
 	"github.com/google/uuid"
+	"io"
 	"sort"
 	"strings"
 )
@@ -16,6 +18,7 @@ type Deps struct {
 	MessagesRepo usecases.MessagesStorage
 	ChatRepo     usecases.ChatStorage
 	KafkaConn    usecases.KafkaProducerServiceInterface
+	Moderation   usecases.ModerationCheckerInterface
 }
 
 type ChatUsecase struct {
@@ -40,7 +43,7 @@ func (u *ChatUsecase) SendUserPrivateMessage(ctx context.Context, req usecases.S
 		return nil, pkgerrors.Wrap("chat search error", err)
 	}
 
-	err = u.KafkaConn.SendMessage(usecases.MessageDto{
+	err = u.KafkaConn.SendMessage(ctx, usecases.MessageDto{
 		ChatId:  existChat.Id.String(),
 		OwnerId: req.CurrentUser,
 		Text:    req.Text,
@@ -74,10 +77,31 @@ func (u *ChatUsecase) SendServerMessage(ctx context.Context, req usecases.SendSe
 		}
 	}
 
-	err = u.KafkaConn.SendMessage(usecases.MessageDto{
-		ChatId:  currentChat.Id.String(),
-		OwnerId: req.ServerId,
-		Text:    req.Text,
+	verdict, err := u.Moderation.CheckMessage(ctx, usecases.CheckMessageRequest{
+		ServerId: req.ServerId,
+		ChatId:   currentChat.Id.String(),
+		AuthorId: req.UserId,
+		Text:     req.Text,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("moderation check error", err)
+	}
+
+	switch verdict.Action {
+	case enum.ModerationActionBlock:
+		return nil, pkgerrors.Wrap("moderation check", models.ErrBlocked)
+	case enum.ModerationActionTimeout:
+		return nil, pkgerrors.Wrap("moderation check", models.ErrTimedOut)
+	}
+
+	err = u.KafkaConn.SendMessage(ctx, usecases.MessageDto{
+		ChatId:    currentChat.Id.String(),
+		OwnerId:   req.UserId,
+		Text:      req.Text,
+		WebhookId: req.WebhookId,
+		Username:  req.Username,
+		AvatarUrl: req.AvatarUrl,
+		VisibleTo: req.VisibleTo,
 	})
 	if err != nil {
 		return nil, pkgerrors.Wrap("send message to server server", err)
@@ -123,6 +147,14 @@ func (u *ChatUsecase) GetServerMessagesRequest(ctx context.Context, req usecases
 		return nil, pkgerrors.Wrap("get server messages error", err)
 	}
 
+	visible := messages[:0]
+	for _, message := range messages {
+		if message.VisibleFor(req.UserId) {
+			visible = append(visible, message)
+		}
+	}
+	messages = visible
+
 	if len(messages) == 0 {
 		return nil, pkgerrors.Wrap("get server messages error", models.ErrEmpty)
 	}
@@ -160,3 +192,60 @@ func (u *ChatUsecase) CreatePrivateChat(ctx context.Context, req usecases.Create
 
 	return existChat, nil
 }
+
+func (u *ChatUsecase) ExportChat(ctx context.Context, req usecases.ExportChatRequest, w io.Writer) error {
+	writer, err := export.NewWriter(req.Format, w)
+	if err != nil {
+		return pkgerrors.Wrap("export chat error", err)
+	}
+
+	chat, err := u.findExportChat(ctx, req)
+	if err != nil {
+		return pkgerrors.Wrap("export chat search error", err)
+	}
+
+	err = writer.WriteHeader(chat)
+	if err != nil {
+		return pkgerrors.Wrap("export chat write error", err)
+	}
+
+	// ephemeral messages belong to a single user and are not part of the history
+	err = u.MessagesRepo.IterateMessages(ctx, chat.Id, req.From, req.To, func(message *models.Message) error {
+		if message.IsEphemeral() {
+			return nil
+		}
+
+		return writer.WriteMessage(message)
+	})
+	if err != nil {
+		return pkgerrors.Wrap("export chat messages error", err)
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return pkgerrors.Wrap("export chat write error", err)
+	}
+
+	return nil
+}
+
+// findExportChat - chat is selected by id, by server or as a private chat of the current user
+func (u *ChatUsecase) findExportChat(ctx context.Context, req usecases.ExportChatRequest) (*models.Chat, error) {
+	switch {
+	case req.ChatId != "":
+		chatId, err := uuid.Parse(req.ChatId)
+		if err != nil {
+			return nil, models.ErrNotFound
+		}
+
+		return u.ChatRepo.GetChatById(ctx, models.ChatID(chatId))
+	case req.ServerId != "":
+		return u.ChatRepo.GetChatByMetadataAndType(ctx, req.ServerId, enum.ServerChatType)
+	}
+
+	dataSlice := []string{req.CurrentUser, req.UserId}
+	sort.StringsAreSorted(dataSlice)
+
+	meta := strings.Join(dataSlice, "_")
+	return u.ChatRepo.GetChatByMetadataAndType(ctx, meta, enum.PrivateChatType)
+}
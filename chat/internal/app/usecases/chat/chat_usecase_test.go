@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"github.com/Nixonxp/discord/chat/internal/app/enum"
@@ -69,6 +70,7 @@ func Test_usecase_ChatUsecase_SendUserPrivateMessage(t *testing.T) {
 					}, nil)
 
 				f.KafkaConn.On("SendMessage",
+					ctx,
 					usecases.MessageDto{
 						ChatId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 						OwnerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
@@ -112,6 +114,7 @@ func Test_usecase_ChatUsecase_SendUserPrivateMessage(t *testing.T) {
 					}, nil)
 
 				f.KafkaConn.On("SendMessage",
+					ctx,
 					usecases.MessageDto{
 						ChatId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 						OwnerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
@@ -209,6 +212,7 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 		MessagesRepo *mocks.MessagesStorage
 		ChatRepo     *mocks.ChatStorage
 		KafkaConn    *mocks.KafkaServiceInterface
+		Moderation   *mocks.ModerationCheckerInterface
 	}
 
 	type args struct {
@@ -231,6 +235,7 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				ctx: ctx, // dumm
 				req: usecases.SendServerMessageRequest{
 					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 					Text:     "text",
 				},
 			},
@@ -252,11 +257,14 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 						OwnerId:  models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
 						MetaData: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 					}, nil)
+				f.Moderation.On("CheckMessage", ctx, mock.AnythingOfType("usecases.CheckMessageRequest")).
+					Return(&models.ModerationVerdict{Action: enum.ModerationActionAllow}, nil)
 
 				f.KafkaConn.On("SendMessage",
+					ctx,
 					usecases.MessageDto{
 						ChatId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
-						OwnerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+						OwnerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 						Text:    "text",
 					},
 				).
@@ -273,6 +281,7 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				ctx: ctx, // dumm
 				req: usecases.SendServerMessageRequest{
 					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 					Text:     "text",
 				},
 			},
@@ -300,12 +309,15 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 					}),
 				).
 					Return(nil)
+				f.Moderation.On("CheckMessage", ctx, mock.AnythingOfType("usecases.CheckMessageRequest")).
+					Return(&models.ModerationVerdict{Action: enum.ModerationActionAllow}, nil)
 
 				f.KafkaConn.On("SendMessage",
+					ctx,
 					mock.MatchedBy(func(dto usecases.MessageDto) bool {
 						return dto.ChatId != "" &&
 							dto.Text == "text" &&
-							dto.OwnerId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b800"
+							dto.OwnerId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
 					}),
 				).
 					Return(nil, nil)
@@ -322,6 +334,7 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				ctx: ctx, // dumm
 				req: usecases.SendServerMessageRequest{
 					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 					Text:     "text",
 				},
 			},
@@ -349,12 +362,15 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 					}),
 				).
 					Return(nil)
+				f.Moderation.On("CheckMessage", ctx, mock.AnythingOfType("usecases.CheckMessageRequest")).
+					Return(&models.ModerationVerdict{Action: enum.ModerationActionAllow}, nil)
 
 				f.KafkaConn.On("SendMessage",
+					ctx,
 					mock.MatchedBy(func(dto usecases.MessageDto) bool {
 						return dto.ChatId != "" &&
 							dto.Text == "text" &&
-							dto.OwnerId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b800"
+							dto.OwnerId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
 					}),
 				).
 					Return(errors.New("some  error"))
@@ -371,6 +387,7 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				ctx: ctx, // dumm
 				req: usecases.SendServerMessageRequest{
 					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 					Text:     "text",
 				},
 			},
@@ -410,6 +427,7 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				ctx: ctx, // dumm
 				req: usecases.SendServerMessageRequest{
 					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 					Text:     "text",
 				},
 			},
@@ -431,6 +449,126 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				f.ChatRepo.AssertNumberOfCalls(t, "GetChatByMetadataAndType", 1)
 			},
 		},
+		{
+			name: "Test 6. Negative. Message blocked by moderation",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SendServerMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:     "text",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "moderation check: message blocked by moderation",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					enum.ServerChatType,
+				).
+					Return(&models.Chat{
+						Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Type:     enum.ServerChatType,
+						OwnerId:  models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						MetaData: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					}, nil)
+
+				f.Moderation.On("CheckMessage", ctx, usecases.CheckMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					ChatId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					AuthorId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:     "text",
+				}).
+					Return(&models.ModerationVerdict{Action: enum.ModerationActionBlock}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.Moderation.AssertNumberOfCalls(t, "CheckMessage", 1)
+				f.KafkaConn.AssertNotCalled(t, "SendMessage", mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. Author timed out by moderation",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SendServerMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:     "text",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "moderation check: author is timed out",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					enum.ServerChatType,
+				).
+					Return(&models.Chat{
+						Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Type:     enum.ServerChatType,
+						OwnerId:  models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						MetaData: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					}, nil)
+
+				f.Moderation.On("CheckMessage", ctx, usecases.CheckMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					ChatId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					AuthorId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:     "text",
+				}).
+					Return(&models.ModerationVerdict{Action: enum.ModerationActionTimeout}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.Moderation.AssertNumberOfCalls(t, "CheckMessage", 1)
+				f.KafkaConn.AssertNotCalled(t, "SendMessage", mock.Anything)
+			},
+		},
+		{
+			name: "Test 8. Negative. CheckMessage return error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SendServerMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:     "text",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "moderation check error: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					enum.ServerChatType,
+				).
+					Return(&models.Chat{
+						Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Type:     enum.ServerChatType,
+						OwnerId:  models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						MetaData: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					}, nil)
+
+				f.Moderation.On("CheckMessage", ctx, usecases.CheckMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					ChatId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					AuthorId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:     "text",
+				}).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.Moderation.AssertNumberOfCalls(t, "CheckMessage", 1)
+				f.KafkaConn.AssertNotCalled(t, "SendMessage", mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -440,11 +578,13 @@ func Test_usecase_ChatUsecase_SendServerMessage(t *testing.T) {
 				MessagesRepo: mocks.NewMessagesStorage(t),
 				ChatRepo:     mocks.NewChatStorage(t),
 				KafkaConn:    mocks.NewKafkaServiceInterface(t),
+				Moderation:   mocks.NewModerationCheckerInterface(t),
 			}
 			au := NewChatUsecase(Deps{
 				MessagesRepo: f.MessagesRepo,
 				ChatRepo:     f.ChatRepo,
 				KafkaConn:    f.KafkaConn,
+				Moderation:   f.Moderation,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -899,6 +1039,85 @@ func Test_usecase_ChatUsecase_GetServerMessagesRequest(t *testing.T) {
 				f.ChatRepo.AssertNumberOfCalls(t, "GetChatByMetadataAndType", 1)
 			},
 		},
+		{
+			name: "Test 5. Positive. Ephemeral messages of other users are skipped",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetServerMessageRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+				},
+			},
+			want: &models.Messages{
+				Data: []*models.Message{
+					{
+						Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111")),
+						Text:      "text",
+						ChatId:    models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						Timestamp: time.Time{},
+					},
+					{
+						Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b112")),
+						Text:      "only for you",
+						ChatId:    models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+						Timestamp: time.Time{},
+						VisibleTo: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					},
+				},
+			},
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					enum.ServerChatType,
+				).
+					Return(&models.Chat{
+						Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+						Type:     enum.ServerChatType,
+						OwnerId:  models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+						MetaData: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					}, nil)
+
+				f.MessagesRepo.On("GetMessages",
+					ctx,
+					models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+				).
+					Return([]*models.Message{
+						{
+							Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111")),
+							Text:      "text",
+							ChatId:    models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+							OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+							Timestamp: time.Time{},
+						},
+						{
+							Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b112")),
+							Text:      "only for you",
+							ChatId:    models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+							OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+							Timestamp: time.Time{},
+							VisibleTo: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+						},
+						{
+							Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b113")),
+							Text:      "only for another user",
+							ChatId:    models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+							OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+							Timestamp: time.Time{},
+							VisibleTo: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatRepo.AssertNumberOfCalls(t, "GetChatByMetadataAndType", 1)
+				f.MessagesRepo.AssertNumberOfCalls(t, "GetMessages", 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1160,3 +1379,231 @@ func Test_usecase_ChatUsecase_CreatePrivateChat(t *testing.T) {
 		})
 	}
 }
+
+func Test_usecase_ChatUsecase_ExportChat(t *testing.T) {
+	// prepare
+	var (
+		ctx      = context.Background() // dummy
+		chatId   = models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+		from     = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to       = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		messages = []*models.Message{
+			{
+				Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001")),
+				Text:      "hello, world",
+				ChatId:    chatId,
+				OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				Timestamp: time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC),
+			},
+			{
+				Id:        models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b002")),
+				Text:      "<b>bold</b>",
+				ChatId:    chatId,
+				OwnerId:   models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+				Timestamp: time.Date(2024, 1, 11, 12, 0, 0, 0, time.UTC),
+			},
+		}
+		serverChat = &models.Chat{
+			Id:       chatId,
+			Type:     enum.ServerChatType,
+			OwnerId:  models.OwnerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900")),
+			MetaData: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+		}
+	)
+	type fields struct {
+		MessagesRepo *mocks.MessagesStorage
+		ChatRepo     *mocks.ChatStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ExportChatRequest
+	}
+
+	iterate := func(f *fields, from time.Time, to time.Time) {
+		f.MessagesRepo.On("IterateMessages", ctx, chatId, from, to, mock.Anything).
+			Run(func(args mock.Arguments) {
+				fn := args.Get(4).(func(message *models.Message) error)
+				for _, m := range messages {
+					_ = fn(m)
+				}
+			}).
+			Return(nil)
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		want        []string
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. CSV server chat",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportChatRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					Format:   enum.ExportFormatCSV,
+				},
+			},
+			want: []string{
+				"id,chat_id,author_id,timestamp,text\n",
+				"284fef68-7e3e-4d1d-96a0-8c96f7b3b001,284fef68-7e3e-4d1d-96a0-8c96f7b3b000,284fef68-7e3e-4d1d-96a0-8c96f7b3b795,2024-01-10T12:00:00Z,\"hello, world\"\n",
+				"284fef68-7e3e-4d1d-96a0-8c96f7b3b002,284fef68-7e3e-4d1d-96a0-8c96f7b3b000,284fef68-7e3e-4d1d-96a0-8c96f7b3b800,2024-01-11T12:00:00Z,<b>bold</b>\n",
+			},
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b900", enum.ServerChatType).
+					Return(serverChat, nil)
+				iterate(f, time.Time{}, time.Time{})
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.MessagesRepo.AssertNumberOfCalls(t, "IterateMessages", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. JSONL private chat with date range",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportChatRequest{
+					UserId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUser: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Format:      enum.ExportFormatJSONL,
+					From:        from,
+					To:          to,
+				},
+			},
+			want: []string{
+				`{"id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b001","chat_id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b000","author_id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b795","text":"hello, world","timestamp":"2024-01-10T12:00:00Z"}` + "\n",
+				`{"id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b002","chat_id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b000","author_id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b800","text":"<b>bold</b>","timestamp":"2024-01-11T12:00:00Z"}` + "\n",
+			},
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b800_284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					enum.PrivateChatType,
+				).
+					Return(&models.Chat{Id: chatId, Type: enum.PrivateChatType}, nil)
+				iterate(f, from, to)
+			},
+		},
+		{
+			name: "Test 3. Positive. HTML transcript escapes text",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportChatRequest{
+					ChatId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Format: enum.ExportFormatHTML,
+				},
+			},
+			want: []string{
+				"<!DOCTYPE html>",
+				"<h1>Chat 284fef68-7e3e-4d1d-96a0-8c96f7b3b000 (server)</h1>",
+				`<div class="text">&lt;b&gt;bold&lt;/b&gt;</div>`,
+				"</html>\n",
+			},
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatById", ctx, chatId).
+					Return(serverChat, nil)
+				iterate(f, time.Time{}, time.Time{})
+			},
+		},
+		{
+			name: "Test 4. Negative. Unknown format",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportChatRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					Format:   "pdf",
+				},
+			},
+			wantErr:     true,
+			errorString: "export chat error: unsupported export format",
+			assert: func(t *testing.T, f *fields) {
+				f.ChatRepo.AssertNotCalled(t, "GetChatByMetadataAndType", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Chat not found",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportChatRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					Format:   enum.ExportFormatCSV,
+				},
+			},
+			wantErr:     true,
+			errorString: "export chat search error: not found",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b900", enum.ServerChatType).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 6. Negative. IterateMessages return error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportChatRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					Format:   enum.ExportFormatJSONL,
+				},
+			},
+			wantErr:     true,
+			errorString: "export chat messages error: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b900", enum.ServerChatType).
+					Return(serverChat, nil)
+				f.MessagesRepo.On("IterateMessages", ctx, chatId, time.Time{}, time.Time{}, mock.Anything).
+					Return(errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				MessagesRepo: mocks.NewMessagesStorage(t),
+				ChatRepo:     mocks.NewChatStorage(t),
+			}
+			au := NewChatUsecase(Deps{
+				MessagesRepo: f.MessagesRepo,
+				ChatRepo:     f.ChatRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			out := &bytes.Buffer{}
+			err := au.ExportChat(tt.args.ctx, tt.args.req, out)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ExportChat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			for _, part := range tt.want {
+				assert.Contains(t, out.String(), part)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// SendPostMessage - the post chat is created with the first message and owned by the forum channel,
+// so it is removed together with the channel chat
+func (u *ChatUsecase) SendPostMessage(ctx context.Context, req usecases.SendPostMessageRequest) (*models.ActionInfo, error) {
+	currentChat, err := u.ChatRepo.GetChatByMetadataAndType(ctx, req.PostId, enum.ForumPostChatType)
+	if err != nil {
+		if !errors.Is(err, models.ErrNotFound) {
+			return nil, pkgerrors.Wrap("post chat search error", err)
+		}
+
+		currentChat = &models.Chat{
+			Id:       models.ChatID(uuid.New()),
+			Type:     enum.ForumPostChatType,
+			OwnerId:  models.OwnerID(uuid.MustParse(req.ChannelId)),
+			MetaData: req.PostId,
+		}
+		err := u.ChatRepo.CreateChat(ctx, currentChat)
+		if errors.Is(err, models.ErrAlreadyExists) {
+			// the chat was created by a concurrent first message
+			currentChat, err = u.ChatRepo.GetChatByMetadataAndType(ctx, req.PostId, enum.ForumPostChatType)
+		}
+		if err != nil {
+			return nil, pkgerrors.Wrap("create new chat for post", err)
+		}
+	}
+
+	err = u.KafkaConn.SendMessage(ctx, usecases.MessageDto{
+		ChatId:  currentChat.Id.String(),
+		OwnerId: req.UserId,
+		Text:    req.Text,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("send message to post", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// GetPostMessages - post without chat has no messages yet
+func (u *ChatUsecase) GetPostMessages(ctx context.Context, req usecases.GetPostMessagesRequest) (*models.Messages, error) {
+	existChat, err := u.ChatRepo.GetChatByMetadataAndType(ctx, req.PostId, enum.ForumPostChatType)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return &models.Messages{}, nil
+		}
+		return nil, pkgerrors.Wrap("get post chat error", err)
+	}
+
+	messages, err := u.MessagesRepo.GetMessages(ctx, existChat.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get post messages error", err)
+	}
+
+	return &models.Messages{
+		Data: messages,
+	}, nil
+}
@@ -0,0 +1,360 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_usecase_ChatUsecase_SendPostMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx       = context.Background() // dummy
+		postId    = "284fef68-7e3e-4d1d-96a0-8c96f7b3b900"
+		channelId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b800"
+		userId    = "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
+		chat      = &models.Chat{
+			Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+			Type:     enum.ForumPostChatType,
+			OwnerId:  models.OwnerID(uuid.MustParse(channelId)),
+			MetaData: postId,
+		}
+		request = usecases.SendPostMessageRequest{
+			PostId:    postId,
+			ChannelId: channelId,
+			Text:      "text",
+			UserId:    userId,
+		}
+	)
+	type fields struct {
+		MessagesRepo *mocks.MessagesStorage
+		ChatRepo     *mocks.ChatStorage
+		KafkaConn    *mocks.KafkaServiceInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.SendPostMessageRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: request,
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(chat, nil)
+				f.KafkaConn.On("SendMessage", ctx, usecases.MessageDto{
+					ChatId:  chat.Id.String(),
+					OwnerId: userId,
+					Text:    "text",
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatRepo.AssertNotCalled(t, "CreateChat", mock.Anything, mock.Anything)
+				f.KafkaConn.AssertNumberOfCalls(t, "SendMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. First message creates the post chat owned by the channel",
+			args: args{
+				ctx: ctx, // dummy
+				req: request,
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(nil, models.ErrNotFound)
+				f.ChatRepo.On("CreateChat", ctx, mock.MatchedBy(func(c *models.Chat) bool {
+					return c.Type == enum.ForumPostChatType &&
+						c.OwnerId == models.OwnerID(uuid.MustParse(channelId)) &&
+						c.MetaData == postId
+				})).
+					Return(nil)
+				f.KafkaConn.On("SendMessage", ctx, mock.AnythingOfType("usecases.MessageDto")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatRepo.AssertNumberOfCalls(t, "CreateChat", 1)
+				f.KafkaConn.AssertNumberOfCalls(t, "SendMessage", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. GetChatByMetadataAndType returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: request,
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "post chat search error: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. SendMessage returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: request,
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "send message to post: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(chat, nil)
+				f.KafkaConn.On("SendMessage", ctx, mock.AnythingOfType("usecases.MessageDto")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNumberOfCalls(t, "SendMessage", 1)
+			},
+		},
+		{
+			name: "Test 5. Positive. Post chat created by a concurrent message is used",
+			args: args{
+				ctx: ctx, // dummy
+				req: request,
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(nil, models.ErrNotFound).Once()
+				f.ChatRepo.On("CreateChat", ctx, mock.AnythingOfType("*models.Chat")).
+					Return(models.ErrAlreadyExists)
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(chat, nil).Once()
+				f.KafkaConn.On("SendMessage", ctx, usecases.MessageDto{
+					ChatId:  chat.Id.String(),
+					OwnerId: userId,
+					Text:    "text",
+				}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatRepo.AssertNumberOfCalls(t, "GetChatByMetadataAndType", 2)
+				f.KafkaConn.AssertNumberOfCalls(t, "SendMessage", 1)
+			},
+		},
+		{
+			name: "Test 6. Negative. CreateChat returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: request,
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create new chat for post: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(nil, models.ErrNotFound)
+				f.ChatRepo.On("CreateChat", ctx, mock.AnythingOfType("*models.Chat")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.KafkaConn.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				MessagesRepo: mocks.NewMessagesStorage(t),
+				ChatRepo:     mocks.NewChatStorage(t),
+				KafkaConn:    mocks.NewKafkaServiceInterface(t),
+			}
+			au := NewChatUsecase(Deps{
+				MessagesRepo: f.MessagesRepo,
+				ChatRepo:     f.ChatRepo,
+				KafkaConn:    f.KafkaConn,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.SendPostMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.SendPostMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ChatUsecase_GetPostMessages(t *testing.T) {
+	// prepare
+	var (
+		ctx    = context.Background() // dummy
+		postId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b900"
+		chat   = &models.Chat{
+			Id:       models.ChatID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+			Type:     enum.ForumPostChatType,
+			MetaData: postId,
+		}
+		messages = []*models.Message{
+			{
+				Id:     models.MessageID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b700")),
+				Text:   "text",
+				ChatId: chat.Id,
+			},
+		}
+	)
+	type fields struct {
+		MessagesRepo *mocks.MessagesStorage
+		ChatRepo     *mocks.ChatStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetPostMessagesRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.Messages
+		wantErr     bool
+		errorString string
+
+		on func(*fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetPostMessagesRequest{PostId: postId},
+			},
+			want:    &models.Messages{Data: messages},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(chat, nil)
+				f.MessagesRepo.On("GetMessages", ctx, chat.Id).
+					Return(messages, nil)
+			},
+		},
+		{
+			name: "Test 2. Positive. Post without chat has no messages",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetPostMessagesRequest{PostId: postId},
+			},
+			want:    &models.Messages{},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 3. Negative. GetMessages returns error",
+			args: args{
+				ctx: ctx, // dummy
+				req: usecases.GetPostMessagesRequest{PostId: postId},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "get post messages error: some error",
+
+			on: func(f *fields) {
+				f.ChatRepo.On("GetChatByMetadataAndType", ctx, postId, enum.ForumPostChatType).
+					Return(chat, nil)
+				f.MessagesRepo.On("GetMessages", ctx, chat.Id).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				MessagesRepo: mocks.NewMessagesStorage(t),
+				ChatRepo:     mocks.NewChatStorage(t),
+			}
+			au := NewChatUsecase(Deps{
+				MessagesRepo: f.MessagesRepo,
+				ChatRepo:     f.ChatRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetPostMessages(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GetPostMessages() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
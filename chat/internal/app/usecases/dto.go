@@ -1,5 +1,7 @@
 package usecases
 
+import "time"
+
 type SendUserPrivateMessageRequest struct {
 	UserId      string
 	Text        string
@@ -16,6 +18,12 @@ type MessageDto struct {
 	Text    string `json:"text"`
 	ChatId  string `json:"chat_id"`
 	OwnerId string `json:"owner_id"`
+	// WebhookId, Username and AvatarUrl are set only for messages posted by a webhook
+	WebhookId string `json:"webhook_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	AvatarUrl string `json:"avatar_url,omitempty"`
+	// VisibleTo is set only for ephemeral messages
+	VisibleTo string `json:"visible_to,omitempty"`
 }
 
 type CreatePrivateChatRequest struct {
@@ -24,9 +32,89 @@ type CreatePrivateChatRequest struct {
 }
 
 type SendServerMessageRequest struct {
-	ServerId string
-	Text     string
+	ServerId  string
+	Text      string
+	UserId    string
+	WebhookId string
+	Username  string
+	AvatarUrl string
+	VisibleTo string
 }
 type GetServerMessageRequest struct {
 	ServerId string
+	UserId   string
+}
+
+type SendChannelMessageRequest struct {
+	ChannelId string
+	Text      string
+	UserId    string
+}
+
+type GetChannelMessagesRequest struct {
+	ChannelId string
+}
+
+type PublishChannelMessageRequest struct {
+	ChannelId        string
+	MessageId        string
+	TargetChannelIds []string
+}
+
+type SendPostMessageRequest struct {
+	PostId    string
+	ChannelId string
+	Text      string
+	UserId    string
+}
+
+type GetPostMessagesRequest struct {
+	PostId string
+}
+
+// PublishedMessageDto - announcement message to be cross-posted to the following channels
+type PublishedMessageDto struct {
+	MessageId        string
+	SourceChannelId  string
+	TargetChannelIds []string
+}
+
+type CreateModerationRuleRequest struct {
+	ServerId       string
+	Type           string
+	Words          []string
+	Pattern        string
+	Limit          int
+	Action         string
+	TimeoutSeconds int64
+}
+
+type DeleteModerationRuleRequest struct {
+	ServerId string
+	RuleId   string
+}
+
+type GetModerationRulesRequest struct {
+	ServerId string
+}
+
+type GetModerationActionsRequest struct {
+	ServerId string
+}
+
+type CheckMessageRequest struct {
+	ServerId string
+	ChatId   string
+	AuthorId string
+	Text     string
+}
+
+type ExportChatRequest struct {
+	ChatId      string
+	ServerId    string
+	UserId      string
+	CurrentUser string
+	Format      string
+	From        time.Time
+	To          time.Time
 }
@@ -32,6 +32,24 @@ func (_m *ChatStorage) CreateChat(ctx context.Context, chat *models.Chat) error
 	return r0
 }
 
+// DeleteChat provides a mock function with given fields: ctx, chatId
+func (_m *ChatStorage) DeleteChat(ctx context.Context, chatId models.ChatID) error {
+	ret := _m.Called(ctx, chatId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChatID) error); ok {
+		r0 = rf(ctx, chatId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetChatById provides a mock function with given fields: ctx, chatId
 func (_m *ChatStorage) GetChatById(ctx context.Context, chatId models.ChatID) (*models.Chat, error) {
 	ret := _m.Called(ctx, chatId)
@@ -92,6 +110,36 @@ func (_m *ChatStorage) GetChatByMetadataAndType(ctx context.Context, metadata st
 	return r0, r1
 }
 
+// GetChatsByOwnerAndType provides a mock function with given fields: ctx, ownerId, chatType
+func (_m *ChatStorage) GetChatsByOwnerAndType(ctx context.Context, ownerId models.OwnerID, chatType string) ([]*models.Chat, error) {
+	ret := _m.Called(ctx, ownerId, chatType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChatsByOwnerAndType")
+	}
+
+	var r0 []*models.Chat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.OwnerID, string) ([]*models.Chat, error)); ok {
+		return rf(ctx, ownerId, chatType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.OwnerID, string) []*models.Chat); ok {
+		r0 = rf(ctx, ownerId, chatType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Chat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.OwnerID, string) error); ok {
+		r1 = rf(ctx, ownerId, chatType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewChatStorage creates a new instance of ChatStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChatStorage(t interface {
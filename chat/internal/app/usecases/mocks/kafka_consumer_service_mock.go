@@ -32,12 +32,37 @@ func (_m *KafkaConsumerServiceInterface) Close() error {
 	return r0
 }
 
-// ReadMessage provides a mock function with given fields: ctx
-func (_m *KafkaConsumerServiceInterface) ReadMessage(ctx context.Context) (kafka.Message, error) {
+// CommitMessages provides a mock function with given fields: ctx, msgs
+func (_m *KafkaConsumerServiceInterface) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	_va := make([]interface{}, len(msgs))
+	for _i := range msgs {
+		_va[_i] = msgs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...kafka.Message) error); ok {
+		r0 = rf(ctx, msgs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchMessage provides a mock function with given fields: ctx
+func (_m *KafkaConsumerServiceInterface) FetchMessage(ctx context.Context) (kafka.Message, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReadMessage")
+		panic("no return value specified for FetchMessage")
 	}
 
 	var r0 kafka.Message
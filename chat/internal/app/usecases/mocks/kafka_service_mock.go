@@ -3,26 +3,46 @@
 package mocks
 
 import (
+	context "context"
+
 	usecases "github.com/Nixonxp/discord/chat/internal/app/usecases"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// KafkaServiceInterface is an autogenerated mock type for the KafkaServiceInterface type
+// KafkaServiceInterface is an autogenerated mock type for the KafkaProducerServiceInterface type
 type KafkaServiceInterface struct {
 	mock.Mock
 }
 
-// SendMessage provides a mock function with given fields: msgData
-func (_m *KafkaServiceInterface) SendMessage(msgData usecases.MessageDto) error {
-	ret := _m.Called(msgData)
+// PublishMessage provides a mock function with given fields: ctx, msgData
+func (_m *KafkaServiceInterface) PublishMessage(ctx context.Context, msgData usecases.PublishedMessageDto) error {
+	ret := _m.Called(ctx, msgData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.PublishedMessageDto) error); ok {
+		r0 = rf(ctx, msgData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendMessage provides a mock function with given fields: ctx, msgData
+func (_m *KafkaServiceInterface) SendMessage(ctx context.Context, msgData usecases.MessageDto) error {
+	ret := _m.Called(ctx, msgData)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendMessage")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(usecases.MessageDto) error); ok {
-		r0 = rf(msgData)
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.MessageDto) error); ok {
+		r0 = rf(ctx, msgData)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	models "github.com/Nixonxp/discord/chat/internal/app/models"
 	mock "github.com/stretchr/testify/mock"
@@ -32,6 +33,94 @@ func (_m *MessagesStorage) CreateMessage(ctx context.Context, message *models.Me
 	return r0
 }
 
+// DeleteByChatId provides a mock function with given fields: ctx, chatId
+func (_m *MessagesStorage) DeleteByChatId(ctx context.Context, chatId models.ChatID) (int64, error) {
+	ret := _m.Called(ctx, chatId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByChatId")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChatID) (int64, error)); ok {
+		return rf(ctx, chatId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChatID) int64); ok {
+		r0 = rf(ctx, chatId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ChatID) error); ok {
+		r1 = rf(ctx, chatId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLastMessagesByOwner provides a mock function with given fields: ctx, chatId, ownerId, limit
+func (_m *MessagesStorage) GetLastMessagesByOwner(ctx context.Context, chatId models.ChatID, ownerId models.OwnerID, limit int64) ([]*models.Message, error) {
+	ret := _m.Called(ctx, chatId, ownerId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastMessagesByOwner")
+	}
+
+	var r0 []*models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChatID, models.OwnerID, int64) ([]*models.Message, error)); ok {
+		return rf(ctx, chatId, ownerId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChatID, models.OwnerID, int64) []*models.Message); ok {
+		r0 = rf(ctx, chatId, ownerId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ChatID, models.OwnerID, int64) error); ok {
+		r1 = rf(ctx, chatId, ownerId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessageById provides a mock function with given fields: ctx, id
+func (_m *MessagesStorage) GetMessageById(ctx context.Context, id models.MessageID) (*models.Message, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessageById")
+	}
+
+	var r0 *models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.MessageID) (*models.Message, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.MessageID) *models.Message); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.MessageID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMessages provides a mock function with given fields: ctx, chatId
 func (_m *MessagesStorage) GetMessages(ctx context.Context, chatId models.ChatID) ([]*models.Message, error) {
 	ret := _m.Called(ctx, chatId)
@@ -62,6 +151,24 @@ func (_m *MessagesStorage) GetMessages(ctx context.Context, chatId models.ChatID
 	return r0, r1
 }
 
+// IterateMessages provides a mock function with given fields: ctx, chatId, from, to, fn
+func (_m *MessagesStorage) IterateMessages(ctx context.Context, chatId models.ChatID, from time.Time, to time.Time, fn func(message *models.Message) error) error {
+	ret := _m.Called(ctx, chatId, from, to, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IterateMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ChatID, time.Time, time.Time, func(message *models.Message) error) error); ok {
+		r0 = rf(ctx, chatId, from, to, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMessagesStorage creates a new instance of MessagesStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMessagesStorage(t interface {
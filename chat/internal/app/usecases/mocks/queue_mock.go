@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	models "github.com/Nixonxp/discord/chat/internal/app/models"
-	mock "github.com/stretchr/testify/mock"
-
 	usecases "github.com/Nixonxp/discord/chat/internal/app/usecases"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // QueueInterface is an autogenerated mock type for the QueueInterface type
@@ -46,6 +45,96 @@ func (_m *QueueInterface) CreateMessage(ctx context.Context, message usecases.Me
 	return r0, r1
 }
 
+// CrossPostMessage provides a mock function with given fields: ctx, message
+func (_m *QueueInterface) CrossPostMessage(ctx context.Context, message usecases.PublishedMessageDto) (*models.ActionInfo, error) {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CrossPostMessage")
+	}
+
+	var r0 *models.ActionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.PublishedMessageDto) (*models.ActionInfo, error)); ok {
+		return rf(ctx, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.PublishedMessageDto) *models.ActionInfo); ok {
+		r0 = rf(ctx, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.PublishedMessageDto) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteChannelChat provides a mock function with given fields: ctx, channelId
+func (_m *QueueInterface) DeleteChannelChat(ctx context.Context, channelId string) (*models.ActionInfo, error) {
+	ret := _m.Called(ctx, channelId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChannelChat")
+	}
+
+	var r0 *models.ActionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.ActionInfo, error)); ok {
+		return rf(ctx, channelId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.ActionInfo); ok {
+		r0 = rf(ctx, channelId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, channelId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteServerChat provides a mock function with given fields: ctx, serverId
+func (_m *QueueInterface) DeleteServerChat(ctx context.Context, serverId string) (*models.ActionInfo, error) {
+	ret := _m.Called(ctx, serverId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServerChat")
+	}
+
+	var r0 *models.ActionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.ActionInfo, error)); ok {
+		return rf(ctx, serverId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.ActionInfo); ok {
+		r0 = rf(ctx, serverId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQueueInterface creates a new instance of QueueInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueueInterface(t interface {
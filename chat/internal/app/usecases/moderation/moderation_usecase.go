@@ -0,0 +1,233 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+type Deps struct {
+	RulesRepo    usecases.ModerationRulesStorage
+	ActionsRepo  usecases.ModerationActionsStorage
+	MessagesRepo usecases.MessagesStorage
+}
+
+type ModerationUsecase struct {
+	Deps
+}
+
+var _ usecases.ModerationUsecaseInterface = (*ModerationUsecase)(nil)
+
+func NewModerationUsecase(d Deps) usecases.ModerationUsecaseInterface {
+	return &ModerationUsecase{
+		Deps: d,
+	}
+}
+
+func (u *ModerationUsecase) CreateRule(ctx context.Context, req usecases.CreateModerationRuleRequest) (*models.ModerationRule, error) {
+	if err := validateRule(req); err != nil {
+		return nil, pkgerrors.Wrap("validate rule error", err)
+	}
+
+	rule := &models.ModerationRule{
+		Id:             models.ModerationRuleID(uuid.New()),
+		ServerId:       req.ServerId,
+		Type:           req.Type,
+		Words:          req.Words,
+		Pattern:        req.Pattern,
+		Limit:          req.Limit,
+		Action:         req.Action,
+		TimeoutSeconds: req.TimeoutSeconds,
+		CreatedAt:      time.Now(),
+	}
+
+	err := u.RulesRepo.CreateRule(ctx, rule)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create rule error", err)
+	}
+
+	return rule, nil
+}
+
+func (u *ModerationUsecase) DeleteRule(ctx context.Context, req usecases.DeleteModerationRuleRequest) (*models.ActionInfo, error) {
+	ruleId, err := uuid.Parse(req.RuleId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("parse rule id error", models.ErrNotFound)
+	}
+
+	err = u.RulesRepo.DeleteRule(ctx, req.ServerId, models.ModerationRuleID(ruleId))
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete rule error", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+func (u *ModerationUsecase) GetRules(ctx context.Context, req usecases.GetModerationRulesRequest) ([]*models.ModerationRule, error) {
+	rules, err := u.RulesRepo.GetRulesByServerId(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get rules error", err)
+	}
+
+	return rules, nil
+}
+
+func (u *ModerationUsecase) GetActions(ctx context.Context, req usecases.GetModerationActionsRequest) ([]*models.ModerationAction, error) {
+	actions, err := u.ActionsRepo.GetActionsByServerId(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get actions error", err)
+	}
+
+	return actions, nil
+}
+
+// CheckMessage - evaluates every server rule against the message and records each triggered one.
+// The most severe action among triggered rules becomes the verdict.
+func (u *ModerationUsecase) CheckMessage(ctx context.Context, req usecases.CheckMessageRequest) (*models.ModerationVerdict, error) {
+	now := time.Now()
+
+	_, err := u.ActionsRepo.GetActiveTimeout(ctx, req.ServerId, req.AuthorId, now)
+	if err == nil {
+		return nil, pkgerrors.Wrap("author timeout check", models.ErrTimedOut)
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, pkgerrors.Wrap("author timeout check error", err)
+	}
+
+	rules, err := u.RulesRepo.GetRulesByServerId(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get rules error", err)
+	}
+
+	verdict := &models.ModerationVerdict{
+		Action: enum.ModerationActionAllow,
+	}
+
+	for _, rule := range rules {
+		matched, err := u.matchRule(ctx, rule, req)
+		if err != nil {
+			return nil, pkgerrors.Wrap("match rule error", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		action := &models.ModerationAction{
+			Id:        models.ModerationActionID(uuid.New()),
+			ServerId:  req.ServerId,
+			RuleId:    rule.Id,
+			RuleType:  rule.Type,
+			Action:    rule.Action,
+			AuthorId:  req.AuthorId,
+			ChatId:    req.ChatId,
+			Text:      req.Text,
+			CreatedAt: now,
+		}
+		if rule.Action == enum.ModerationActionTimeout {
+			action.ExpiresAt = now.Add(time.Duration(rule.TimeoutSeconds) * time.Second)
+		}
+
+		err = u.ActionsRepo.CreateAction(ctx, action)
+		if err != nil {
+			return nil, pkgerrors.Wrap("create action error", err)
+		}
+
+		verdict.Triggered = append(verdict.Triggered, action)
+		if actionSeverity[rule.Action] > actionSeverity[verdict.Action] {
+			verdict.Action = rule.Action
+		}
+	}
+
+	return verdict, nil
+}
+
+func (u *ModerationUsecase) matchRule(ctx context.Context, rule *models.ModerationRule, req usecases.CheckMessageRequest) (bool, error) {
+	switch rule.Type {
+	case enum.ModerationRuleBlockedWords:
+		return containsBlockedWord(req.Text, rule.Words), nil
+	case enum.ModerationRuleRegex:
+		return matchesPattern(req.Text, rule.Pattern), nil
+	case enum.ModerationRuleLinks:
+		return containsLink(req.Text), nil
+	case enum.ModerationRuleInvites:
+		return containsInvite(req.Text), nil
+	case enum.ModerationRuleMentionSpam:
+		return mentionsCount(req.Text) > rule.Limit, nil
+	case enum.ModerationRuleRepeatedMessage:
+		return u.isRepeated(ctx, rule, req)
+	}
+
+	return false, nil
+}
+
+// isRepeated - true when the author's last rule.Limit messages in the chat all have the same text
+func (u *ModerationUsecase) isRepeated(ctx context.Context, rule *models.ModerationRule, req usecases.CheckMessageRequest) (bool, error) {
+	chatId, err := uuid.Parse(req.ChatId)
+	if err != nil {
+		return false, nil
+	}
+
+	authorId, err := uuid.Parse(req.AuthorId)
+	if err != nil {
+		return false, nil
+	}
+
+	messages, err := u.MessagesRepo.GetLastMessagesByOwner(ctx, models.ChatID(chatId), models.OwnerID(authorId), int64(rule.Limit))
+	if err != nil {
+		return false, err
+	}
+
+	if len(messages) < rule.Limit {
+		return false, nil
+	}
+
+	text := normalizeText(req.Text)
+	for _, message := range messages {
+		if normalizeText(message.Text) != text {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func validateRule(req usecases.CreateModerationRuleRequest) error {
+	switch req.Action {
+	case enum.ModerationActionFlag, enum.ModerationActionBlock:
+	case enum.ModerationActionTimeout:
+		if req.TimeoutSeconds <= 0 {
+			return models.ErrInvalidRule
+		}
+	default:
+		return models.ErrInvalidRule
+	}
+
+	switch req.Type {
+	case enum.ModerationRuleBlockedWords:
+		if len(req.Words) == 0 {
+			return models.ErrInvalidRule
+		}
+	case enum.ModerationRuleRegex:
+		if _, err := regexp.Compile(req.Pattern); err != nil || req.Pattern == "" {
+			return models.ErrInvalidRule
+		}
+	case enum.ModerationRuleLinks, enum.ModerationRuleInvites:
+	case enum.ModerationRuleMentionSpam, enum.ModerationRuleRepeatedMessage:
+		if req.Limit <= 0 {
+			return models.ErrInvalidRule
+		}
+	default:
+		return models.ErrInvalidRule
+	}
+
+	return nil
+}
@@ -0,0 +1,411 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"github.com/Nixonxp/discord/chat/internal/app/models"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	"github.com/Nixonxp/discord/chat/internal/app/usecases/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_usecase_ModerationUsecase_CreateRule(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		RulesRepo *mocks.ModerationRulesStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateModerationRuleRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Type:     enum.ModerationRuleBlockedWords,
+					Words:    []string{"spam"},
+					Action:   enum.ModerationActionBlock,
+				},
+			},
+			wantErr:     false,
+			errorString: "",
+
+			on: func(f *fields) {
+				f.RulesRepo.On("CreateRule",
+					ctx,
+					mock.MatchedBy(func(rule *models.ModerationRule) bool {
+						return rule.ServerId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b800" &&
+							rule.Type == enum.ModerationRuleBlockedWords &&
+							rule.Action == enum.ModerationActionBlock
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.RulesRepo.AssertNumberOfCalls(t, "CreateRule", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Invalid regex pattern",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Type:     enum.ModerationRuleRegex,
+					Pattern:  "([a-z",
+					Action:   enum.ModerationActionFlag,
+				},
+			},
+			wantErr:     true,
+			errorString: "validate rule error: invalid moderation rule",
+		},
+		{
+			name: "Test 3. Negative. Timeout without duration",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Type:     enum.ModerationRuleLinks,
+					Action:   enum.ModerationActionTimeout,
+				},
+			},
+			wantErr:     true,
+			errorString: "validate rule error: invalid moderation rule",
+		},
+		{
+			name: "Test 4. Negative. Mention spam without limit",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Type:     enum.ModerationRuleMentionSpam,
+					Action:   enum.ModerationActionFlag,
+				},
+			},
+			wantErr:     true,
+			errorString: "validate rule error: invalid moderation rule",
+		},
+		{
+			name: "Test 5. Negative. CreateRule return error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Type:     enum.ModerationRuleInvites,
+					Action:   enum.ModerationActionBlock,
+				},
+			},
+			wantErr:     true,
+			errorString: "create rule error: some error",
+
+			on: func(f *fields) {
+				f.RulesRepo.On("CreateRule", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.RulesRepo.AssertNumberOfCalls(t, "CreateRule", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				RulesRepo: mocks.NewModerationRulesStorage(t),
+			}
+			u := NewModerationUsecase(Deps{
+				RulesRepo: f.RulesRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := u.CreateRule(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.args.req.Type, got.Type)
+			assert.Equal(t, tt.args.req.Action, got.Action)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ModerationUsecase_CheckMessage(t *testing.T) {
+	// prepare
+	var (
+		ctx      = context.Background() // dummy
+		serverId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b800"
+		chatId   = "284fef68-7e3e-4d1d-96a0-8c96f7b3b000"
+		authorId = "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
+		ruleId   = models.ModerationRuleID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	)
+	type fields struct {
+		RulesRepo    *mocks.ModerationRulesStorage
+		ActionsRepo  *mocks.ModerationActionsStorage
+		MessagesRepo *mocks.MessagesStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CheckMessageRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantAction  string
+		wantCount   int
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. No rules triggered",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CheckMessageRequest{
+					ServerId: serverId,
+					ChatId:   chatId,
+					AuthorId: authorId,
+					Text:     "hello everyone",
+				},
+			},
+			wantAction: enum.ModerationActionAllow,
+			wantCount:  0,
+
+			on: func(f *fields) {
+				f.ActionsRepo.On("GetActiveTimeout", ctx, serverId, authorId, mock.Anything).
+					Return(nil, models.ErrNotFound)
+				f.RulesRepo.On("GetRulesByServerId", ctx, serverId).
+					Return([]*models.ModerationRule{
+						{Id: ruleId, Type: enum.ModerationRuleBlockedWords, Words: []string{"spam"}, Action: enum.ModerationActionBlock},
+						{Id: ruleId, Type: enum.ModerationRuleLinks, Action: enum.ModerationActionFlag},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ActionsRepo.AssertNotCalled(t, "CreateAction", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 2. Positive. Most severe action wins",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CheckMessageRequest{
+					ServerId: serverId,
+					ChatId:   chatId,
+					AuthorId: authorId,
+					Text:     "Buy SPAM at https://example.com",
+				},
+			},
+			wantAction: enum.ModerationActionBlock,
+			wantCount:  2,
+
+			on: func(f *fields) {
+				f.ActionsRepo.On("GetActiveTimeout", ctx, serverId, authorId, mock.Anything).
+					Return(nil, models.ErrNotFound)
+				f.RulesRepo.On("GetRulesByServerId", ctx, serverId).
+					Return([]*models.ModerationRule{
+						{Id: ruleId, Type: enum.ModerationRuleLinks, Action: enum.ModerationActionFlag},
+						{Id: ruleId, Type: enum.ModerationRuleBlockedWords, Words: []string{"spam"}, Action: enum.ModerationActionBlock},
+						{Id: ruleId, Type: enum.ModerationRuleInvites, Action: enum.ModerationActionBlock},
+					}, nil)
+				f.ActionsRepo.On("CreateAction", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ActionsRepo.AssertNumberOfCalls(t, "CreateAction", 2)
+			},
+		},
+		{
+			name: "Test 3. Positive. Timeout sets expiration",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CheckMessageRequest{
+					ServerId: serverId,
+					ChatId:   chatId,
+					AuthorId: authorId,
+					Text:     "@one @two @three",
+				},
+			},
+			wantAction: enum.ModerationActionTimeout,
+			wantCount:  1,
+
+			on: func(f *fields) {
+				f.ActionsRepo.On("GetActiveTimeout", ctx, serverId, authorId, mock.Anything).
+					Return(nil, models.ErrNotFound)
+				f.RulesRepo.On("GetRulesByServerId", ctx, serverId).
+					Return([]*models.ModerationRule{
+						{Id: ruleId, Type: enum.ModerationRuleMentionSpam, Limit: 2, Action: enum.ModerationActionTimeout, TimeoutSeconds: 60},
+					}, nil)
+				f.ActionsRepo.On("CreateAction",
+					ctx,
+					mock.MatchedBy(func(action *models.ModerationAction) bool {
+						return action.Action == enum.ModerationActionTimeout &&
+							action.AuthorId == authorId &&
+							action.ExpiresAt.Sub(action.CreatedAt).Seconds() == 60
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ActionsRepo.AssertNumberOfCalls(t, "CreateAction", 1)
+			},
+		},
+		{
+			name: "Test 4. Positive. Repeated message",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CheckMessageRequest{
+					ServerId: serverId,
+					ChatId:   chatId,
+					AuthorId: authorId,
+					Text:     "Hello!",
+				},
+			},
+			wantAction: enum.ModerationActionFlag,
+			wantCount:  1,
+
+			on: func(f *fields) {
+				f.ActionsRepo.On("GetActiveTimeout", ctx, serverId, authorId, mock.Anything).
+					Return(nil, models.ErrNotFound)
+				f.RulesRepo.On("GetRulesByServerId", ctx, serverId).
+					Return([]*models.ModerationRule{
+						{Id: ruleId, Type: enum.ModerationRuleRepeatedMessage, Limit: 2, Action: enum.ModerationActionFlag},
+					}, nil)
+				f.MessagesRepo.On("GetLastMessagesByOwner",
+					ctx,
+					models.ChatID(uuid.MustParse(chatId)),
+					models.OwnerID(uuid.MustParse(authorId)),
+					int64(2),
+				).
+					Return([]*models.Message{
+						{Text: "hello"},
+						{Text: "HELLO"},
+					}, nil)
+				f.ActionsRepo.On("CreateAction", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.MessagesRepo.AssertNumberOfCalls(t, "GetLastMessagesByOwner", 1)
+			},
+		},
+		{
+			name: "Test 5. Negative. Author is timed out",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CheckMessageRequest{
+					ServerId: serverId,
+					ChatId:   chatId,
+					AuthorId: authorId,
+					Text:     "text",
+				},
+			},
+			wantErr:     true,
+			errorString: "author timeout check: author is timed out",
+
+			on: func(f *fields) {
+				f.ActionsRepo.On("GetActiveTimeout", ctx, serverId, authorId, mock.Anything).
+					Return(&models.ModerationAction{Action: enum.ModerationActionTimeout}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.RulesRepo.AssertNotCalled(t, "GetRulesByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. GetRulesByServerId return error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CheckMessageRequest{
+					ServerId: serverId,
+					ChatId:   chatId,
+					AuthorId: authorId,
+					Text:     "text",
+				},
+			},
+			wantErr:     true,
+			errorString: "get rules error: some error",
+
+			on: func(f *fields) {
+				f.ActionsRepo.On("GetActiveTimeout", ctx, serverId, authorId, mock.Anything).
+					Return(nil, models.ErrNotFound)
+				f.RulesRepo.On("GetRulesByServerId", ctx, serverId).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				RulesRepo:    mocks.NewModerationRulesStorage(t),
+				ActionsRepo:  mocks.NewModerationActionsStorage(t),
+				MessagesRepo: mocks.NewMessagesStorage(t),
+			}
+			u := NewModerationUsecase(Deps{
+				RulesRepo:    f.RulesRepo,
+				ActionsRepo:  f.ActionsRepo,
+				MessagesRepo: f.MessagesRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := u.CheckMessage(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CheckMessage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.wantAction, got.Action)
+			assert.Len(t, got.Triggered, tt.wantCount)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
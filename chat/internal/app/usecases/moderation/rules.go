@@ -0,0 +1,72 @@
+package moderation
+
+import (
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	linkRegexp    = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+	inviteRegexp  = regexp.MustCompile(`(?i)(discord\.gg|discord(app)?\.com/invite)/\S+`)
+	mentionRegexp = regexp.MustCompile(`@[\p{L}\p{N}_.\-]+`)
+)
+
+var actionSeverity = map[string]int{
+	enum.ModerationActionAllow:   0,
+	enum.ModerationActionFlag:    1,
+	enum.ModerationActionBlock:   2,
+	enum.ModerationActionTimeout: 3,
+}
+
+// normalizeText - lower-cases text and collapses everything except letters and digits into single spaces
+func normalizeText(text string) string {
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	return strings.Join(tokens, " ")
+}
+
+func containsBlockedWord(text string, words []string) bool {
+	normalized := " " + normalizeText(text) + " "
+	for _, word := range words {
+		w := normalizeText(word)
+		if w == "" {
+			continue
+		}
+
+		if strings.Contains(normalized, " "+w+" ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesPattern(text string, pattern string) bool {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(text)
+}
+
+func containsLink(text string) bool {
+	return linkRegexp.MatchString(text)
+}
+
+func containsInvite(text string) bool {
+	return inviteRegexp.MatchString(text)
+}
+
+func mentionsCount(text string) int {
+	unique := make(map[string]struct{})
+	for _, mention := range mentionRegexp.FindAllString(text, -1) {
+		unique[strings.ToLower(mention)] = struct{}{}
+	}
+
+	return len(unique)
+}
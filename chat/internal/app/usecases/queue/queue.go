@@ -2,19 +2,24 @@ package queue
 
 import (
 	"context"
+	"errors"
+	"github.com/Nixonxp/discord/chat/internal/app/enum"
 	"github.com/Nixonxp/discord/chat/internal/app/models"
 	"github.com/Nixonxp/discord/chat/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/chat/pkg/errors"
 	"github.com/google/uuid"
 	"time"
 )
 
 type QueueUsecase struct {
-	chatRepo usecases.MessagesStorage
+	messagesRepo usecases.MessagesStorage
+	chatRepo     usecases.ChatStorage
 }
 
-func NewQueueUsecase(chatRepo usecases.MessagesStorage) *QueueUsecase {
+func NewQueueUsecase(messagesRepo usecases.MessagesStorage, chatRepo usecases.ChatStorage) *QueueUsecase {
 	return &QueueUsecase{
-		chatRepo: chatRepo,
+		messagesRepo: messagesRepo,
+		chatRepo:     chatRepo,
 	}
 }
 
@@ -23,12 +28,16 @@ func (u *QueueUsecase) CreateMessage(ctx context.Context, message usecases.Messa
 		message.Id = uuid.New().String()
 	}
 
-	err := u.chatRepo.CreateMessage(ctx, &models.Message{
+	err := u.messagesRepo.CreateMessage(ctx, &models.Message{
 		Id:        models.MessageID(uuid.MustParse(message.Id)),
 		ChatId:    models.ChatID(uuid.MustParse(message.ChatId)),
 		OwnerId:   models.OwnerID(uuid.MustParse(message.OwnerId)),
 		Text:      message.Text,
 		Timestamp: time.Now(),
+		WebhookId: message.WebhookId,
+		Username:  message.Username,
+		AvatarUrl: message.AvatarUrl,
+		VisibleTo: message.VisibleTo,
 	})
 	if err != nil {
 		return &models.ActionInfo{}, err
@@ -38,3 +47,140 @@ func (u *QueueUsecase) CreateMessage(ctx context.Context, message usecases.Messa
 		Success: true,
 	}, nil
 }
+
+// DeleteServerChat - removes messages and then the chat of a deleted server,
+// a server without chat is already cleaned up so redelivered events succeed
+func (u *QueueUsecase) DeleteServerChat(ctx context.Context, serverId string) (*models.ActionInfo, error) {
+	chat, err := u.chatRepo.GetChatByMetadataAndType(ctx, serverId, enum.ServerChatType)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return &models.ActionInfo{Success: true}, nil
+		}
+		return nil, pkgerrors.Wrap("get server chat error", err)
+	}
+
+	_, err = u.messagesRepo.DeleteByChatId(ctx, chat.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete server messages error", err)
+	}
+
+	err = u.chatRepo.DeleteChat(ctx, chat.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete server chat error", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// DeleteChannelChat - removes messages and then the chats of a purged channel and of its forum posts,
+// cross-posted copies in other channels stay, a channel without chat is already cleaned up
+func (u *QueueUsecase) DeleteChannelChat(ctx context.Context, channelId string) (*models.ActionInfo, error) {
+	postChats, err := u.chatRepo.GetChatsByOwnerAndType(ctx, models.OwnerID(uuid.MustParse(channelId)), enum.ForumPostChatType)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get post chats error", err)
+	}
+
+	for _, chat := range postChats {
+		_, err = u.messagesRepo.DeleteByChatId(ctx, chat.Id)
+		if err != nil {
+			return nil, pkgerrors.Wrap("delete post messages error", err)
+		}
+
+		err = u.chatRepo.DeleteChat(ctx, chat.Id)
+		if err != nil {
+			return nil, pkgerrors.Wrap("delete post chat error", err)
+		}
+	}
+
+	chat, err := u.chatRepo.GetChatByMetadataAndType(ctx, channelId, enum.ChannelChatType)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return &models.ActionInfo{Success: true}, nil
+		}
+		return nil, pkgerrors.Wrap("get channel chat error", err)
+	}
+
+	_, err = u.messagesRepo.DeleteByChatId(ctx, chat.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete channel messages error", err)
+	}
+
+	err = u.chatRepo.DeleteChat(ctx, chat.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete channel chat error", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// CrossPostMessage - copies the published message to every target channel chat,
+// copy ids are derived from the original and the target so redelivered events overwrite the same copies
+func (u *QueueUsecase) CrossPostMessage(ctx context.Context, message usecases.PublishedMessageDto) (*models.ActionInfo, error) {
+	original, err := u.messagesRepo.GetMessageById(ctx, models.MessageID(uuid.MustParse(message.MessageId)))
+	if err != nil {
+		// message is deleted before it was copied, there is nothing to cross-post
+		if errors.Is(err, models.ErrNotFound) {
+			return &models.ActionInfo{Success: true}, nil
+		}
+		return nil, pkgerrors.Wrap("get published message error", err)
+	}
+
+	reference := original.Original(message.SourceChannelId)
+	for _, channelId := range message.TargetChannelIds {
+		chat, err := u.getChannelChat(ctx, channelId)
+		if err != nil {
+			return nil, pkgerrors.Wrap("get channel chat error", err)
+		}
+
+		err = u.messagesRepo.CreateMessage(ctx, &models.Message{
+			Id:        models.MessageID(uuid.NewSHA1(uuid.UUID(original.Id), []byte(channelId))),
+			ChatId:    chat.Id,
+			OwnerId:   original.OwnerId,
+			Text:      original.Text,
+			Timestamp: time.Now(),
+			WebhookId: original.WebhookId,
+			Username:  original.Username,
+			AvatarUrl: original.AvatarUrl,
+			Reference: reference,
+		})
+		if err != nil {
+			return nil, pkgerrors.Wrap("create cross-posted message error", err)
+		}
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// getChannelChat - chat of a channel is created with its first message
+func (u *QueueUsecase) getChannelChat(ctx context.Context, channelId string) (*models.Chat, error) {
+	chat, err := u.chatRepo.GetChatByMetadataAndType(ctx, channelId, enum.ChannelChatType)
+	if err == nil {
+		return chat, nil
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	chat = &models.Chat{
+		Id:       models.ChatID(uuid.New()),
+		Type:     enum.ChannelChatType,
+		OwnerId:  models.OwnerID(uuid.MustParse(channelId)),
+		MetaData: channelId,
+	}
+	err = u.chatRepo.CreateChat(ctx, chat)
+	if errors.Is(err, models.ErrAlreadyExists) {
+		// the chat was created by a concurrent first message
+		return u.chatRepo.GetChatByMetadataAndType(ctx, channelId, enum.ChannelChatType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return chat, nil
+}
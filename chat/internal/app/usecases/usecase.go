@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/Nixonxp/discord/chat/internal/app/models"
 	"github.com/segmentio/kafka-go"
+	"io"
+	"time"
 )
 
 type UsecaseInterface interface {
@@ -12,17 +14,57 @@ type UsecaseInterface interface {
 	CreatePrivateChat(ctx context.Context, req CreatePrivateChatRequest) (*models.Chat, error)
 	GetServerMessagesRequest(ctx context.Context, req GetServerMessageRequest) (*models.Messages, error)
 	SendServerMessage(ctx context.Context, req SendServerMessageRequest) (*models.ActionInfo, error)
+	SendChannelMessage(ctx context.Context, req SendChannelMessageRequest) (*models.ActionInfo, error)
+	GetChannelMessages(ctx context.Context, req GetChannelMessagesRequest) (*models.Messages, error)
+	PublishChannelMessage(ctx context.Context, req PublishChannelMessageRequest) (*models.ActionInfo, error)
+	SendPostMessage(ctx context.Context, req SendPostMessageRequest) (*models.ActionInfo, error)
+	GetPostMessages(ctx context.Context, req GetPostMessagesRequest) (*models.Messages, error)
+	ExportChat(ctx context.Context, req ExportChatRequest, w io.Writer) error
+}
+
+type ModerationUsecaseInterface interface {
+	ModerationCheckerInterface
+	CreateRule(ctx context.Context, req CreateModerationRuleRequest) (*models.ModerationRule, error)
+	DeleteRule(ctx context.Context, req DeleteModerationRuleRequest) (*models.ActionInfo, error)
+	GetRules(ctx context.Context, req GetModerationRulesRequest) ([]*models.ModerationRule, error)
+	GetActions(ctx context.Context, req GetModerationActionsRequest) ([]*models.ModerationAction, error)
+}
+
+//go:generate mockery --name=ModerationCheckerInterface --filename=moderation_checker_mock.go --disable-version-string
+type ModerationCheckerInterface interface {
+	CheckMessage(ctx context.Context, req CheckMessageRequest) (*models.ModerationVerdict, error)
 }
 
 //go:generate mockery --name=QueueInterface --filename=queue_mock.go --disable-version-string
 type QueueInterface interface {
 	CreateMessage(ctx context.Context, message MessageDto) (*models.ActionInfo, error)
+	DeleteServerChat(ctx context.Context, serverId string) (*models.ActionInfo, error)
+	DeleteChannelChat(ctx context.Context, channelId string) (*models.ActionInfo, error)
+	CrossPostMessage(ctx context.Context, message PublishedMessageDto) (*models.ActionInfo, error)
 }
 
 //go:generate mockery --name=MessagesStorage --filename=messages_storage_mock.go --disable-version-string
 type MessagesStorage interface {
 	CreateMessage(ctx context.Context, message *models.Message) error
 	GetMessages(ctx context.Context, chatId models.ChatID) ([]*models.Message, error)
+	GetMessageById(ctx context.Context, id models.MessageID) (*models.Message, error)
+	GetLastMessagesByOwner(ctx context.Context, chatId models.ChatID, ownerId models.OwnerID, limit int64) ([]*models.Message, error)
+	IterateMessages(ctx context.Context, chatId models.ChatID, from time.Time, to time.Time, fn func(message *models.Message) error) error
+	DeleteByChatId(ctx context.Context, chatId models.ChatID) (int64, error)
+}
+
+//go:generate mockery --name=ModerationRulesStorage --filename=moderation_rules_storage_mock.go --disable-version-string
+type ModerationRulesStorage interface {
+	CreateRule(ctx context.Context, rule *models.ModerationRule) error
+	DeleteRule(ctx context.Context, serverId string, ruleId models.ModerationRuleID) error
+	GetRulesByServerId(ctx context.Context, serverId string) ([]*models.ModerationRule, error)
+}
+
+//go:generate mockery --name=ModerationActionsStorage --filename=moderation_actions_storage_mock.go --disable-version-string
+type ModerationActionsStorage interface {
+	CreateAction(ctx context.Context, action *models.ModerationAction) error
+	GetActionsByServerId(ctx context.Context, serverId string) ([]*models.ModerationAction, error)
+	GetActiveTimeout(ctx context.Context, serverId string, authorId string, now time.Time) (*models.ModerationAction, error)
 }
 
 //go:generate mockery --name=ChatStorage --filename=chat_storage_mock.go --disable-version-string
@@ -30,15 +72,19 @@ type ChatStorage interface {
 	CreateChat(ctx context.Context, chat *models.Chat) error
 	GetChatById(ctx context.Context, chatId models.ChatID) (*models.Chat, error)
 	GetChatByMetadataAndType(ctx context.Context, metadata string, chatType string) (*models.Chat, error)
+	GetChatsByOwnerAndType(ctx context.Context, ownerId models.OwnerID, chatType string) ([]*models.Chat, error)
+	DeleteChat(ctx context.Context, chatId models.ChatID) error
 }
 
 //go:generate mockery --name=KafkaServiceInterface --filename=kafka_producer_service_mock.go --disable-version-string
 type KafkaProducerServiceInterface interface {
-	SendMessage(msgData MessageDto) error
+	SendMessage(ctx context.Context, msgData MessageDto) error
+	PublishMessage(ctx context.Context, msgData PublishedMessageDto) error
 }
 
 //go:generate mockery --name=KafkaConsumerServiceInterface --filename=kafka_consumer_service_mock.go --disable-version-string
 type KafkaConsumerServiceInterface interface {
-	ReadMessage(ctx context.Context) (kafka.Message, error)
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
 	Close() error
 }
@@ -20,27 +20,47 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 	) (resp interface{}, err error) {
 		resp, err = handler(ctx, req)
 
-		if _, ok := status.FromError(err); ok {
-			return
-		}
-
-		switch {
-		case errors.Is(err, models.ErrAlreadyExists):
-			err = status.Error(codes.AlreadyExists, err.Error())
-		case errors.Is(err, models.ErrUnimplemented):
-			err = status.Error(codes.Unimplemented, err.Error())
-		case errors.Is(err, models.ErrCreate):
-			err = status.Error(codes.Aborted, err.Error())
-		case errors.Is(err, models.ErrEmpty):
-			err = status.Error(codes.NotFound, err.Error())
-		case errors.Is(err, models.Unauthenticated):
-			err = status.Error(codes.Unauthenticated, err.Error())
-		case errors.Is(err, models.PermissionDenied):
-			err = status.Error(codes.PermissionDenied, err.Error())
-		default:
-			err = status.Error(codes.Internal, err.Error())
-		}
-
-		return
+		return resp, convertError(err)
 	}
 }
+
+// ErrorsStreamInterceptor - convert any arror returned by stream handler to rpc error
+func ErrorsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return convertError(handler(srv, ss))
+	}
+}
+
+func convertError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, models.ErrAlreadyExists):
+		err = status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrUnimplemented):
+		err = status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, models.ErrCreate):
+		err = status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, models.ErrEmpty), errors.Is(err, models.ErrNotFound):
+		err = status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.Unauthenticated):
+		err = status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, models.PermissionDenied):
+		err = status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, models.ErrInvalidRule), errors.Is(err, models.ErrExportFormat):
+		err = status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrBlocked), errors.Is(err, models.ErrTimedOut):
+		err = status.Error(codes.PermissionDenied, err.Error())
+	default:
+		err = status.Error(codes.Internal, err.Error())
+	}
+
+	return err
+}
@@ -24,6 +24,16 @@ const (
 	ChatService_GetUserPrivateMessages_FullMethodName = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetUserPrivateMessages"
 	ChatService_SendServerMessage_FullMethodName      = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/SendServerMessage"
 	ChatService_GetServerMessages_FullMethodName      = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetServerMessages"
+	ChatService_SendChannelMessage_FullMethodName     = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/SendChannelMessage"
+	ChatService_GetChannelMessages_FullMethodName     = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetChannelMessages"
+	ChatService_PublishChannelMessage_FullMethodName  = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/PublishChannelMessage"
+	ChatService_SendPostMessage_FullMethodName        = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/SendPostMessage"
+	ChatService_GetPostMessages_FullMethodName        = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetPostMessages"
+	ChatService_CreateModerationRule_FullMethodName   = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/CreateModerationRule"
+	ChatService_DeleteModerationRule_FullMethodName   = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/DeleteModerationRule"
+	ChatService_GetModerationRules_FullMethodName     = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetModerationRules"
+	ChatService_GetModerationActions_FullMethodName   = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetModerationActions"
+	ChatService_ExportChat_FullMethodName             = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/ExportChat"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -35,6 +45,16 @@ type ChatServiceClient interface {
 	GetUserPrivateMessages(ctx context.Context, in *GetUserPrivateMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
 	SendServerMessage(ctx context.Context, in *SendServerMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	GetServerMessages(ctx context.Context, in *GetServerMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	SendPostMessage(ctx context.Context, in *SendPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetPostMessages(ctx context.Context, in *GetPostMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	CreateModerationRule(ctx context.Context, in *CreateModerationRuleRequest, opts ...grpc.CallOption) (*ModerationRule, error)
+	DeleteModerationRule(ctx context.Context, in *DeleteModerationRuleRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error)
+	GetModerationActions(ctx context.Context, in *GetModerationActionsRequest, opts ...grpc.CallOption) (*GetModerationActionsResponse, error)
+	ExportChat(ctx context.Context, in *ExportChatRequest, opts ...grpc.CallOption) (ChatService_ExportChatClient, error)
 }
 
 type chatServiceClient struct {
@@ -90,6 +110,119 @@ func (c *chatServiceClient) GetServerMessages(ctx context.Context, in *GetServer
 	return out, nil
 }
 
+func (c *chatServiceClient) SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_SendChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetChannelMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_PublishChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SendPostMessage(ctx context.Context, in *SendPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_SendPostMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetPostMessages(ctx context.Context, in *GetPostMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetPostMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateModerationRule(ctx context.Context, in *CreateModerationRuleRequest, opts ...grpc.CallOption) (*ModerationRule, error) {
+	out := new(ModerationRule)
+	err := c.cc.Invoke(ctx, ChatService_CreateModerationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteModerationRule(ctx context.Context, in *DeleteModerationRuleRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChatService_DeleteModerationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error) {
+	out := new(GetModerationRulesResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetModerationRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetModerationActions(ctx context.Context, in *GetModerationActionsRequest, opts ...grpc.CallOption) (*GetModerationActionsResponse, error) {
+	out := new(GetModerationActionsResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetModerationActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ExportChat(ctx context.Context, in *ExportChatRequest, opts ...grpc.CallOption) (ChatService_ExportChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_ExportChat_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServiceExportChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatService_ExportChatClient interface {
+	Recv() (*ExportChatChunk, error)
+	grpc.ClientStream
+}
+
+type chatServiceExportChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServiceExportChatClient) Recv() (*ExportChatChunk, error) {
+	m := new(ExportChatChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
@@ -99,6 +232,16 @@ type ChatServiceServer interface {
 	GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error)
 	SendServerMessage(context.Context, *SendServerMessageRequest) (*ActionResponse, error)
 	GetServerMessages(context.Context, *GetServerMessagesRequest) (*GetMessagesResponse, error)
+	SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error)
+	GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetMessagesResponse, error)
+	PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error)
+	SendPostMessage(context.Context, *SendPostMessageRequest) (*ActionResponse, error)
+	GetPostMessages(context.Context, *GetPostMessagesRequest) (*GetMessagesResponse, error)
+	CreateModerationRule(context.Context, *CreateModerationRuleRequest) (*ModerationRule, error)
+	DeleteModerationRule(context.Context, *DeleteModerationRuleRequest) (*ActionResponse, error)
+	GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error)
+	GetModerationActions(context.Context, *GetModerationActionsRequest) (*GetModerationActionsResponse, error)
+	ExportChat(*ExportChatRequest, ChatService_ExportChatServer) error
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -121,6 +264,36 @@ func (UnimplementedChatServiceServer) SendServerMessage(context.Context, *SendSe
 func (UnimplementedChatServiceServer) GetServerMessages(context.Context, *GetServerMessagesRequest) (*GetMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServerMessages not implemented")
 }
+func (UnimplementedChatServiceServer) SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChannelMessage not implemented")
+}
+func (UnimplementedChatServiceServer) GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMessages not implemented")
+}
+func (UnimplementedChatServiceServer) PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishChannelMessage not implemented")
+}
+func (UnimplementedChatServiceServer) SendPostMessage(context.Context, *SendPostMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPostMessage not implemented")
+}
+func (UnimplementedChatServiceServer) GetPostMessages(context.Context, *GetPostMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostMessages not implemented")
+}
+func (UnimplementedChatServiceServer) CreateModerationRule(context.Context, *CreateModerationRuleRequest) (*ModerationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateModerationRule not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteModerationRule(context.Context, *DeleteModerationRuleRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModerationRule not implemented")
+}
+func (UnimplementedChatServiceServer) GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationRules not implemented")
+}
+func (UnimplementedChatServiceServer) GetModerationActions(context.Context, *GetModerationActionsRequest) (*GetModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationActions not implemented")
+}
+func (UnimplementedChatServiceServer) ExportChat(*ExportChatRequest, ChatService_ExportChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportChat not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +397,189 @@ func _ChatService_GetServerMessages_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_SendChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendChannelMessage(ctx, req.(*SendChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChannelMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChannelMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChannelMessages(ctx, req.(*GetChannelMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PublishChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishChannelMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PublishChannelMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PublishChannelMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PublishChannelMessage(ctx, req.(*PublishChannelMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SendPostMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPostMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendPostMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendPostMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendPostMessage(ctx, req.(*SendPostMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetPostMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetPostMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetPostMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetPostMessages(ctx, req.(*GetPostMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateModerationRule(ctx, req.(*CreateModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeleteModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteModerationRule(ctx, req.(*DeleteModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetModerationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetModerationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetModerationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetModerationRules(ctx, req.(*GetModerationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetModerationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetModerationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetModerationActions(ctx, req.(*GetModerationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ExportChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).ExportChat(m, &chatServiceExportChatServer{stream})
+}
+
+type ChatService_ExportChatServer interface {
+	Send(*ExportChatChunk) error
+	grpc.ServerStream
+}
+
+type chatServiceExportChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServiceExportChatServer) Send(m *ExportChatChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,7 +607,49 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServerMessages",
 			Handler:    _ChatService_GetServerMessages_Handler,
 		},
+		{
+			MethodName: "SendChannelMessage",
+			Handler:    _ChatService_SendChannelMessage_Handler,
+		},
+		{
+			MethodName: "GetChannelMessages",
+			Handler:    _ChatService_GetChannelMessages_Handler,
+		},
+		{
+			MethodName: "PublishChannelMessage",
+			Handler:    _ChatService_PublishChannelMessage_Handler,
+		},
+		{
+			MethodName: "SendPostMessage",
+			Handler:    _ChatService_SendPostMessage_Handler,
+		},
+		{
+			MethodName: "GetPostMessages",
+			Handler:    _ChatService_GetPostMessages_Handler,
+		},
+		{
+			MethodName: "CreateModerationRule",
+			Handler:    _ChatService_CreateModerationRule_Handler,
+		},
+		{
+			MethodName: "DeleteModerationRule",
+			Handler:    _ChatService_DeleteModerationRule_Handler,
+		},
+		{
+			MethodName: "GetModerationRules",
+			Handler:    _ChatService_GetModerationRules_Handler,
+		},
+		{
+			MethodName: "GetModerationActions",
+			Handler:    _ChatService_GetModerationActions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportChat",
+			Handler:       _ChatService_ExportChat_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/chat.proto",
 }
@@ -112,3 +112,35 @@ func (c *Collection) DeleteOne(ctx context.Context, filter interface{},
 
 	return c.collection.DeleteOne(ctx, filter, opts...)
 }
+
+func (c *Collection) DeleteMany(ctx context.Context, filter interface{},
+	opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongodb.DeleteMany")
+	defer span.Finish()
+
+	return c.collection.DeleteMany(ctx, filter, opts...)
+}
+
+func (c *Collection) UpdateMany(ctx context.Context, filter interface{}, update interface{},
+	opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongodb.UpdateMany")
+	defer span.Finish()
+
+	return c.collection.UpdateMany(ctx, filter, update, opts...)
+}
+
+func (c *Collection) Aggregate(ctx context.Context, pipeline interface{},
+	opts ...*options.AggregateOptions) (*mongo.Cursor, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongodb.Aggregate")
+	defer span.Finish()
+
+	return c.collection.Aggregate(ctx, pipeline, opts...)
+}
+
+func (c *Collection) CreateIndexes(ctx context.Context, models []mongo.IndexModel,
+	opts ...*options.CreateIndexesOptions) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mongodb.CreateIndexes")
+	defer span.Finish()
+
+	return c.collection.Indexes().CreateMany(ctx, models, opts...)
+}
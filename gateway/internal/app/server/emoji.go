@@ -0,0 +1,38 @@
+package server
+
+import (
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	grpcutils "github.com/Nixonxp/discord/gateway/pkg/grpc_utils"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"net/http"
+	"strconv"
+)
+
+// emojiCacheControl - an emoji id always points to the same image
+const emojiCacheControl = "public, max-age=86400, immutable"
+
+// GetEmojiImageHandler - GET /api/v1/emoji/{emoji_id}, raw image bytes for img tags
+func (s *DiscordGatewayServiceServer) GetEmojiImageHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		req := &pb.GetEmojiImageRequest{
+			EmojiId: pathParams["emoji_id"],
+		}
+		if err := s.validator.Validate(req); err != nil {
+			writeExportError(mux, w, r, grpcutils.RPCValidationError(err))
+			return
+		}
+
+		image, err := s.DiscordGatewayService.GetEmojiImage(r.Context(), req)
+		if err != nil {
+			writeExportError(mux, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", image.GetContentType())
+		w.Header().Set("Content-Length", strconv.Itoa(len(image.GetData())))
+		w.Header().Set("Cache-Control", emojiCacheControl)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(image.GetData())
+	}
+}
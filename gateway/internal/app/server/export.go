@@ -0,0 +1,155 @@
+package server
+
+import (
+	"fmt"
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	grpcutils "github.com/Nixonxp/discord/gateway/pkg/grpc_utils"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"time"
+)
+
+const defaultExportFormat = "jsonl"
+
+var exportContentTypes = map[string]string{
+	"jsonl": "application/x-ndjson; charset=utf-8",
+	"csv":   "text/csv; charset=utf-8",
+	"html":  "text/html; charset=utf-8",
+}
+
+// exportResponseWriter - sets download headers on the first write, so errors returned
+// before any data can still be rendered as a regular http error
+type exportResponseWriter struct {
+	w        http.ResponseWriter
+	format   string
+	filename string
+	started  bool
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.w.Header().Set("Content-Type", exportContentTypes[e.format])
+		e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename))
+		e.w.WriteHeader(http.StatusOK)
+	}
+
+	return e.w.Write(p)
+}
+
+// ExportServerChatHandler - GET /api/v1/servers/{server_id}/export?format=csv&from=...&to=...
+func (s *DiscordGatewayServiceServer) ExportServerChatHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		format, from, to, err := parseExportQuery(r)
+		if err != nil {
+			writeExportError(mux, w, r, err)
+			return
+		}
+
+		req := &pb.ExportServerChatRequest{
+			ServerId: pathParams["server_id"],
+			Format:   format,
+			From:     from,
+			To:       to,
+		}
+		if err := s.validator.Validate(req); err != nil {
+			writeExportError(mux, w, r, grpcutils.RPCValidationError(err))
+			return
+		}
+
+		out := &exportResponseWriter{
+			w:        w,
+			format:   format,
+			filename: fmt.Sprintf("server-%s.%s", req.GetServerId(), format),
+		}
+		err = s.DiscordGatewayService.ExportServerChat(r.Context(), req, out)
+		finishExport(mux, w, r, out, err)
+	}
+}
+
+// ExportPrivateChatHandler - GET /api/v1/chats/private/{user_id}/export?format=csv&from=...&to=...
+func (s *DiscordGatewayServiceServer) ExportPrivateChatHandler(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		format, from, to, err := parseExportQuery(r)
+		if err != nil {
+			writeExportError(mux, w, r, err)
+			return
+		}
+
+		req := &pb.ExportPrivateChatRequest{
+			UserId: pathParams["user_id"],
+			Format: format,
+			From:   from,
+			To:     to,
+		}
+		if err := s.validator.Validate(req); err != nil {
+			writeExportError(mux, w, r, grpcutils.RPCValidationError(err))
+			return
+		}
+
+		out := &exportResponseWriter{
+			w:        w,
+			format:   format,
+			filename: fmt.Sprintf("private-%s.%s", req.GetUserId(), format),
+		}
+		err = s.DiscordGatewayService.ExportPrivateChat(r.Context(), req, out)
+		finishExport(mux, w, r, out, err)
+	}
+}
+
+// finishExport - before the first chunk the error is rendered as usual. After it the 200 status is
+// already sent, so the connection is aborted and the client does not keep a truncated file as complete
+func finishExport(mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, out *exportResponseWriter, err error) {
+	if err == nil {
+		return
+	}
+
+	if !out.started {
+		writeExportError(mux, w, r, err)
+		return
+	}
+
+	panic(http.ErrAbortHandler)
+}
+
+func parseExportQuery(r *http.Request) (string, *timestamppb.Timestamp, *timestamppb.Timestamp, error) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = defaultExportFormat
+	}
+
+	from, err := parseExportTime(query.Get("from"))
+	if err != nil {
+		return "", nil, nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+	}
+
+	to, err := parseExportTime(query.Get("to"))
+	if err != nil {
+		return "", nil, nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+	}
+
+	return format, from, to, nil
+}
+
+func parseExportTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return timestamppb.New(t), nil
+}
+
+func writeExportError(mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, err error) {
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+}
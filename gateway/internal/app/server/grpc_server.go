@@ -43,16 +43,78 @@ func NewDiscordGatewayServiceServer(ctx context.Context, d Deps) (*DiscordGatewa
 				&pb.DeclineFriendInviteRequest{},
 				&pb.CreateServerRequest{},
 				&pb.SearchServerRequest{},
+				&pb.GetServerRequest{},
+				&pb.UpdateServerRequest{},
+				&pb.DeleteServerRequest{},
+				&pb.RestoreServerRequest{},
+				&pb.TransferOwnershipRequest{},
+				&pb.ListServerMembersRequest{},
+				&pb.GetMemberRequest{},
+				&pb.SetMemberNicknameRequest{},
+				&pb.CreateServerTemplateRequest{},
+				&pb.ListTemplatesRequest{},
+				&pb.CreateServerFromTemplateRequest{},
+				&pb.CreateWebhookRequest{},
+				&pb.ListWebhooksRequest{},
+				&pb.RegenerateWebhookTokenRequest{},
+				&pb.DeleteWebhookRequest{},
+				&pb.ExecuteWebhookRequest{},
+				&pb.CreateOutgoingWebhookRequest{},
+				&pb.ListOutgoingWebhooksRequest{},
+				&pb.EnableOutgoingWebhookRequest{},
+				&pb.DeleteOutgoingWebhookRequest{},
+				&pb.ListOutgoingDeliveriesRequest{},
+				&pb.CreateBotRequest{},
+				&pb.ListBotsRequest{},
+				&pb.ResetBotTokenRequest{},
+				&pb.RevokeBotTokenRequest{},
+				&pb.AddBotToServerRequest{},
+				&pb.CreateCommandRequest{},
+				&pb.ListCommandsRequest{},
+				&pb.DeleteCommandRequest{},
+				&pb.RespondInteractionRequest{},
+				&pb.CreateEmojiRequest{},
+				&pb.ListServerEmojiRequest{},
+				&pb.RenameEmojiRequest{},
+				&pb.DeleteEmojiRequest{},
+				&pb.GetEmojiImageRequest{},
 				&pb.SubscribeServerRequest{},
 				&pb.UnsubscribeServerRequest{},
 				&pb.SearchServerByUserIdRequest{},
 				&pb.InviteUserToServerRequest{},
 				&pb.PublishMessageOnServerRequest{},
 				&pb.GetMessagesFromServerRequest{},
+				&pb.CreateModerationRuleRequest{},
+				&pb.DeleteModerationRuleRequest{},
+				&pb.GetModerationRulesRequest{},
+				&pb.GetModerationActionsRequest{},
+				&pb.GetAuditLogRequest{},
+				&pb.ExportServerChatRequest{},
+				&pb.ExportPrivateChatRequest{},
 				&pb.AddChannelRequest{},
+				&pb.UpdateChannelRequest{},
 				&pb.DeleteChannelRequest{},
+				&pb.RestoreChannelRequest{},
+				&pb.ArchiveChannelRequest{},
 				&pb.JoinChannelRequest{},
 				&pb.LeaveChannelRequest{},
+				&pb.ListMyChannelsRequest{},
+				&pb.ListChannelMembersRequest{},
+				&pb.IsChannelMemberRequest{},
+				&pb.ChannelAccessRequest{},
+				&pb.RequestChannelAccessRequest{},
+				&pb.ListChannelJoinRequestsRequest{},
+				&pb.SendChannelMessageRequest{},
+				&pb.GetChannelMessagesRequest{},
+				&pb.CreateForumPostRequest{},
+				&pb.ListForumPostsRequest{},
+				&pb.SendForumPostMessageRequest{},
+				&pb.GetForumPostMessagesRequest{},
+				&pb.FollowChannelRequest{},
+				&pb.UnfollowChannelRequest{},
+				&pb.PublishChannelMessageRequest{},
+				&pb.ListServerChannelsRequest{},
+				&pb.ReorderChannelsRequest{},
 				&pb.SendUserPrivateMessageRequest{},
 				&pb.GetUserPrivateMessagesRequest{},
 				&pb.DeleteFromFriendRequest{},
@@ -266,11 +328,545 @@ func (s *DiscordGatewayServiceServer) SearchServer(ctx context.Context, req *pb.
 	return resp, nil
 }
 
+func (s *DiscordGatewayServiceServer) GetServer(ctx context.Context, req *pb.GetServerRequest) (*pb.ServerProfile, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) UpdateServer(ctx context.Context, req *pb.UpdateServerRequest) (*pb.ServerProfile, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.UpdateServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) DeleteServer(ctx context.Context, req *pb.DeleteServerRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.DeleteServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) RestoreServer(ctx context.Context, req *pb.RestoreServerRequest) (*pb.ServerProfile, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.RestoreServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.ServerProfile, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.TransferOwnership(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListServerMembers(ctx context.Context, req *pb.ListServerMembersRequest) (*pb.ListServerMembersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListServerMembers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetMember(ctx context.Context, req *pb.GetMemberRequest) (*pb.ServerMember, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) SetMemberNickname(ctx context.Context, req *pb.SetMemberNicknameRequest) (*pb.ServerMember, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.SetMemberNickname(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateServerTemplate(ctx context.Context, req *pb.CreateServerTemplateRequest) (*pb.ServerTemplate, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateServerTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListTemplates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateServerFromTemplate(ctx context.Context, req *pb.CreateServerFromTemplateRequest) (*pb.ServerProfile, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateServerFromTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListWebhooks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) RegenerateWebhookToken(ctx context.Context, req *pb.RegenerateWebhookTokenRequest) (*pb.Webhook, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.RegenerateWebhookToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.DeleteWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ExecuteWebhook(ctx context.Context, req *pb.ExecuteWebhookRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ExecuteWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateOutgoingWebhook(ctx context.Context, req *pb.CreateOutgoingWebhookRequest) (*pb.OutgoingWebhook, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateOutgoingWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListOutgoingWebhooks(ctx context.Context, req *pb.ListOutgoingWebhooksRequest) (*pb.ListOutgoingWebhooksResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListOutgoingWebhooks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) EnableOutgoingWebhook(ctx context.Context, req *pb.EnableOutgoingWebhookRequest) (*pb.OutgoingWebhook, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.EnableOutgoingWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) DeleteOutgoingWebhook(ctx context.Context, req *pb.DeleteOutgoingWebhookRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.DeleteOutgoingWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListOutgoingDeliveries(ctx context.Context, req *pb.ListOutgoingDeliveriesRequest) (*pb.ListOutgoingDeliveriesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListOutgoingDeliveries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateBot(ctx context.Context, req *pb.CreateBotRequest) (*pb.CreateBotResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateBot(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListBots(ctx context.Context, req *pb.ListBotsRequest) (*pb.ListBotsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListBots(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ResetBotToken(ctx context.Context, req *pb.ResetBotTokenRequest) (*pb.ResetBotTokenResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ResetBotToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) RevokeBotToken(ctx context.Context, req *pb.RevokeBotTokenRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.RevokeBotToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) AddBotToServer(ctx context.Context, req *pb.AddBotToServerRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.AddBotToServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (s *DiscordGatewayServiceServer) SubscribeServer(ctx context.Context, req *pb.SubscribeServerRequest) (*pb.ActionResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
-	resp, err := s.DiscordGatewayService.SubscribeServer(ctx, req)
+	resp, err := s.DiscordGatewayService.SubscribeServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) UnsubscribeServer(ctx context.Context, req *pb.UnsubscribeServerRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.UnsubscribeServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) SearchServerByUserId(ctx context.Context, req *pb.SearchServerByUserIdRequest) (*pb.SearchServerByUserIdResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.SearchServerByUserId(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) InviteUserToServer(ctx context.Context, req *pb.InviteUserToServerRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.InviteUserToServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) PublishMessageOnServer(ctx context.Context, req *pb.PublishMessageOnServerRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.PublishMessageOnServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetMessagesFromServer(ctx context.Context, req *pb.GetMessagesFromServerRequest) (*pb.GetMessagesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		log.Println(err)
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetMessagesFromServer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateModerationRule(ctx context.Context, req *pb.CreateModerationRuleRequest) (*pb.ModerationRule, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateModerationRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) DeleteModerationRule(ctx context.Context, req *pb.DeleteModerationRuleRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.DeleteModerationRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetModerationRules(ctx context.Context, req *pb.GetModerationRulesRequest) (*pb.GetModerationRulesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetModerationRules(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetModerationActions(ctx context.Context, req *pb.GetModerationActionsRequest) (*pb.GetModerationActionsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetModerationActions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetAuditLog(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateCommand(ctx context.Context, req *pb.CreateCommandRequest) (*pb.Command, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateCommand(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListCommands(ctx context.Context, req *pb.ListCommandsRequest) (*pb.ListCommandsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListCommands(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) DeleteCommand(ctx context.Context, req *pb.DeleteCommandRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.DeleteCommand(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) RespondInteraction(ctx context.Context, req *pb.RespondInteractionRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.RespondInteraction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateEmoji(ctx context.Context, req *pb.CreateEmojiRequest) (*pb.Emoji, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateEmoji(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -278,12 +874,12 @@ func (s *DiscordGatewayServiceServer) SubscribeServer(ctx context.Context, req *
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) UnsubscribeServer(ctx context.Context, req *pb.UnsubscribeServerRequest) (*pb.ActionResponse, error) {
+func (s *DiscordGatewayServiceServer) ListServerEmoji(ctx context.Context, req *pb.ListServerEmojiRequest) (*pb.ListServerEmojiResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.UnsubscribeServer(ctx, req)
+	resp, err := s.DiscordGatewayService.ListServerEmoji(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -291,12 +887,12 @@ func (s *DiscordGatewayServiceServer) UnsubscribeServer(ctx context.Context, req
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) SearchServerByUserId(ctx context.Context, req *pb.SearchServerByUserIdRequest) (*pb.SearchServerByUserIdResponse, error) {
+func (s *DiscordGatewayServiceServer) RenameEmoji(ctx context.Context, req *pb.RenameEmojiRequest) (*pb.Emoji, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.SearchServerByUserId(ctx, req)
+	resp, err := s.DiscordGatewayService.RenameEmoji(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -304,12 +900,12 @@ func (s *DiscordGatewayServiceServer) SearchServerByUserId(ctx context.Context,
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) InviteUserToServer(ctx context.Context, req *pb.InviteUserToServerRequest) (*pb.ActionResponse, error) {
+func (s *DiscordGatewayServiceServer) DeleteEmoji(ctx context.Context, req *pb.DeleteEmojiRequest) (*pb.ActionResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.InviteUserToServer(ctx, req)
+	resp, err := s.DiscordGatewayService.DeleteEmoji(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -317,12 +913,12 @@ func (s *DiscordGatewayServiceServer) InviteUserToServer(ctx context.Context, re
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) PublishMessageOnServer(ctx context.Context, req *pb.PublishMessageOnServerRequest) (*pb.ActionResponse, error) {
+func (s *DiscordGatewayServiceServer) AddChannel(ctx context.Context, req *pb.AddChannelRequest) (*pb.ActionResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.PublishMessageOnServer(ctx, req)
+	resp, err := s.DiscordGatewayService.AddChannel(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -330,13 +926,12 @@ func (s *DiscordGatewayServiceServer) PublishMessageOnServer(ctx context.Context
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) GetMessagesFromServer(ctx context.Context, req *pb.GetMessagesFromServerRequest) (*pb.GetMessagesResponse, error) {
+func (s *DiscordGatewayServiceServer) UpdateChannel(ctx context.Context, req *pb.UpdateChannelRequest) (*pb.Channel, error) {
 	if err := s.validator.Validate(req); err != nil {
-		log.Println(err)
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.GetMessagesFromServer(ctx, req)
+	resp, err := s.DiscordGatewayService.UpdateChannel(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -344,12 +939,12 @@ func (s *DiscordGatewayServiceServer) GetMessagesFromServer(ctx context.Context,
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) AddChannel(ctx context.Context, req *pb.AddChannelRequest) (*pb.ActionResponse, error) {
+func (s *DiscordGatewayServiceServer) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.ActionResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.AddChannel(ctx, req)
+	resp, err := s.DiscordGatewayService.DeleteChannel(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -357,12 +952,38 @@ func (s *DiscordGatewayServiceServer) AddChannel(ctx context.Context, req *pb.Ad
 	return resp, nil
 }
 
-func (s *DiscordGatewayServiceServer) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.ActionResponse, error) {
+func (s *DiscordGatewayServiceServer) RestoreChannel(ctx context.Context, req *pb.RestoreChannelRequest) (*pb.ActionResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	resp, err := s.DiscordGatewayService.DeleteChannel(ctx, req)
+	resp, err := s.DiscordGatewayService.RestoreChannel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ArchiveChannel(ctx context.Context, req *pb.ArchiveChannelRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ArchiveChannel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) UnarchiveChannel(ctx context.Context, req *pb.ArchiveChannelRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.UnarchiveChannel(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +1017,253 @@ func (s *DiscordGatewayServiceServer) LeaveChannel(ctx context.Context, req *pb.
 	return resp, nil
 }
 
+func (s *DiscordGatewayServiceServer) ListMyChannels(ctx context.Context, req *pb.ListMyChannelsRequest) (*pb.ListMyChannelsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListMyChannels(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListChannelMembers(ctx context.Context, req *pb.ListChannelMembersRequest) (*pb.ListChannelMembersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListChannelMembers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) IsChannelMember(ctx context.Context, req *pb.IsChannelMemberRequest) (*pb.IsChannelMemberResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.IsChannelMember(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GrantChannelAccess(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GrantChannelAccess(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) RevokeChannelAccess(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.RevokeChannelAccess(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) RequestChannelAccess(ctx context.Context, req *pb.RequestChannelAccessRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.RequestChannelAccess(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListChannelJoinRequests(ctx context.Context, req *pb.ListChannelJoinRequestsRequest) (*pb.ListChannelJoinRequestsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListChannelJoinRequests(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ApproveChannelJoinRequest(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ApproveChannelJoinRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) SendChannelMessage(ctx context.Context, req *pb.SendChannelMessageRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.SendChannelMessage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetChannelMessages(ctx context.Context, req *pb.GetChannelMessagesRequest) (*pb.GetChannelMessagesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetChannelMessages(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) CreateForumPost(ctx context.Context, req *pb.CreateForumPostRequest) (*pb.ForumPost, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.CreateForumPost(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListForumPosts(ctx context.Context, req *pb.ListForumPostsRequest) (*pb.ListForumPostsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListForumPosts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) SendForumPostMessage(ctx context.Context, req *pb.SendForumPostMessageRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.SendForumPostMessage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) GetForumPostMessages(ctx context.Context, req *pb.GetForumPostMessagesRequest) (*pb.GetChannelMessagesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.GetForumPostMessages(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) FollowChannel(ctx context.Context, req *pb.FollowChannelRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.FollowChannel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) UnfollowChannel(ctx context.Context, req *pb.UnfollowChannelRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.UnfollowChannel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) PublishChannelMessage(ctx context.Context, req *pb.PublishChannelMessageRequest) (*pb.ActionResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.PublishChannelMessage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ListServerChannels(ctx context.Context, req *pb.ListServerChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ListServerChannels(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *DiscordGatewayServiceServer) ReorderChannels(ctx context.Context, req *pb.ReorderChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	resp, err := s.DiscordGatewayService.ReorderChannels(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (s *DiscordGatewayServiceServer) SendUserPrivateMessage(ctx context.Context, req *pb.SendUserPrivateMessageRequest) (*pb.ActionResponse, error) {
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
@@ -52,8 +52,21 @@ func (s *MainServer) AppStart(ctx context.Context, cfg *config.Config) error {
 		return fmt.Errorf("server: failed to register handler: %v", err)
 	}
 
+	if err := mux.HandlePath(http.MethodGet, "/api/v1/servers/{server_id}/export", srv.ExportServerChatHandler(mux)); err != nil {
+		return fmt.Errorf("server: failed to register export handler: %v", err)
+	}
+
+	if err := mux.HandlePath(http.MethodGet, "/api/v1/chats/private/{user_id}/export", srv.ExportPrivateChatHandler(mux)); err != nil {
+		return fmt.Errorf("server: failed to register export handler: %v", err)
+	}
+
+	if err := mux.HandlePath(http.MethodGet, "/api/v1/emoji/{emoji_id}", srv.GetEmojiImageHandler(mux)); err != nil {
+		return fmt.Errorf("server: failed to register emoji image handler: %v", err)
+	}
+
 	httpServer := &http.Server{
 		Handler: middleware.JWTMiddleware(
+			s.gateway.GetInstance(),
 			middleware.PrometheusMiddleware(
 				middleware.TracingWrapper(middleware.AllowCORS(mux)),
 			),
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	pb_auth "github.com/Nixonxp/discord/gateway/pkg/api/auth"
+	pb_server "github.com/Nixonxp/discord/gateway/pkg/api/server"
+	pb_user "github.com/Nixonxp/discord/gateway/pkg/api/user"
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (s *DiscordGatewayService) CreateBot(ctx context.Context, req *pb.CreateBotRequest) (*pb.CreateBotResponse, error) {
+	authClient := pb_auth.NewAuthServiceClient(s.AuthConn)
+	request := pb_auth.CreateBotRequest{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Public:      req.GetPublic(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "auth_service.CreateBot")
+	defer span.Finish()
+
+	response, err := authClient.CreateBot(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("Name", req.GetName()).Error("create bot error")
+		return nil, err
+	}
+
+	return &pb.CreateBotResponse{
+		Bot:   botToPb(response.GetBot()),
+		Token: response.GetToken(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ListBots(ctx context.Context, _ *pb.ListBotsRequest) (*pb.ListBotsResponse, error) {
+	authClient := pb_auth.NewAuthServiceClient(s.AuthConn)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "auth_service.ListBots")
+	defer span.Finish()
+
+	response, err := authClient.ListBots(ctx, &pb_auth.ListBotsRequest{})
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).Error("list bots error")
+		return nil, err
+	}
+
+	bots := make([]*pb.Bot, len(response.GetBots()))
+	for i, bot := range response.GetBots() {
+		bots[i] = botToPb(bot)
+	}
+
+	return &pb.ListBotsResponse{
+		Bots: bots,
+	}, nil
+}
+
+func (s *DiscordGatewayService) ResetBotToken(ctx context.Context, req *pb.ResetBotTokenRequest) (*pb.ResetBotTokenResponse, error) {
+	authClient := pb_auth.NewAuthServiceClient(s.AuthConn)
+	request := pb_auth.ResetBotTokenRequest{
+		BotId: req.GetBotId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "auth_service.ResetBotToken")
+	defer span.Finish()
+
+	response, err := authClient.ResetBotToken(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("BotId", req.GetBotId()).Error("reset bot token error")
+		return nil, err
+	}
+
+	return &pb.ResetBotTokenResponse{
+		Token: response.GetToken(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) RevokeBotToken(ctx context.Context, req *pb.RevokeBotTokenRequest) (*pb.ActionResponse, error) {
+	authClient := pb_auth.NewAuthServiceClient(s.AuthConn)
+	request := pb_auth.RevokeBotTokenRequest{
+		BotId: req.GetBotId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "auth_service.RevokeBotToken")
+	defer span.Finish()
+
+	response, err := authClient.RevokeBotToken(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("BotId", req.GetBotId()).Error("revoke bot token error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+// AddBotToServer - authorizes the bot for the current user, then the server owner check is done by the server service
+func (s *DiscordGatewayService) AddBotToServer(ctx context.Context, req *pb.AddBotToServerRequest) (*pb.ActionResponse, error) {
+	userClient := pb_user.NewUserServiceClient(s.UserConn)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.AddBotToServer")
+	defer span.Finish()
+
+	bot, err := userClient.GetBot(ctx, &pb_user.GetBotRequest{
+		BotId: req.GetBotId(),
+	})
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("BotId", req.GetBotId()).Error("get bot error")
+		return nil, err
+	}
+
+	if !bot.GetPublic() && bot.GetOwnerId() != currentUserId(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "bot is private")
+	}
+
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	response, err := serverClient.AddBotToServer(ctx, &pb_server.AddBotToServerRequest{
+		ServerId: req.GetServerId(),
+		BotId:    req.GetBotId(),
+	})
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("add bot to server error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+// VerifyBotToken - bot user id by the bot token, used by the authorization middleware
+func (s *DiscordGatewayService) VerifyBotToken(ctx context.Context, token string) (string, error) {
+	authClient := pb_auth.NewAuthServiceClient(s.AuthConn)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "auth_service.VerifyBotToken")
+	defer span.Finish()
+
+	response, err := authClient.VerifyBotToken(ctx, &pb_auth.VerifyBotTokenRequest{
+		Token: token,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return response.GetBotId(), nil
+}
+
+// currentUserId - user id set by the authorization middleware
+func currentUserId(ctx context.Context) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	userIds := md.Get("userId")
+	if len(userIds) == 0 {
+		return ""
+	}
+
+	return userIds[0]
+}
+
+func botToPb(bot *pb_auth.Bot) *pb.Bot {
+	return &pb.Bot{
+		Id:          bot.GetId(),
+		OwnerId:     bot.GetOwnerId(),
+		Name:        bot.GetName(),
+		Description: bot.GetDescription(),
+		Public:      bot.GetPublic(),
+		HasToken:    bot.GetHasToken(),
+		CreatedAt:   bot.GetCreatedAt(),
+	}
+}
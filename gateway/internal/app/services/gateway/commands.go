@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	pb_server "github.com/Nixonxp/discord/gateway/pkg/api/server"
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	"github.com/opentracing/opentracing-go"
+)
+
+func (s *DiscordGatewayService) CreateCommand(ctx context.Context, req *pb.CreateCommandRequest) (*pb.Command, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	options := make([]*pb_server.CommandOption, len(req.GetOptions()))
+	for i, option := range req.GetOptions() {
+		options[i] = &pb_server.CommandOption{
+			Name:        option.GetName(),
+			Description: option.GetDescription(),
+			Type:        option.GetType(),
+			Required:    option.GetRequired(),
+		}
+	}
+	request := pb_server.CreateCommandRequest{
+		ServerId:    req.GetServerId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Options:     options,
+		HandlerUrl:  req.GetHandlerUrl(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateCommand")
+	defer span.Finish()
+
+	response, err := serverClient.CreateCommand(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("create command error")
+		return nil, err
+	}
+
+	return commandToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListCommands(ctx context.Context, req *pb.ListCommandsRequest) (*pb.ListCommandsResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListCommandsRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListCommands")
+	defer span.Finish()
+
+	response, err := serverClient.ListCommands(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("list commands error")
+		return nil, err
+	}
+
+	commands := make([]*pb.Command, len(response.GetCommands()))
+	for i, command := range response.GetCommands() {
+		commands[i] = commandToPb(command)
+	}
+
+	return &pb.ListCommandsResponse{
+		Commands: commands,
+	}, nil
+}
+
+func (s *DiscordGatewayService) DeleteCommand(ctx context.Context, req *pb.DeleteCommandRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.DeleteCommandRequest{
+		ServerId:  req.GetServerId(),
+		CommandId: req.GetCommandId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.DeleteCommand")
+	defer span.Finish()
+
+	response, err := serverClient.DeleteCommand(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("CommandId", req.GetCommandId()).Error("delete command error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) RespondInteraction(ctx context.Context, req *pb.RespondInteractionRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.RespondInteractionRequest{
+		InteractionId: req.GetInteractionId(),
+		Token:         req.GetToken(),
+		Content:       req.GetContent(),
+		Ephemeral:     req.GetEphemeral(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.RespondInteraction")
+	defer span.Finish()
+
+	response, err := serverClient.RespondInteraction(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("InteractionId", req.GetInteractionId()).Error("respond interaction error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func commandToPb(command *pb_server.Command) *pb.Command {
+	options := make([]*pb.CommandOption, len(command.GetOptions()))
+	for i, option := range command.GetOptions() {
+		options[i] = &pb.CommandOption{
+			Name:        option.GetName(),
+			Description: option.GetDescription(),
+			Type:        option.GetType(),
+			Required:    option.GetRequired(),
+		}
+	}
+
+	return &pb.Command{
+		Id:          command.GetId(),
+		ServerId:    command.GetServerId(),
+		Name:        command.GetName(),
+		Description: command.GetDescription(),
+		Options:     options,
+		HandlerUrl:  command.GetHandlerUrl(),
+		Secret:      command.GetSecret(),
+		CreatorId:   command.GetCreatorId(),
+		CreatedAt:   command.GetCreatedAt(),
+	}
+}
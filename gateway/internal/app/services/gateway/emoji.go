@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	pb_server "github.com/Nixonxp/discord/gateway/pkg/api/server"
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	"github.com/opentracing/opentracing-go"
+)
+
+// emojiImagePath - public url of emoji images, served by the gateway
+const emojiImagePath = "/api/v1/emoji/"
+
+func (s *DiscordGatewayService) CreateEmoji(ctx context.Context, req *pb.CreateEmojiRequest) (*pb.Emoji, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.CreateEmojiRequest{
+		ServerId: req.GetServerId(),
+		Name:     req.GetName(),
+		Image:    req.GetImage(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateEmoji")
+	defer span.Finish()
+
+	response, err := serverClient.CreateEmoji(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("create emoji error")
+		return nil, err
+	}
+
+	return emojiToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListServerEmoji(ctx context.Context, req *pb.ListServerEmojiRequest) (*pb.ListServerEmojiResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListServerEmojiRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListServerEmoji")
+	defer span.Finish()
+
+	response, err := serverClient.ListServerEmoji(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("list server emoji error")
+		return nil, err
+	}
+
+	emoji := make([]*pb.Emoji, len(response.GetEmoji()))
+	for i, e := range response.GetEmoji() {
+		emoji[i] = emojiToPb(e)
+	}
+
+	return &pb.ListServerEmojiResponse{
+		Emoji: emoji,
+	}, nil
+}
+
+func (s *DiscordGatewayService) RenameEmoji(ctx context.Context, req *pb.RenameEmojiRequest) (*pb.Emoji, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.RenameEmojiRequest{
+		ServerId: req.GetServerId(),
+		EmojiId:  req.GetEmojiId(),
+		Name:     req.GetName(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.RenameEmoji")
+	defer span.Finish()
+
+	response, err := serverClient.RenameEmoji(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("EmojiId", req.GetEmojiId()).Error("rename emoji error")
+		return nil, err
+	}
+
+	return emojiToPb(response), nil
+}
+
+func (s *DiscordGatewayService) DeleteEmoji(ctx context.Context, req *pb.DeleteEmojiRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.DeleteEmojiRequest{
+		ServerId: req.GetServerId(),
+		EmojiId:  req.GetEmojiId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.DeleteEmoji")
+	defer span.Finish()
+
+	response, err := serverClient.DeleteEmoji(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("EmojiId", req.GetEmojiId()).Error("delete emoji error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) GetEmojiImage(ctx context.Context, req *pb.GetEmojiImageRequest) (*pb.EmojiImage, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.GetEmojiImageRequest{
+		EmojiId: req.GetEmojiId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetEmojiImage")
+	defer span.Finish()
+
+	response, err := serverClient.GetEmojiImage(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("EmojiId", req.GetEmojiId()).Error("get emoji image error")
+		return nil, err
+	}
+
+	return &pb.EmojiImage{
+		ContentType: response.GetContentType(),
+		Data:        response.GetData(),
+	}, nil
+}
+
+func emojiToPb(emoji *pb_server.Emoji) *pb.Emoji {
+	return &pb.Emoji{
+		Id:          emoji.GetId(),
+		ServerId:    emoji.GetServerId(),
+		Name:        emoji.GetName(),
+		ContentType: emoji.GetContentType(),
+		Size:        emoji.GetSize(),
+		CreatorId:   emoji.GetCreatorId(),
+		CreatedAt:   emoji.GetCreatedAt(),
+		Url:         emojiImagePath + emoji.GetId(),
+	}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"errors"
+	pb_chat "github.com/Nixonxp/discord/gateway/pkg/api/chat"
+	pb_server "github.com/Nixonxp/discord/gateway/pkg/api/server"
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	"github.com/opentracing/opentracing-go"
+	"io"
+)
+
+// exportChunk - chat and server services send export chunks of their own proto types
+type exportChunk interface {
+	GetData() []byte
+}
+
+type exportChunkStream[T exportChunk] interface {
+	Recv() (T, error)
+}
+
+func (s *DiscordGatewayService) ExportServerChat(ctx context.Context, req *pb.ExportServerChatRequest, w io.Writer) error {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ExportServerChatRequest{
+		ServerId: req.GetServerId(),
+		Format:   req.GetFormat(),
+		From:     req.GetFrom(),
+		To:       req.GetTo(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ExportServerChat")
+	defer span.Finish()
+
+	stream, err := serverClient.ExportServerChat(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("export server chat error")
+		return err
+	}
+
+	return copyExportChunks[*pb_server.ExportChatChunk](stream, w)
+}
+
+func (s *DiscordGatewayService) ExportPrivateChat(ctx context.Context, req *pb.ExportPrivateChatRequest, w io.Writer) error {
+	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
+	request := pb_chat.ExportChatRequest{
+		UserId: req.GetUserId(),
+		Format: req.GetFormat(),
+		From:   req.GetFrom(),
+		To:     req.GetTo(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.ExportChat")
+	defer span.Finish()
+
+	stream, err := chatClient.ExportChat(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("export private chat error")
+		return err
+	}
+
+	return copyExportChunks[*pb_chat.ExportChatChunk](stream, w)
+}
+
+// copyExportChunks - writes received chunks into w until the stream ends
+func copyExportChunks[T exportChunk](stream exportChunkStream[T], w io.Writer) error {
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return err
+		}
+	}
+}
@@ -24,11 +24,14 @@ type Deps struct {
 
 type DiscordGatewayService struct {
 	Deps
+
+	profiles *profileCache
 }
 
 func NewDiscordGatewayService(d Deps) *DiscordGatewayService {
 	return &DiscordGatewayService{
-		d,
+		Deps:     d,
+		profiles: newProfileCache(profileCacheTTL, profileCacheSize),
 	}
 }
 
@@ -354,7 +357,11 @@ func (s *DiscordGatewayService) CreateServer(ctx context.Context, req *pb.Create
 func (s *DiscordGatewayService) SearchServer(ctx context.Context, req *pb.SearchServerRequest) (*pb.SearchServerResponse, error) {
 	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
 	request := pb_server.SearchServerRequest{
-		Name: req.GetName(),
+		Name:     req.GetName(),
+		Tags:     req.GetTags(),
+		Category: req.GetCategory(),
+		Cursor:   req.GetCursor(),
+		Limit:    req.GetLimit(),
 	}
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.SearchServer")
@@ -369,16 +376,226 @@ func (s *DiscordGatewayService) SearchServer(ctx context.Context, req *pb.Search
 	serverList := make([]*pb.ServerInfo, len(response.GetServers()))
 	for i, server := range response.GetServers() {
 		serverList[i] = &pb.ServerInfo{
-			Id:   server.GetId(),
-			Name: server.GetName(),
+			Id:          server.GetId(),
+			Name:        server.GetName(),
+			Description: server.GetDescription(),
+			IconUrl:     server.GetIconUrl(),
+			Tags:        server.GetTags(),
+			Category:    server.GetCategory(),
+			MemberCount: server.GetMemberCount(),
 		}
 	}
 
 	return &pb.SearchServerResponse{
-		Servers: serverList,
+		Servers:    serverList,
+		NextCursor: response.GetNextCursor(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) GetServer(ctx context.Context, req *pb.GetServerRequest) (*pb.ServerProfile, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.GetServerRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetServer")
+	defer span.Finish()
+
+	response, err := serverClient.GetServer(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("get server error")
+		return nil, err
+	}
+
+	return serverProfileToPb(response), nil
+}
+
+func (s *DiscordGatewayService) UpdateServer(ctx context.Context, req *pb.UpdateServerRequest) (*pb.ServerProfile, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.UpdateServerRequest{
+		ServerId:     req.GetServerId(),
+		Name:         req.Name,
+		Description:  req.Description,
+		IconUrl:      req.IconUrl,
+		BannerUrl:    req.BannerUrl,
+		Visibility:   req.Visibility,
+		Category:     req.Category,
+		Discoverable: req.Discoverable,
+	}
+	if req.GetTags() != nil {
+		request.Tags = &pb_server.ServerTags{
+			Tags: req.GetTags().GetTags(),
+		}
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.UpdateServer")
+	defer span.Finish()
+
+	response, err := serverClient.UpdateServer(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("update server error")
+		return nil, err
+	}
+
+	return serverProfileToPb(response), nil
+}
+
+func (s *DiscordGatewayService) DeleteServer(ctx context.Context, req *pb.DeleteServerRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.DeleteServerRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.DeleteServer")
+	defer span.Finish()
+
+	response, err := serverClient.DeleteServer(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("delete server error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) RestoreServer(ctx context.Context, req *pb.RestoreServerRequest) (*pb.ServerProfile, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.RestoreServerRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.RestoreServer")
+	defer span.Finish()
+
+	response, err := serverClient.RestoreServer(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("restore server error")
+		return nil, err
+	}
+
+	return serverProfileToPb(response), nil
+}
+
+func (s *DiscordGatewayService) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.ServerProfile, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.TransferOwnershipRequest{
+		ServerId:   req.GetServerId(),
+		NewOwnerId: req.GetNewOwnerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.TransferOwnership")
+	defer span.Finish()
+
+	response, err := serverClient.TransferOwnership(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("transfer ownership error")
+		return nil, err
+	}
+
+	return serverProfileToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListServerMembers(ctx context.Context, req *pb.ListServerMembersRequest) (*pb.ListServerMembersResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListServerMembersRequest{
+		ServerId: req.GetServerId(),
+		Cursor:   req.GetCursor(),
+		Limit:    req.GetLimit(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListServerMembers")
+	defer span.Finish()
+
+	response, err := serverClient.ListServerMembers(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("list server members error")
+		return nil, err
+	}
+
+	members := make([]*pb.ServerMember, len(response.GetMembers()))
+	for i, member := range response.GetMembers() {
+		members[i] = serverMemberToPb(member)
+	}
+	s.enrichMembers(ctx, members)
+
+	return &pb.ListServerMembersResponse{
+		Members:    members,
+		NextCursor: response.GetNextCursor(),
 	}, nil
 }
 
+func (s *DiscordGatewayService) GetMember(ctx context.Context, req *pb.GetMemberRequest) (*pb.ServerMember, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.GetMemberRequest{
+		ServerId: req.GetServerId(),
+		UserId:   req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetMember")
+	defer span.Finish()
+
+	response, err := serverClient.GetMember(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("get member error")
+		return nil, err
+	}
+
+	member := serverMemberToPb(response)
+	s.enrichMembers(ctx, []*pb.ServerMember{member})
+
+	return member, nil
+}
+
+func (s *DiscordGatewayService) SetMemberNickname(ctx context.Context, req *pb.SetMemberNicknameRequest) (*pb.ServerMember, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.SetMemberNicknameRequest{
+		ServerId: req.GetServerId(),
+		UserId:   req.GetUserId(),
+		Nickname: req.GetNickname(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.SetMemberNickname")
+	defer span.Finish()
+
+	response, err := serverClient.SetMemberNickname(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("set member nickname error")
+		return nil, err
+	}
+
+	member := serverMemberToPb(response)
+	s.enrichMembers(ctx, []*pb.ServerMember{member})
+
+	return member, nil
+}
+
+func serverMemberToPb(member *pb_server.Member) *pb.ServerMember {
+	return &pb.ServerMember{
+		UserId:   member.GetUserId(),
+		JoinedAt: member.GetJoinedAt(),
+		IsOwner:  member.GetIsOwner(),
+		Nickname: member.GetNickname(),
+	}
+}
+
+func serverProfileToPb(server *pb_server.ServerProfile) *pb.ServerProfile {
+	return &pb.ServerProfile{
+		Id:           server.GetId(),
+		Name:         server.GetName(),
+		OwnerId:      server.GetOwnerId(),
+		Description:  server.GetDescription(),
+		IconUrl:      server.GetIconUrl(),
+		BannerUrl:    server.GetBannerUrl(),
+		Visibility:   server.GetVisibility(),
+		MemberCount:  server.GetMemberCount(),
+		Tags:         server.GetTags(),
+		Category:     server.GetCategory(),
+		Discoverable: server.GetDiscoverable(),
+	}
+}
+
 func (s *DiscordGatewayService) SubscribeServer(ctx context.Context, req *pb.SubscribeServerRequest) (*pb.ActionResponse, error) {
 	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
 	request := pb_server.SubscribeServerRequest{
@@ -497,51 +714,73 @@ func (s *DiscordGatewayService) GetMessagesFromServer(ctx context.Context, req *
 	}
 
 	messages := make([]*pb.Message, len(response.GetMessages()))
+	nicknames := make(map[string]string)
 	for i, m := range response.GetMessages() {
 		messages[i] = &pb.Message{
 			Id:        m.GetId(),
 			Text:      m.GetText(),
 			Timestamp: m.GetTimestamp(),
+			OwnerId:   m.GetOwnerId(),
+			WebhookId: m.GetWebhookId(),
+			Ephemeral: m.GetEphemeral(),
+		}
+		if m.GetWebhookId() != "" {
+			messages[i].Author = &pb.MessageAuthor{
+				Id:             m.GetWebhookId(),
+				Name:           m.GetUsername(),
+				AvatarPhotoUrl: m.GetAvatarUrl(),
+			}
+			continue
+		}
+		if m.GetAuthorNickname() != "" {
+			nicknames[m.GetOwnerId()] = m.GetAuthorNickname()
 		}
 	}
 
+	s.enrichAuthors(ctx, messages, nicknames)
+
 	return &pb.GetMessagesResponse{
 		Messages: messages,
 	}, nil
 }
 
-func (s *DiscordGatewayService) AddChannel(ctx context.Context, req *pb.AddChannelRequest) (*pb.ActionResponse, error) {
-	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
-	request := pb_channel.AddChannelRequest{
-		Name: req.GetName(),
+func (s *DiscordGatewayService) CreateModerationRule(ctx context.Context, req *pb.CreateModerationRuleRequest) (*pb.ModerationRule, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.CreateModerationRuleRequest{
+		ServerId:       req.GetServerId(),
+		Type:           req.GetType(),
+		Words:          req.GetWords(),
+		Pattern:        req.GetPattern(),
+		Limit:          req.GetLimit(),
+		Action:         req.GetAction(),
+		TimeoutSeconds: req.GetTimeoutSeconds(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.AddChannel")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateModerationRule")
 	defer span.Finish()
 
-	response, err := channelClient.AddChannel(ctx, &request)
+	response, err := serverClient.CreateModerationRule(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("name", req.GetName()).Error("add channel error")
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("create moderation rule error")
 		return nil, err
 	}
 
-	return &pb.ActionResponse{
-		Success: response.GetSuccess(),
-	}, nil
+	return moderationRuleToPb(response), nil
 }
 
-func (s *DiscordGatewayService) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.ActionResponse, error) {
-	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
-	request := pb_channel.DeleteChannelRequest{
-		ChannelId: req.GetChannelId(),
+func (s *DiscordGatewayService) DeleteModerationRule(ctx context.Context, req *pb.DeleteModerationRuleRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.DeleteModerationRuleRequest{
+		ServerId: req.GetServerId(),
+		RuleId:   req.GetRuleId(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.DeleteChannel")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.DeleteModerationRule")
 	defer span.Finish()
 
-	response, err := channelClient.DeleteChannel(ctx, &request)
+	response, err := serverClient.DeleteModerationRule(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("delete channel error")
+		s.Log.WithContext(ctx).WithError(err).WithField("RuleId", req.GetRuleId()).Error("delete moderation rule error")
 		return nil, err
 	}
 
@@ -550,114 +789,1220 @@ func (s *DiscordGatewayService) DeleteChannel(ctx context.Context, req *pb.Delet
 	}, nil
 }
 
-func (s *DiscordGatewayService) JoinChannel(ctx context.Context, req *pb.JoinChannelRequest) (*pb.ActionResponse, error) {
-	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
-	request := pb_channel.JoinChannelRequest{
-		ChannelId: req.GetChannelId(),
+func (s *DiscordGatewayService) GetModerationRules(ctx context.Context, req *pb.GetModerationRulesRequest) (*pb.GetModerationRulesResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.GetModerationRulesRequest{
+		ServerId: req.GetServerId(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.JoinChannel")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetModerationRules")
 	defer span.Finish()
 
-	response, err := channelClient.JoinChannel(ctx, &request)
+	response, err := serverClient.GetModerationRules(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("join channel error")
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("get moderation rules error")
 		return nil, err
 	}
 
-	return &pb.ActionResponse{
-		Success: response.GetSuccess(),
+	rules := make([]*pb.ModerationRule, len(response.GetRules()))
+	for i, r := range response.GetRules() {
+		rules[i] = moderationRuleToPb(r)
+	}
+
+	return &pb.GetModerationRulesResponse{
+		Rules: rules,
 	}, nil
 }
 
-func (s *DiscordGatewayService) LeaveChannel(ctx context.Context, req *pb.LeaveChannelRequest) (*pb.ActionResponse, error) {
-	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
-	request := pb_channel.LeaveChannelRequest{
-		ChannelId: req.GetChannelId(),
+func (s *DiscordGatewayService) GetModerationActions(ctx context.Context, req *pb.GetModerationActionsRequest) (*pb.GetModerationActionsResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.GetModerationActionsRequest{
+		ServerId: req.GetServerId(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.LeaveChannel")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetModerationActions")
 	defer span.Finish()
 
-	response, err := channelClient.LeaveChannel(ctx, &request)
+	response, err := serverClient.GetModerationActions(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("leave channel error")
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("get moderation actions error")
 		return nil, err
 	}
 
-	return &pb.ActionResponse{
-		Success: response.GetSuccess(),
+	actions := make([]*pb.ModerationAction, len(response.GetActions()))
+	for i, a := range response.GetActions() {
+		actions[i] = &pb.ModerationAction{
+			Id:        a.GetId(),
+			ServerId:  a.GetServerId(),
+			RuleId:    a.GetRuleId(),
+			RuleType:  a.GetRuleType(),
+			Action:    a.GetAction(),
+			AuthorId:  a.GetAuthorId(),
+			ChatId:    a.GetChatId(),
+			Text:      a.GetText(),
+			CreatedAt: a.GetCreatedAt(),
+			ExpiresAt: a.GetExpiresAt(),
+		}
+	}
+
+	return &pb.GetModerationActionsResponse{
+		Actions: actions,
 	}, nil
 }
 
-func (s *DiscordGatewayService) CreatePrivateChat(ctx context.Context, req *pb.CreatePrivateChatRequest) (*pb.CreatePrivateChatResponse, error) {
-	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
-	request := pb_chat.CreatePrivateChatRequest{
-		UserId: req.GetUserId(),
+func (s *DiscordGatewayService) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.GetAuditLogRequest{
+		ServerId: req.GetServerId(),
+		ActorId:  req.GetActorId(),
+		Action:   req.GetAction(),
+		Cursor:   req.GetCursor(),
+		Limit:    req.GetLimit(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.CreatePrivateChat")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.GetAuditLog")
 	defer span.Finish()
 
-	response, err := chatClient.CreatePrivateChat(ctx, &request)
+	response, err := serverClient.GetAuditLog(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("create private chat error")
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("get audit log error")
 		return nil, err
 	}
 
-	return &pb.CreatePrivateChatResponse{
-		Success: response.GetSuccess(),
-		ChatId:  response.GetChatId(),
+	entries := make([]*pb.AuditEntry, len(response.GetEntries()))
+	for i, e := range response.GetEntries() {
+		entries[i] = &pb.AuditEntry{
+			Id:         e.GetId(),
+			ServerId:   e.GetServerId(),
+			ActorId:    e.GetActorId(),
+			Action:     e.GetAction(),
+			TargetType: e.GetTargetType(),
+			TargetId:   e.GetTargetId(),
+			Before:     e.GetBefore(),
+			After:      e.GetAfter(),
+			CreatedAt:  e.GetCreatedAt(),
+		}
+	}
+
+	return &pb.GetAuditLogResponse{
+		Entries:    entries,
+		NextCursor: response.GetNextCursor(),
 	}, nil
 }
 
-func (s *DiscordGatewayService) SendUserPrivateMessage(ctx context.Context, req *pb.SendUserPrivateMessageRequest) (*pb.ActionResponse, error) {
-	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
-	request := pb_chat.SendUserPrivateMessageRequest{
-		UserId: req.GetUserId(),
-		Text:   req.GetText(),
+func (s *DiscordGatewayService) CreateServerTemplate(ctx context.Context, req *pb.CreateServerTemplateRequest) (*pb.ServerTemplate, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.CreateServerTemplateRequest{
+		ServerId: req.GetServerId(),
+		Name:     req.GetName(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.SendUserPrivateMessage")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateServerTemplate")
 	defer span.Finish()
 
-	response, err := chatClient.SendUserPrivateMessage(ctx, &request)
+	response, err := serverClient.CreateServerTemplate(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("send user private message error")
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("create server template error")
 		return nil, err
 	}
 
-	return &pb.ActionResponse{
-		Success: response.GetSuccess(),
+	return serverTemplateToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListTemplatesRequest{}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListTemplates")
+	defer span.Finish()
+
+	response, err := serverClient.ListTemplates(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).Error("list templates error")
+		return nil, err
+	}
+
+	templates := make([]*pb.ServerTemplate, len(response.GetTemplates()))
+	for i, t := range response.GetTemplates() {
+		templates[i] = serverTemplateToPb(t)
+	}
+
+	return &pb.ListTemplatesResponse{
+		Templates: templates,
 	}, nil
 }
 
-func (s *DiscordGatewayService) GetUserPrivateMessages(ctx context.Context, req *pb.GetUserPrivateMessagesRequest) (*pb.GetMessagesResponse, error) {
-	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
-	request := pb_chat.GetUserPrivateMessagesRequest{
-		UserId: req.GetUserId(),
+func (s *DiscordGatewayService) CreateServerFromTemplate(ctx context.Context, req *pb.CreateServerFromTemplateRequest) (*pb.ServerProfile, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.CreateServerFromTemplateRequest{
+		TemplateId: req.GetTemplateId(),
+		Name:       req.GetName(),
 	}
 
-	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.GetUserPrivateMessages")
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateServerFromTemplate")
 	defer span.Finish()
 
-	response, err := chatClient.GetUserPrivateMessages(ctx, &request)
+	response, err := serverClient.CreateServerFromTemplate(ctx, &request)
 	if err != nil {
-		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("get user private messages error")
+		s.Log.WithContext(ctx).WithError(err).WithField("TemplateId", req.GetTemplateId()).Error("create server from template error")
 		return nil, err
 	}
 
-	messages := make([]*pb.Message, len(response.GetMessages()))
-	for i, m := range response.GetMessages() {
-		messages[i] = &pb.Message{
-			Id:        m.GetId(),
-			Text:      m.GetText(),
-			Timestamp: m.GetTimestamp(),
-			OwnerId:   m.OwnerId,
-			ChatId:    m.ChatId,
-		}
+	return serverProfileToPb(response), nil
+}
+
+func serverTemplateToPb(template *pb_server.ServerTemplate) *pb.ServerTemplate {
+	return &pb.ServerTemplate{
+		Id:             template.GetId(),
+		Name:           template.GetName(),
+		SourceServerId: template.GetSourceServerId(),
+		OwnerId:        template.GetOwnerId(),
+		Description:    template.GetDescription(),
+		IconUrl:        template.GetIconUrl(),
+		BannerUrl:      template.GetBannerUrl(),
+		Visibility:     template.GetVisibility(),
+		Tags:           template.GetTags(),
+		Category:       template.GetCategory(),
+		Channels:       template.GetChannels(),
+		CreatedAt:      template.GetCreatedAt(),
+	}
+}
+
+func (s *DiscordGatewayService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.CreateWebhookRequest{
+		ServerId:  req.GetServerId(),
+		Name:      req.GetName(),
+		AvatarUrl: req.GetAvatarUrl(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateWebhook")
+	defer span.Finish()
+
+	response, err := serverClient.CreateWebhook(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("create webhook error")
+		return nil, err
+	}
+
+	return webhookToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListWebhooksRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListWebhooks")
+	defer span.Finish()
+
+	response, err := serverClient.ListWebhooks(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("list webhooks error")
+		return nil, err
+	}
+
+	webhooks := make([]*pb.Webhook, len(response.GetWebhooks()))
+	for i, w := range response.GetWebhooks() {
+		webhooks[i] = webhookToPb(w)
+	}
+
+	return &pb.ListWebhooksResponse{
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (s *DiscordGatewayService) RegenerateWebhookToken(ctx context.Context, req *pb.RegenerateWebhookTokenRequest) (*pb.Webhook, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.RegenerateWebhookTokenRequest{
+		ServerId:  req.GetServerId(),
+		WebhookId: req.GetWebhookId(),
 	}
 
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.RegenerateWebhookToken")
+	defer span.Finish()
+
+	response, err := serverClient.RegenerateWebhookToken(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("WebhookId", req.GetWebhookId()).Error("regenerate webhook token error")
+		return nil, err
+	}
+
+	return webhookToPb(response), nil
+}
+
+func (s *DiscordGatewayService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.DeleteWebhookRequest{
+		ServerId:  req.GetServerId(),
+		WebhookId: req.GetWebhookId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.DeleteWebhook")
+	defer span.Finish()
+
+	response, err := serverClient.DeleteWebhook(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("WebhookId", req.GetWebhookId()).Error("delete webhook error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+// ExecuteWebhook - public route, the token is not logged
+func (s *DiscordGatewayService) ExecuteWebhook(ctx context.Context, req *pb.ExecuteWebhookRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ExecuteWebhookRequest{
+		WebhookId: req.GetWebhookId(),
+		Token:     req.GetToken(),
+		Content:   req.GetContent(),
+		Username:  req.GetUsername(),
+		AvatarUrl: req.GetAvatarUrl(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ExecuteWebhook")
+	defer span.Finish()
+
+	response, err := serverClient.ExecuteWebhook(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("WebhookId", req.GetWebhookId()).Error("execute webhook error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func webhookToPb(webhook *pb_server.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:        webhook.GetId(),
+		ServerId:  webhook.GetServerId(),
+		Name:      webhook.GetName(),
+		AvatarUrl: webhook.GetAvatarUrl(),
+		CreatorId: webhook.GetCreatorId(),
+		Token:     webhook.GetToken(),
+		CreatedAt: webhook.GetCreatedAt(),
+	}
+}
+
+func (s *DiscordGatewayService) CreateOutgoingWebhook(ctx context.Context, req *pb.CreateOutgoingWebhookRequest) (*pb.OutgoingWebhook, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.CreateOutgoingWebhookRequest{
+		ServerId: req.GetServerId(),
+		Url:      req.GetUrl(),
+		Events:   req.GetEvents(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.CreateOutgoingWebhook")
+	defer span.Finish()
+
+	response, err := serverClient.CreateOutgoingWebhook(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("create outgoing webhook error")
+		return nil, err
+	}
+
+	return outgoingWebhookToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListOutgoingWebhooks(ctx context.Context, req *pb.ListOutgoingWebhooksRequest) (*pb.ListOutgoingWebhooksResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListOutgoingWebhooksRequest{
+		ServerId: req.GetServerId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListOutgoingWebhooks")
+	defer span.Finish()
+
+	response, err := serverClient.ListOutgoingWebhooks(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("list outgoing webhooks error")
+		return nil, err
+	}
+
+	webhooks := make([]*pb.OutgoingWebhook, len(response.GetWebhooks()))
+	for i, w := range response.GetWebhooks() {
+		webhooks[i] = outgoingWebhookToPb(w)
+	}
+
+	return &pb.ListOutgoingWebhooksResponse{
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (s *DiscordGatewayService) EnableOutgoingWebhook(ctx context.Context, req *pb.EnableOutgoingWebhookRequest) (*pb.OutgoingWebhook, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.EnableOutgoingWebhookRequest{
+		ServerId:  req.GetServerId(),
+		WebhookId: req.GetWebhookId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.EnableOutgoingWebhook")
+	defer span.Finish()
+
+	response, err := serverClient.EnableOutgoingWebhook(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("WebhookId", req.GetWebhookId()).Error("enable outgoing webhook error")
+		return nil, err
+	}
+
+	return outgoingWebhookToPb(response), nil
+}
+
+func (s *DiscordGatewayService) DeleteOutgoingWebhook(ctx context.Context, req *pb.DeleteOutgoingWebhookRequest) (*pb.ActionResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.DeleteOutgoingWebhookRequest{
+		ServerId:  req.GetServerId(),
+		WebhookId: req.GetWebhookId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.DeleteOutgoingWebhook")
+	defer span.Finish()
+
+	response, err := serverClient.DeleteOutgoingWebhook(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("WebhookId", req.GetWebhookId()).Error("delete outgoing webhook error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ListOutgoingDeliveries(ctx context.Context, req *pb.ListOutgoingDeliveriesRequest) (*pb.ListOutgoingDeliveriesResponse, error) {
+	serverClient := pb_server.NewServerServiceClient(s.ServerConn)
+	request := pb_server.ListOutgoingDeliveriesRequest{
+		ServerId:  req.GetServerId(),
+		WebhookId: req.GetWebhookId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "server_service.ListOutgoingDeliveries")
+	defer span.Finish()
+
+	response, err := serverClient.ListOutgoingDeliveries(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("WebhookId", req.GetWebhookId()).Error("list outgoing deliveries error")
+		return nil, err
+	}
+
+	deliveries := make([]*pb.OutgoingDelivery, len(response.GetDeliveries()))
+	for i, d := range response.GetDeliveries() {
+		deliveries[i] = &pb.OutgoingDelivery{
+			Id:             d.GetId(),
+			WebhookId:      d.GetWebhookId(),
+			EventId:        d.GetEventId(),
+			EventType:      d.GetEventType(),
+			Status:         d.GetStatus(),
+			Attempts:       d.GetAttempts(),
+			LastStatusCode: d.GetLastStatusCode(),
+			LastError:      d.GetLastError(),
+			NextAttemptAt:  d.GetNextAttemptAt(),
+			CreatedAt:      d.GetCreatedAt(),
+			UpdatedAt:      d.GetUpdatedAt(),
+		}
+	}
+
+	return &pb.ListOutgoingDeliveriesResponse{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func outgoingWebhookToPb(webhook *pb_server.OutgoingWebhook) *pb.OutgoingWebhook {
+	return &pb.OutgoingWebhook{
+		Id:                  webhook.GetId(),
+		ServerId:            webhook.GetServerId(),
+		Url:                 webhook.GetUrl(),
+		Events:              webhook.GetEvents(),
+		Secret:              webhook.GetSecret(),
+		CreatorId:           webhook.GetCreatorId(),
+		Enabled:             webhook.GetEnabled(),
+		ConsecutiveFailures: webhook.GetConsecutiveFailures(),
+		DisabledAt:          webhook.GetDisabledAt(),
+		CreatedAt:           webhook.GetCreatedAt(),
+	}
+}
+
+func moderationRuleToPb(rule *pb_server.ModerationRule) *pb.ModerationRule {
+	return &pb.ModerationRule{
+		Id:             rule.GetId(),
+		ServerId:       rule.GetServerId(),
+		Type:           rule.GetType(),
+		Words:          rule.GetWords(),
+		Pattern:        rule.GetPattern(),
+		Limit:          rule.GetLimit(),
+		Action:         rule.GetAction(),
+		TimeoutSeconds: rule.GetTimeoutSeconds(),
+		CreatedAt:      rule.GetCreatedAt(),
+	}
+}
+
+func (s *DiscordGatewayService) AddChannel(ctx context.Context, req *pb.AddChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.AddChannelRequest{
+		Name:     req.GetName(),
+		ServerId: req.GetServerId(),
+		Type:     req.GetType(),
+		ParentId: req.GetParentId(),
+		Topic:    req.GetTopic(),
+		Nsfw:     req.GetNsfw(),
+		Voice:    voiceSettingsToChannelPb(req.GetVoice()),
+		Forum:    forumSettingsToChannelPb(req.GetForum()),
+		Private:  req.GetPrivate(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.AddChannel")
+	defer span.Finish()
+
+	response, err := channelClient.AddChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("name", req.GetName()).Error("add channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) UpdateChannel(ctx context.Context, req *pb.UpdateChannelRequest) (*pb.Channel, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.UpdateChannelRequest{
+		ChannelId: req.GetChannelId(),
+		Name:      req.Name,
+		Topic:     req.Topic,
+		Nsfw:      req.Nsfw,
+		Voice:     voiceSettingsToChannelPb(req.GetVoice()),
+		Forum:     forumSettingsToChannelPb(req.GetForum()),
+		Private:   req.Private,
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.UpdateChannel")
+	defer span.Finish()
+
+	response, err := channelClient.UpdateChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("update channel error")
+		return nil, err
+	}
+
+	return channelToPb(response), nil
+}
+
+func (s *DiscordGatewayService) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.DeleteChannelRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.DeleteChannel")
+	defer span.Finish()
+
+	response, err := channelClient.DeleteChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("delete channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) RestoreChannel(ctx context.Context, req *pb.RestoreChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.RestoreChannelRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.RestoreChannel")
+	defer span.Finish()
+
+	response, err := channelClient.RestoreChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("restore channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ArchiveChannel(ctx context.Context, req *pb.ArchiveChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ArchiveChannelRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ArchiveChannel")
+	defer span.Finish()
+
+	response, err := channelClient.ArchiveChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("archive channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) UnarchiveChannel(ctx context.Context, req *pb.ArchiveChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ArchiveChannelRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.UnarchiveChannel")
+	defer span.Finish()
+
+	response, err := channelClient.UnarchiveChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("unarchive channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) JoinChannel(ctx context.Context, req *pb.JoinChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.JoinChannelRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.JoinChannel")
+	defer span.Finish()
+
+	response, err := channelClient.JoinChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("join channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) LeaveChannel(ctx context.Context, req *pb.LeaveChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.LeaveChannelRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.LeaveChannel")
+	defer span.Finish()
+
+	response, err := channelClient.LeaveChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("leave channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ListMyChannels(ctx context.Context, req *pb.ListMyChannelsRequest) (*pb.ListMyChannelsResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ListMyChannelsRequest{
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ListMyChannels")
+	defer span.Finish()
+
+	response, err := channelClient.ListMyChannels(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).Error("list my channels error")
+		return nil, err
+	}
+
+	return &pb.ListMyChannelsResponse{
+		Channels: channelsToPb(response.GetChannels()),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ListChannelMembers(ctx context.Context, req *pb.ListChannelMembersRequest) (*pb.ListChannelMembersResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ListChannelMembersRequest{
+		ChannelId: req.GetChannelId(),
+		Cursor:    req.GetCursor(),
+		Limit:     req.GetLimit(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ListChannelMembers")
+	defer span.Finish()
+
+	response, err := channelClient.ListChannelMembers(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("list channel members error")
+		return nil, err
+	}
+
+	members := make([]*pb.ChannelMember, len(response.GetMembers()))
+	for i, member := range response.GetMembers() {
+		members[i] = &pb.ChannelMember{
+			UserId:   member.GetUserId(),
+			JoinedAt: member.GetJoinedAt(),
+		}
+	}
+
+	return &pb.ListChannelMembersResponse{
+		Members:    members,
+		NextCursor: response.GetNextCursor(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) IsChannelMember(ctx context.Context, req *pb.IsChannelMemberRequest) (*pb.IsChannelMemberResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.IsChannelMemberRequest{
+		ChannelId: req.GetChannelId(),
+		UserId:    req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.IsChannelMember")
+	defer span.Finish()
+
+	response, err := channelClient.IsChannelMember(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("is channel member error")
+		return nil, err
+	}
+
+	return &pb.IsChannelMemberResponse{
+		IsMember: response.GetIsMember(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) GrantChannelAccess(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ChannelAccessRequest{
+		ChannelId: req.GetChannelId(),
+		UserId:    req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.GrantChannelAccess")
+	defer span.Finish()
+
+	response, err := channelClient.GrantChannelAccess(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("grant channel access error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) RevokeChannelAccess(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ChannelAccessRequest{
+		ChannelId: req.GetChannelId(),
+		UserId:    req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.RevokeChannelAccess")
+	defer span.Finish()
+
+	response, err := channelClient.RevokeChannelAccess(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("revoke channel access error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) RequestChannelAccess(ctx context.Context, req *pb.RequestChannelAccessRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.RequestChannelAccessRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.RequestChannelAccess")
+	defer span.Finish()
+
+	response, err := channelClient.RequestChannelAccess(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("request channel access error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ListChannelJoinRequests(ctx context.Context, req *pb.ListChannelJoinRequestsRequest) (*pb.ListChannelJoinRequestsResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ListChannelJoinRequestsRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ListChannelJoinRequests")
+	defer span.Finish()
+
+	response, err := channelClient.ListChannelJoinRequests(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("list channel join requests error")
+		return nil, err
+	}
+
+	requests := make([]*pb.ChannelJoinRequest, len(response.GetRequests()))
+	for i, joinRequest := range response.GetRequests() {
+		requests[i] = &pb.ChannelJoinRequest{
+			UserId:      joinRequest.GetUserId(),
+			RequestedAt: joinRequest.GetRequestedAt(),
+		}
+	}
+
+	return &pb.ListChannelJoinRequestsResponse{
+		Requests: requests,
+	}, nil
+}
+
+func (s *DiscordGatewayService) ApproveChannelJoinRequest(ctx context.Context, req *pb.ChannelAccessRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ChannelAccessRequest{
+		ChannelId: req.GetChannelId(),
+		UserId:    req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ApproveChannelJoinRequest")
+	defer span.Finish()
+
+	response, err := channelClient.ApproveChannelJoinRequest(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("approve channel join request error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) SendChannelMessage(ctx context.Context, req *pb.SendChannelMessageRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.SendChannelMessageRequest{
+		ChannelId: req.GetChannelId(),
+		Text:      req.GetText(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.SendChannelMessage")
+	defer span.Finish()
+
+	response, err := channelClient.SendChannelMessage(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("send channel message error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) GetChannelMessages(ctx context.Context, req *pb.GetChannelMessagesRequest) (*pb.GetChannelMessagesResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.GetChannelMessagesRequest{
+		ChannelId: req.GetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.GetChannelMessages")
+	defer span.Finish()
+
+	response, err := channelClient.GetChannelMessages(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("get channel messages error")
+		return nil, err
+	}
+
+	return &pb.GetChannelMessagesResponse{
+		Messages: channelMessagesToPb(response.GetMessages()),
+	}, nil
+}
+
+func (s *DiscordGatewayService) CreateForumPost(ctx context.Context, req *pb.CreateForumPostRequest) (*pb.ForumPost, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.CreateForumPostRequest{
+		ChannelId: req.GetChannelId(),
+		Title:     req.GetTitle(),
+		Text:      req.GetText(),
+		Tags:      req.GetTags(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.CreateForumPost")
+	defer span.Finish()
+
+	response, err := channelClient.CreateForumPost(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("create forum post error")
+		return nil, err
+	}
+
+	return forumPostToPb(response), nil
+}
+
+func (s *DiscordGatewayService) ListForumPosts(ctx context.Context, req *pb.ListForumPostsRequest) (*pb.ListForumPostsResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ListForumPostsRequest{
+		ChannelId: req.GetChannelId(),
+		Sort:      req.GetSort(),
+		Tag:       req.GetTag(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ListForumPosts")
+	defer span.Finish()
+
+	response, err := channelClient.ListForumPosts(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("list forum posts error")
+		return nil, err
+	}
+
+	posts := make([]*pb.ForumPost, len(response.GetPosts()))
+	for i, post := range response.GetPosts() {
+		posts[i] = forumPostToPb(post)
+	}
+
+	return &pb.ListForumPostsResponse{
+		Posts: posts,
+	}, nil
+}
+
+func (s *DiscordGatewayService) SendForumPostMessage(ctx context.Context, req *pb.SendForumPostMessageRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.SendForumPostMessageRequest{
+		PostId: req.GetPostId(),
+		Text:   req.GetText(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.SendForumPostMessage")
+	defer span.Finish()
+
+	response, err := channelClient.SendForumPostMessage(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("PostId", req.GetPostId()).Error("send forum post message error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) GetForumPostMessages(ctx context.Context, req *pb.GetForumPostMessagesRequest) (*pb.GetChannelMessagesResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.GetForumPostMessagesRequest{
+		PostId: req.GetPostId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.GetForumPostMessages")
+	defer span.Finish()
+
+	response, err := channelClient.GetForumPostMessages(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("PostId", req.GetPostId()).Error("get forum post messages error")
+		return nil, err
+	}
+
+	return &pb.GetChannelMessagesResponse{
+		Messages: channelMessagesToPb(response.GetMessages()),
+	}, nil
+}
+
+func (s *DiscordGatewayService) FollowChannel(ctx context.Context, req *pb.FollowChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.FollowChannelRequest{
+		SourceChannelId: req.GetSourceChannelId(),
+		TargetChannelId: req.GetTargetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.FollowChannel")
+	defer span.Finish()
+
+	response, err := channelClient.FollowChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("SourceChannelId", req.GetSourceChannelId()).Error("follow channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) UnfollowChannel(ctx context.Context, req *pb.UnfollowChannelRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.FollowChannelRequest{
+		SourceChannelId: req.GetSourceChannelId(),
+		TargetChannelId: req.GetTargetChannelId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.UnfollowChannel")
+	defer span.Finish()
+
+	response, err := channelClient.UnfollowChannel(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("SourceChannelId", req.GetSourceChannelId()).Error("unfollow channel error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) PublishChannelMessage(ctx context.Context, req *pb.PublishChannelMessageRequest) (*pb.ActionResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.PublishChannelMessageRequest{
+		ChannelId: req.GetChannelId(),
+		MessageId: req.GetMessageId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.PublishChannelMessage")
+	defer span.Finish()
+
+	response, err := channelClient.PublishChannelMessage(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ChannelId", req.GetChannelId()).Error("publish channel message error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ListServerChannels(ctx context.Context, req *pb.ListServerChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	request := pb_channel.ListServerChannelsRequest{
+		ServerId:        req.GetServerId(),
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ListServerChannels")
+	defer span.Finish()
+
+	response, err := channelClient.ListServerChannels(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("list server channels error")
+		return nil, err
+	}
+
+	return &pb.ListServerChannelsResponse{
+		Channels: channelsToPb(response.GetChannels()),
+	}, nil
+}
+
+func (s *DiscordGatewayService) ReorderChannels(ctx context.Context, req *pb.ReorderChannelsRequest) (*pb.ListServerChannelsResponse, error) {
+	channelClient := pb_channel.NewChannelServiceClient(s.ChannelConn)
+	positions := make([]*pb_channel.ChannelPosition, len(req.GetPositions()))
+	for i, position := range req.GetPositions() {
+		positions[i] = &pb_channel.ChannelPosition{
+			ChannelId: position.GetChannelId(),
+			Position:  position.GetPosition(),
+			ParentId:  position.GetParentId(),
+		}
+	}
+	request := pb_channel.ReorderChannelsRequest{
+		ServerId:  req.GetServerId(),
+		Positions: positions,
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ReorderChannels")
+	defer span.Finish()
+
+	response, err := channelClient.ReorderChannels(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("ServerId", req.GetServerId()).Error("reorder channels error")
+		return nil, err
+	}
+
+	return &pb.ListServerChannelsResponse{
+		Channels: channelsToPb(response.GetChannels()),
+	}, nil
+}
+
+func channelsToPb(channels []*pb_channel.Channel) []*pb.Channel {
+	result := make([]*pb.Channel, len(channels))
+	for i, channel := range channels {
+		result[i] = channelToPb(channel)
+	}
+
+	return result
+}
+
+func channelToPb(channel *pb_channel.Channel) *pb.Channel {
+	result := &pb.Channel{
+		Id:         channel.GetId(),
+		Name:       channel.GetName(),
+		OwnerId:    channel.GetOwnerId(),
+		ServerId:   channel.GetServerId(),
+		Type:       channel.GetType(),
+		ParentId:   channel.GetParentId(),
+		Position:   channel.GetPosition(),
+		Topic:      channel.GetTopic(),
+		Nsfw:       channel.GetNsfw(),
+		Private:    channel.GetPrivate(),
+		ArchivedAt: channel.GetArchivedAt(),
+	}
+	if voice := channel.GetVoice(); voice != nil {
+		result.Voice = &pb.VoiceSettings{
+			Bitrate:   voice.GetBitrate(),
+			UserLimit: voice.GetUserLimit(),
+		}
+	}
+	if forum := channel.GetForum(); forum != nil {
+		result.Forum = &pb.ForumSettings{
+			DefaultSort: forum.GetDefaultSort(),
+			Tags:        forum.GetTags(),
+		}
+	}
+
+	return result
+}
+
+func forumPostToPb(post *pb_channel.ForumPost) *pb.ForumPost {
+	return &pb.ForumPost{
+		Id:             post.GetId(),
+		ChannelId:      post.GetChannelId(),
+		OwnerId:        post.GetOwnerId(),
+		Title:          post.GetTitle(),
+		Tags:           post.GetTags(),
+		CreatedAt:      post.GetCreatedAt(),
+		LastActivityAt: post.GetLastActivityAt(),
+	}
+}
+
+func channelMessagesToPb(data []*pb_channel.ChannelMessage) []*pb.ChannelMessage {
+	messages := make([]*pb.ChannelMessage, len(data))
+	for i, message := range data {
+		messages[i] = &pb.ChannelMessage{
+			Id:        message.GetId(),
+			Text:      message.GetText(),
+			Timestamp: message.GetTimestamp(),
+			OwnerId:   message.GetOwnerId(),
+		}
+		if reference := message.GetReference(); reference != nil {
+			messages[i].Reference = &pb.MessageReference{
+				ChannelId: reference.GetChannelId(),
+				MessageId: reference.GetMessageId(),
+			}
+		}
+	}
+
+	return messages
+}
+
+func voiceSettingsToChannelPb(settings *pb.VoiceSettings) *pb_channel.VoiceSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &pb_channel.VoiceSettings{
+		Bitrate:   settings.GetBitrate(),
+		UserLimit: settings.GetUserLimit(),
+	}
+}
+
+func forumSettingsToChannelPb(settings *pb.ForumSettings) *pb_channel.ForumSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &pb_channel.ForumSettings{
+		DefaultSort: settings.GetDefaultSort(),
+		Tags:        settings.GetTags(),
+	}
+}
+
+func (s *DiscordGatewayService) CreatePrivateChat(ctx context.Context, req *pb.CreatePrivateChatRequest) (*pb.CreatePrivateChatResponse, error) {
+	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
+	request := pb_chat.CreatePrivateChatRequest{
+		UserId: req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.CreatePrivateChat")
+	defer span.Finish()
+
+	response, err := chatClient.CreatePrivateChat(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("create private chat error")
+		return nil, err
+	}
+
+	return &pb.CreatePrivateChatResponse{
+		Success: response.GetSuccess(),
+		ChatId:  response.GetChatId(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) SendUserPrivateMessage(ctx context.Context, req *pb.SendUserPrivateMessageRequest) (*pb.ActionResponse, error) {
+	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
+	request := pb_chat.SendUserPrivateMessageRequest{
+		UserId: req.GetUserId(),
+		Text:   req.GetText(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.SendUserPrivateMessage")
+	defer span.Finish()
+
+	response, err := chatClient.SendUserPrivateMessage(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("send user private message error")
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *DiscordGatewayService) GetUserPrivateMessages(ctx context.Context, req *pb.GetUserPrivateMessagesRequest) (*pb.GetMessagesResponse, error) {
+	chatClient := pb_chat.NewChatServiceClient(s.ChatConn)
+	request := pb_chat.GetUserPrivateMessagesRequest{
+		UserId: req.GetUserId(),
+	}
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.GetUserPrivateMessages")
+	defer span.Finish()
+
+	response, err := chatClient.GetUserPrivateMessages(ctx, &request)
+	if err != nil {
+		s.Log.WithContext(ctx).WithError(err).WithField("UserId", req.GetUserId()).Error("get user private messages error")
+		return nil, err
+	}
+
+	messages := make([]*pb.Message, len(response.GetMessages()))
+	for i, m := range response.GetMessages() {
+		messages[i] = &pb.Message{
+			Id:        m.GetId(),
+			Text:      m.GetText(),
+			Timestamp: m.GetTimestamp(),
+			OwnerId:   m.OwnerId,
+			ChatId:    m.ChatId,
+		}
+	}
+
+	s.enrichAuthors(ctx, messages, nil)
+
 	return &pb.GetMessagesResponse{
 		Messages: messages,
 	}, nil
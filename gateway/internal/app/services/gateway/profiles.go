@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	pb_user "github.com/Nixonxp/discord/gateway/pkg/api/user"
+	pb "github.com/Nixonxp/discord/gateway/pkg/api/v1"
+	"github.com/opentracing/opentracing-go"
+	"sync"
+	"time"
+)
+
+const (
+	profileCacheTTL  = time.Minute
+	profileCacheSize = 10000
+	// profileBatchSize - user service rejects batch lookups of more ids
+	profileBatchSize = 100
+)
+
+type profileCacheItem struct {
+	profile   *pb_user.UserProfile
+	expiresAt time.Time
+}
+
+// profileCache - short-lived in-memory cache of user profiles,
+// unknown ids are cached as nil so they are not requested again until expiration
+type profileCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	size  int
+	items map[string]profileCacheItem
+	now   func() time.Time
+}
+
+func newProfileCache(ttl time.Duration, size int) *profileCache {
+	return &profileCache{
+		ttl:   ttl,
+		size:  size,
+		items: make(map[string]profileCacheItem),
+		now:   time.Now,
+	}
+}
+
+// get - returns cached profiles and ids missing in cache
+func (c *profileCache) get(ids []string) (map[string]*pb_user.UserProfile, []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	found := make(map[string]*pb_user.UserProfile, len(ids))
+	missing := make([]string, 0)
+	for _, id := range ids {
+		item, ok := c.items[id]
+		if !ok || now.After(item.expiresAt) {
+			missing = append(missing, id)
+			continue
+		}
+		found[id] = item.profile
+	}
+
+	return found, missing
+}
+
+func (c *profileCache) set(profiles map[string]*pb_user.UserProfile) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.items)+len(profiles) > c.size {
+		for id, item := range c.items {
+			if now.After(item.expiresAt) {
+				delete(c.items, id)
+			}
+		}
+	}
+	if len(c.items)+len(profiles) > c.size {
+		c.items = make(map[string]profileCacheItem)
+	}
+
+	expiresAt := now.Add(c.ttl)
+	for id, profile := range profiles {
+		c.items[id] = profileCacheItem{
+			profile:   profile,
+			expiresAt: expiresAt,
+		}
+	}
+}
+
+// getUserProfiles - profiles by user id, a failed lookup leaves the missing profiles out
+func (s *DiscordGatewayService) getUserProfiles(ctx context.Context, ids []string) map[string]*pb_user.UserProfile {
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 {
+		return map[string]*pb_user.UserProfile{}
+	}
+
+	profiles, missing := s.profiles.get(unique)
+	if len(missing) > 0 {
+		fetched, err := s.fetchUserProfiles(ctx, missing)
+		if err != nil {
+			s.Log.WithContext(ctx).WithError(err).Error("get user profiles error")
+			return profiles
+		}
+
+		s.profiles.set(fetched)
+		for id, profile := range fetched {
+			profiles[id] = profile
+		}
+	}
+
+	return profiles
+}
+
+func (s *DiscordGatewayService) fetchUserProfiles(ctx context.Context, ids []string) (map[string]*pb_user.UserProfile, error) {
+	userClient := pb_user.NewUserServiceClient(s.UserConn)
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user_service.GetUsersByIds")
+	defer span.Finish()
+
+	return fetchProfileBatches(ctx, userClient, ids, profileBatchSize)
+}
+
+// fetchProfileBatches - looks ids up in batches of batchSize, ids without a profile are returned as nil
+func fetchProfileBatches(ctx context.Context, client pb_user.UserServiceClient, ids []string, batchSize int) (map[string]*pb_user.UserProfile, error) {
+	profiles := make(map[string]*pb_user.UserProfile, len(ids))
+	for start := 0; start < len(ids); start += batchSize {
+		batch := ids[start:min(start+batchSize, len(ids))]
+
+		response, err := client.GetUsersByIds(ctx, &pb_user.GetUsersByIdsRequest{
+			Ids: batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range batch {
+			profiles[id] = nil
+		}
+		for _, u := range response.GetUsers() {
+			profiles[u.GetId()] = u
+		}
+	}
+
+	return profiles, nil
+}
+
+// enrichAuthors - embeds author profiles into messages,
+// nicknames by author id are set for server messages and nil for private ones
+func (s *DiscordGatewayService) enrichAuthors(ctx context.Context, messages []*pb.Message, nicknames map[string]string) {
+	ids := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if m.GetWebhookId() == "" {
+			ids = append(ids, m.GetOwnerId())
+		}
+	}
+
+	profiles := s.getUserProfiles(ctx, ids)
+	for _, m := range messages {
+		// webhook messages already have the author from the message itself
+		if m.GetWebhookId() != "" {
+			continue
+		}
+		profile := profiles[m.GetOwnerId()]
+		nickname := nicknames[m.GetOwnerId()]
+		if profile == nil && nickname == "" {
+			continue
+		}
+		m.Author = &pb.MessageAuthor{
+			Id:             m.GetOwnerId(),
+			Login:          profile.GetLogin(),
+			Name:           profile.GetName(),
+			AvatarPhotoUrl: profile.GetAvatarPhotoUrl(),
+			Nickname:       nickname,
+			IsBot:          profile.GetIsBot(),
+		}
+	}
+}
+
+// enrichMembers - embeds user profiles into server members
+func (s *DiscordGatewayService) enrichMembers(ctx context.Context, members []*pb.ServerMember) {
+	ids := make([]string, len(members))
+	for i, m := range members {
+		ids[i] = m.GetUserId()
+	}
+
+	profiles := s.getUserProfiles(ctx, ids)
+	for _, m := range members {
+		profile := profiles[m.GetUserId()]
+		if profile == nil {
+			continue
+		}
+		m.Profile = &pb.MemberProfile{
+			Login:          profile.GetLogin(),
+			Name:           profile.GetName(),
+			AvatarPhotoUrl: profile.GetAvatarPhotoUrl(),
+			IsBot:          profile.GetIsBot(),
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb_user "github.com/Nixonxp/discord/gateway/pkg/api/user"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"testing"
+	"time"
+)
+
+// fakeUserClient - answers GetUsersByIds with known profiles and records requested batches
+type fakeUserClient struct {
+	pb_user.UserServiceClient
+
+	known   map[string]*pb_user.UserProfile
+	err     error
+	batches [][]string
+}
+
+func (c *fakeUserClient) GetUsersByIds(_ context.Context, in *pb_user.GetUsersByIdsRequest, _ ...grpc.CallOption) (*pb_user.GetUsersByIdsResponse, error) {
+	c.batches = append(c.batches, in.GetIds())
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	users := make([]*pb_user.UserProfile, 0, len(in.GetIds()))
+	for _, id := range in.GetIds() {
+		if profile, ok := c.known[id]; ok {
+			users = append(users, profile)
+		}
+	}
+
+	return &pb_user.GetUsersByIdsResponse{Users: users}, nil
+}
+
+func userIds(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("user-%d", i)
+	}
+
+	return ids
+}
+
+func Test_fetchProfileBatches(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	tests := []struct {
+		name        string
+		ids         []string
+		known       map[string]*pb_user.UserProfile
+		clientErr   error
+		wantBatches []int
+		wantErr     bool
+		errorString string
+	}{
+		{
+			name:        "Test 1. Positive. Ids fit in one batch",
+			ids:         userIds(3),
+			known:       map[string]*pb_user.UserProfile{"user-1": {Id: "user-1", Login: "login"}},
+			wantBatches: []int{3},
+		},
+		{
+			name:        "Test 2. Positive. Exactly one full batch",
+			ids:         userIds(profileBatchSize),
+			wantBatches: []int{profileBatchSize},
+		},
+		{
+			name:        "Test 3. Positive. Ids are split into batches",
+			ids:         userIds(2*profileBatchSize + 1),
+			known:       map[string]*pb_user.UserProfile{"user-150": {Id: "user-150", Login: "login"}},
+			wantBatches: []int{profileBatchSize, profileBatchSize, 1},
+		},
+		{
+			name:        "Test 4. Negative. GetUsersByIds returns error",
+			ids:         userIds(profileBatchSize + 1),
+			clientErr:   errors.New("some error"),
+			wantBatches: []int{profileBatchSize},
+			wantErr:     true,
+			errorString: "some error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			client := &fakeUserClient{known: tt.known, err: tt.clientErr}
+
+			// act
+			got, err := fetchProfileBatches(ctx, client, tt.ids, profileBatchSize)
+
+			// assert
+			batchSizes := make([]int, len(client.batches))
+			for i, batch := range client.batches {
+				batchSizes[i] = len(batch)
+			}
+			assert.Equal(t, tt.wantBatches, batchSizes)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fetchProfileBatches() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Len(t, got, len(tt.ids))
+			for _, id := range tt.ids {
+				assert.Equal(t, tt.known[id], got[id])
+			}
+		})
+	}
+}
+
+func Test_profileCache(t *testing.T) {
+	// prepare
+	var (
+		now     = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		profile = &pb_user.UserProfile{Id: "user-1", Login: "login"}
+	)
+	tests := []struct {
+		name        string
+		size        int
+		set         map[string]*pb_user.UserProfile
+		elapsed     time.Duration
+		get         []string
+		wantFound   map[string]*pb_user.UserProfile
+		wantMissing []string
+	}{
+		{
+			name:        "Test 1. Positive. Cached profile and unknown id are found",
+			size:        10,
+			set:         map[string]*pb_user.UserProfile{"user-1": profile, "user-2": nil},
+			get:         []string{"user-1", "user-2", "user-3"},
+			wantFound:   map[string]*pb_user.UserProfile{"user-1": profile, "user-2": nil},
+			wantMissing: []string{"user-3"},
+		},
+		{
+			name:        "Test 2. Positive. Expired profiles are missing",
+			size:        10,
+			set:         map[string]*pb_user.UserProfile{"user-1": profile},
+			elapsed:     profileCacheTTL + time.Second,
+			get:         []string{"user-1"},
+			wantFound:   map[string]*pb_user.UserProfile{},
+			wantMissing: []string{"user-1"},
+		},
+		{
+			name:        "Test 3. Positive. Full cache is reset",
+			size:        1,
+			set:         map[string]*pb_user.UserProfile{"user-1": profile},
+			get:         []string{"user-1", "user-0"},
+			wantFound:   map[string]*pb_user.UserProfile{"user-1": profile},
+			wantMissing: []string{"user-0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			current := now
+			cache := newProfileCache(profileCacheTTL, tt.size)
+			cache.now = func() time.Time { return current }
+			// the entry set before is dropped when the new profiles do not fit
+			cache.set(map[string]*pb_user.UserProfile{"user-0": nil})
+			cache.set(tt.set)
+			current = current.Add(tt.elapsed)
+
+			// act
+			found, missing := cache.get(tt.get)
+
+			// assert
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	config "github.com/Nixonxp/discord/gateway/configs"
 	"github.com/dgrijalva/jwt-go"
@@ -11,10 +12,27 @@ import (
 
 var secretKey *string
 
-func JWTMiddleware(next http.Handler) http.Handler {
+// webhookExecutePrefix - webhook execution is authorized by the token in the path
+const webhookExecutePrefix = "/api/v1/webhooks/"
+
+// interactionCallbackPrefix - command handler replies are authorized by the interaction token in the path
+const interactionCallbackPrefix = "/api/v1/interactions/"
+
+// emojiImagePrefix - emoji images are loaded by img tags that can not send the token
+const emojiImagePrefix = "/api/v1/emoji/"
+
+// botScheme - authorization scheme of bot tokens, users authorize with "Bearer <jwt>"
+const botScheme = "Bot "
+
+// BotTokenVerifier - resolves a bot token to the bot user id
+type BotTokenVerifier interface {
+	VerifyBotToken(ctx context.Context, token string) (string, error)
+}
+
+func JWTMiddleware(bots BotTokenVerifier, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// skip if auth routes
-		if strings.Contains(strings.ToLower(r.URL.Path), "auth") {
+		if strings.Contains(strings.ToLower(r.URL.Path), "auth") || isWebhookExecute(r) || isInteractionCallback(r) || isEmojiImage(r) {
 			next.ServeHTTP(w, r)
 		} else {
 			if secretKey == nil {
@@ -23,6 +41,18 @@ func JWTMiddleware(next http.Handler) http.Handler {
 				fmt.Println("get key from config")
 			}
 			authorizationHeader := r.Header.Get("Authorization")
+			if strings.HasPrefix(authorizationHeader, botScheme) {
+				botID, err := bots.VerifyBotToken(r.Context(), strings.TrimPrefix(authorizationHeader, botScheme))
+				if err != nil {
+					http.Error(w, "Invalid or revoked bot token", http.StatusUnauthorized)
+					return
+				}
+
+				ctx := metadata.AppendToOutgoingContext(r.Context(), "userId", botID)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
 			tokenString := strings.Replace(authorizationHeader, "Bearer ", "", 1)
 			token, err := jwt.ParseWithClaims(tokenString, &jwt.StandardClaims{}, func(token *jwt.Token) (interface{}, error) {
 				return []byte(*secretKey), nil
@@ -45,3 +75,15 @@ func JWTMiddleware(next http.Handler) http.Handler {
 		}
 	})
 }
+
+func isWebhookExecute(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, webhookExecutePrefix)
+}
+
+func isInteractionCallback(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, interactionCallbackPrefix)
+}
+
+func isEmojiImage(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, emojiImagePrefix)
+}
@@ -22,6 +22,7 @@ const (
 	ChatService_CreatePrivateChat_FullMethodName      = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/CreatePrivateChat"
 	ChatService_SendUserPrivateMessage_FullMethodName = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/SendUserPrivateMessage"
 	ChatService_GetUserPrivateMessages_FullMethodName = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/GetUserPrivateMessages"
+	ChatService_ExportChat_FullMethodName             = "/github.com.Nixonxp.discord.chat.api.v1.ChatService/ExportChat"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -31,6 +32,7 @@ type ChatServiceClient interface {
 	CreatePrivateChat(ctx context.Context, in *CreatePrivateChatRequest, opts ...grpc.CallOption) (*CreatePrivateChatResponse, error)
 	SendUserPrivateMessage(ctx context.Context, in *SendUserPrivateMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	GetUserPrivateMessages(ctx context.Context, in *GetUserPrivateMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	ExportChat(ctx context.Context, in *ExportChatRequest, opts ...grpc.CallOption) (ChatService_ExportChatClient, error)
 }
 
 type chatServiceClient struct {
@@ -68,6 +70,38 @@ func (c *chatServiceClient) GetUserPrivateMessages(ctx context.Context, in *GetU
 	return out, nil
 }
 
+func (c *chatServiceClient) ExportChat(ctx context.Context, in *ExportChatRequest, opts ...grpc.CallOption) (ChatService_ExportChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_ExportChat_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chatServiceExportChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChatService_ExportChatClient interface {
+	Recv() (*ExportChatChunk, error)
+	grpc.ClientStream
+}
+
+type chatServiceExportChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *chatServiceExportChatClient) Recv() (*ExportChatChunk, error) {
+	m := new(ExportChatChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
@@ -75,6 +109,7 @@ type ChatServiceServer interface {
 	CreatePrivateChat(context.Context, *CreatePrivateChatRequest) (*CreatePrivateChatResponse, error)
 	SendUserPrivateMessage(context.Context, *SendUserPrivateMessageRequest) (*ActionResponse, error)
 	GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error)
+	ExportChat(*ExportChatRequest, ChatService_ExportChatServer) error
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -91,6 +126,9 @@ func (UnimplementedChatServiceServer) SendUserPrivateMessage(context.Context, *S
 func (UnimplementedChatServiceServer) GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserPrivateMessages not implemented")
 }
+func (UnimplementedChatServiceServer) ExportChat(*ExportChatRequest, ChatService_ExportChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportChat not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +196,27 @@ func _ChatService_GetUserPrivateMessages_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_ExportChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).ExportChat(m, &chatServiceExportChatServer{stream})
+}
+
+type ChatService_ExportChatServer interface {
+	Send(*ExportChatChunk) error
+	grpc.ServerStream
+}
+
+type chatServiceExportChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *chatServiceExportChatServer) Send(m *ExportChatChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -178,6 +237,12 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ChatService_GetUserPrivateMessages_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportChat",
+			Handler:       _ChatService_ExportChat_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "internal/app/api/chat/chat.proto",
 }
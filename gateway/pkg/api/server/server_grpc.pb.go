@@ -19,14 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ServerService_CreateServer_FullMethodName           = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateServer"
-	ServerService_SearchServer_FullMethodName           = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SearchServer"
-	ServerService_SubscribeServer_FullMethodName        = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SubscribeServer"
-	ServerService_UnsubscribeServer_FullMethodName      = "/github.com.Nixonxp.discord.server.api.v1.ServerService/UnsubscribeServer"
-	ServerService_SearchServerByUserId_FullMethodName   = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SearchServerByUserId"
-	ServerService_InviteUserToServer_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/InviteUserToServer"
-	ServerService_PublishMessageOnServer_FullMethodName = "/github.com.Nixonxp.discord.server.api.v1.ServerService/PublishMessageOnServer"
-	ServerService_GetMessagesFromServer_FullMethodName  = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetMessagesFromServer"
+	ServerService_CreateServer_FullMethodName             = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateServer"
+	ServerService_SearchServer_FullMethodName             = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SearchServer"
+	ServerService_GetServer_FullMethodName                = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetServer"
+	ServerService_UpdateServer_FullMethodName             = "/github.com.Nixonxp.discord.server.api.v1.ServerService/UpdateServer"
+	ServerService_DeleteServer_FullMethodName             = "/github.com.Nixonxp.discord.server.api.v1.ServerService/DeleteServer"
+	ServerService_RestoreServer_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/RestoreServer"
+	ServerService_TransferOwnership_FullMethodName        = "/github.com.Nixonxp.discord.server.api.v1.ServerService/TransferOwnership"
+	ServerService_ListServerMembers_FullMethodName        = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListServerMembers"
+	ServerService_GetMember_FullMethodName                = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetMember"
+	ServerService_SetMemberNickname_FullMethodName        = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SetMemberNickname"
+	ServerService_SubscribeServer_FullMethodName          = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SubscribeServer"
+	ServerService_UnsubscribeServer_FullMethodName        = "/github.com.Nixonxp.discord.server.api.v1.ServerService/UnsubscribeServer"
+	ServerService_SearchServerByUserId_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/SearchServerByUserId"
+	ServerService_InviteUserToServer_FullMethodName       = "/github.com.Nixonxp.discord.server.api.v1.ServerService/InviteUserToServer"
+	ServerService_AddBotToServer_FullMethodName           = "/github.com.Nixonxp.discord.server.api.v1.ServerService/AddBotToServer"
+	ServerService_PublishMessageOnServer_FullMethodName   = "/github.com.Nixonxp.discord.server.api.v1.ServerService/PublishMessageOnServer"
+	ServerService_GetMessagesFromServer_FullMethodName    = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetMessagesFromServer"
+	ServerService_CreateModerationRule_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateModerationRule"
+	ServerService_DeleteModerationRule_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/DeleteModerationRule"
+	ServerService_GetModerationRules_FullMethodName       = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetModerationRules"
+	ServerService_GetModerationActions_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetModerationActions"
+	ServerService_ExportServerChat_FullMethodName         = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ExportServerChat"
+	ServerService_GetAuditLog_FullMethodName              = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetAuditLog"
+	ServerService_RecordAuditEntry_FullMethodName         = "/github.com.Nixonxp.discord.server.api.v1.ServerService/RecordAuditEntry"
+	ServerService_CreateServerTemplate_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateServerTemplate"
+	ServerService_ListTemplates_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListTemplates"
+	ServerService_CreateServerFromTemplate_FullMethodName = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateServerFromTemplate"
+	ServerService_CreateWebhook_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateWebhook"
+	ServerService_ListWebhooks_FullMethodName             = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListWebhooks"
+	ServerService_RegenerateWebhookToken_FullMethodName   = "/github.com.Nixonxp.discord.server.api.v1.ServerService/RegenerateWebhookToken"
+	ServerService_DeleteWebhook_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/DeleteWebhook"
+	ServerService_ExecuteWebhook_FullMethodName           = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ExecuteWebhook"
+	ServerService_CreateOutgoingWebhook_FullMethodName    = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateOutgoingWebhook"
+	ServerService_ListOutgoingWebhooks_FullMethodName     = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListOutgoingWebhooks"
+	ServerService_EnableOutgoingWebhook_FullMethodName    = "/github.com.Nixonxp.discord.server.api.v1.ServerService/EnableOutgoingWebhook"
+	ServerService_DeleteOutgoingWebhook_FullMethodName    = "/github.com.Nixonxp.discord.server.api.v1.ServerService/DeleteOutgoingWebhook"
+	ServerService_ListOutgoingDeliveries_FullMethodName   = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListOutgoingDeliveries"
+	ServerService_CreateCommand_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateCommand"
+	ServerService_ListCommands_FullMethodName             = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListCommands"
+	ServerService_DeleteCommand_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/DeleteCommand"
+	ServerService_RespondInteraction_FullMethodName       = "/github.com.Nixonxp.discord.server.api.v1.ServerService/RespondInteraction"
+	ServerService_CreateEmoji_FullMethodName              = "/github.com.Nixonxp.discord.server.api.v1.ServerService/CreateEmoji"
+	ServerService_ListServerEmoji_FullMethodName          = "/github.com.Nixonxp.discord.server.api.v1.ServerService/ListServerEmoji"
+	ServerService_RenameEmoji_FullMethodName              = "/github.com.Nixonxp.discord.server.api.v1.ServerService/RenameEmoji"
+	ServerService_DeleteEmoji_FullMethodName              = "/github.com.Nixonxp.discord.server.api.v1.ServerService/DeleteEmoji"
+	ServerService_GetEmojiImage_FullMethodName            = "/github.com.Nixonxp.discord.server.api.v1.ServerService/GetEmojiImage"
 )
 
 // ServerServiceClient is the client API for ServerService service.
@@ -35,12 +73,54 @@ const (
 type ServerServiceClient interface {
 	CreateServer(ctx context.Context, in *CreateServerRequest, opts ...grpc.CallOption) (*CreateServerResponse, error)
 	SearchServer(ctx context.Context, in *SearchServerRequest, opts ...grpc.CallOption) (*SearchServerResponse, error)
+	GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	RestoreServer(ctx context.Context, in *RestoreServerRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	ListServerMembers(ctx context.Context, in *ListServerMembersRequest, opts ...grpc.CallOption) (*ListServerMembersResponse, error)
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*Member, error)
 	SubscribeServer(ctx context.Context, in *SubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	UnsubscribeServer(ctx context.Context, in *UnsubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	SearchServerByUserId(ctx context.Context, in *SearchServerByUserIdRequest, opts ...grpc.CallOption) (*SearchServerByUserIdResponse, error)
 	InviteUserToServer(ctx context.Context, in *InviteUserToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// AddBotToServer - the caller checks that the bot is public or owned by the current user
+	AddBotToServer(ctx context.Context, in *AddBotToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	PublishMessageOnServer(ctx context.Context, in *PublishMessageOnServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	GetMessagesFromServer(ctx context.Context, in *GetMessagesFromServerRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	CreateModerationRule(ctx context.Context, in *CreateModerationRuleRequest, opts ...grpc.CallOption) (*ModerationRule, error)
+	DeleteModerationRule(ctx context.Context, in *DeleteModerationRuleRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error)
+	GetModerationActions(ctx context.Context, in *GetModerationActionsRequest, opts ...grpc.CallOption) (*GetModerationActionsResponse, error)
+	ExportServerChat(ctx context.Context, in *ExportServerChatRequest, opts ...grpc.CallOption) (ServerService_ExportServerChatClient, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// RecordAuditEntry - internal, used by other services to log their administrative actions
+	RecordAuditEntry(ctx context.Context, in *RecordAuditEntryRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	CreateServerTemplate(ctx context.Context, in *CreateServerTemplateRequest, opts ...grpc.CallOption) (*ServerTemplate, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	CreateServerFromTemplate(ctx context.Context, in *CreateServerFromTemplateRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	RegenerateWebhookToken(ctx context.Context, in *RegenerateWebhookTokenRequest, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// ExecuteWebhook - public, authorized by the webhook token instead of the user
+	ExecuteWebhook(ctx context.Context, in *ExecuteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	CreateOutgoingWebhook(ctx context.Context, in *CreateOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error)
+	ListOutgoingWebhooks(ctx context.Context, in *ListOutgoingWebhooksRequest, opts ...grpc.CallOption) (*ListOutgoingWebhooksResponse, error)
+	EnableOutgoingWebhook(ctx context.Context, in *EnableOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error)
+	DeleteOutgoingWebhook(ctx context.Context, in *DeleteOutgoingWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	ListOutgoingDeliveries(ctx context.Context, in *ListOutgoingDeliveriesRequest, opts ...grpc.CallOption) (*ListOutgoingDeliveriesResponse, error)
+	CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*Command, error)
+	ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error)
+	DeleteCommand(ctx context.Context, in *DeleteCommandRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// RespondInteraction - public, authorized by the interaction token instead of the user
+	RespondInteraction(ctx context.Context, in *RespondInteractionRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	CreateEmoji(ctx context.Context, in *CreateEmojiRequest, opts ...grpc.CallOption) (*Emoji, error)
+	ListServerEmoji(ctx context.Context, in *ListServerEmojiRequest, opts ...grpc.CallOption) (*ListServerEmojiResponse, error)
+	RenameEmoji(ctx context.Context, in *RenameEmojiRequest, opts ...grpc.CallOption) (*Emoji, error)
+	DeleteEmoji(ctx context.Context, in *DeleteEmojiRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetEmojiImage(ctx context.Context, in *GetEmojiImageRequest, opts ...grpc.CallOption) (*EmojiImage, error)
 }
 
 type serverServiceClient struct {
@@ -69,256 +149,1464 @@ func (c *serverServiceClient) SearchServer(ctx context.Context, in *SearchServer
 	return out, nil
 }
 
+func (c *serverServiceClient) GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, ServerService_GetServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, ServerService_UpdateServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_DeleteServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) RestoreServer(ctx context.Context, in *RestoreServerRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, ServerService_RestoreServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, ServerService_TransferOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListServerMembers(ctx context.Context, in *ListServerMembersRequest, opts ...grpc.CallOption) (*ListServerMembersResponse, error) {
+	out := new(ListServerMembersResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListServerMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, ServerService_GetMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, ServerService_SetMemberNickname_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverServiceClient) SubscribeServer(ctx context.Context, in *SubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
 	out := new(ActionResponse)
 	err := c.cc.Invoke(ctx, ServerService_SubscribeServer_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *serverServiceClient) UnsubscribeServer(ctx context.Context, in *UnsubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_UnsubscribeServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) SearchServerByUserId(ctx context.Context, in *SearchServerByUserIdRequest, opts ...grpc.CallOption) (*SearchServerByUserIdResponse, error) {
+	out := new(SearchServerByUserIdResponse)
+	err := c.cc.Invoke(ctx, ServerService_SearchServerByUserId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) InviteUserToServer(ctx context.Context, in *InviteUserToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_InviteUserToServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) AddBotToServer(ctx context.Context, in *AddBotToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_AddBotToServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) PublishMessageOnServer(ctx context.Context, in *PublishMessageOnServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_PublishMessageOnServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) GetMessagesFromServer(ctx context.Context, in *GetMessagesFromServerRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, ServerService_GetMessagesFromServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateModerationRule(ctx context.Context, in *CreateModerationRuleRequest, opts ...grpc.CallOption) (*ModerationRule, error) {
+	out := new(ModerationRule)
+	err := c.cc.Invoke(ctx, ServerService_CreateModerationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) DeleteModerationRule(ctx context.Context, in *DeleteModerationRuleRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_DeleteModerationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error) {
+	out := new(GetModerationRulesResponse)
+	err := c.cc.Invoke(ctx, ServerService_GetModerationRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) GetModerationActions(ctx context.Context, in *GetModerationActionsRequest, opts ...grpc.CallOption) (*GetModerationActionsResponse, error) {
+	out := new(GetModerationActionsResponse)
+	err := c.cc.Invoke(ctx, ServerService_GetModerationActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ExportServerChat(ctx context.Context, in *ExportServerChatRequest, opts ...grpc.CallOption) (ServerService_ExportServerChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ServerService_ServiceDesc.Streams[0], ServerService_ExportServerChat_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverServiceExportServerChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ServerService_ExportServerChatClient interface {
+	Recv() (*ExportChatChunk, error)
+	grpc.ClientStream
+}
+
+type serverServiceExportServerChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverServiceExportServerChatClient) Recv() (*ExportChatChunk, error) {
+	m := new(ExportChatChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, ServerService_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) RecordAuditEntry(ctx context.Context, in *RecordAuditEntryRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_RecordAuditEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateServerTemplate(ctx context.Context, in *CreateServerTemplateRequest, opts ...grpc.CallOption) (*ServerTemplate, error) {
+	out := new(ServerTemplate)
+	err := c.cc.Invoke(ctx, ServerService_CreateServerTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateServerFromTemplate(ctx context.Context, in *CreateServerFromTemplateRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, ServerService_CreateServerFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, ServerService_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) RegenerateWebhookToken(ctx context.Context, in *RegenerateWebhookTokenRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, ServerService_RegenerateWebhookToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ExecuteWebhook(ctx context.Context, in *ExecuteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_ExecuteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateOutgoingWebhook(ctx context.Context, in *CreateOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error) {
+	out := new(OutgoingWebhook)
+	err := c.cc.Invoke(ctx, ServerService_CreateOutgoingWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListOutgoingWebhooks(ctx context.Context, in *ListOutgoingWebhooksRequest, opts ...grpc.CallOption) (*ListOutgoingWebhooksResponse, error) {
+	out := new(ListOutgoingWebhooksResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListOutgoingWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) EnableOutgoingWebhook(ctx context.Context, in *EnableOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error) {
+	out := new(OutgoingWebhook)
+	err := c.cc.Invoke(ctx, ServerService_EnableOutgoingWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) DeleteOutgoingWebhook(ctx context.Context, in *DeleteOutgoingWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_DeleteOutgoingWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListOutgoingDeliveries(ctx context.Context, in *ListOutgoingDeliveriesRequest, opts ...grpc.CallOption) (*ListOutgoingDeliveriesResponse, error) {
+	out := new(ListOutgoingDeliveriesResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListOutgoingDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*Command, error) {
+	out := new(Command)
+	err := c.cc.Invoke(ctx, ServerService_CreateCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error) {
+	out := new(ListCommandsResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListCommands_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) DeleteCommand(ctx context.Context, in *DeleteCommandRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_DeleteCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) RespondInteraction(ctx context.Context, in *RespondInteractionRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_RespondInteraction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) CreateEmoji(ctx context.Context, in *CreateEmojiRequest, opts ...grpc.CallOption) (*Emoji, error) {
+	out := new(Emoji)
+	err := c.cc.Invoke(ctx, ServerService_CreateEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) ListServerEmoji(ctx context.Context, in *ListServerEmojiRequest, opts ...grpc.CallOption) (*ListServerEmojiResponse, error) {
+	out := new(ListServerEmojiResponse)
+	err := c.cc.Invoke(ctx, ServerService_ListServerEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) RenameEmoji(ctx context.Context, in *RenameEmojiRequest, opts ...grpc.CallOption) (*Emoji, error) {
+	out := new(Emoji)
+	err := c.cc.Invoke(ctx, ServerService_RenameEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) DeleteEmoji(ctx context.Context, in *DeleteEmojiRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ServerService_DeleteEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverServiceClient) GetEmojiImage(ctx context.Context, in *GetEmojiImageRequest, opts ...grpc.CallOption) (*EmojiImage, error) {
+	out := new(EmojiImage)
+	err := c.cc.Invoke(ctx, ServerService_GetEmojiImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServerServiceServer is the server API for ServerService service.
+// All implementations must embed UnimplementedServerServiceServer
+// for forward compatibility
+type ServerServiceServer interface {
+	CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error)
+	SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error)
+	GetServer(context.Context, *GetServerRequest) (*ServerProfile, error)
+	UpdateServer(context.Context, *UpdateServerRequest) (*ServerProfile, error)
+	DeleteServer(context.Context, *DeleteServerRequest) (*ActionResponse, error)
+	RestoreServer(context.Context, *RestoreServerRequest) (*ServerProfile, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*ServerProfile, error)
+	ListServerMembers(context.Context, *ListServerMembersRequest) (*ListServerMembersResponse, error)
+	GetMember(context.Context, *GetMemberRequest) (*Member, error)
+	SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*Member, error)
+	SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error)
+	UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error)
+	SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error)
+	InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error)
+	// AddBotToServer - the caller checks that the bot is public or owned by the current user
+	AddBotToServer(context.Context, *AddBotToServerRequest) (*ActionResponse, error)
+	PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error)
+	GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error)
+	CreateModerationRule(context.Context, *CreateModerationRuleRequest) (*ModerationRule, error)
+	DeleteModerationRule(context.Context, *DeleteModerationRuleRequest) (*ActionResponse, error)
+	GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error)
+	GetModerationActions(context.Context, *GetModerationActionsRequest) (*GetModerationActionsResponse, error)
+	ExportServerChat(*ExportServerChatRequest, ServerService_ExportServerChatServer) error
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	// RecordAuditEntry - internal, used by other services to log their administrative actions
+	RecordAuditEntry(context.Context, *RecordAuditEntryRequest) (*ActionResponse, error)
+	CreateServerTemplate(context.Context, *CreateServerTemplateRequest) (*ServerTemplate, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	CreateServerFromTemplate(context.Context, *CreateServerFromTemplateRequest) (*ServerProfile, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	RegenerateWebhookToken(context.Context, *RegenerateWebhookTokenRequest) (*Webhook, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*ActionResponse, error)
+	// ExecuteWebhook - public, authorized by the webhook token instead of the user
+	ExecuteWebhook(context.Context, *ExecuteWebhookRequest) (*ActionResponse, error)
+	CreateOutgoingWebhook(context.Context, *CreateOutgoingWebhookRequest) (*OutgoingWebhook, error)
+	ListOutgoingWebhooks(context.Context, *ListOutgoingWebhooksRequest) (*ListOutgoingWebhooksResponse, error)
+	EnableOutgoingWebhook(context.Context, *EnableOutgoingWebhookRequest) (*OutgoingWebhook, error)
+	DeleteOutgoingWebhook(context.Context, *DeleteOutgoingWebhookRequest) (*ActionResponse, error)
+	ListOutgoingDeliveries(context.Context, *ListOutgoingDeliveriesRequest) (*ListOutgoingDeliveriesResponse, error)
+	CreateCommand(context.Context, *CreateCommandRequest) (*Command, error)
+	ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error)
+	DeleteCommand(context.Context, *DeleteCommandRequest) (*ActionResponse, error)
+	// RespondInteraction - public, authorized by the interaction token instead of the user
+	RespondInteraction(context.Context, *RespondInteractionRequest) (*ActionResponse, error)
+	CreateEmoji(context.Context, *CreateEmojiRequest) (*Emoji, error)
+	ListServerEmoji(context.Context, *ListServerEmojiRequest) (*ListServerEmojiResponse, error)
+	RenameEmoji(context.Context, *RenameEmojiRequest) (*Emoji, error)
+	DeleteEmoji(context.Context, *DeleteEmojiRequest) (*ActionResponse, error)
+	GetEmojiImage(context.Context, *GetEmojiImageRequest) (*EmojiImage, error)
+	mustEmbedUnimplementedServerServiceServer()
+}
+
+// UnimplementedServerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServerServiceServer struct {
+}
+
+func (UnimplementedServerServiceServer) CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServer not implemented")
+}
+func (UnimplementedServerServiceServer) SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchServer not implemented")
+}
+func (UnimplementedServerServiceServer) GetServer(context.Context, *GetServerRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServer not implemented")
+}
+func (UnimplementedServerServiceServer) UpdateServer(context.Context, *UpdateServerRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServer not implemented")
+}
+func (UnimplementedServerServiceServer) DeleteServer(context.Context, *DeleteServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServer not implemented")
+}
+func (UnimplementedServerServiceServer) RestoreServer(context.Context, *RestoreServerRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreServer not implemented")
+}
+func (UnimplementedServerServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedServerServiceServer) ListServerMembers(context.Context, *ListServerMembersRequest) (*ListServerMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerMembers not implemented")
+}
+func (UnimplementedServerServiceServer) GetMember(context.Context, *GetMemberRequest) (*Member, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMember not implemented")
+}
+func (UnimplementedServerServiceServer) SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*Member, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberNickname not implemented")
+}
+func (UnimplementedServerServiceServer) SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeServer not implemented")
+}
+func (UnimplementedServerServiceServer) UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeServer not implemented")
+}
+func (UnimplementedServerServiceServer) SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchServerByUserId not implemented")
+}
+func (UnimplementedServerServiceServer) InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUserToServer not implemented")
+}
+func (UnimplementedServerServiceServer) AddBotToServer(context.Context, *AddBotToServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBotToServer not implemented")
+}
+func (UnimplementedServerServiceServer) PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMessageOnServer not implemented")
+}
+func (UnimplementedServerServiceServer) GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesFromServer not implemented")
+}
+func (UnimplementedServerServiceServer) CreateModerationRule(context.Context, *CreateModerationRuleRequest) (*ModerationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateModerationRule not implemented")
+}
+func (UnimplementedServerServiceServer) DeleteModerationRule(context.Context, *DeleteModerationRuleRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModerationRule not implemented")
+}
+func (UnimplementedServerServiceServer) GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationRules not implemented")
+}
+func (UnimplementedServerServiceServer) GetModerationActions(context.Context, *GetModerationActionsRequest) (*GetModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationActions not implemented")
+}
+func (UnimplementedServerServiceServer) ExportServerChat(*ExportServerChatRequest, ServerService_ExportServerChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportServerChat not implemented")
+}
+func (UnimplementedServerServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedServerServiceServer) RecordAuditEntry(context.Context, *RecordAuditEntryRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAuditEntry not implemented")
+}
+func (UnimplementedServerServiceServer) CreateServerTemplate(context.Context, *CreateServerTemplateRequest) (*ServerTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerTemplate not implemented")
+}
+func (UnimplementedServerServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedServerServiceServer) CreateServerFromTemplate(context.Context, *CreateServerFromTemplateRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerFromTemplate not implemented")
+}
+func (UnimplementedServerServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedServerServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedServerServiceServer) RegenerateWebhookToken(context.Context, *RegenerateWebhookTokenRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateWebhookToken not implemented")
+}
+func (UnimplementedServerServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedServerServiceServer) ExecuteWebhook(context.Context, *ExecuteWebhookRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteWebhook not implemented")
+}
+func (UnimplementedServerServiceServer) CreateOutgoingWebhook(context.Context, *CreateOutgoingWebhookRequest) (*OutgoingWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOutgoingWebhook not implemented")
+}
+func (UnimplementedServerServiceServer) ListOutgoingWebhooks(context.Context, *ListOutgoingWebhooksRequest) (*ListOutgoingWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingWebhooks not implemented")
+}
+func (UnimplementedServerServiceServer) EnableOutgoingWebhook(context.Context, *EnableOutgoingWebhookRequest) (*OutgoingWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableOutgoingWebhook not implemented")
+}
+func (UnimplementedServerServiceServer) DeleteOutgoingWebhook(context.Context, *DeleteOutgoingWebhookRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOutgoingWebhook not implemented")
+}
+func (UnimplementedServerServiceServer) ListOutgoingDeliveries(context.Context, *ListOutgoingDeliveriesRequest) (*ListOutgoingDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingDeliveries not implemented")
+}
+func (UnimplementedServerServiceServer) CreateCommand(context.Context, *CreateCommandRequest) (*Command, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommand not implemented")
+}
+func (UnimplementedServerServiceServer) ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
+}
+func (UnimplementedServerServiceServer) DeleteCommand(context.Context, *DeleteCommandRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommand not implemented")
+}
+func (UnimplementedServerServiceServer) RespondInteraction(context.Context, *RespondInteractionRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondInteraction not implemented")
+}
+func (UnimplementedServerServiceServer) CreateEmoji(context.Context, *CreateEmojiRequest) (*Emoji, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmoji not implemented")
+}
+func (UnimplementedServerServiceServer) ListServerEmoji(context.Context, *ListServerEmojiRequest) (*ListServerEmojiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerEmoji not implemented")
+}
+func (UnimplementedServerServiceServer) RenameEmoji(context.Context, *RenameEmojiRequest) (*Emoji, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameEmoji not implemented")
+}
+func (UnimplementedServerServiceServer) DeleteEmoji(context.Context, *DeleteEmojiRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmoji not implemented")
+}
+func (UnimplementedServerServiceServer) GetEmojiImage(context.Context, *GetEmojiImageRequest) (*EmojiImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmojiImage not implemented")
+}
+func (UnimplementedServerServiceServer) mustEmbedUnimplementedServerServiceServer() {}
+
+// UnsafeServerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServerServiceServer will
+// result in compilation errors.
+type UnsafeServerServiceServer interface {
+	mustEmbedUnimplementedServerServiceServer()
+}
+
+func RegisterServerServiceServer(s grpc.ServiceRegistrar, srv ServerServiceServer) {
+	s.RegisterService(&ServerService_ServiceDesc, srv)
+}
+
+func _ServerService_CreateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateServer(ctx, req.(*CreateServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_SearchServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).SearchServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_SearchServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).SearchServer(ctx, req.(*SearchServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_GetServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetServer(ctx, req.(*GetServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_UpdateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).UpdateServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_UpdateServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).UpdateServer(ctx, req.(*UpdateServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_DeleteServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).DeleteServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_DeleteServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).DeleteServer(ctx, req.(*DeleteServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_RestoreServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).RestoreServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_RestoreServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).RestoreServer(ctx, req.(*RestoreServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ListServerMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ListServerMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ListServerMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ListServerMembers(ctx, req.(*ListServerMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_SetMemberNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberNicknameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).SetMemberNickname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_SetMemberNickname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).SetMemberNickname(ctx, req.(*SetMemberNicknameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_SubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).SubscribeServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_SubscribeServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).SubscribeServer(ctx, req.(*SubscribeServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_UnsubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).UnsubscribeServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_UnsubscribeServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).UnsubscribeServer(ctx, req.(*UnsubscribeServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_SearchServerByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchServerByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).SearchServerByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_SearchServerByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).SearchServerByUserId(ctx, req.(*SearchServerByUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_InviteUserToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserToServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).InviteUserToServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_InviteUserToServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).InviteUserToServer(ctx, req.(*InviteUserToServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_AddBotToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotToServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).AddBotToServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_AddBotToServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).AddBotToServer(ctx, req.(*AddBotToServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_PublishMessageOnServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMessageOnServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).PublishMessageOnServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_PublishMessageOnServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).PublishMessageOnServer(ctx, req.(*PublishMessageOnServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_GetMessagesFromServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesFromServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetMessagesFromServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetMessagesFromServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetMessagesFromServer(ctx, req.(*GetMessagesFromServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_CreateModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateModerationRule(ctx, req.(*CreateModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_DeleteModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).DeleteModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_DeleteModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).DeleteModerationRule(ctx, req.(*DeleteModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_GetModerationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetModerationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetModerationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetModerationRules(ctx, req.(*GetModerationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_GetModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetModerationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetModerationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetModerationActions(ctx, req.(*GetModerationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ExportServerChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportServerChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServerServiceServer).ExportServerChat(m, &serverServiceExportServerChatServer{stream})
+}
+
+type ServerService_ExportServerChatServer interface {
+	Send(*ExportChatChunk) error
+	grpc.ServerStream
+}
+
+type serverServiceExportServerChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverServiceExportServerChatServer) Send(m *ExportChatChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ServerService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_RecordAuditEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAuditEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).RecordAuditEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_RecordAuditEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).RecordAuditEntry(ctx, req.(*RecordAuditEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_CreateServerTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateServerTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateServerTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateServerTemplate(ctx, req.(*CreateServerTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_CreateServerFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateServerFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateServerFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateServerFromTemplate(ctx, req.(*CreateServerFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_RegenerateWebhookToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateWebhookTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).RegenerateWebhookToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_RegenerateWebhookToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).RegenerateWebhookToken(ctx, req.(*RegenerateWebhookTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerService_ExecuteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ExecuteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ExecuteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ExecuteWebhook(ctx, req.(*ExecuteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverServiceClient) UnsubscribeServer(ctx context.Context, in *UnsubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, ServerService_UnsubscribeServer_FullMethodName, in, out, opts...)
-	if err != nil {
+func _ServerService_CreateOutgoingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOutgoingWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateOutgoingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateOutgoingWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateOutgoingWebhook(ctx, req.(*CreateOutgoingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverServiceClient) SearchServerByUserId(ctx context.Context, in *SearchServerByUserIdRequest, opts ...grpc.CallOption) (*SearchServerByUserIdResponse, error) {
-	out := new(SearchServerByUserIdResponse)
-	err := c.cc.Invoke(ctx, ServerService_SearchServerByUserId_FullMethodName, in, out, opts...)
-	if err != nil {
+func _ServerService_ListOutgoingWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingWebhooksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ListOutgoingWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ListOutgoingWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ListOutgoingWebhooks(ctx, req.(*ListOutgoingWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverServiceClient) InviteUserToServer(ctx context.Context, in *InviteUserToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, ServerService_InviteUserToServer_FullMethodName, in, out, opts...)
-	if err != nil {
+func _ServerService_EnableOutgoingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableOutgoingWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServiceServer).EnableOutgoingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_EnableOutgoingWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).EnableOutgoingWebhook(ctx, req.(*EnableOutgoingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverServiceClient) PublishMessageOnServer(ctx context.Context, in *PublishMessageOnServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, ServerService_PublishMessageOnServer_FullMethodName, in, out, opts...)
-	if err != nil {
+func _ServerService_DeleteOutgoingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOutgoingWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServiceServer).DeleteOutgoingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_DeleteOutgoingWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).DeleteOutgoingWebhook(ctx, req.(*DeleteOutgoingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverServiceClient) GetMessagesFromServer(ctx context.Context, in *GetMessagesFromServerRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
-	out := new(GetMessagesResponse)
-	err := c.cc.Invoke(ctx, ServerService_GetMessagesFromServer_FullMethodName, in, out, opts...)
-	if err != nil {
+func _ServerService_ListOutgoingDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingDeliveriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ServerServiceServer is the server API for ServerService service.
-// All implementations must embed UnimplementedServerServiceServer
-// for forward compatibility
-type ServerServiceServer interface {
-	CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error)
-	SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error)
-	SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error)
-	UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error)
-	SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error)
-	InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error)
-	PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error)
-	GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error)
-	mustEmbedUnimplementedServerServiceServer()
-}
-
-// UnimplementedServerServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedServerServiceServer struct {
-}
-
-func (UnimplementedServerServiceServer) CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateServer not implemented")
-}
-func (UnimplementedServerServiceServer) SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchServer not implemented")
-}
-func (UnimplementedServerServiceServer) SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeServer not implemented")
-}
-func (UnimplementedServerServiceServer) UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeServer not implemented")
-}
-func (UnimplementedServerServiceServer) SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchServerByUserId not implemented")
-}
-func (UnimplementedServerServiceServer) InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InviteUserToServer not implemented")
-}
-func (UnimplementedServerServiceServer) PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishMessageOnServer not implemented")
-}
-func (UnimplementedServerServiceServer) GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesFromServer not implemented")
-}
-func (UnimplementedServerServiceServer) mustEmbedUnimplementedServerServiceServer() {}
-
-// UnsafeServerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ServerServiceServer will
-// result in compilation errors.
-type UnsafeServerServiceServer interface {
-	mustEmbedUnimplementedServerServiceServer()
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ListOutgoingDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_ListOutgoingDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ListOutgoingDeliveries(ctx, req.(*ListOutgoingDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterServerServiceServer(s grpc.ServiceRegistrar, srv ServerServiceServer) {
-	s.RegisterService(&ServerService_ServiceDesc, srv)
+func _ServerService_CreateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).CreateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerService_CreateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).CreateCommand(ctx, req.(*CreateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_CreateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateServerRequest)
+func _ServerService_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).CreateServer(ctx, in)
+		return srv.(ServerServiceServer).ListCommands(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_CreateServer_FullMethodName,
+		FullMethod: ServerService_ListCommands_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).CreateServer(ctx, req.(*CreateServerRequest))
+		return srv.(ServerServiceServer).ListCommands(ctx, req.(*ListCommandsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_SearchServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchServerRequest)
+func _ServerService_DeleteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).SearchServer(ctx, in)
+		return srv.(ServerServiceServer).DeleteCommand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_SearchServer_FullMethodName,
+		FullMethod: ServerService_DeleteCommand_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).SearchServer(ctx, req.(*SearchServerRequest))
+		return srv.(ServerServiceServer).DeleteCommand(ctx, req.(*DeleteCommandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_SubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubscribeServerRequest)
+func _ServerService_RespondInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondInteractionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).SubscribeServer(ctx, in)
+		return srv.(ServerServiceServer).RespondInteraction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_SubscribeServer_FullMethodName,
+		FullMethod: ServerService_RespondInteraction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).SubscribeServer(ctx, req.(*SubscribeServerRequest))
+		return srv.(ServerServiceServer).RespondInteraction(ctx, req.(*RespondInteractionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_UnsubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsubscribeServerRequest)
+func _ServerService_CreateEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmojiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).UnsubscribeServer(ctx, in)
+		return srv.(ServerServiceServer).CreateEmoji(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_UnsubscribeServer_FullMethodName,
+		FullMethod: ServerService_CreateEmoji_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).UnsubscribeServer(ctx, req.(*UnsubscribeServerRequest))
+		return srv.(ServerServiceServer).CreateEmoji(ctx, req.(*CreateEmojiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_SearchServerByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchServerByUserIdRequest)
+func _ServerService_ListServerEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerEmojiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).SearchServerByUserId(ctx, in)
+		return srv.(ServerServiceServer).ListServerEmoji(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_SearchServerByUserId_FullMethodName,
+		FullMethod: ServerService_ListServerEmoji_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).SearchServerByUserId(ctx, req.(*SearchServerByUserIdRequest))
+		return srv.(ServerServiceServer).ListServerEmoji(ctx, req.(*ListServerEmojiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_InviteUserToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InviteUserToServerRequest)
+func _ServerService_RenameEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameEmojiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).InviteUserToServer(ctx, in)
+		return srv.(ServerServiceServer).RenameEmoji(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_InviteUserToServer_FullMethodName,
+		FullMethod: ServerService_RenameEmoji_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).InviteUserToServer(ctx, req.(*InviteUserToServerRequest))
+		return srv.(ServerServiceServer).RenameEmoji(ctx, req.(*RenameEmojiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_PublishMessageOnServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishMessageOnServerRequest)
+func _ServerService_DeleteEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmojiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).PublishMessageOnServer(ctx, in)
+		return srv.(ServerServiceServer).DeleteEmoji(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_PublishMessageOnServer_FullMethodName,
+		FullMethod: ServerService_DeleteEmoji_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).PublishMessageOnServer(ctx, req.(*PublishMessageOnServerRequest))
+		return srv.(ServerServiceServer).DeleteEmoji(ctx, req.(*DeleteEmojiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ServerService_GetMessagesFromServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessagesFromServerRequest)
+func _ServerService_GetEmojiImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServiceServer).GetMessagesFromServer(ctx, in)
+		return srv.(ServerServiceServer).GetEmojiImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ServerService_GetMessagesFromServer_FullMethodName,
+		FullMethod: ServerService_GetEmojiImage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServiceServer).GetMessagesFromServer(ctx, req.(*GetMessagesFromServerRequest))
+		return srv.(ServerServiceServer).GetEmojiImage(ctx, req.(*GetEmojiImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -338,6 +1626,38 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchServer",
 			Handler:    _ServerService_SearchServer_Handler,
 		},
+		{
+			MethodName: "GetServer",
+			Handler:    _ServerService_GetServer_Handler,
+		},
+		{
+			MethodName: "UpdateServer",
+			Handler:    _ServerService_UpdateServer_Handler,
+		},
+		{
+			MethodName: "DeleteServer",
+			Handler:    _ServerService_DeleteServer_Handler,
+		},
+		{
+			MethodName: "RestoreServer",
+			Handler:    _ServerService_RestoreServer_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _ServerService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "ListServerMembers",
+			Handler:    _ServerService_ListServerMembers_Handler,
+		},
+		{
+			MethodName: "GetMember",
+			Handler:    _ServerService_GetMember_Handler,
+		},
+		{
+			MethodName: "SetMemberNickname",
+			Handler:    _ServerService_SetMemberNickname_Handler,
+		},
 		{
 			MethodName: "SubscribeServer",
 			Handler:    _ServerService_SubscribeServer_Handler,
@@ -354,6 +1674,10 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InviteUserToServer",
 			Handler:    _ServerService_InviteUserToServer_Handler,
 		},
+		{
+			MethodName: "AddBotToServer",
+			Handler:    _ServerService_AddBotToServer_Handler,
+		},
 		{
 			MethodName: "PublishMessageOnServer",
 			Handler:    _ServerService_PublishMessageOnServer_Handler,
@@ -362,7 +1686,125 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessagesFromServer",
 			Handler:    _ServerService_GetMessagesFromServer_Handler,
 		},
+		{
+			MethodName: "CreateModerationRule",
+			Handler:    _ServerService_CreateModerationRule_Handler,
+		},
+		{
+			MethodName: "DeleteModerationRule",
+			Handler:    _ServerService_DeleteModerationRule_Handler,
+		},
+		{
+			MethodName: "GetModerationRules",
+			Handler:    _ServerService_GetModerationRules_Handler,
+		},
+		{
+			MethodName: "GetModerationActions",
+			Handler:    _ServerService_GetModerationActions_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _ServerService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "RecordAuditEntry",
+			Handler:    _ServerService_RecordAuditEntry_Handler,
+		},
+		{
+			MethodName: "CreateServerTemplate",
+			Handler:    _ServerService_CreateServerTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _ServerService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateServerFromTemplate",
+			Handler:    _ServerService_CreateServerFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _ServerService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _ServerService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "RegenerateWebhookToken",
+			Handler:    _ServerService_RegenerateWebhookToken_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _ServerService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ExecuteWebhook",
+			Handler:    _ServerService_ExecuteWebhook_Handler,
+		},
+		{
+			MethodName: "CreateOutgoingWebhook",
+			Handler:    _ServerService_CreateOutgoingWebhook_Handler,
+		},
+		{
+			MethodName: "ListOutgoingWebhooks",
+			Handler:    _ServerService_ListOutgoingWebhooks_Handler,
+		},
+		{
+			MethodName: "EnableOutgoingWebhook",
+			Handler:    _ServerService_EnableOutgoingWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteOutgoingWebhook",
+			Handler:    _ServerService_DeleteOutgoingWebhook_Handler,
+		},
+		{
+			MethodName: "ListOutgoingDeliveries",
+			Handler:    _ServerService_ListOutgoingDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateCommand",
+			Handler:    _ServerService_CreateCommand_Handler,
+		},
+		{
+			MethodName: "ListCommands",
+			Handler:    _ServerService_ListCommands_Handler,
+		},
+		{
+			MethodName: "DeleteCommand",
+			Handler:    _ServerService_DeleteCommand_Handler,
+		},
+		{
+			MethodName: "RespondInteraction",
+			Handler:    _ServerService_RespondInteraction_Handler,
+		},
+		{
+			MethodName: "CreateEmoji",
+			Handler:    _ServerService_CreateEmoji_Handler,
+		},
+		{
+			MethodName: "ListServerEmoji",
+			Handler:    _ServerService_ListServerEmoji_Handler,
+		},
+		{
+			MethodName: "RenameEmoji",
+			Handler:    _ServerService_RenameEmoji_Handler,
+		},
+		{
+			MethodName: "DeleteEmoji",
+			Handler:    _ServerService_DeleteEmoji_Handler,
+		},
+		{
+			MethodName: "GetEmojiImage",
+			Handler:    _ServerService_GetEmojiImage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportServerChat",
+			Handler:       _ServerService_ExportServerChat_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/api/server/server.proto",
 }
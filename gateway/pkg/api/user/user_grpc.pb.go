@@ -23,12 +23,14 @@ const (
 	UserService_GetUserByLoginAndPassword_FullMethodName = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetUserByLoginAndPassword"
 	UserService_UpdateUser_FullMethodName                = "/github.com.Nixonxp.discord.user.api.v1.UserService/UpdateUser"
 	UserService_GetUserByLogin_FullMethodName            = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetUserByLogin"
+	UserService_GetUsersByIds_FullMethodName             = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetUsersByIds"
 	UserService_GetUserFriends_FullMethodName            = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetUserFriends"
 	UserService_GetUserInvites_FullMethodName            = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetUserInvites"
 	UserService_AddToFriendByUserId_FullMethodName       = "/github.com.Nixonxp.discord.user.api.v1.UserService/AddToFriendByUserId"
 	UserService_AcceptFriendInvite_FullMethodName        = "/github.com.Nixonxp.discord.user.api.v1.UserService/AcceptFriendInvite"
 	UserService_DeclineFriendInvite_FullMethodName       = "/github.com.Nixonxp.discord.user.api.v1.UserService/DeclineFriendInvite"
 	UserService_DeleteFromFriend_FullMethodName          = "/github.com.Nixonxp.discord.user.api.v1.UserService/DeleteFromFriend"
+	UserService_GetBot_FullMethodName                    = "/github.com.Nixonxp.discord.user.api.v1.UserService/GetBot"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -39,12 +41,14 @@ type UserServiceClient interface {
 	GetUserByLoginAndPassword(ctx context.Context, in *GetUserByLoginAndPasswordRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
 	GetUserByLogin(ctx context.Context, in *GetUserByLoginRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
+	GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error)
 	GetUserFriends(ctx context.Context, in *GetUserFriendsRequest, opts ...grpc.CallOption) (*GetUserFriendsResponse, error)
 	GetUserInvites(ctx context.Context, in *GetUserInvitesRequest, opts ...grpc.CallOption) (*GetUserInvitesResponse, error)
 	AddToFriendByUserId(ctx context.Context, in *AddToFriendByUserIdRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	AcceptFriendInvite(ctx context.Context, in *AcceptFriendInviteRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	DeclineFriendInvite(ctx context.Context, in *DeclineFriendInviteRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	DeleteFromFriend(ctx context.Context, in *DeleteFromFriendRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	GetBot(ctx context.Context, in *GetBotRequest, opts ...grpc.CallOption) (*Bot, error)
 }
 
 type userServiceClient struct {
@@ -91,6 +95,15 @@ func (c *userServiceClient) GetUserByLogin(ctx context.Context, in *GetUserByLog
 	return out, nil
 }
 
+func (c *userServiceClient) GetUsersByIds(ctx context.Context, in *GetUsersByIdsRequest, opts ...grpc.CallOption) (*GetUsersByIdsResponse, error) {
+	out := new(GetUsersByIdsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUsersByIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserFriends(ctx context.Context, in *GetUserFriendsRequest, opts ...grpc.CallOption) (*GetUserFriendsResponse, error) {
 	out := new(GetUserFriendsResponse)
 	err := c.cc.Invoke(ctx, UserService_GetUserFriends_FullMethodName, in, out, opts...)
@@ -145,6 +158,15 @@ func (c *userServiceClient) DeleteFromFriend(ctx context.Context, in *DeleteFrom
 	return out, nil
 }
 
+func (c *userServiceClient) GetBot(ctx context.Context, in *GetBotRequest, opts ...grpc.CallOption) (*Bot, error) {
+	out := new(Bot)
+	err := c.cc.Invoke(ctx, UserService_GetBot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -153,12 +175,14 @@ type UserServiceServer interface {
 	GetUserByLoginAndPassword(context.Context, *GetUserByLoginAndPasswordRequest) (*UserDataResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UserDataResponse, error)
 	GetUserByLogin(context.Context, *GetUserByLoginRequest) (*UserDataResponse, error)
+	GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error)
 	GetUserFriends(context.Context, *GetUserFriendsRequest) (*GetUserFriendsResponse, error)
 	GetUserInvites(context.Context, *GetUserInvitesRequest) (*GetUserInvitesResponse, error)
 	AddToFriendByUserId(context.Context, *AddToFriendByUserIdRequest) (*ActionResponse, error)
 	AcceptFriendInvite(context.Context, *AcceptFriendInviteRequest) (*ActionResponse, error)
 	DeclineFriendInvite(context.Context, *DeclineFriendInviteRequest) (*ActionResponse, error)
 	DeleteFromFriend(context.Context, *DeleteFromFriendRequest) (*ActionResponse, error)
+	GetBot(context.Context, *GetBotRequest) (*Bot, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -178,6 +202,9 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) GetUserByLogin(context.Context, *GetUserByLoginRequest) (*UserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByLogin not implemented")
 }
+func (UnimplementedUserServiceServer) GetUsersByIds(context.Context, *GetUsersByIdsRequest) (*GetUsersByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIds not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserFriends(context.Context, *GetUserFriendsRequest) (*GetUserFriendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserFriends not implemented")
 }
@@ -196,6 +223,9 @@ func (UnimplementedUserServiceServer) DeclineFriendInvite(context.Context, *Decl
 func (UnimplementedUserServiceServer) DeleteFromFriend(context.Context, *DeleteFromFriendRequest) (*ActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromFriend not implemented")
 }
+func (UnimplementedUserServiceServer) GetBot(context.Context, *GetBotRequest) (*Bot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBot not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -281,6 +311,24 @@ func _UserService_GetUserByLogin_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUsersByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByIds(ctx, req.(*GetUsersByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserFriendsRequest)
 	if err := dec(in); err != nil {
@@ -389,6 +437,24 @@ func _UserService_DeleteFromFriend_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBot(ctx, req.(*GetBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -412,6 +478,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByLogin",
 			Handler:    _UserService_GetUserByLogin_Handler,
 		},
+		{
+			MethodName: "GetUsersByIds",
+			Handler:    _UserService_GetUsersByIds_Handler,
+		},
 		{
 			MethodName: "GetUserFriends",
 			Handler:    _UserService_GetUserFriends_Handler,
@@ -436,6 +506,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFromFriend",
 			Handler:    _UserService_DeleteFromFriend_Handler,
 		},
+		{
+			MethodName: "GetBot",
+			Handler:    _UserService_GetBot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/api/user/user.proto",
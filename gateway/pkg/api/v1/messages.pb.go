@@ -1432,7 +1432,11 @@ type SearchServerRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tags     []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category string   `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Cursor   string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit    int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (x *SearchServerRequest) Reset() {
@@ -1474,6 +1478,34 @@ func (x *SearchServerRequest) GetName() string {
 	return ""
 }
 
+func (x *SearchServerRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchServerRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchServerRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchServerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 type SubscribeServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1573,7 +1605,8 @@ type SearchServerResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Servers []*ServerInfo `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Servers    []*ServerInfo `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	NextCursor string        `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (x *SearchServerResponse) Reset() {
@@ -1615,13 +1648,25 @@ func (x *SearchServerResponse) GetServers() []*ServerInfo {
 	return nil
 }
 
+func (x *SearchServerResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ServerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IconUrl     string   `protobuf:"bytes,4,opt,name=icon_url,proto3" json:"icon_url,omitempty"`
+	Tags        []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category    string   `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	MemberCount int64    `protobuf:"varint,7,opt,name=member_count,proto3" json:"member_count,omitempty"`
 }
 
 func (x *ServerInfo) Reset() {
@@ -1670,16 +1715,51 @@ func (x *ServerInfo) GetName() string {
 	return ""
 }
 
-type ErrorMessage struct {
+func (x *ServerInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+type GetServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
 }
 
-func (x *ErrorMessage) Reset() {
-	*x = ErrorMessage{}
+func (x *GetServerRequest) Reset() {
+	*x = GetServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1687,13 +1767,13 @@ func (x *ErrorMessage) Reset() {
 	}
 }
 
-func (x *ErrorMessage) String() string {
+func (x *GetServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorMessage) ProtoMessage() {}
+func (*GetServerRequest) ProtoMessage() {}
 
-func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
+func (x *GetServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1705,28 +1785,36 @@ func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
-func (*ErrorMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServerRequest.ProtoReflect.Descriptor instead.
+func (*GetServerRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ErrorMessage) GetMessage() string {
+func (x *GetServerRequest) GetServerId() string {
 	if x != nil {
-		return x.Message
+		return x.ServerId
 	}
 	return ""
 }
 
-type SearchServerByUserIdRequest struct {
+type UpdateServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	ServerId     string      `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name         *string     `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description  *string     `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IconUrl      *string     `protobuf:"bytes,4,opt,name=icon_url,proto3,oneof" json:"icon_url,omitempty"`
+	BannerUrl    *string     `protobuf:"bytes,5,opt,name=banner_url,proto3,oneof" json:"banner_url,omitempty"`
+	Visibility   *string     `protobuf:"bytes,6,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	Tags         *ServerTags `protobuf:"bytes,7,opt,name=tags,proto3" json:"tags,omitempty"`
+	Category     *string     `protobuf:"bytes,8,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Discoverable *bool       `protobuf:"varint,9,opt,name=discoverable,proto3,oneof" json:"discoverable,omitempty"`
 }
 
-func (x *SearchServerByUserIdRequest) Reset() {
-	*x = SearchServerByUserIdRequest{}
+func (x *UpdateServerRequest) Reset() {
+	*x = UpdateServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,13 +1822,13 @@ func (x *SearchServerByUserIdRequest) Reset() {
 	}
 }
 
-func (x *SearchServerByUserIdRequest) String() string {
+func (x *UpdateServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchServerByUserIdRequest) ProtoMessage() {}
+func (*UpdateServerRequest) ProtoMessage() {}
 
-func (x *SearchServerByUserIdRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,28 +1840,84 @@ func (x *SearchServerByUserIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchServerByUserIdRequest.ProtoReflect.Descriptor instead.
-func (*SearchServerByUserIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateServerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SearchServerByUserIdRequest) GetUserId() string {
+func (x *UpdateServerRequest) GetServerId() string {
 	if x != nil {
-		return x.UserId
+		return x.ServerId
 	}
 	return ""
 }
 
-type SearchServerByUserIdResponse struct {
+func (x *UpdateServerRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetIconUrl() string {
+	if x != nil && x.IconUrl != nil {
+		return *x.IconUrl
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetBannerUrl() string {
+	if x != nil && x.BannerUrl != nil {
+		return *x.BannerUrl
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetTags() *ServerTags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateServerRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetDiscoverable() bool {
+	if x != nil && x.Discoverable != nil {
+		return *x.Discoverable
+	}
+	return false
+}
+
+type ServerTags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id []string `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *SearchServerByUserIdResponse) Reset() {
-	*x = SearchServerByUserIdResponse{}
+func (x *ServerTags) Reset() {
+	*x = ServerTags{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1781,13 +1925,13 @@ func (x *SearchServerByUserIdResponse) Reset() {
 	}
 }
 
-func (x *SearchServerByUserIdResponse) String() string {
+func (x *ServerTags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchServerByUserIdResponse) ProtoMessage() {}
+func (*ServerTags) ProtoMessage() {}
 
-func (x *SearchServerByUserIdResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerTags) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1799,29 +1943,28 @@ func (x *SearchServerByUserIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchServerByUserIdResponse.ProtoReflect.Descriptor instead.
-func (*SearchServerByUserIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerTags.ProtoReflect.Descriptor instead.
+func (*ServerTags) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SearchServerByUserIdResponse) GetId() []string {
+func (x *ServerTags) GetTags() []string {
 	if x != nil {
-		return x.Id
+		return x.Tags
 	}
 	return nil
 }
 
-type InviteUserToServerRequest struct {
+type DeleteServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
-	ServerId string `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
 }
 
-func (x *InviteUserToServerRequest) Reset() {
-	*x = InviteUserToServerRequest{}
+func (x *DeleteServerRequest) Reset() {
+	*x = DeleteServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1829,13 +1972,13 @@ func (x *InviteUserToServerRequest) Reset() {
 	}
 }
 
-func (x *InviteUserToServerRequest) String() string {
+func (x *DeleteServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteUserToServerRequest) ProtoMessage() {}
+func (*DeleteServerRequest) ProtoMessage() {}
 
-func (x *InviteUserToServerRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1847,36 +1990,28 @@ func (x *InviteUserToServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteUserToServerRequest.ProtoReflect.Descriptor instead.
-func (*InviteUserToServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *InviteUserToServerRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *InviteUserToServerRequest) GetServerId() string {
+func (x *DeleteServerRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-type PublishMessageOnServerRequest struct {
+type RestoreServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
-	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *PublishMessageOnServerRequest) Reset() {
-	*x = PublishMessageOnServerRequest{}
+func (x *RestoreServerRequest) Reset() {
+	*x = RestoreServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1884,13 +2019,13 @@ func (x *PublishMessageOnServerRequest) Reset() {
 	}
 }
 
-func (x *PublishMessageOnServerRequest) String() string {
+func (x *RestoreServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishMessageOnServerRequest) ProtoMessage() {}
+func (*RestoreServerRequest) ProtoMessage() {}
 
-func (x *PublishMessageOnServerRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1902,35 +2037,29 @@ func (x *PublishMessageOnServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishMessageOnServerRequest.ProtoReflect.Descriptor instead.
-func (*PublishMessageOnServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreServerRequest.ProtoReflect.Descriptor instead.
+func (*RestoreServerRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *PublishMessageOnServerRequest) GetServerId() string {
+func (x *RestoreServerRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-func (x *PublishMessageOnServerRequest) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-type GetMessagesFromServerRequest struct {
+type TransferOwnershipRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	ServerId   string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	NewOwnerId string `protobuf:"bytes,2,opt,name=new_owner_id,proto3" json:"new_owner_id,omitempty"`
 }
 
-func (x *GetMessagesFromServerRequest) Reset() {
-	*x = GetMessagesFromServerRequest{}
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1938,13 +2067,13 @@ func (x *GetMessagesFromServerRequest) Reset() {
 	}
 }
 
-func (x *GetMessagesFromServerRequest) String() string {
+func (x *TransferOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesFromServerRequest) ProtoMessage() {}
+func (*TransferOwnershipRequest) ProtoMessage() {}
 
-func (x *GetMessagesFromServerRequest) ProtoReflect() protoreflect.Message {
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1956,28 +2085,45 @@ func (x *GetMessagesFromServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesFromServerRequest.ProtoReflect.Descriptor instead.
-func (*GetMessagesFromServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetMessagesFromServerRequest) GetServerId() string {
+func (x *TransferOwnershipRequest) GetServerId() string {
 	if x != nil {
 		return x.ServerId
 	}
 	return ""
 }
 
-type GetMessagesResponse struct {
+func (x *TransferOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type ServerProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-}
-
-func (x *GetMessagesResponse) Reset() {
-	*x = GetMessagesResponse{}
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId      string   `protobuf:"bytes,3,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	Description  string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IconUrl      string   `protobuf:"bytes,5,opt,name=icon_url,proto3" json:"icon_url,omitempty"`
+	BannerUrl    string   `protobuf:"bytes,6,opt,name=banner_url,proto3" json:"banner_url,omitempty"`
+	Visibility   string   `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	MemberCount  int64    `protobuf:"varint,8,opt,name=member_count,proto3" json:"member_count,omitempty"`
+	Tags         []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category     string   `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	Discoverable bool     `protobuf:"varint,11,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+}
+
+func (x *ServerProfile) Reset() {
+	*x = ServerProfile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1985,13 +2131,13 @@ func (x *GetMessagesResponse) Reset() {
 	}
 }
 
-func (x *GetMessagesResponse) String() string {
+func (x *ServerProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesResponse) ProtoMessage() {}
+func (*ServerProfile) ProtoMessage() {}
 
-func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2003,122 +2149,115 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerProfile.ProtoReflect.Descriptor instead.
+func (*ServerProfile) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetMessagesResponse) GetMessages() []*Message {
+func (x *ServerProfile) GetId() string {
 	if x != nil {
-		return x.Messages
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type Message struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text      string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	ChatId    string                 `protobuf:"bytes,4,opt,name=chat_id,proto3" json:"chat_id,omitempty"`
-	OwnerId   string                 `protobuf:"bytes,5,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+func (x *ServerProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_messages_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ServerProfile) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
 	}
+	return ""
 }
 
-func (x *Message) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServerProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*Message) ProtoMessage() {}
-
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_messages_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ServerProfile) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_api_v1_messages_proto_rawDescGZIP(), []int{38}
+func (x *ServerProfile) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
 }
 
-func (x *Message) GetId() string {
+func (x *ServerProfile) GetVisibility() string {
 	if x != nil {
-		return x.Id
+		return x.Visibility
 	}
 	return ""
 }
 
-func (x *Message) GetText() string {
+func (x *ServerProfile) GetMemberCount() int64 {
 	if x != nil {
-		return x.Text
+		return x.MemberCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetTimestamp() *timestamppb.Timestamp {
+func (x *ServerProfile) GetTags() []string {
 	if x != nil {
-		return x.Timestamp
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *Message) GetChatId() string {
+func (x *ServerProfile) GetCategory() string {
 	if x != nil {
-		return x.ChatId
+		return x.Category
 	}
 	return ""
 }
 
-func (x *Message) GetOwnerId() string {
+func (x *ServerProfile) GetDiscoverable() bool {
 	if x != nil {
-		return x.OwnerId
+		return x.Discoverable
 	}
-	return ""
+	return false
 }
 
-type AddChannelRequest struct {
+type ListServerMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Cursor   string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *AddChannelRequest) Reset() {
-	*x = AddChannelRequest{}
+func (x *ListServerMembersRequest) Reset() {
+	*x = ListServerMembersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_messages_proto_msgTypes[39]
+		mi := &file_api_v1_messages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddChannelRequest) String() string {
+func (x *ListServerMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddChannelRequest) ProtoMessage() {}
+func (*ListServerMembersRequest) ProtoMessage() {}
 
-func (x *AddChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_messages_proto_msgTypes[39]
+func (x *ListServerMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,28 +2268,98 @@ func (x *AddChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddChannelRequest.ProtoReflect.Descriptor instead.
-func (*AddChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListServerMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListServerMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListServerMembersRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ListServerMembersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListServerMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListServerMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members    []*ServerMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextCursor string          `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListServerMembersResponse) Reset() {
+	*x = ListServerMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerMembersResponse) ProtoMessage() {}
+
+func (x *ListServerMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListServerMembersResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AddChannelRequest) GetName() string {
+func (x *ListServerMembersResponse) GetMembers() []*ServerMember {
 	if x != nil {
-		return x.Name
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListServerMembersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-type DeleteChannelRequest struct {
+type GetMemberRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	UserId   string `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
 }
 
-func (x *DeleteChannelRequest) Reset() {
-	*x = DeleteChannelRequest{}
+func (x *GetMemberRequest) Reset() {
+	*x = GetMemberRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,13 +2367,13 @@ func (x *DeleteChannelRequest) Reset() {
 	}
 }
 
-func (x *DeleteChannelRequest) String() string {
+func (x *GetMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteChannelRequest) ProtoMessage() {}
+func (*GetMemberRequest) ProtoMessage() {}
 
-func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2176,28 +2385,38 @@ func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
-func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteChannelRequest) GetChannelId() string {
+func (x *GetMemberRequest) GetServerId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.ServerId
 	}
 	return ""
 }
 
-type JoinChannelRequest struct {
+func (x *GetMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// SetMemberNicknameRequest - empty nickname removes the nickname
+type SetMemberNicknameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	UserId   string `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
 }
 
-func (x *JoinChannelRequest) Reset() {
-	*x = JoinChannelRequest{}
+func (x *SetMemberNicknameRequest) Reset() {
+	*x = SetMemberNicknameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2205,13 +2424,13 @@ func (x *JoinChannelRequest) Reset() {
 	}
 }
 
-func (x *JoinChannelRequest) String() string {
+func (x *SetMemberNicknameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinChannelRequest) ProtoMessage() {}
+func (*SetMemberNicknameRequest) ProtoMessage() {}
 
-func (x *JoinChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *SetMemberNicknameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2223,28 +2442,49 @@ func (x *JoinChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinChannelRequest.ProtoReflect.Descriptor instead.
-func (*JoinChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMemberNicknameRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberNicknameRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *JoinChannelRequest) GetChannelId() string {
+func (x *SetMemberNicknameRequest) GetServerId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.ServerId
 	}
 	return ""
 }
 
-type LeaveChannelRequest struct {
+func (x *SetMemberNicknameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMemberNicknameRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type ServerMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// not set when join time is unknown
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=joined_at,proto3" json:"joined_at,omitempty"`
+	IsOwner  bool                   `protobuf:"varint,3,opt,name=is_owner,proto3" json:"is_owner,omitempty"`
+	// not set when user profile is unavailable
+	Profile *MemberProfile `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	// empty when the member has no nickname in the server
+	Nickname string `protobuf:"bytes,5,opt,name=nickname,proto3" json:"nickname,omitempty"`
 }
 
-func (x *LeaveChannelRequest) Reset() {
-	*x = LeaveChannelRequest{}
+func (x *ServerMember) Reset() {
+	*x = ServerMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,13 +2492,13 @@ func (x *LeaveChannelRequest) Reset() {
 	}
 }
 
-func (x *LeaveChannelRequest) String() string {
+func (x *ServerMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveChannelRequest) ProtoMessage() {}
+func (*ServerMember) ProtoMessage() {}
 
-func (x *LeaveChannelRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerMember) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2270,29 +2510,59 @@ func (x *LeaveChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveChannelRequest.ProtoReflect.Descriptor instead.
-func (*LeaveChannelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerMember.ProtoReflect.Descriptor instead.
+func (*ServerMember) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *LeaveChannelRequest) GetChannelId() string {
+func (x *ServerMember) GetUserId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.UserId
 	}
 	return ""
 }
 
-type SendUserPrivateMessageRequest struct {
+func (x *ServerMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *ServerMember) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+func (x *ServerMember) GetProfile() *MemberProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ServerMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type MemberProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
-	Text   string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Login          string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarPhotoUrl string `protobuf:"bytes,3,opt,name=avatar_photo_url,proto3" json:"avatar_photo_url,omitempty"`
+	IsBot          bool   `protobuf:"varint,4,opt,name=is_bot,proto3" json:"is_bot,omitempty"`
 }
 
-func (x *SendUserPrivateMessageRequest) Reset() {
-	*x = SendUserPrivateMessageRequest{}
+func (x *MemberProfile) Reset() {
+	*x = MemberProfile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2300,13 +2570,13 @@ func (x *SendUserPrivateMessageRequest) Reset() {
 	}
 }
 
-func (x *SendUserPrivateMessageRequest) String() string {
+func (x *MemberProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendUserPrivateMessageRequest) ProtoMessage() {}
+func (*MemberProfile) ProtoMessage() {}
 
-func (x *SendUserPrivateMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *MemberProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2318,35 +2588,49 @@ func (x *SendUserPrivateMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendUserPrivateMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendUserPrivateMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemberProfile.ProtoReflect.Descriptor instead.
+func (*MemberProfile) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SendUserPrivateMessageRequest) GetUserId() string {
+func (x *MemberProfile) GetLogin() string {
 	if x != nil {
-		return x.UserId
+		return x.Login
 	}
 	return ""
 }
 
-func (x *SendUserPrivateMessageRequest) GetText() string {
+func (x *MemberProfile) GetName() string {
 	if x != nil {
-		return x.Text
+		return x.Name
 	}
 	return ""
 }
 
-type GetUserPrivateMessagesRequest struct {
+func (x *MemberProfile) GetAvatarPhotoUrl() string {
+	if x != nil {
+		return x.AvatarPhotoUrl
+	}
+	return ""
+}
+
+func (x *MemberProfile) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+type ErrorMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetUserPrivateMessagesRequest) Reset() {
-	*x = GetUserPrivateMessagesRequest{}
+func (x *ErrorMessage) Reset() {
+	*x = ErrorMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2354,13 +2638,13 @@ func (x *GetUserPrivateMessagesRequest) Reset() {
 	}
 }
 
-func (x *GetUserPrivateMessagesRequest) String() string {
+func (x *ErrorMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPrivateMessagesRequest) ProtoMessage() {}
+func (*ErrorMessage) ProtoMessage() {}
 
-func (x *GetUserPrivateMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2372,28 +2656,28 @@ func (x *GetUserPrivateMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPrivateMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPrivateMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
+func (*ErrorMessage) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetUserPrivateMessagesRequest) GetUserId() string {
+func (x *ErrorMessage) GetMessage() string {
 	if x != nil {
-		return x.UserId
+		return x.Message
 	}
 	return ""
 }
 
-type DeleteFromFriendRequest struct {
+type SearchServerByUserIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FriendId string `protobuf:"bytes,1,opt,name=friend_id,proto3" json:"friend_id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
 }
 
-func (x *DeleteFromFriendRequest) Reset() {
-	*x = DeleteFromFriendRequest{}
+func (x *SearchServerByUserIdRequest) Reset() {
+	*x = SearchServerByUserIdRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2401,13 +2685,13 @@ func (x *DeleteFromFriendRequest) Reset() {
 	}
 }
 
-func (x *DeleteFromFriendRequest) String() string {
+func (x *SearchServerByUserIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFromFriendRequest) ProtoMessage() {}
+func (*SearchServerByUserIdRequest) ProtoMessage() {}
 
-func (x *DeleteFromFriendRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchServerByUserIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2419,28 +2703,28 @@ func (x *DeleteFromFriendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFromFriendRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFromFriendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchServerByUserIdRequest.ProtoReflect.Descriptor instead.
+func (*SearchServerByUserIdRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DeleteFromFriendRequest) GetFriendId() string {
+func (x *SearchServerByUserIdRequest) GetUserId() string {
 	if x != nil {
-		return x.FriendId
+		return x.UserId
 	}
 	return ""
 }
 
-type CreatePrivateChatRequest struct {
+type SearchServerByUserIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Id []string `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreatePrivateChatRequest) Reset() {
-	*x = CreatePrivateChatRequest{}
+func (x *SearchServerByUserIdResponse) Reset() {
+	*x = SearchServerByUserIdResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2448,13 +2732,13 @@ func (x *CreatePrivateChatRequest) Reset() {
 	}
 }
 
-func (x *CreatePrivateChatRequest) String() string {
+func (x *SearchServerByUserIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePrivateChatRequest) ProtoMessage() {}
+func (*SearchServerByUserIdResponse) ProtoMessage() {}
 
-func (x *CreatePrivateChatRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchServerByUserIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2466,29 +2750,29 @@ func (x *CreatePrivateChatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePrivateChatRequest.ProtoReflect.Descriptor instead.
-func (*CreatePrivateChatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchServerByUserIdResponse.ProtoReflect.Descriptor instead.
+func (*SearchServerByUserIdResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreatePrivateChatRequest) GetUserId() string {
+func (x *SearchServerByUserIdResponse) GetId() []string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-type CreatePrivateChatResponse struct {
+type InviteUserToServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ChatId  string `protobuf:"bytes,2,opt,name=chatId,json=chat_id,proto3" json:"chatId,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	ServerId string `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
 }
 
-func (x *CreatePrivateChatResponse) Reset() {
-	*x = CreatePrivateChatResponse{}
+func (x *InviteUserToServerRequest) Reset() {
+	*x = InviteUserToServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_messages_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2496,13 +2780,13 @@ func (x *CreatePrivateChatResponse) Reset() {
 	}
 }
 
-func (x *CreatePrivateChatResponse) String() string {
+func (x *InviteUserToServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePrivateChatResponse) ProtoMessage() {}
+func (*InviteUserToServerRequest) ProtoMessage() {}
 
-func (x *CreatePrivateChatResponse) ProtoReflect() protoreflect.Message {
+func (x *InviteUserToServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_messages_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2514,455 +2798,9955 @@ func (x *CreatePrivateChatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePrivateChatResponse.ProtoReflect.Descriptor instead.
-func (*CreatePrivateChatResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteUserToServerRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserToServerRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_messages_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreatePrivateChatResponse) GetSuccess() bool {
+func (x *InviteUserToServerRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-func (x *CreatePrivateChatResponse) GetChatId() string {
+func (x *InviteUserToServerRequest) GetServerId() string {
 	if x != nil {
-		return x.ChatId
+		return x.ServerId
 	}
 	return ""
 }
 
-var File_api_v1_messages_proto protoreflect.FileDescriptor
+type PublishMessageOnServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_v1_messages_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
-	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xa6, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01,
-	0x72, 0x02, 0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x0b, 0x4a, 0x09,
-	0x22, 0x75, 0x73, 0x65, 0x72, 0x31, 0x32, 0x33, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8,
-	0x01, 0x01, 0x72, 0x02, 0x10, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x13,
-	0x4a, 0x11, 0x22, 0x75, 0x73, 0x65, 0x72, 0x31, 0x32, 0x33, 0x40, 0x6d, 0x61, 0x69, 0x6c, 0x2e,
-	0x72, 0x75, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x60, 0x01,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x0f, 0x4a, 0x0d,
-	0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x31, 0x32, 0x33, 0x22, 0xe0, 0x41, 0x02,
-	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x3a, 0x38, 0x92, 0x41, 0x35, 0x0a, 0x33, 0x2a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0xd2, 0x01, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0xd2,
-	0x01, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07,
-	0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3b,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1f, 0x92, 0x41, 0x0f, 0x4a, 0x0d, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x31, 0x32, 0x33, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10,
-	0x08, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x3a, 0x26, 0x92, 0x41, 0x23,
-	0x0a, 0x21, 0x2a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0xd2, 0x01, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0x4a, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0c, 0x72, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x40, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x27, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x4f, 0x61,
-	0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x28, 0x0a, 0x12, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7e, 0x0a, 0x19, 0x4f, 0x61, 0x75,
-	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x0a, 0x4a, 0x08, 0x22, 0x7b, 0x63, 0x6f, 0x64,
-	0x65, 0x7d, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x0b, 0x4a, 0x09, 0x22, 0x7b, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x7d, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
-	0x10, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x1a, 0x4f, 0x61, 0x75,
-	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a,
-	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
-	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
-	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
-	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x4d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78,
-	0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x22, 0xf7, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
-	0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75,
-	0x73, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8,
-	0x01, 0x01, 0x72, 0x02, 0x10, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x11,
-	0x4a, 0x0f, 0x22, 0x74, 0x65, 0x73, 0x74, 0x40, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d,
-	0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f,
-	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x17, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x22, 0x75, 0x72, 0x6c, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48,
-	0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
-	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x55,
-	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x4d, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72,
-	0x02, 0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
-	0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f,
-	0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x06, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x17, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x51, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e,
-	0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x0c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x1a, 0x41, 0x64, 0x64,
-	0x54, 0x6f, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
-	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
-	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
-	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
-	0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x72, 0x0a, 0x19, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
-	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
-	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
-	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
-	0xb0, 0x01, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x73,
-	0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65,
-	0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34,
-	0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08,
-	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0x4a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x0f, 0x4a, 0x0d, 0x22, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01,
-	0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x0f, 0x4a, 0x0d, 0x22,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe0, 0x41, 0x02, 0xba,
-	0x48, 0x04, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x16,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
-	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
-	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
-	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
-	0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x71, 0x0a,
-	0x18, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
-	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
-	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
-	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
-	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x22, 0x67, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x30, 0x0a, 0x0a, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
-	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
-	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
-	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30,
-	0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d,
-	0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30,
-	0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
-	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
-	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
-	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
-	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22,
-	0xa9, 0x01, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x4f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
-	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
-	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
-	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x10, 0x4a, 0x0e, 0x22, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48,
-	0x04, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x75, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
-	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
-	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
-	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
-	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x22, 0x65, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70,
-	0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x41, 0x64, 0x64,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41,
-	0x10, 0x4a, 0x0e, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x6f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92,
-	0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39,
-	0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36,
-	0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01,
-	0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x22, 0x6d, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
-	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
-	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
-	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
-	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
-	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
-	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
-	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
-	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x22, 0xa5, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
-	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
-	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
-	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x10, 0x4a, 0x0e, 0x22, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x72,
-	0x02, 0x10, 0x03, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x72, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
-	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
-	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
-	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
-	0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x70, 0x0a,
-	0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x66, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
-	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
-	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
-	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
-	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22,
-	0x6d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
-	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
-	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
-	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
-	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x4e,
-	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x3f,
-	0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x78,
-	0x6f, 0x6e, 0x78, 0x70, 0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-var (
-	file_api_v1_messages_proto_rawDescOnce sync.Once
-	file_api_v1_messages_proto_rawDescData = file_api_v1_messages_proto_rawDesc
-)
+func (x *PublishMessageOnServerRequest) Reset() {
+	*x = PublishMessageOnServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_v1_messages_proto_rawDescGZIP() []byte {
-	file_api_v1_messages_proto_rawDescOnce.Do(func() {
-		file_api_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_messages_proto_rawDescData)
-	})
-	return file_api_v1_messages_proto_rawDescData
+func (x *PublishMessageOnServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
-var file_api_v1_messages_proto_goTypes = []interface{}{
-	(*RegisterRequest)(nil),               // 0: github.com.Nixonxp.discord.gateway.api.v1.RegisterRequest
-	(*RegisterResponse)(nil),              // 1: github.com.Nixonxp.discord.gateway.api.v1.RegisterResponse
-	(*LoginRequest)(nil),                  // 2: github.com.Nixonxp.discord.gateway.api.v1.LoginRequest
-	(*LoginResponse)(nil),                 // 3: github.com.Nixonxp.discord.gateway.api.v1.LoginResponse
-	(*RefreshRequest)(nil),                // 4: github.com.Nixonxp.discord.gateway.api.v1.RefreshRequest
-	(*RefreshResponse)(nil),               // 5: github.com.Nixonxp.discord.gateway.api.v1.RefreshResponse
-	(*OauthLoginRequest)(nil),             // 6: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginRequest
-	(*OauthLoginResponse)(nil),            // 7: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginResponse
-	(*OauthLoginCallbackRequest)(nil),     // 8: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginCallbackRequest
-	(*OauthLoginCallbackResponse)(nil),    // 9: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginCallbackResponse
-	(*UpdateUserRequest)(nil),             // 10: github.com.Nixonxp.discord.gateway.api.v1.UpdateUserRequest
-	(*UpdateUserBody)(nil),                // 11: github.com.Nixonxp.discord.gateway.api.v1.UpdateUserBody
-	(*UserDataResponse)(nil),              // 12: github.com.Nixonxp.discord.gateway.api.v1.UserDataResponse
-	(*GetUserByLoginRequest)(nil),         // 13: github.com.Nixonxp.discord.gateway.api.v1.GetUserByLoginRequest
-	(*GetUserFriendsRequest)(nil),         // 14: github.com.Nixonxp.discord.gateway.api.v1.GetUserFriendsRequest
-	(*GetUserFriendsResponse)(nil),        // 15: github.com.Nixonxp.discord.gateway.api.v1.GetUserFriendsResponse
-	(*Friend)(nil),                        // 16: github.com.Nixonxp.discord.gateway.api.v1.Friend
-	(*GetUserInvitesRequest)(nil),         // 17: github.com.Nixonxp.discord.gateway.api.v1.GetUserInvitesRequest
-	(*GetUserInvitesResponse)(nil),        // 18: github.com.Nixonxp.discord.gateway.api.v1.GetUserInvitesResponse
-	(*FriendInvite)(nil),                  // 19: github.com.Nixonxp.discord.gateway.api.v1.FriendInvite
-	(*AddToFriendByUserIdRequest)(nil),    // 20: github.com.Nixonxp.discord.gateway.api.v1.AddToFriendByUserIdRequest
-	(*AcceptFriendInviteRequest)(nil),     // 21: github.com.Nixonxp.discord.gateway.api.v1.AcceptFriendInviteRequest
-	(*DeclineFriendInviteRequest)(nil),    // 22: github.com.Nixonxp.discord.gateway.api.v1.DeclineFriendInviteRequest
-	(*ActionResponse)(nil),                // 23: github.com.Nixonxp.discord.gateway.api.v1.ActionResponse
-	(*CreateServerRequest)(nil),           // 24: github.com.Nixonxp.discord.gateway.api.v1.CreateServerRequest
-	(*CreateServerResponse)(nil),          // 25: github.com.Nixonxp.discord.gateway.api.v1.CreateServerResponse
-	(*SearchServerRequest)(nil),           // 26: github.com.Nixonxp.discord.gateway.api.v1.SearchServerRequest
-	(*SubscribeServerRequest)(nil),        // 27: github.com.Nixonxp.discord.gateway.api.v1.SubscribeServerRequest
-	(*UnsubscribeServerRequest)(nil),      // 28: github.com.Nixonxp.discord.gateway.api.v1.UnsubscribeServerRequest
-	(*SearchServerResponse)(nil),          // 29: github.com.Nixonxp.discord.gateway.api.v1.SearchServerResponse
-	(*ServerInfo)(nil),                    // 30: github.com.Nixonxp.discord.gateway.api.v1.ServerInfo
-	(*ErrorMessage)(nil),                  // 31: github.com.Nixonxp.discord.gateway.api.v1.ErrorMessage
-	(*SearchServerByUserIdRequest)(nil),   // 32: github.com.Nixonxp.discord.gateway.api.v1.SearchServerByUserIdRequest
-	(*SearchServerByUserIdResponse)(nil),  // 33: github.com.Nixonxp.discord.gateway.api.v1.SearchServerByUserIdResponse
-	(*InviteUserToServerRequest)(nil),     // 34: github.com.Nixonxp.discord.gateway.api.v1.InviteUserToServerRequest
-	(*PublishMessageOnServerRequest)(nil), // 35: github.com.Nixonxp.discord.gateway.api.v1.PublishMessageOnServerRequest
-	(*GetMessagesFromServerRequest)(nil),  // 36: github.com.Nixonxp.discord.gateway.api.v1.GetMessagesFromServerRequest
-	(*GetMessagesResponse)(nil),           // 37: github.com.Nixonxp.discord.gateway.api.v1.GetMessagesResponse
-	(*Message)(nil),                       // 38: github.com.Nixonxp.discord.gateway.api.v1.Message
-	(*AddChannelRequest)(nil),             // 39: github.com.Nixonxp.discord.gateway.api.v1.AddChannelRequest
-	(*DeleteChannelRequest)(nil),          // 40: github.com.Nixonxp.discord.gateway.api.v1.DeleteChannelRequest
-	(*JoinChannelRequest)(nil),            // 41: github.com.Nixonxp.discord.gateway.api.v1.JoinChannelRequest
-	(*LeaveChannelRequest)(nil),           // 42: github.com.Nixonxp.discord.gateway.api.v1.LeaveChannelRequest
-	(*SendUserPrivateMessageRequest)(nil), // 43: github.com.Nixonxp.discord.gateway.api.v1.SendUserPrivateMessageRequest
-	(*GetUserPrivateMessagesRequest)(nil), // 44: github.com.Nixonxp.discord.gateway.api.v1.GetUserPrivateMessagesRequest
-	(*DeleteFromFriendRequest)(nil),       // 45: github.com.Nixonxp.discord.gateway.api.v1.DeleteFromFriendRequest
-	(*CreatePrivateChatRequest)(nil),      // 46: github.com.Nixonxp.discord.gateway.api.v1.CreatePrivateChatRequest
-	(*CreatePrivateChatResponse)(nil),     // 47: github.com.Nixonxp.discord.gateway.api.v1.CreatePrivateChatResponse
-	(*timestamppb.Timestamp)(nil),         // 48: google.protobuf.Timestamp
+func (*PublishMessageOnServerRequest) ProtoMessage() {}
+
+func (x *PublishMessageOnServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_v1_messages_proto_depIdxs = []int32{
-	11, // 0: github.com.Nixonxp.discord.gateway.api.v1.UpdateUserRequest.body:type_name -> github.com.Nixonxp.discord.gateway.api.v1.UpdateUserBody
-	16, // 1: github.com.Nixonxp.discord.gateway.api.v1.GetUserFriendsResponse.friends:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Friend
-	19, // 2: github.com.Nixonxp.discord.gateway.api.v1.GetUserInvitesResponse.invites:type_name -> github.com.Nixonxp.discord.gateway.api.v1.FriendInvite
-	30, // 3: github.com.Nixonxp.discord.gateway.api.v1.SearchServerResponse.servers:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ServerInfo
-	38, // 4: github.com.Nixonxp.discord.gateway.api.v1.GetMessagesResponse.messages:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Message
-	48, // 5: github.com.Nixonxp.discord.gateway.api.v1.Message.timestamp:type_name -> google.protobuf.Timestamp
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+// Deprecated: Use PublishMessageOnServerRequest.ProtoReflect.Descriptor instead.
+func (*PublishMessageOnServerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{48}
 }
 
-func init() { file_api_v1_messages_proto_init() }
-func file_api_v1_messages_proto_init() {
-	if File_api_v1_messages_proto != nil {
-		return
+func (x *PublishMessageOnServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+	return ""
+}
+
+func (x *PublishMessageOnServerRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GetMessagesFromServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *GetMessagesFromServerRequest) Reset() {
+	*x = GetMessagesFromServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessagesFromServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesFromServerRequest) ProtoMessage() {}
+
+func (x *GetMessagesFromServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesFromServerRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesFromServerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMessagesFromServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesResponse) ProtoMessage() {}
+
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ChatId    string                 `protobuf:"bytes,4,opt,name=chat_id,proto3" json:"chat_id,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,5,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	Author    *MessageAuthor         `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// webhook_id is set for messages posted by a webhook, author holds its name and avatar then
+	WebhookId string `protobuf:"bytes,7,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+	// ephemeral messages are command replies shown only to the current user
+	Ephemeral bool `protobuf:"varint,8,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Message) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Message) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Message) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Message) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *Message) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Message) GetAuthor() *MessageAuthor {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Message) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *Message) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
+type MessageAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login          string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarPhotoUrl string `protobuf:"bytes,4,opt,name=avatar_photo_url,proto3" json:"avatar_photo_url,omitempty"`
+	// nickname in the server, empty for private messages and members without nickname
+	Nickname string `protobuf:"bytes,5,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	IsBot    bool   `protobuf:"varint,6,opt,name=is_bot,proto3" json:"is_bot,omitempty"`
+}
+
+func (x *MessageAuthor) Reset() {
+	*x = MessageAuthor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAuthor) ProtoMessage() {}
+
+func (x *MessageAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAuthor.ProtoReflect.Descriptor instead.
+func (*MessageAuthor) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MessageAuthor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetAvatarPhotoUrl() string {
+	if x != nil {
+		return x.AvatarPhotoUrl
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MessageAuthor) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+type ModerationRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId       string                 `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Words          []string               `protobuf:"bytes,4,rep,name=words,proto3" json:"words,omitempty"`
+	Pattern        string                 `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Limit          int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Action         string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,8,opt,name=timeout_seconds,proto3" json:"timeout_seconds,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ModerationRule) Reset() {
+	*x = ModerationRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationRule) ProtoMessage() {}
+
+func (x *ModerationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationRule.ProtoReflect.Descriptor instead.
+func (*ModerationRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ModerationRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *ModerationRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ModerationRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ModerationRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateModerationRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId       string   `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Type           string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Words          []string `protobuf:"bytes,3,rep,name=words,proto3" json:"words,omitempty"`
+	Pattern        string   `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Limit          int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Action         string   `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	TimeoutSeconds int64    `protobuf:"varint,7,opt,name=timeout_seconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *CreateModerationRuleRequest) Reset() {
+	*x = CreateModerationRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateModerationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateModerationRuleRequest) ProtoMessage() {}
+
+func (x *CreateModerationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateModerationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateModerationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateModerationRuleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateModerationRuleRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateModerationRuleRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *CreateModerationRuleRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *CreateModerationRuleRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CreateModerationRuleRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CreateModerationRuleRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type DeleteModerationRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	RuleId   string `protobuf:"bytes,2,opt,name=rule_id,proto3" json:"rule_id,omitempty"`
+}
+
+func (x *DeleteModerationRuleRequest) Reset() {
+	*x = DeleteModerationRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteModerationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModerationRuleRequest) ProtoMessage() {}
+
+func (x *DeleteModerationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModerationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModerationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteModerationRuleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteModerationRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type GetModerationRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *GetModerationRulesRequest) Reset() {
+	*x = GetModerationRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationRulesRequest) ProtoMessage() {}
+
+func (x *GetModerationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetModerationRulesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetModerationRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*ModerationRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *GetModerationRulesResponse) Reset() {
+	*x = GetModerationRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationRulesResponse) ProtoMessage() {}
+
+func (x *GetModerationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetModerationRulesResponse) GetRules() []*ModerationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ModerationAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId  string                 `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	RuleId    string                 `protobuf:"bytes,3,opt,name=rule_id,proto3" json:"rule_id,omitempty"`
+	RuleType  string                 `protobuf:"bytes,4,opt,name=rule_type,proto3" json:"rule_type,omitempty"`
+	Action    string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	AuthorId  string                 `protobuf:"bytes,6,opt,name=author_id,proto3" json:"author_id,omitempty"`
+	ChatId    string                 `protobuf:"bytes,7,opt,name=chat_id,proto3" json:"chat_id,omitempty"`
+	Text      string                 `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAction) ProtoMessage() {}
+
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ModerationAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModerationAction) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetModerationActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *GetModerationActionsRequest) Reset() {
+	*x = GetModerationActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationActionsRequest) ProtoMessage() {}
+
+func (x *GetModerationActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationActionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetModerationActionsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetModerationActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*ModerationAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *GetModerationActionsResponse) Reset() {
+	*x = GetModerationActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModerationActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationActionsResponse) ProtoMessage() {}
+
+func (x *GetModerationActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationActionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetModerationActionsResponse) GetActions() []*ModerationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type GetAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	ActorId  string `protobuf:"bytes,2,opt,name=actor_id,proto3" json:"actor_id,omitempty"`
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Cursor   string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit    int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogRequest) ProtoMessage() {}
+
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetAuditLogRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string        `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAuditLogResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAuditLogResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId   string `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	ActorId    string `protobuf:"bytes,3,opt,name=actor_id,proto3" json:"actor_id,omitempty"`
+	Action     string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType string `protobuf:"bytes,5,opt,name=target_type,proto3" json:"target_type,omitempty"`
+	TargetId   string `protobuf:"bytes,6,opt,name=target_id,proto3" json:"target_id,omitempty"`
+	// only changed values
+	Before    map[string]string      `protobuf:"bytes,7,rep,name=before,proto3" json:"before,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	After     map[string]string      `protobuf:"bytes,8,rep,name=after,proto3" json:"after,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() map[string]string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetAfter() map[string]string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExportServerChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string                 `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Format   string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ExportServerChatRequest) Reset() {
+	*x = ExportServerChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportServerChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportServerChatRequest) ProtoMessage() {}
+
+func (x *ExportServerChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportServerChatRequest.ProtoReflect.Descriptor instead.
+func (*ExportServerChatRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ExportServerChatRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ExportServerChatRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportServerChatRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportServerChatRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ExportPrivateChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Format string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ExportPrivateChatRequest) Reset() {
+	*x = ExportPrivateChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPrivateChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPrivateChatRequest) ProtoMessage() {}
+
+func (x *ExportPrivateChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPrivateChatRequest.ProtoReflect.Descriptor instead.
+func (*ExportPrivateChatRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExportPrivateChatRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportPrivateChatRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportPrivateChatRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportPrivateChatRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// CreateServerTemplateRequest - name of the server is used when name is empty
+type CreateServerTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateServerTemplateRequest) Reset() {
+	*x = CreateServerTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServerTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerTemplateRequest) ProtoMessage() {}
+
+func (x *CreateServerTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateServerTemplateRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateServerTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{67}
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*ServerTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*ServerTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// CreateServerFromTemplateRequest - name of the template is used when name is empty
+type CreateServerFromTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateServerFromTemplateRequest) Reset() {
+	*x = CreateServerFromTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServerFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateServerFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateServerFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateServerFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ServerTemplate - server settings and channel names, members and messages are not copied
+type ServerTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SourceServerId string                 `protobuf:"bytes,3,opt,name=source_server_id,proto3" json:"source_server_id,omitempty"`
+	OwnerId        string                 `protobuf:"bytes,4,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	IconUrl        string                 `protobuf:"bytes,6,opt,name=icon_url,proto3" json:"icon_url,omitempty"`
+	BannerUrl      string                 `protobuf:"bytes,7,opt,name=banner_url,proto3" json:"banner_url,omitempty"`
+	Visibility     string                 `protobuf:"bytes,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Tags           []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Category       string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	Channels       []string               `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ServerTemplate) Reset() {
+	*x = ServerTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerTemplate) ProtoMessage() {}
+
+func (x *ServerTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerTemplate.ProtoReflect.Descriptor instead.
+func (*ServerTemplate) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ServerTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetSourceServerId() string {
+	if x != nil {
+		return x.SourceServerId
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetIconUrl() string {
+	if x != nil {
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ServerTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ServerTemplate) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ServerTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl string `protobuf:"bytes,3,opt,name=avatar_url,proto3" json:"avatar_url,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateWebhookRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListWebhooksRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type RegenerateWebhookTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *RegenerateWebhookTokenRequest) Reset() {
+	*x = RegenerateWebhookTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateWebhookTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateWebhookTokenRequest) ProtoMessage() {}
+
+func (x *RegenerateWebhookTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateWebhookTokenRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateWebhookTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RegenerateWebhookTokenRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *RegenerateWebhookTokenRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteWebhookRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// ExecuteWebhookRequest - username and avatar_url override the webhook settings for this message
+type ExecuteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Content   string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Username  string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarUrl string `protobuf:"bytes,5,opt,name=avatar_url,proto3" json:"avatar_url,omitempty"`
+}
+
+func (x *ExecuteWebhookRequest) Reset() {
+	*x = ExecuteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteWebhookRequest) ProtoMessage() {}
+
+func (x *ExecuteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ExecuteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ExecuteWebhookRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExecuteWebhookRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExecuteWebhookRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ExecuteWebhookRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+// Webhook - token is returned only on creation and regeneration
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId  string                 `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,4,opt,name=avatar_url,proto3" json:"avatar_url,omitempty"`
+	CreatorId string                 `protobuf:"bytes,5,opt,name=creator_id,proto3" json:"creator_id,omitempty"`
+	Token     string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Webhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webhook) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Webhook) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateOutgoingWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string   `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Url      string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events   []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *CreateOutgoingWebhookRequest) Reset() {
+	*x = CreateOutgoingWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOutgoingWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOutgoingWebhookRequest) ProtoMessage() {}
+
+func (x *CreateOutgoingWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOutgoingWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateOutgoingWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateOutgoingWebhookRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateOutgoingWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateOutgoingWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListOutgoingWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *ListOutgoingWebhooksRequest) Reset() {
+	*x = ListOutgoingWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingWebhooksRequest) ProtoMessage() {}
+
+func (x *ListOutgoingWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListOutgoingWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListOutgoingWebhooksRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListOutgoingWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*OutgoingWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListOutgoingWebhooksResponse) Reset() {
+	*x = ListOutgoingWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingWebhooksResponse) ProtoMessage() {}
+
+func (x *ListOutgoingWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListOutgoingWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListOutgoingWebhooksResponse) GetWebhooks() []*OutgoingWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type EnableOutgoingWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *EnableOutgoingWebhookRequest) Reset() {
+	*x = EnableOutgoingWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableOutgoingWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableOutgoingWebhookRequest) ProtoMessage() {}
+
+func (x *EnableOutgoingWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableOutgoingWebhookRequest.ProtoReflect.Descriptor instead.
+func (*EnableOutgoingWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *EnableOutgoingWebhookRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *EnableOutgoingWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeleteOutgoingWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *DeleteOutgoingWebhookRequest) Reset() {
+	*x = DeleteOutgoingWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOutgoingWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOutgoingWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteOutgoingWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOutgoingWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOutgoingWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteOutgoingWebhookRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteOutgoingWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// OutgoingWebhook - secret signs the X-Webhook-Signature header and is returned only on creation
+type OutgoingWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId            string                 `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Url                 string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Events              []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Secret              string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatorId           string                 `protobuf:"bytes,6,opt,name=creator_id,proto3" json:"creator_id,omitempty"`
+	Enabled             bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ConsecutiveFailures int64                  `protobuf:"varint,8,opt,name=consecutive_failures,proto3" json:"consecutive_failures,omitempty"`
+	DisabledAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=disabled_at,proto3" json:"disabled_at,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *OutgoingWebhook) Reset() {
+	*x = OutgoingWebhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutgoingWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutgoingWebhook) ProtoMessage() {}
+
+func (x *OutgoingWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutgoingWebhook.ProtoReflect.Descriptor instead.
+func (*OutgoingWebhook) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *OutgoingWebhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutgoingWebhook) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *OutgoingWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OutgoingWebhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *OutgoingWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *OutgoingWebhook) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *OutgoingWebhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *OutgoingWebhook) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *OutgoingWebhook) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *OutgoingWebhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListOutgoingDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *ListOutgoingDeliveriesRequest) Reset() {
+	*x = ListOutgoingDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListOutgoingDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListOutgoingDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListOutgoingDeliveriesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ListOutgoingDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListOutgoingDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*OutgoingDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListOutgoingDeliveriesResponse) Reset() {
+	*x = ListOutgoingDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutgoingDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListOutgoingDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListOutgoingDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListOutgoingDeliveriesResponse) GetDeliveries() []*OutgoingDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type OutgoingDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      string                 `protobuf:"bytes,2,opt,name=webhook_id,proto3" json:"webhook_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,proto3" json:"event_type,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int64                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int64                  `protobuf:"varint,7,opt,name=last_status_code,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_attempt_at,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *OutgoingDelivery) Reset() {
+	*x = OutgoingDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutgoingDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutgoingDelivery) ProtoMessage() {}
+
+func (x *OutgoingDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutgoingDelivery.ProtoReflect.Descriptor instead.
+func (*OutgoingDelivery) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *OutgoingDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OutgoingDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *OutgoingDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OutgoingDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OutgoingDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OutgoingDelivery) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OutgoingDelivery) GetLastStatusCode() int64 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *OutgoingDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OutgoingDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *OutgoingDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OutgoingDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateBotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// public bots can be added to servers by any server owner
+	Public bool `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+}
+
+func (x *CreateBotRequest) Reset() {
+	*x = CreateBotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBotRequest) ProtoMessage() {}
+
+func (x *CreateBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBotRequest.ProtoReflect.Descriptor instead.
+func (*CreateBotRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateBotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBotRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBotRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type CreateBotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bot *Bot `protobuf:"bytes,1,opt,name=bot,proto3" json:"bot,omitempty"`
+	// shown only once, store it securely
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *CreateBotResponse) Reset() {
+	*x = CreateBotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBotResponse) ProtoMessage() {}
+
+func (x *CreateBotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBotResponse.ProtoReflect.Descriptor instead.
+func (*CreateBotResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateBotResponse) GetBot() *Bot {
+	if x != nil {
+		return x.Bot
+	}
+	return nil
+}
+
+func (x *CreateBotResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListBotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBotsRequest) Reset() {
+	*x = ListBotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBotsRequest) ProtoMessage() {}
+
+func (x *ListBotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBotsRequest.ProtoReflect.Descriptor instead.
+func (*ListBotsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{89}
+}
+
+type ListBotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bots []*Bot `protobuf:"bytes,1,rep,name=bots,proto3" json:"bots,omitempty"`
+}
+
+func (x *ListBotsResponse) Reset() {
+	*x = ListBotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBotsResponse) ProtoMessage() {}
+
+func (x *ListBotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBotsResponse.ProtoReflect.Descriptor instead.
+func (*ListBotsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListBotsResponse) GetBots() []*Bot {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+type ResetBotTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BotId string `protobuf:"bytes,1,opt,name=bot_id,proto3" json:"bot_id,omitempty"`
+}
+
+func (x *ResetBotTokenRequest) Reset() {
+	*x = ResetBotTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetBotTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetBotTokenRequest) ProtoMessage() {}
+
+func (x *ResetBotTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetBotTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetBotTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ResetBotTokenRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type ResetBotTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ResetBotTokenResponse) Reset() {
+	*x = ResetBotTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetBotTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetBotTokenResponse) ProtoMessage() {}
+
+func (x *ResetBotTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetBotTokenResponse.ProtoReflect.Descriptor instead.
+func (*ResetBotTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ResetBotTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeBotTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BotId string `protobuf:"bytes,1,opt,name=bot_id,proto3" json:"bot_id,omitempty"`
+}
+
+func (x *RevokeBotTokenRequest) Reset() {
+	*x = RevokeBotTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeBotTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeBotTokenRequest) ProtoMessage() {}
+
+func (x *RevokeBotTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeBotTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeBotTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RevokeBotTokenRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type AddBotToServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	BotId    string `protobuf:"bytes,2,opt,name=bot_id,proto3" json:"bot_id,omitempty"`
+}
+
+func (x *AddBotToServerRequest) Reset() {
+	*x = AddBotToServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBotToServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotToServerRequest) ProtoMessage() {}
+
+func (x *AddBotToServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotToServerRequest.ProtoReflect.Descriptor instead.
+func (*AddBotToServerRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AddBotToServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AddBotToServerRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type Bot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId     string                 `protobuf:"bytes,2,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Public      bool                   `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`
+	HasToken    bool                   `protobuf:"varint,6,opt,name=has_token,proto3" json:"has_token,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Bot) Reset() {
+	*x = Bot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bot) ProtoMessage() {}
+
+func (x *Bot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bot.ProtoReflect.Descriptor instead.
+func (*Bot) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Bot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bot) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Bot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bot) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Bot) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Bot) GetHasToken() bool {
+	if x != nil {
+		return x.HasToken
+	}
+	return false
+}
+
+func (x *Bot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CommandOption - option types are string, integer, boolean and user
+type CommandOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Required    bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *CommandOption) Reset() {
+	*x = CommandOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandOption) ProtoMessage() {}
+
+func (x *CommandOption) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandOption.ProtoReflect.Descriptor instead.
+func (*CommandOption) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CommandOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommandOption) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CommandOption) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CommandOption) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type CreateCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId    string           `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name        string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Options     []*CommandOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	HandlerUrl  string           `protobuf:"bytes,5,opt,name=handler_url,proto3" json:"handler_url,omitempty"`
+}
+
+func (x *CreateCommandRequest) Reset() {
+	*x = CreateCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommandRequest) ProtoMessage() {}
+
+func (x *CreateCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommandRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommandRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateCommandRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateCommandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCommandRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCommandRequest) GetOptions() []*CommandOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateCommandRequest) GetHandlerUrl() string {
+	if x != nil {
+		return x.HandlerUrl
+	}
+	return ""
+}
+
+type ListCommandsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *ListCommandsRequest) Reset() {
+	*x = ListCommandsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommandsRequest) ProtoMessage() {}
+
+func (x *ListCommandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommandsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommandsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListCommandsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListCommandsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commands []*Command `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+}
+
+func (x *ListCommandsResponse) Reset() {
+	*x = ListCommandsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommandsResponse) ProtoMessage() {}
+
+func (x *ListCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommandsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListCommandsResponse) GetCommands() []*Command {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type DeleteCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	CommandId string `protobuf:"bytes,2,opt,name=command_id,proto3" json:"command_id,omitempty"`
+}
+
+func (x *DeleteCommandRequest) Reset() {
+	*x = DeleteCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommandRequest) ProtoMessage() {}
+
+func (x *DeleteCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommandRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommandRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteCommandRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteCommandRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+// RespondInteractionRequest - ephemeral reply is shown only to the user who invoked the command
+type RespondInteractionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InteractionId string `protobuf:"bytes,1,opt,name=interaction_id,proto3" json:"interaction_id,omitempty"`
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Ephemeral     bool   `protobuf:"varint,4,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+}
+
+func (x *RespondInteractionRequest) Reset() {
+	*x = RespondInteractionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondInteractionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondInteractionRequest) ProtoMessage() {}
+
+func (x *RespondInteractionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondInteractionRequest.ProtoReflect.Descriptor instead.
+func (*RespondInteractionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RespondInteractionRequest) GetInteractionId() string {
+	if x != nil {
+		return x.InteractionId
+	}
+	return ""
+}
+
+func (x *RespondInteractionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RespondInteractionRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RespondInteractionRequest) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
+// Command - secret is returned only on creation, requests to the handler are signed with it
+type Command struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId    string                 `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Options     []*CommandOption       `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	HandlerUrl  string                 `protobuf:"bytes,6,opt,name=handler_url,proto3" json:"handler_url,omitempty"`
+	Secret      string                 `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatorId   string                 `protobuf:"bytes,8,opt,name=creator_id,proto3" json:"creator_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Command) Reset() {
+	*x = Command{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command) ProtoMessage() {}
+
+func (x *Command) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Command) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Command) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Command) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Command) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Command) GetOptions() []*CommandOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Command) GetHandlerUrl() string {
+	if x != nil {
+		return x.HandlerUrl
+	}
+	return ""
+}
+
+func (x *Command) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Command) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Command) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateEmojiRequest - png, gif, jpeg or webp image up to 256 KiB, base64 encoded in json
+type CreateEmojiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image    []byte `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *CreateEmojiRequest) Reset() {
+	*x = CreateEmojiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmojiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmojiRequest) ProtoMessage() {}
+
+func (x *CreateEmojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmojiRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmojiRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateEmojiRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateEmojiRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEmojiRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ListServerEmojiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+}
+
+func (x *ListServerEmojiRequest) Reset() {
+	*x = ListServerEmojiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerEmojiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerEmojiRequest) ProtoMessage() {}
+
+func (x *ListServerEmojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerEmojiRequest.ProtoReflect.Descriptor instead.
+func (*ListServerEmojiRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListServerEmojiRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type ListServerEmojiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji []*Emoji `protobuf:"bytes,1,rep,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *ListServerEmojiResponse) Reset() {
+	*x = ListServerEmojiResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerEmojiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerEmojiResponse) ProtoMessage() {}
+
+func (x *ListServerEmojiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerEmojiResponse.ProtoReflect.Descriptor instead.
+func (*ListServerEmojiResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListServerEmojiResponse) GetEmoji() []*Emoji {
+	if x != nil {
+		return x.Emoji
+	}
+	return nil
+}
+
+type RenameEmojiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	EmojiId  string `protobuf:"bytes,2,opt,name=emoji_id,proto3" json:"emoji_id,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RenameEmojiRequest) Reset() {
+	*x = RenameEmojiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameEmojiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameEmojiRequest) ProtoMessage() {}
+
+func (x *RenameEmojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameEmojiRequest.ProtoReflect.Descriptor instead.
+func (*RenameEmojiRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RenameEmojiRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *RenameEmojiRequest) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+func (x *RenameEmojiRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteEmojiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	EmojiId  string `protobuf:"bytes,2,opt,name=emoji_id,proto3" json:"emoji_id,omitempty"`
+}
+
+func (x *DeleteEmojiRequest) Reset() {
+	*x = DeleteEmojiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEmojiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmojiRequest) ProtoMessage() {}
+
+func (x *DeleteEmojiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmojiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmojiRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteEmojiRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteEmojiRequest) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+type GetEmojiImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmojiId string `protobuf:"bytes,1,opt,name=emoji_id,proto3" json:"emoji_id,omitempty"`
+}
+
+func (x *GetEmojiImageRequest) Reset() {
+	*x = GetEmojiImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmojiImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmojiImageRequest) ProtoMessage() {}
+
+func (x *GetEmojiImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmojiImageRequest.ProtoReflect.Descriptor instead.
+func (*GetEmojiImageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetEmojiImageRequest) GetEmojiId() string {
+	if x != nil {
+		return x.EmojiId
+	}
+	return ""
+}
+
+type EmojiImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,proto3" json:"content_type,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *EmojiImage) Reset() {
+	*x = EmojiImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmojiImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiImage) ProtoMessage() {}
+
+func (x *EmojiImage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiImage.ProtoReflect.Descriptor instead.
+func (*EmojiImage) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *EmojiImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *EmojiImage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Emoji - used in message text as :name:, sent messages keep it as <:name:id>
+type Emoji struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerId    string                 `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatorId   string                 `protobuf:"bytes,6,opt,name=creator_id,proto3" json:"creator_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// image url, served without authorization
+	Url string `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *Emoji) Reset() {
+	*x = Emoji{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Emoji) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emoji) ProtoMessage() {}
+
+func (x *Emoji) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emoji.ProtoReflect.Descriptor instead.
+func (*Emoji) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Emoji) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Emoji) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Emoji) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Emoji) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Emoji) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Emoji) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Emoji) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Emoji) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type AddChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ServerId string `protobuf:"bytes,2,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	// text by default, category groups other channels of the server
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// optional, category of the same server
+	ParentId string `protobuf:"bytes,4,opt,name=parent_id,proto3" json:"parent_id,omitempty"`
+	Topic    string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Nsfw     bool   `protobuf:"varint,6,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	// optional, defaults are used for voice channels without settings
+	Voice *VoiceSettings `protobuf:"bytes,7,opt,name=voice,proto3" json:"voice,omitempty"`
+	Forum *ForumSettings `protobuf:"bytes,8,opt,name=forum,proto3" json:"forum,omitempty"`
+	// only the owner and users granted access can join
+	Private bool `protobuf:"varint,9,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *AddChannelRequest) Reset() {
+	*x = AddChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChannelRequest) ProtoMessage() {}
+
+func (x *AddChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChannelRequest.ProtoReflect.Descriptor instead.
+func (*AddChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AddChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddChannelRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *AddChannelRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddChannelRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AddChannelRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AddChannelRequest) GetNsfw() bool {
+	if x != nil {
+		return x.Nsfw
+	}
+	return false
+}
+
+func (x *AddChannelRequest) GetVoice() *VoiceSettings {
+	if x != nil {
+		return x.Voice
+	}
+	return nil
+}
+
+func (x *AddChannelRequest) GetForum() *ForumSettings {
+	if x != nil {
+		return x.Forum
+	}
+	return nil
+}
+
+func (x *AddChannelRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type VoiceSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bits per second, 0 keeps the default
+	Bitrate int32 `protobuf:"varint,1,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	// 0 means no limit
+	UserLimit int32 `protobuf:"varint,2,opt,name=user_limit,proto3" json:"user_limit,omitempty"`
+}
+
+func (x *VoiceSettings) Reset() {
+	*x = VoiceSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoiceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoiceSettings) ProtoMessage() {}
+
+func (x *VoiceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoiceSettings.ProtoReflect.Descriptor instead.
+func (*VoiceSettings) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *VoiceSettings) GetBitrate() int32 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *VoiceSettings) GetUserLimit() int32 {
+	if x != nil {
+		return x.UserLimit
+	}
+	return 0
+}
+
+type ForumSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DefaultSort string `protobuf:"bytes,1,opt,name=default_sort,proto3" json:"default_sort,omitempty"`
+	// tags posts of the forum can be marked with
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ForumSettings) Reset() {
+	*x = ForumSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForumSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForumSettings) ProtoMessage() {}
+
+func (x *ForumSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForumSettings.ProtoReflect.Descriptor instead.
+func (*ForumSettings) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ForumSettings) GetDefaultSort() string {
+	if x != nil {
+		return x.DefaultSort
+	}
+	return ""
+}
+
+func (x *ForumSettings) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// UpdateChannelRequest - only set fields are changed, settings must match the type of the channel
+type UpdateChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string  `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Name      *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Topic     *string `protobuf:"bytes,3,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
+	Nsfw      *bool   `protobuf:"varint,4,opt,name=nsfw,proto3,oneof" json:"nsfw,omitempty"`
+	// replaces voice settings
+	Voice *VoiceSettings `protobuf:"bytes,5,opt,name=voice,proto3" json:"voice,omitempty"`
+	// replaces forum settings
+	Forum *ForumSettings `protobuf:"bytes,6,opt,name=forum,proto3" json:"forum,omitempty"`
+	// users already joined keep their membership until access is revoked
+	Private *bool `protobuf:"varint,7,opt,name=private,proto3,oneof" json:"private,omitempty"`
+}
+
+func (x *UpdateChannelRequest) Reset() {
+	*x = UpdateChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelRequest) ProtoMessage() {}
+
+func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *UpdateChannelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateChannelRequest) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
+	}
+	return ""
+}
+
+func (x *UpdateChannelRequest) GetNsfw() bool {
+	if x != nil && x.Nsfw != nil {
+		return *x.Nsfw
+	}
+	return false
+}
+
+func (x *UpdateChannelRequest) GetVoice() *VoiceSettings {
+	if x != nil {
+		return x.Voice
+	}
+	return nil
+}
+
+func (x *UpdateChannelRequest) GetForum() *ForumSettings {
+	if x != nil {
+		return x.Forum
+	}
+	return nil
+}
+
+func (x *UpdateChannelRequest) GetPrivate() bool {
+	if x != nil && x.Private != nil {
+		return *x.Private
+	}
+	return false
+}
+
+type DeleteChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *DeleteChannelRequest) Reset() {
+	*x = DeleteChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelRequest) ProtoMessage() {}
+
+func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeleteChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// RestoreChannelRequest - deleted channel can be restored by its owner during the grace period
+type RestoreChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *RestoreChannelRequest) Reset() {
+	*x = RestoreChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreChannelRequest) ProtoMessage() {}
+
+func (x *RestoreChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreChannelRequest.ProtoReflect.Descriptor instead.
+func (*RestoreChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *RestoreChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// ArchiveChannelRequest - archived channel is read-only and hidden from listings
+type ArchiveChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *ArchiveChannelRequest) Reset() {
+	*x = ArchiveChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveChannelRequest) ProtoMessage() {}
+
+func (x *ArchiveChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveChannelRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ArchiveChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type JoinChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *JoinChannelRequest) Reset() {
+	*x = JoinChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChannelRequest) ProtoMessage() {}
+
+func (x *JoinChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChannelRequest.ProtoReflect.Descriptor instead.
+func (*JoinChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *JoinChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type LeaveChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *LeaveChannelRequest) Reset() {
+	*x = LeaveChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveChannelRequest) ProtoMessage() {}
+
+func (x *LeaveChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveChannelRequest.ProtoReflect.Descriptor instead.
+func (*LeaveChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *LeaveChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListMyChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeArchived bool `protobuf:"varint,1,opt,name=include_archived,proto3" json:"include_archived,omitempty"`
+}
+
+func (x *ListMyChannelsRequest) Reset() {
+	*x = ListMyChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyChannelsRequest) ProtoMessage() {}
+
+func (x *ListMyChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListMyChannelsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// ListMyChannelsResponse - joined channels in join order
+type ListMyChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ListMyChannelsResponse) Reset() {
+	*x = ListMyChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyChannelsResponse) ProtoMessage() {}
+
+func (x *ListMyChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListMyChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ListChannelMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Cursor    string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListChannelMembersRequest) Reset() {
+	*x = ListChannelMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChannelMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelMembersRequest) ProtoMessage() {}
+
+func (x *ListChannelMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListChannelMembersRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ListChannelMembersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListChannelMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListChannelMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members    []*ChannelMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListChannelMembersResponse) Reset() {
+	*x = ListChannelMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChannelMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelMembersResponse) ProtoMessage() {}
+
+func (x *ListChannelMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelMembersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListChannelMembersResponse) GetMembers() []*ChannelMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListChannelMembersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// ChannelAccessRequest - used by the owner of a private channel to manage its access list
+type ChannelAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ChannelAccessRequest) Reset() {
+	*x = ChannelAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAccessRequest) ProtoMessage() {}
+
+func (x *ChannelAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAccessRequest.ProtoReflect.Descriptor instead.
+func (*ChannelAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ChannelAccessRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RequestChannelAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *RequestChannelAccessRequest) Reset() {
+	*x = RequestChannelAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestChannelAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestChannelAccessRequest) ProtoMessage() {}
+
+func (x *RequestChannelAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestChannelAccessRequest.ProtoReflect.Descriptor instead.
+func (*RequestChannelAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RequestChannelAccessRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListChannelJoinRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *ListChannelJoinRequestsRequest) Reset() {
+	*x = ListChannelJoinRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChannelJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListChannelJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListChannelJoinRequestsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// ListChannelJoinRequestsResponse - pending requests, oldest first
+type ListChannelJoinRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*ChannelJoinRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListChannelJoinRequestsResponse) Reset() {
+	*x = ListChannelJoinRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChannelJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListChannelJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListChannelJoinRequestsResponse) GetRequests() []*ChannelJoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ChannelJoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=requested_at,proto3" json:"requested_at,omitempty"`
+}
+
+func (x *ChannelJoinRequest) Reset() {
+	*x = ChannelJoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelJoinRequest) ProtoMessage() {}
+
+func (x *ChannelJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelJoinRequest.ProtoReflect.Descriptor instead.
+func (*ChannelJoinRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ChannelJoinRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelJoinRequest) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+type ChannelMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// not set when join time is unknown
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=joined_at,proto3" json:"joined_at,omitempty"`
+}
+
+func (x *ChannelMember) Reset() {
+	*x = ChannelMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelMember) ProtoMessage() {}
+
+func (x *ChannelMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelMember.ProtoReflect.Descriptor instead.
+func (*ChannelMember) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ChannelMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChannelMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// IsChannelMemberRequest - the current user is checked when user_id is empty
+type IsChannelMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+}
+
+func (x *IsChannelMemberRequest) Reset() {
+	*x = IsChannelMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsChannelMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsChannelMemberRequest) ProtoMessage() {}
+
+func (x *IsChannelMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsChannelMemberRequest.ProtoReflect.Descriptor instead.
+func (*IsChannelMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *IsChannelMemberRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *IsChannelMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type IsChannelMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsMember bool `protobuf:"varint,1,opt,name=is_member,proto3" json:"is_member,omitempty"`
+}
+
+func (x *IsChannelMemberResponse) Reset() {
+	*x = IsChannelMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsChannelMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsChannelMemberResponse) ProtoMessage() {}
+
+func (x *IsChannelMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsChannelMemberResponse.ProtoReflect.Descriptor instead.
+func (*IsChannelMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *IsChannelMemberResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type Channel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId  string `protobuf:"bytes,3,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	ServerId string `protobuf:"bytes,4,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// empty for channels outside of categories
+	ParentId string `protobuf:"bytes,6,opt,name=parent_id,proto3" json:"parent_id,omitempty"`
+	// order among channels with the same parent
+	Position int32  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	Topic    string `protobuf:"bytes,8,opt,name=topic,proto3" json:"topic,omitempty"`
+	Nsfw     bool   `protobuf:"varint,9,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	// set for voice channels
+	Voice *VoiceSettings `protobuf:"bytes,10,opt,name=voice,proto3" json:"voice,omitempty"`
+	// set for forum channels
+	Forum   *ForumSettings `protobuf:"bytes,11,opt,name=forum,proto3" json:"forum,omitempty"`
+	Private bool           `protobuf:"varint,12,opt,name=private,proto3" json:"private,omitempty"`
+	// set for archived channels
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=archived_at,proto3" json:"archived_at,omitempty"`
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Channel) ProtoMessage() {}
+
+func (x *Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *Channel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Channel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Channel) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Channel) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *Channel) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Channel) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Channel) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Channel) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Channel) GetNsfw() bool {
+	if x != nil {
+		return x.Nsfw
+	}
+	return false
+}
+
+func (x *Channel) GetVoice() *VoiceSettings {
+	if x != nil {
+		return x.Voice
+	}
+	return nil
+}
+
+func (x *Channel) GetForum() *ForumSettings {
+	if x != nil {
+		return x.Forum
+	}
+	return nil
+}
+
+func (x *Channel) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Channel) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type ChannelPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Position  int32  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// empty moves the channel out of its category
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *ChannelPosition) Reset() {
+	*x = ChannelPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPosition) ProtoMessage() {}
+
+func (x *ChannelPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPosition.ProtoReflect.Descriptor instead.
+func (*ChannelPosition) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ChannelPosition) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelPosition) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChannelPosition) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// ReorderChannelsRequest - positions are applied all or none, channels not listed keep their place
+type ReorderChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId  string             `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	Positions []*ChannelPosition `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (x *ReorderChannelsRequest) Reset() {
+	*x = ReorderChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChannelsRequest) ProtoMessage() {}
+
+func (x *ReorderChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ReorderChannelsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ReorderChannelsRequest) GetPositions() []*ChannelPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type SendChannelMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Text      string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendChannelMessageRequest) Reset() {
+	*x = SendChannelMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChannelMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChannelMessageRequest) ProtoMessage() {}
+
+func (x *SendChannelMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChannelMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChannelMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SendChannelMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SendChannelMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GetChannelMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *GetChannelMessagesRequest) Reset() {
+	*x = GetChannelMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelMessagesRequest) ProtoMessage() {}
+
+func (x *GetChannelMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetChannelMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type GetChannelMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*ChannelMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GetChannelMessagesResponse) Reset() {
+	*x = GetChannelMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelMessagesResponse) ProtoMessage() {}
+
+func (x *GetChannelMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetChannelMessagesResponse) GetMessages() []*ChannelMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ChannelMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,4,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	// reference is set for messages cross-posted from a followed announcement channel
+	Reference *MessageReference `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *ChannelMessage) Reset() {
+	*x = ChannelMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelMessage) ProtoMessage() {}
+
+func (x *ChannelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelMessage.ProtoReflect.Descriptor instead.
+func (*ChannelMessage) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ChannelMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ChannelMessage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ChannelMessage) GetReference() *MessageReference {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+// MessageReference - original message of a cross-posted one
+type MessageReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,proto3" json:"message_id,omitempty"`
+}
+
+func (x *MessageReference) Reset() {
+	*x = MessageReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReference) ProtoMessage() {}
+
+func (x *MessageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReference.ProtoReflect.Descriptor instead.
+func (*MessageReference) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *MessageReference) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *MessageReference) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// ForumPost - titled thread of a forum channel with its own messages
+type ForumPost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId string                 `protobuf:"bytes,2,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,3,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	Title     string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Tags      []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// time of the latest message of the post
+	LastActivityAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_activity_at,proto3" json:"last_activity_at,omitempty"`
+}
+
+func (x *ForumPost) Reset() {
+	*x = ForumPost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForumPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForumPost) ProtoMessage() {}
+
+func (x *ForumPost) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForumPost.ProtoReflect.Descriptor instead.
+func (*ForumPost) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ForumPost) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForumPost) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ForumPost) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ForumPost) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ForumPost) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ForumPost) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ForumPost) GetLastActivityAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return nil
+}
+
+// CreateForumPostRequest - text is the opening message, tags are picked from the forum tags
+type CreateForumPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string   `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Title     string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Text      string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Tags      []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *CreateForumPostRequest) Reset() {
+	*x = CreateForumPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateForumPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateForumPostRequest) ProtoMessage() {}
+
+func (x *CreateForumPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateForumPostRequest.ProtoReflect.Descriptor instead.
+func (*CreateForumPostRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CreateForumPostRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CreateForumPostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateForumPostRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateForumPostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// ListForumPostsRequest - forum default sort is used when sort is empty, empty tag lists all posts
+type ListForumPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Sort      string `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	Tag       string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *ListForumPostsRequest) Reset() {
+	*x = ListForumPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForumPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForumPostsRequest) ProtoMessage() {}
+
+func (x *ListForumPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForumPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListForumPostsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListForumPostsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ListForumPostsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListForumPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type ListForumPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*ForumPost `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+}
+
+func (x *ListForumPostsResponse) Reset() {
+	*x = ListForumPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListForumPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListForumPostsResponse) ProtoMessage() {}
+
+func (x *ListForumPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListForumPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListForumPostsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ListForumPostsResponse) GetPosts() []*ForumPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type SendForumPostMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId string `protobuf:"bytes,1,opt,name=post_id,proto3" json:"post_id,omitempty"`
+	Text   string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendForumPostMessageRequest) Reset() {
+	*x = SendForumPostMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendForumPostMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendForumPostMessageRequest) ProtoMessage() {}
+
+func (x *SendForumPostMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendForumPostMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendForumPostMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SendForumPostMessageRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *SendForumPostMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GetForumPostMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId string `protobuf:"bytes,1,opt,name=post_id,proto3" json:"post_id,omitempty"`
+}
+
+func (x *GetForumPostMessagesRequest) Reset() {
+	*x = GetForumPostMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetForumPostMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForumPostMessagesRequest) ProtoMessage() {}
+
+func (x *GetForumPostMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForumPostMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetForumPostMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetForumPostMessagesRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+// FollowChannelRequest - target channel of another server gets messages published in the announcement source channel
+type FollowChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChannelId string `protobuf:"bytes,1,opt,name=source_channel_id,proto3" json:"source_channel_id,omitempty"`
+	TargetChannelId string `protobuf:"bytes,2,opt,name=target_channel_id,proto3" json:"target_channel_id,omitempty"`
+}
+
+func (x *FollowChannelRequest) Reset() {
+	*x = FollowChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowChannelRequest) ProtoMessage() {}
+
+func (x *FollowChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowChannelRequest.ProtoReflect.Descriptor instead.
+func (*FollowChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *FollowChannelRequest) GetSourceChannelId() string {
+	if x != nil {
+		return x.SourceChannelId
+	}
+	return ""
+}
+
+func (x *FollowChannelRequest) GetTargetChannelId() string {
+	if x != nil {
+		return x.TargetChannelId
+	}
+	return ""
+}
+
+type UnfollowChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChannelId string `protobuf:"bytes,1,opt,name=source_channel_id,proto3" json:"source_channel_id,omitempty"`
+	TargetChannelId string `protobuf:"bytes,2,opt,name=target_channel_id,proto3" json:"target_channel_id,omitempty"`
+}
+
+func (x *UnfollowChannelRequest) Reset() {
+	*x = UnfollowChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowChannelRequest) ProtoMessage() {}
+
+func (x *UnfollowChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowChannelRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *UnfollowChannelRequest) GetSourceChannelId() string {
+	if x != nil {
+		return x.SourceChannelId
+	}
+	return ""
+}
+
+func (x *UnfollowChannelRequest) GetTargetChannelId() string {
+	if x != nil {
+		return x.TargetChannelId
+	}
+	return ""
+}
+
+type PublishChannelMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,proto3" json:"message_id,omitempty"`
+}
+
+func (x *PublishChannelMessageRequest) Reset() {
+	*x = PublishChannelMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishChannelMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishChannelMessageRequest) ProtoMessage() {}
+
+func (x *PublishChannelMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishChannelMessageRequest.ProtoReflect.Descriptor instead.
+func (*PublishChannelMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *PublishChannelMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *PublishChannelMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type ListServerChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId        string `protobuf:"bytes,1,opt,name=server_id,proto3" json:"server_id,omitempty"`
+	IncludeArchived bool   `protobuf:"varint,2,opt,name=include_archived,proto3" json:"include_archived,omitempty"`
+}
+
+func (x *ListServerChannelsRequest) Reset() {
+	*x = ListServerChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerChannelsRequest) ProtoMessage() {}
+
+func (x *ListServerChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListServerChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListServerChannelsRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ListServerChannelsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// ListServerChannelsResponse - channels in display order, uncategorized channels first,
+// then every category followed by its channels
+type ListServerChannelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *ListServerChannelsResponse) Reset() {
+	*x = ListServerChannelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServerChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServerChannelsResponse) ProtoMessage() {}
+
+func (x *ListServerChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServerChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListServerChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ListServerChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type SendUserPrivateMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Text   string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SendUserPrivateMessageRequest) Reset() {
+	*x = SendUserPrivateMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendUserPrivateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendUserPrivateMessageRequest) ProtoMessage() {}
+
+func (x *SendUserPrivateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendUserPrivateMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendUserPrivateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SendUserPrivateMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendUserPrivateMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GetUserPrivateMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserPrivateMessagesRequest) Reset() {
+	*x = GetUserPrivateMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPrivateMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPrivateMessagesRequest) ProtoMessage() {}
+
+func (x *GetUserPrivateMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPrivateMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPrivateMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetUserPrivateMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteFromFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FriendId string `protobuf:"bytes,1,opt,name=friend_id,proto3" json:"friend_id,omitempty"`
+}
+
+func (x *DeleteFromFriendRequest) Reset() {
+	*x = DeleteFromFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFromFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFromFriendRequest) ProtoMessage() {}
+
+func (x *DeleteFromFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFromFriendRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFromFriendRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *DeleteFromFriendRequest) GetFriendId() string {
+	if x != nil {
+		return x.FriendId
+	}
+	return ""
+}
+
+type CreatePrivateChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreatePrivateChatRequest) Reset() {
+	*x = CreatePrivateChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePrivateChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrivateChatRequest) ProtoMessage() {}
+
+func (x *CreatePrivateChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrivateChatRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrivateChatRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CreatePrivateChatRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreatePrivateChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ChatId  string `protobuf:"bytes,2,opt,name=chatId,json=chat_id,proto3" json:"chatId,omitempty"`
+}
+
+func (x *CreatePrivateChatResponse) Reset() {
+	*x = CreatePrivateChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_messages_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePrivateChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrivateChatResponse) ProtoMessage() {}
+
+func (x *CreatePrivateChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_messages_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrivateChatResponse.ProtoReflect.Descriptor instead.
+func (*CreatePrivateChatResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_messages_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *CreatePrivateChatResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreatePrivateChatResponse) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+var File_api_v1_messages_proto protoreflect.FileDescriptor
+
+var file_api_v1_messages_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa6, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01,
+	0x72, 0x02, 0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x0b, 0x4a, 0x09,
+	0x22, 0x75, 0x73, 0x65, 0x72, 0x31, 0x32, 0x33, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8,
+	0x01, 0x01, 0x72, 0x02, 0x10, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x13,
+	0x4a, 0x11, 0x22, 0x75, 0x73, 0x65, 0x72, 0x31, 0x32, 0x33, 0x40, 0x6d, 0x61, 0x69, 0x6c, 0x2e,
+	0x72, 0x75, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x60, 0x01,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x0f, 0x4a, 0x0d,
+	0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x31, 0x32, 0x33, 0x22, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x3a, 0x38, 0x92, 0x41, 0x35, 0x0a, 0x33, 0x2a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0xd2, 0x01, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0xd2,
+	0x01, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07,
+	0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x3b,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1f, 0x92, 0x41, 0x0f, 0x4a, 0x0d, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x31, 0x32, 0x33, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10,
+	0x08, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x3a, 0x26, 0x92, 0x41, 0x23,
+	0x0a, 0x21, 0x2a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0xd2, 0x01, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x4a, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x40, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x27, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x4f, 0x61,
+	0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x28, 0x0a, 0x12, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7e, 0x0a, 0x19, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x0a, 0x4a, 0x08, 0x22, 0x7b, 0x63, 0x6f, 0x64,
+	0x65, 0x7d, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x0b, 0x4a, 0x09, 0x22, 0x7b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x7d, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
+	0x10, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x1a, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
+	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
+	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x4d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78,
+	0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x22, 0xf7, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
+	0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75,
+	0x73, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8,
+	0x01, 0x01, 0x72, 0x02, 0x10, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x11,
+	0x4a, 0x0f, 0x22, 0x74, 0x65, 0x73, 0x74, 0x40, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x22, 0x75, 0x72, 0x6c, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x4d, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72,
+	0x02, 0x10, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f,
+	0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x06, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x17, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e,
+	0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x0c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x1a, 0x41, 0x64, 0x64,
+	0x54, 0x6f, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x72, 0x0a, 0x19, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x73,
+	0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65,
+	0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34,
+	0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x4a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x0f, 0x4a, 0x0d, 0x22, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01,
+	0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x0f, 0x4a, 0x0d,
+	0x22, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xba, 0x48, 0x09,
+	0xd0, 0x01, 0x01, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0x92,
+	0x41, 0x0b, 0x4a, 0x09, 0x5b, 0x22, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5d, 0xba, 0x48, 0x05,
+	0x92, 0x01, 0x02, 0x10, 0x0a, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x4a, 0x08, 0x22, 0x67, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0x92, 0x41, 0x04, 0x4a, 0x02, 0x32, 0x30, 0xba, 0x48, 0x06,
+	0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6f, 0x0a,
+	0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x71,
+	0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92,
+	0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39,
+	0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36,
+	0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01,
+	0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70,
+	0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc2, 0x01,
+	0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x97, 0x06,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0f, 0x4a,
+	0x0d, 0x22, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x03, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x48, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x16, 0x4a, 0x14, 0x22, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x08, 0x69, 0x63,
+	0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41,
+	0x24, 0x4a, 0x22, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x63, 0x64, 0x6e, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x63, 0x6f, 0x6e,
+	0x2e, 0x70, 0x6e, 0x67, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x10, 0x48, 0x02, 0x52,
+	0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x0a,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+	0x63, 0x64, 0x6e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x6e, 0x67, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x10, 0x48, 0x03, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x0a, 0x4a, 0x08, 0x22,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0xba, 0x48, 0x13, 0x72, 0x11, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x48, 0x04, 0x52,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x6a,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78,
+	0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54,
+	0x61, 0x67, 0x73, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x61, 0x67, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x73, 0x65, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
+	0x0a, 0x4a, 0x08, 0x22, 0x67, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x22, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x18, 0x40, 0x48, 0x05, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x32, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0x92, 0x41, 0x06, 0x4a, 0x04, 0x74, 0x72,
+	0x75, 0x65, 0x48, 0x06, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x0b, 0x4a, 0x09, 0x5b, 0x22, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x5d, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x0a, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x22, 0x6c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22,
+	0x6d, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xd0,
+	0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x12, 0x5d, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x92, 0x41, 0x28, 0x4a, 0x26, 0x22,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0xc5, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x3d, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92,
+	0x41, 0x22, 0x32, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20,
+	0x70, 0x61, 0x67, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x04,
+	0x4a, 0x02, 0x35, 0x30, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xc8, 0x01, 0x28, 0x00, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d,
+	0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34,
+	0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xf8, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35,
+	0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34,
+	0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30,
+	0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
+	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
+	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
+	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x32,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x16, 0x92, 0x41, 0x0c, 0x4a, 0x0a, 0x22, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6a, 0x6f,
+	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x7d, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x5f, 0x62, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x5f, 0x62,
+	0x6f, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x1b,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
+	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
+	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
+	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x2e,
+	0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc5,
+	0x01, 0x0a, 0x19, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92,
+	0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39,
+	0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36,
+	0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01,
+	0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30,
+	0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36,
+	0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x31, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92,
+	0x41, 0x10, 0x4a, 0x0e, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0xad, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x50, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69,
+	0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x22, 0x96, 0x02, 0x0a,
+	0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xf7, 0x03, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x79, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x65, 0x92, 0x41, 0x11, 0x4a,
+	0x0f, 0x22, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x4b, 0xc8, 0x01, 0x01, 0x72, 0x46, 0x52, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x70, 0x61, 0x6d, 0x52,
+	0x10, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x4a, 0x10, 0x5b, 0x22, 0x73,
+	0x70, 0x61, 0x6d, 0x22, 0x2c, 0x20, 0x22, 0x73, 0x63, 0x61, 0x6d, 0x22, 0x5d, 0x52, 0x05, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x4a, 0x13, 0x22, 0x28, 0x3f, 0x69,
+	0x29, 0x66, 0x72, 0x65, 0x65, 0x5c, 0x5c, 0x73, 0x2b, 0x6e, 0x69, 0x74, 0x72, 0x6f, 0x22, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x23, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0d, 0x92, 0x41, 0x03, 0x4a, 0x01, 0x35, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x45, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92,
+	0x41, 0x09, 0x4a, 0x07, 0x22, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x1b, 0xc8, 0x01, 0x01, 0x72, 0x16, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0f, 0x92,
+	0x41, 0x05, 0x4a, 0x03, 0x36, 0x30, 0x30, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0xc7, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30,
+	0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36,
+	0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35,
+	0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34,
+	0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30,
+	0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x72, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xd4, 0x02, 0x0a,
+	0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x22, 0x74, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xd3, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x50,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x34, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d,
+	0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34,
+	0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xba, 0x48, 0x08, 0xd0, 0x01,
+	0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0x92, 0x41, 0x11, 0x4a, 0x0f, 0x22, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25,
+	0x92, 0x41, 0x22, 0x32, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x20, 0x70, 0x61, 0x67, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41,
+	0x04, 0x4a, 0x02, 0x35, 0x30, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xc8, 0x01, 0x28, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78,
+	0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x92, 0x04, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x12, 0x59, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x41, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x56, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78,
+	0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x2e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x07, 0x4a, 0x05,
+	0x22, 0x63, 0x73, 0x76, 0x22, 0xba, 0x48, 0x14, 0x72, 0x12, 0x52, 0x05, 0x6a, 0x73, 0x6f, 0x6e,
+	0x6c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x84, 0x02, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x22, 0x63, 0x73, 0x76, 0x22, 0xba, 0x48, 0x14, 0x72,
+	0x12, 0x52, 0x05, 0x6a, 0x73, 0x6f, 0x6e, 0x6c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x52, 0x04, 0x68,
+	0x74, 0x6d, 0x6c, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x32,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41,
+	0x11, 0x4a, 0x0f, 0x22, 0x74, 0x65, 0x61, 0x6d, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x22, 0xba, 0x48, 0x07, 0xd0, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x70, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a,
+	0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x59, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x0f, 0x4a, 0x0d,
+	0x22, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xba, 0x48, 0x07,
+	0xd0, 0x01, 0x01, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x03,
+	0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0x92, 0x41, 0x06, 0x4a, 0x04, 0x22, 0x63, 0x69, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x01, 0x18, 0x50, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x4f, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41, 0x1e, 0x4a, 0x1c, 0x22, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x3a, 0x2f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x69, 0x2e, 0x70, 0x6e, 0x67, 0x22, 0xba, 0x48, 0x0b, 0xd0, 0x01, 0x01, 0x72, 0x06, 0x18,
+	0x80, 0x10, 0x88, 0x01, 0x01, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72,
+	0x6c, 0x22, 0x6c, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22,
+	0x66, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
+	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
+	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
+	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x57, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
+	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
+	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92,
+	0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39,
+	0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36,
+	0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01,
+	0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x22, 0xe7, 0x02, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d,
+	0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34,
+	0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0x18, 0x80, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41,
+	0x14, 0x4a, 0x12, 0x22, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x20, 0x23, 0x34, 0x32, 0x20, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x64, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0x18, 0xd0, 0x0f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0x92, 0x41, 0x0f, 0x4a, 0x0d, 0x22, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x20, 0x62, 0x6f,
+	0x74, 0x22, 0xba, 0x48, 0x07, 0xd0, 0x01, 0x01, 0x72, 0x02, 0x18, 0x50, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x23, 0x4a,
+	0x21, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x6e,
+	0x67, 0x22, 0xba, 0x48, 0x0b, 0xd0, 0x01, 0x01, 0x72, 0x06, 0x18, 0x80, 0x10, 0x88, 0x01, 0x01,
+	0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xdd, 0x01, 0x0a,
+	0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xf1, 0x02, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d,
+	0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34,
+	0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x12, 0x55, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x43, 0x92, 0x41, 0x25, 0x4a, 0x23, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
+	0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x15, 0xc8, 0x01, 0x01, 0x72, 0x10, 0x18, 0x80, 0x10, 0x3a, 0x08, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x3a, 0x2f, 0x2f, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0xa2, 0x01, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x89, 0x01, 0x92,
+	0x41, 0x26, 0x4a, 0x24, 0x5b, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e,
+	0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x5d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x5a, 0x92, 0x01,
+	0x57, 0x08, 0x01, 0x10, 0x05, 0x22, 0x51, 0x72, 0x4f, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x2e, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x2e, 0x6c, 0x65, 0x66, 0x74, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x74, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30,
+	0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36,
+	0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xce,
+	0x01, 0x0a, 0x1c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30,
+	0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36,
+	0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x22,
+	0xce, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34,
+	0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31,
+	0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41,
+	0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x22, 0xe9, 0x02, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xcf, 0x01, 0x0a,
+	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55,
+	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30,
+	0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d,
+	0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba,
+	0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x7d,
+	0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xbc, 0x03,
+	0x0a, 0x10, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x44,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa0, 0x01, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0x92, 0x41, 0x0a, 0x4a, 0x08, 0x22, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x22, 0xe0, 0x41,
+	0x02, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x02, 0x18, 0x50, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x15, 0x4a, 0x13, 0x22,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x20, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22,
+	0x6b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x03, 0x62, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e,
+	0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
+	0x74, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x11, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x56, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e,
+	0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f,
+	0x74, 0x52, 0x04, 0x62, 0x6f, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x42, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65,
+	0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34,
+	0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64,
+	0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x74, 0x42, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x68, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x42, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x06, 0x62, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x15, 0x41, 0x64,
+	0x64, 0x42, 0x6f, 0x74, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30,
+	0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d,
+	0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30,
+	0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x06, 0x62, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x92, 0x41, 0x28, 0x4a,
+	0x26, 0x22, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xd9, 0x01,
+	0x0a, 0x03, 0x42, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x0b, 0x4a, 0x09,
+	0x22, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x1c, 0xc8,
+	0x01, 0x01, 0x72, 0x17, 0x32, 0x15, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2d, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x13, 0x4a, 0x11, 0x22, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x61, 0x69, 0x74, 0x22, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x39, 0x92, 0x41, 0x0b, 0x4a, 0x09, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x22,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x25, 0xc8, 0x01, 0x01, 0x72, 0x20, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x52, 0x07, 0x62, 0x6f,
+	0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xb5, 0x03,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x43, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41, 0x0a,
+	0x4a, 0x08, 0x22, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x1c,
+	0xc8, 0x01, 0x01, 0x72, 0x17, 0x32, 0x15, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x15, 0x4a, 0x13, 0x22, 0x72,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x73, 0x20, 0x79, 0x6f, 0x75, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x22, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x10, 0x0a, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0x92, 0x41, 0x20, 0x4a, 0x1e, 0x22,
+	0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x15, 0xc8, 0x01, 0x01, 0x72, 0x10, 0x18, 0x80, 0x10, 0x3a, 0x08, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x88, 0x01, 0x01, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x6c, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65,
+	0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34,
+	0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e,
+	0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35,
+	0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34,
+	0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30,
+	0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65,
+	0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34,
+	0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0x18,
+	0x80, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x10, 0x4a,
+	0x0e, 0x22, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x20, 0x73, 0x65, 0x74, 0x22, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0x18, 0xd0, 0x0f, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x22, 0xd7, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xdc,
+	0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x10, 0x4a,
+	0x0e, 0x22, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x72, 0x6f, 0x74, 0x22, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x1b, 0xc8, 0x01, 0x01, 0x72, 0x16, 0x32, 0x14, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x7b, 0x32, 0x2c, 0x33, 0x32, 0x7d, 0x24,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0f, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01,
+	0x7a, 0x04, 0x18, 0x80, 0x80, 0x10, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x6f, 0x6a, 0x69,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a,
+	0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34,
+	0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34,
+	0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x61,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x6f, 0x6a,
+	0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x65, 0x6d, 0x6f,
+	0x6a, 0x69, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x22, 0x8a, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6d, 0x6f, 0x6a,
+	0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x53, 0x0a, 0x08, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30,
+	0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d,
+	0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba,
+	0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x5f, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x10, 0x4a, 0x0e, 0x22, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x70, 0x61, 0x72, 0x72, 0x6f, 0x74, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x1b, 0xc8, 0x01, 0x01,
+	0x72, 0x16, 0x32, 0x14, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f,
+	0x5d, 0x7b, 0x32, 0x2c, 0x33, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc0,
+	0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x53, 0x0a, 0x08,
+	0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x5f, 0x69,
+	0x64, 0x22, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x65, 0x6d, 0x6f,
+	0x6a, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x08, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x5f, 0x69, 0x64, 0x22, 0x44,
+	0x0a, 0x0a, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xef, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xbb, 0x07, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x10, 0x4a,
+	0x0e, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x54, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x28, 0x4a, 0x26, 0x22, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34,
+	0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31,
+	0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0x22, 0xba,
+	0x48, 0x08, 0xd0, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x53, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3f, 0x92, 0x41, 0x08, 0x4a, 0x06, 0x22, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xba, 0x48, 0x31, 0xd0, 0x01, 0x01, 0x72, 0x2c, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x75, 0x6d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x54, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92,
+	0x41, 0x28, 0x4a, 0x26, 0x22, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0x22, 0xba, 0x48, 0x08, 0xd0, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x12, 0x32, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0x92, 0x41, 0x11, 0x4a, 0x0f, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x73, 0x66, 0x77, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x04,
+	0x6e, 0x73, 0x66, 0x77, 0x12, 0x4e, 0x0a, 0x05, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x75, 0x6d, 0x12, 0x24, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73,
+	0x65, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0xd7, 0x02, 0xba, 0x48, 0xd3,
+	0x02, 0x1a, 0x69, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x20, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x1a, 0x28, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x27, 0x1a, 0x69, 0x0a, 0x11,
+	0x61, 0x64, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x12, 0x2a, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x66,
+	0x6f, 0x72, 0x75, 0x6d, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x1a, 0x28, 0x21,
+	0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x29, 0x20,
+	0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x20, 0x3d, 0x3d, 0x20,
+	0x27, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x27, 0x1a, 0x7b, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2b, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x20, 0x68, 0x61, 0x76, 0x65,
+	0x20, 0x6e, 0x6f, 0x20, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x1a, 0x39, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x21,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x27, 0x2c, 0x20, 0x27, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x27, 0x5d, 0x29, 0x22, 0x76, 0x0a, 0x0d, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x19, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x36, 0x34, 0x30,
+	0x30, 0x30, 0xba, 0x48, 0x0c, 0xd0, 0x01, 0x01, 0x1a, 0x07, 0x18, 0x80, 0xee, 0x05, 0x28, 0xc0,
+	0x3e, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x10,
+	0x92, 0x41, 0x04, 0x4a, 0x02, 0x31, 0x30, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x63, 0x28, 0x00,
+	0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9b, 0x01, 0x0a,
+	0x0d, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x62,
+	0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0x92, 0x41, 0x13, 0x4a, 0x11, 0x22, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x22, 0xba, 0x48, 0x25, 0xd0,
+	0x01, 0x01, 0x72, 0x20, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x6f,
+	0x72, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x12, 0xba, 0x48, 0x0f, 0x92, 0x01, 0x0c, 0x10, 0x14, 0x18, 0x01, 0x22, 0x06, 0x72, 0x04,
+	0x10, 0x01, 0x18, 0x14, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xeb, 0x04, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35,
+	0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34,
+	0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30,
+	0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x10, 0x4a,
+	0x0e, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x11, 0x4a, 0x0f, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x20, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08,
+	0x48, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04,
+	0x6e, 0x73, 0x66, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x4a,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x73, 0x66, 0x77, 0x88, 0x01,
+	0x01, 0x12, 0x4e, 0x0a, 0x05, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69,
+	0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x12, 0x4e, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69,
+	0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x48, 0x03,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x3a, 0x72, 0xba, 0x48,
+	0x6f, 0x1a, 0x6d, 0x0a, 0x17, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x61, 0x20,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x20, 0x68, 0x61, 0x73, 0x20, 0x65, 0x69, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x24, 0x21, 0x68, 0x61, 0x73,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x29, 0x20, 0x7c, 0x7c, 0x20,
+	0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x29,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x73, 0x66, 0x77, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x6f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
+	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
+	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x70, 0x0a, 0x15, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30,
+	0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d,
+	0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30,
+	0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x70, 0x0a, 0x15, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a,
+	0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35,
+	0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34,
+	0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30,
+	0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x13,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35,
+	0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34,
+	0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30,
+	0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x0a, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x68, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x3d,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25,
+	0x92, 0x41, 0x22, 0x32, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x20, 0x70, 0x61, 0x67, 0x65, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41,
+	0x04, 0x4a, 0x02, 0x35, 0x30, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xc8, 0x01, 0x28, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x14,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x51, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x22, 0x76, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34,
+	0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31,
+	0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41,
+	0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x79, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x22, 0x6e, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0x63, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x16, 0x49, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xba, 0x48, 0x08, 0xd0, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x17, 0x49, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0xd7, 0x03, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x73, 0x66, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6e, 0x73, 0x66, 0x77, 0x12, 0x4e, 0x0a, 0x05, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x3c, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xed, 0x01,
+	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x59, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x92, 0x41, 0x28, 0x4a, 0x26, 0x22, 0x35, 0x35, 0x30,
+	0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d,
+	0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30,
+	0x30, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0d,
+	0x92, 0x41, 0x03, 0x4a, 0x01, 0x30, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x28, 0x4a,
+	0x26, 0x22, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0x22, 0xba, 0x48, 0x08, 0xd0, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xd6, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26,
+	0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d,
+	0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35,
+	0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12,
+	0x65, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
+	0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xf4, 0x03, 0x52, 0x09, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x34, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x10,
+	0x4a, 0x0e, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38,
+	0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37,
+	0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe5,
+	0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f,
+	0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x09, 0x46,
+	0x6f, 0x72, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x3a,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f,
+	0x61, 0x74, 0x22, 0x8b, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
+	0x75, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30,
+	0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d,
+	0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba,
+	0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x0e, 0x4a, 0x0c, 0x22, 0x70, 0x6f, 0x73,
+	0x74, 0x20, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x09, 0xc8, 0x01,
+	0x01, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x34,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41,
+	0x10, 0x4a, 0x0e, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x09, 0x4a, 0x07, 0x5b, 0x22, 0x62, 0x75, 0x67, 0x22, 0x5d,
+	0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x10, 0x05, 0x18, 0x01, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0xde, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x12, 0x50, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3c, 0x92, 0x41, 0x11, 0x4a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0xba, 0x48, 0x25, 0xd0, 0x01, 0x01, 0x72, 0x20, 0x52,
+	0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0x92, 0x41, 0x05, 0x4a, 0x03, 0x62, 0x75, 0x67, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x22, 0x64, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x05, 0x70,
+	0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64,
+	0x46, 0x6f, 0x72, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x10, 0x4a, 0x0e, 0x22,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x70, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x51, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d,
+	0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34,
+	0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x14, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x11, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30,
+	0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d,
+	0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30,
+	0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x12, 0x67, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0x92,
+	0x41, 0x28, 0x4a, 0x26, 0x22, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32,
+	0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36,
+	0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x16,
+	0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30,
+	0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d,
+	0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba,
+	0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x65, 0x0a,
+	0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24,
+	0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31,
+	0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34,
+	0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x57,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30,
+	0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36,
+	0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35,
+	0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64,
+	0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30,
+	0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x4a, 0x05, 0x66, 0x61, 0x6c,
+	0x73, 0x65, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x22, 0x6c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x4e, 0x69, 0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65,
+	0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61,
+	0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x10, 0x4a, 0x0e, 0x22, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x72, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
+	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
+	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
+	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x70,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41,
+	0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39, 0x62,
+	0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36, 0x35,
+	0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x22, 0x6d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92,
+	0x41, 0x26, 0x4a, 0x24, 0x35, 0x35, 0x30, 0x65, 0x38, 0x34, 0x30, 0x30, 0x2d, 0x65, 0x32, 0x39,
+	0x62, 0x2d, 0x34, 0x31, 0x64, 0x34, 0x2d, 0x61, 0x37, 0x31, 0x36, 0x2d, 0x34, 0x34, 0x36, 0x36,
+	0x35, 0x35, 0x34, 0x34, 0x30, 0x30, 0x30, 0x30, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0xc8, 0x01,
+	0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22,
+	0x4e, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x42,
+	0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69,
+	0x78, 0x6f, 0x6e, 0x78, 0x70, 0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_messages_proto_rawDescOnce sync.Once
+	file_api_v1_messages_proto_rawDescData = file_api_v1_messages_proto_rawDesc
+)
+
+func file_api_v1_messages_proto_rawDescGZIP() []byte {
+	file_api_v1_messages_proto_rawDescOnce.Do(func() {
+		file_api_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_messages_proto_rawDescData)
+	})
+	return file_api_v1_messages_proto_rawDescData
+}
+
+var file_api_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 158)
+var file_api_v1_messages_proto_goTypes = []interface{}{
+	(*RegisterRequest)(nil),                 // 0: github.com.Nixonxp.discord.gateway.api.v1.RegisterRequest
+	(*RegisterResponse)(nil),                // 1: github.com.Nixonxp.discord.gateway.api.v1.RegisterResponse
+	(*LoginRequest)(nil),                    // 2: github.com.Nixonxp.discord.gateway.api.v1.LoginRequest
+	(*LoginResponse)(nil),                   // 3: github.com.Nixonxp.discord.gateway.api.v1.LoginResponse
+	(*RefreshRequest)(nil),                  // 4: github.com.Nixonxp.discord.gateway.api.v1.RefreshRequest
+	(*RefreshResponse)(nil),                 // 5: github.com.Nixonxp.discord.gateway.api.v1.RefreshResponse
+	(*OauthLoginRequest)(nil),               // 6: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginRequest
+	(*OauthLoginResponse)(nil),              // 7: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginResponse
+	(*OauthLoginCallbackRequest)(nil),       // 8: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginCallbackRequest
+	(*OauthLoginCallbackResponse)(nil),      // 9: github.com.Nixonxp.discord.gateway.api.v1.OauthLoginCallbackResponse
+	(*UpdateUserRequest)(nil),               // 10: github.com.Nixonxp.discord.gateway.api.v1.UpdateUserRequest
+	(*UpdateUserBody)(nil),                  // 11: github.com.Nixonxp.discord.gateway.api.v1.UpdateUserBody
+	(*UserDataResponse)(nil),                // 12: github.com.Nixonxp.discord.gateway.api.v1.UserDataResponse
+	(*GetUserByLoginRequest)(nil),           // 13: github.com.Nixonxp.discord.gateway.api.v1.GetUserByLoginRequest
+	(*GetUserFriendsRequest)(nil),           // 14: github.com.Nixonxp.discord.gateway.api.v1.GetUserFriendsRequest
+	(*GetUserFriendsResponse)(nil),          // 15: github.com.Nixonxp.discord.gateway.api.v1.GetUserFriendsResponse
+	(*Friend)(nil),                          // 16: github.com.Nixonxp.discord.gateway.api.v1.Friend
+	(*GetUserInvitesRequest)(nil),           // 17: github.com.Nixonxp.discord.gateway.api.v1.GetUserInvitesRequest
+	(*GetUserInvitesResponse)(nil),          // 18: github.com.Nixonxp.discord.gateway.api.v1.GetUserInvitesResponse
+	(*FriendInvite)(nil),                    // 19: github.com.Nixonxp.discord.gateway.api.v1.FriendInvite
+	(*AddToFriendByUserIdRequest)(nil),      // 20: github.com.Nixonxp.discord.gateway.api.v1.AddToFriendByUserIdRequest
+	(*AcceptFriendInviteRequest)(nil),       // 21: github.com.Nixonxp.discord.gateway.api.v1.AcceptFriendInviteRequest
+	(*DeclineFriendInviteRequest)(nil),      // 22: github.com.Nixonxp.discord.gateway.api.v1.DeclineFriendInviteRequest
+	(*ActionResponse)(nil),                  // 23: github.com.Nixonxp.discord.gateway.api.v1.ActionResponse
+	(*CreateServerRequest)(nil),             // 24: github.com.Nixonxp.discord.gateway.api.v1.CreateServerRequest
+	(*CreateServerResponse)(nil),            // 25: github.com.Nixonxp.discord.gateway.api.v1.CreateServerResponse
+	(*SearchServerRequest)(nil),             // 26: github.com.Nixonxp.discord.gateway.api.v1.SearchServerRequest
+	(*SubscribeServerRequest)(nil),          // 27: github.com.Nixonxp.discord.gateway.api.v1.SubscribeServerRequest
+	(*UnsubscribeServerRequest)(nil),        // 28: github.com.Nixonxp.discord.gateway.api.v1.UnsubscribeServerRequest
+	(*SearchServerResponse)(nil),            // 29: github.com.Nixonxp.discord.gateway.api.v1.SearchServerResponse
+	(*ServerInfo)(nil),                      // 30: github.com.Nixonxp.discord.gateway.api.v1.ServerInfo
+	(*GetServerRequest)(nil),                // 31: github.com.Nixonxp.discord.gateway.api.v1.GetServerRequest
+	(*UpdateServerRequest)(nil),             // 32: github.com.Nixonxp.discord.gateway.api.v1.UpdateServerRequest
+	(*ServerTags)(nil),                      // 33: github.com.Nixonxp.discord.gateway.api.v1.ServerTags
+	(*DeleteServerRequest)(nil),             // 34: github.com.Nixonxp.discord.gateway.api.v1.DeleteServerRequest
+	(*RestoreServerRequest)(nil),            // 35: github.com.Nixonxp.discord.gateway.api.v1.RestoreServerRequest
+	(*TransferOwnershipRequest)(nil),        // 36: github.com.Nixonxp.discord.gateway.api.v1.TransferOwnershipRequest
+	(*ServerProfile)(nil),                   // 37: github.com.Nixonxp.discord.gateway.api.v1.ServerProfile
+	(*ListServerMembersRequest)(nil),        // 38: github.com.Nixonxp.discord.gateway.api.v1.ListServerMembersRequest
+	(*ListServerMembersResponse)(nil),       // 39: github.com.Nixonxp.discord.gateway.api.v1.ListServerMembersResponse
+	(*GetMemberRequest)(nil),                // 40: github.com.Nixonxp.discord.gateway.api.v1.GetMemberRequest
+	(*SetMemberNicknameRequest)(nil),        // 41: github.com.Nixonxp.discord.gateway.api.v1.SetMemberNicknameRequest
+	(*ServerMember)(nil),                    // 42: github.com.Nixonxp.discord.gateway.api.v1.ServerMember
+	(*MemberProfile)(nil),                   // 43: github.com.Nixonxp.discord.gateway.api.v1.MemberProfile
+	(*ErrorMessage)(nil),                    // 44: github.com.Nixonxp.discord.gateway.api.v1.ErrorMessage
+	(*SearchServerByUserIdRequest)(nil),     // 45: github.com.Nixonxp.discord.gateway.api.v1.SearchServerByUserIdRequest
+	(*SearchServerByUserIdResponse)(nil),    // 46: github.com.Nixonxp.discord.gateway.api.v1.SearchServerByUserIdResponse
+	(*InviteUserToServerRequest)(nil),       // 47: github.com.Nixonxp.discord.gateway.api.v1.InviteUserToServerRequest
+	(*PublishMessageOnServerRequest)(nil),   // 48: github.com.Nixonxp.discord.gateway.api.v1.PublishMessageOnServerRequest
+	(*GetMessagesFromServerRequest)(nil),    // 49: github.com.Nixonxp.discord.gateway.api.v1.GetMessagesFromServerRequest
+	(*GetMessagesResponse)(nil),             // 50: github.com.Nixonxp.discord.gateway.api.v1.GetMessagesResponse
+	(*Message)(nil),                         // 51: github.com.Nixonxp.discord.gateway.api.v1.Message
+	(*MessageAuthor)(nil),                   // 52: github.com.Nixonxp.discord.gateway.api.v1.MessageAuthor
+	(*ModerationRule)(nil),                  // 53: github.com.Nixonxp.discord.gateway.api.v1.ModerationRule
+	(*CreateModerationRuleRequest)(nil),     // 54: github.com.Nixonxp.discord.gateway.api.v1.CreateModerationRuleRequest
+	(*DeleteModerationRuleRequest)(nil),     // 55: github.com.Nixonxp.discord.gateway.api.v1.DeleteModerationRuleRequest
+	(*GetModerationRulesRequest)(nil),       // 56: github.com.Nixonxp.discord.gateway.api.v1.GetModerationRulesRequest
+	(*GetModerationRulesResponse)(nil),      // 57: github.com.Nixonxp.discord.gateway.api.v1.GetModerationRulesResponse
+	(*ModerationAction)(nil),                // 58: github.com.Nixonxp.discord.gateway.api.v1.ModerationAction
+	(*GetModerationActionsRequest)(nil),     // 59: github.com.Nixonxp.discord.gateway.api.v1.GetModerationActionsRequest
+	(*GetModerationActionsResponse)(nil),    // 60: github.com.Nixonxp.discord.gateway.api.v1.GetModerationActionsResponse
+	(*GetAuditLogRequest)(nil),              // 61: github.com.Nixonxp.discord.gateway.api.v1.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),             // 62: github.com.Nixonxp.discord.gateway.api.v1.GetAuditLogResponse
+	(*AuditEntry)(nil),                      // 63: github.com.Nixonxp.discord.gateway.api.v1.AuditEntry
+	(*ExportServerChatRequest)(nil),         // 64: github.com.Nixonxp.discord.gateway.api.v1.ExportServerChatRequest
+	(*ExportPrivateChatRequest)(nil),        // 65: github.com.Nixonxp.discord.gateway.api.v1.ExportPrivateChatRequest
+	(*CreateServerTemplateRequest)(nil),     // 66: github.com.Nixonxp.discord.gateway.api.v1.CreateServerTemplateRequest
+	(*ListTemplatesRequest)(nil),            // 67: github.com.Nixonxp.discord.gateway.api.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),           // 68: github.com.Nixonxp.discord.gateway.api.v1.ListTemplatesResponse
+	(*CreateServerFromTemplateRequest)(nil), // 69: github.com.Nixonxp.discord.gateway.api.v1.CreateServerFromTemplateRequest
+	(*ServerTemplate)(nil),                  // 70: github.com.Nixonxp.discord.gateway.api.v1.ServerTemplate
+	(*CreateWebhookRequest)(nil),            // 71: github.com.Nixonxp.discord.gateway.api.v1.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),             // 72: github.com.Nixonxp.discord.gateway.api.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),            // 73: github.com.Nixonxp.discord.gateway.api.v1.ListWebhooksResponse
+	(*RegenerateWebhookTokenRequest)(nil),   // 74: github.com.Nixonxp.discord.gateway.api.v1.RegenerateWebhookTokenRequest
+	(*DeleteWebhookRequest)(nil),            // 75: github.com.Nixonxp.discord.gateway.api.v1.DeleteWebhookRequest
+	(*ExecuteWebhookRequest)(nil),           // 76: github.com.Nixonxp.discord.gateway.api.v1.ExecuteWebhookRequest
+	(*Webhook)(nil),                         // 77: github.com.Nixonxp.discord.gateway.api.v1.Webhook
+	(*CreateOutgoingWebhookRequest)(nil),    // 78: github.com.Nixonxp.discord.gateway.api.v1.CreateOutgoingWebhookRequest
+	(*ListOutgoingWebhooksRequest)(nil),     // 79: github.com.Nixonxp.discord.gateway.api.v1.ListOutgoingWebhooksRequest
+	(*ListOutgoingWebhooksResponse)(nil),    // 80: github.com.Nixonxp.discord.gateway.api.v1.ListOutgoingWebhooksResponse
+	(*EnableOutgoingWebhookRequest)(nil),    // 81: github.com.Nixonxp.discord.gateway.api.v1.EnableOutgoingWebhookRequest
+	(*DeleteOutgoingWebhookRequest)(nil),    // 82: github.com.Nixonxp.discord.gateway.api.v1.DeleteOutgoingWebhookRequest
+	(*OutgoingWebhook)(nil),                 // 83: github.com.Nixonxp.discord.gateway.api.v1.OutgoingWebhook
+	(*ListOutgoingDeliveriesRequest)(nil),   // 84: github.com.Nixonxp.discord.gateway.api.v1.ListOutgoingDeliveriesRequest
+	(*ListOutgoingDeliveriesResponse)(nil),  // 85: github.com.Nixonxp.discord.gateway.api.v1.ListOutgoingDeliveriesResponse
+	(*OutgoingDelivery)(nil),                // 86: github.com.Nixonxp.discord.gateway.api.v1.OutgoingDelivery
+	(*CreateBotRequest)(nil),                // 87: github.com.Nixonxp.discord.gateway.api.v1.CreateBotRequest
+	(*CreateBotResponse)(nil),               // 88: github.com.Nixonxp.discord.gateway.api.v1.CreateBotResponse
+	(*ListBotsRequest)(nil),                 // 89: github.com.Nixonxp.discord.gateway.api.v1.ListBotsRequest
+	(*ListBotsResponse)(nil),                // 90: github.com.Nixonxp.discord.gateway.api.v1.ListBotsResponse
+	(*ResetBotTokenRequest)(nil),            // 91: github.com.Nixonxp.discord.gateway.api.v1.ResetBotTokenRequest
+	(*ResetBotTokenResponse)(nil),           // 92: github.com.Nixonxp.discord.gateway.api.v1.ResetBotTokenResponse
+	(*RevokeBotTokenRequest)(nil),           // 93: github.com.Nixonxp.discord.gateway.api.v1.RevokeBotTokenRequest
+	(*AddBotToServerRequest)(nil),           // 94: github.com.Nixonxp.discord.gateway.api.v1.AddBotToServerRequest
+	(*Bot)(nil),                             // 95: github.com.Nixonxp.discord.gateway.api.v1.Bot
+	(*CommandOption)(nil),                   // 96: github.com.Nixonxp.discord.gateway.api.v1.CommandOption
+	(*CreateCommandRequest)(nil),            // 97: github.com.Nixonxp.discord.gateway.api.v1.CreateCommandRequest
+	(*ListCommandsRequest)(nil),             // 98: github.com.Nixonxp.discord.gateway.api.v1.ListCommandsRequest
+	(*ListCommandsResponse)(nil),            // 99: github.com.Nixonxp.discord.gateway.api.v1.ListCommandsResponse
+	(*DeleteCommandRequest)(nil),            // 100: github.com.Nixonxp.discord.gateway.api.v1.DeleteCommandRequest
+	(*RespondInteractionRequest)(nil),       // 101: github.com.Nixonxp.discord.gateway.api.v1.RespondInteractionRequest
+	(*Command)(nil),                         // 102: github.com.Nixonxp.discord.gateway.api.v1.Command
+	(*CreateEmojiRequest)(nil),              // 103: github.com.Nixonxp.discord.gateway.api.v1.CreateEmojiRequest
+	(*ListServerEmojiRequest)(nil),          // 104: github.com.Nixonxp.discord.gateway.api.v1.ListServerEmojiRequest
+	(*ListServerEmojiResponse)(nil),         // 105: github.com.Nixonxp.discord.gateway.api.v1.ListServerEmojiResponse
+	(*RenameEmojiRequest)(nil),              // 106: github.com.Nixonxp.discord.gateway.api.v1.RenameEmojiRequest
+	(*DeleteEmojiRequest)(nil),              // 107: github.com.Nixonxp.discord.gateway.api.v1.DeleteEmojiRequest
+	(*GetEmojiImageRequest)(nil),            // 108: github.com.Nixonxp.discord.gateway.api.v1.GetEmojiImageRequest
+	(*EmojiImage)(nil),                      // 109: github.com.Nixonxp.discord.gateway.api.v1.EmojiImage
+	(*Emoji)(nil),                           // 110: github.com.Nixonxp.discord.gateway.api.v1.Emoji
+	(*AddChannelRequest)(nil),               // 111: github.com.Nixonxp.discord.gateway.api.v1.AddChannelRequest
+	(*VoiceSettings)(nil),                   // 112: github.com.Nixonxp.discord.gateway.api.v1.VoiceSettings
+	(*ForumSettings)(nil),                   // 113: github.com.Nixonxp.discord.gateway.api.v1.ForumSettings
+	(*UpdateChannelRequest)(nil),            // 114: github.com.Nixonxp.discord.gateway.api.v1.UpdateChannelRequest
+	(*DeleteChannelRequest)(nil),            // 115: github.com.Nixonxp.discord.gateway.api.v1.DeleteChannelRequest
+	(*RestoreChannelRequest)(nil),           // 116: github.com.Nixonxp.discord.gateway.api.v1.RestoreChannelRequest
+	(*ArchiveChannelRequest)(nil),           // 117: github.com.Nixonxp.discord.gateway.api.v1.ArchiveChannelRequest
+	(*JoinChannelRequest)(nil),              // 118: github.com.Nixonxp.discord.gateway.api.v1.JoinChannelRequest
+	(*LeaveChannelRequest)(nil),             // 119: github.com.Nixonxp.discord.gateway.api.v1.LeaveChannelRequest
+	(*ListMyChannelsRequest)(nil),           // 120: github.com.Nixonxp.discord.gateway.api.v1.ListMyChannelsRequest
+	(*ListMyChannelsResponse)(nil),          // 121: github.com.Nixonxp.discord.gateway.api.v1.ListMyChannelsResponse
+	(*ListChannelMembersRequest)(nil),       // 122: github.com.Nixonxp.discord.gateway.api.v1.ListChannelMembersRequest
+	(*ListChannelMembersResponse)(nil),      // 123: github.com.Nixonxp.discord.gateway.api.v1.ListChannelMembersResponse
+	(*ChannelAccessRequest)(nil),            // 124: github.com.Nixonxp.discord.gateway.api.v1.ChannelAccessRequest
+	(*RequestChannelAccessRequest)(nil),     // 125: github.com.Nixonxp.discord.gateway.api.v1.RequestChannelAccessRequest
+	(*ListChannelJoinRequestsRequest)(nil),  // 126: github.com.Nixonxp.discord.gateway.api.v1.ListChannelJoinRequestsRequest
+	(*ListChannelJoinRequestsResponse)(nil), // 127: github.com.Nixonxp.discord.gateway.api.v1.ListChannelJoinRequestsResponse
+	(*ChannelJoinRequest)(nil),              // 128: github.com.Nixonxp.discord.gateway.api.v1.ChannelJoinRequest
+	(*ChannelMember)(nil),                   // 129: github.com.Nixonxp.discord.gateway.api.v1.ChannelMember
+	(*IsChannelMemberRequest)(nil),          // 130: github.com.Nixonxp.discord.gateway.api.v1.IsChannelMemberRequest
+	(*IsChannelMemberResponse)(nil),         // 131: github.com.Nixonxp.discord.gateway.api.v1.IsChannelMemberResponse
+	(*Channel)(nil),                         // 132: github.com.Nixonxp.discord.gateway.api.v1.Channel
+	(*ChannelPosition)(nil),                 // 133: github.com.Nixonxp.discord.gateway.api.v1.ChannelPosition
+	(*ReorderChannelsRequest)(nil),          // 134: github.com.Nixonxp.discord.gateway.api.v1.ReorderChannelsRequest
+	(*SendChannelMessageRequest)(nil),       // 135: github.com.Nixonxp.discord.gateway.api.v1.SendChannelMessageRequest
+	(*GetChannelMessagesRequest)(nil),       // 136: github.com.Nixonxp.discord.gateway.api.v1.GetChannelMessagesRequest
+	(*GetChannelMessagesResponse)(nil),      // 137: github.com.Nixonxp.discord.gateway.api.v1.GetChannelMessagesResponse
+	(*ChannelMessage)(nil),                  // 138: github.com.Nixonxp.discord.gateway.api.v1.ChannelMessage
+	(*MessageReference)(nil),                // 139: github.com.Nixonxp.discord.gateway.api.v1.MessageReference
+	(*ForumPost)(nil),                       // 140: github.com.Nixonxp.discord.gateway.api.v1.ForumPost
+	(*CreateForumPostRequest)(nil),          // 141: github.com.Nixonxp.discord.gateway.api.v1.CreateForumPostRequest
+	(*ListForumPostsRequest)(nil),           // 142: github.com.Nixonxp.discord.gateway.api.v1.ListForumPostsRequest
+	(*ListForumPostsResponse)(nil),          // 143: github.com.Nixonxp.discord.gateway.api.v1.ListForumPostsResponse
+	(*SendForumPostMessageRequest)(nil),     // 144: github.com.Nixonxp.discord.gateway.api.v1.SendForumPostMessageRequest
+	(*GetForumPostMessagesRequest)(nil),     // 145: github.com.Nixonxp.discord.gateway.api.v1.GetForumPostMessagesRequest
+	(*FollowChannelRequest)(nil),            // 146: github.com.Nixonxp.discord.gateway.api.v1.FollowChannelRequest
+	(*UnfollowChannelRequest)(nil),          // 147: github.com.Nixonxp.discord.gateway.api.v1.UnfollowChannelRequest
+	(*PublishChannelMessageRequest)(nil),    // 148: github.com.Nixonxp.discord.gateway.api.v1.PublishChannelMessageRequest
+	(*ListServerChannelsRequest)(nil),       // 149: github.com.Nixonxp.discord.gateway.api.v1.ListServerChannelsRequest
+	(*ListServerChannelsResponse)(nil),      // 150: github.com.Nixonxp.discord.gateway.api.v1.ListServerChannelsResponse
+	(*SendUserPrivateMessageRequest)(nil),   // 151: github.com.Nixonxp.discord.gateway.api.v1.SendUserPrivateMessageRequest
+	(*GetUserPrivateMessagesRequest)(nil),   // 152: github.com.Nixonxp.discord.gateway.api.v1.GetUserPrivateMessagesRequest
+	(*DeleteFromFriendRequest)(nil),         // 153: github.com.Nixonxp.discord.gateway.api.v1.DeleteFromFriendRequest
+	(*CreatePrivateChatRequest)(nil),        // 154: github.com.Nixonxp.discord.gateway.api.v1.CreatePrivateChatRequest
+	(*CreatePrivateChatResponse)(nil),       // 155: github.com.Nixonxp.discord.gateway.api.v1.CreatePrivateChatResponse
+	nil,                                     // 156: github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.BeforeEntry
+	nil,                                     // 157: github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.AfterEntry
+	(*timestamppb.Timestamp)(nil),           // 158: google.protobuf.Timestamp
+}
+var file_api_v1_messages_proto_depIdxs = []int32{
+	11,  // 0: github.com.Nixonxp.discord.gateway.api.v1.UpdateUserRequest.body:type_name -> github.com.Nixonxp.discord.gateway.api.v1.UpdateUserBody
+	16,  // 1: github.com.Nixonxp.discord.gateway.api.v1.GetUserFriendsResponse.friends:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Friend
+	19,  // 2: github.com.Nixonxp.discord.gateway.api.v1.GetUserInvitesResponse.invites:type_name -> github.com.Nixonxp.discord.gateway.api.v1.FriendInvite
+	30,  // 3: github.com.Nixonxp.discord.gateway.api.v1.SearchServerResponse.servers:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ServerInfo
+	33,  // 4: github.com.Nixonxp.discord.gateway.api.v1.UpdateServerRequest.tags:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ServerTags
+	42,  // 5: github.com.Nixonxp.discord.gateway.api.v1.ListServerMembersResponse.members:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ServerMember
+	158, // 6: github.com.Nixonxp.discord.gateway.api.v1.ServerMember.joined_at:type_name -> google.protobuf.Timestamp
+	43,  // 7: github.com.Nixonxp.discord.gateway.api.v1.ServerMember.profile:type_name -> github.com.Nixonxp.discord.gateway.api.v1.MemberProfile
+	51,  // 8: github.com.Nixonxp.discord.gateway.api.v1.GetMessagesResponse.messages:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Message
+	158, // 9: github.com.Nixonxp.discord.gateway.api.v1.Message.timestamp:type_name -> google.protobuf.Timestamp
+	52,  // 10: github.com.Nixonxp.discord.gateway.api.v1.Message.author:type_name -> github.com.Nixonxp.discord.gateway.api.v1.MessageAuthor
+	158, // 11: github.com.Nixonxp.discord.gateway.api.v1.ModerationRule.created_at:type_name -> google.protobuf.Timestamp
+	53,  // 12: github.com.Nixonxp.discord.gateway.api.v1.GetModerationRulesResponse.rules:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ModerationRule
+	158, // 13: github.com.Nixonxp.discord.gateway.api.v1.ModerationAction.created_at:type_name -> google.protobuf.Timestamp
+	158, // 14: github.com.Nixonxp.discord.gateway.api.v1.ModerationAction.expires_at:type_name -> google.protobuf.Timestamp
+	58,  // 15: github.com.Nixonxp.discord.gateway.api.v1.GetModerationActionsResponse.actions:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ModerationAction
+	63,  // 16: github.com.Nixonxp.discord.gateway.api.v1.GetAuditLogResponse.entries:type_name -> github.com.Nixonxp.discord.gateway.api.v1.AuditEntry
+	156, // 17: github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.before:type_name -> github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.BeforeEntry
+	157, // 18: github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.after:type_name -> github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.AfterEntry
+	158, // 19: github.com.Nixonxp.discord.gateway.api.v1.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	158, // 20: github.com.Nixonxp.discord.gateway.api.v1.ExportServerChatRequest.from:type_name -> google.protobuf.Timestamp
+	158, // 21: github.com.Nixonxp.discord.gateway.api.v1.ExportServerChatRequest.to:type_name -> google.protobuf.Timestamp
+	158, // 22: github.com.Nixonxp.discord.gateway.api.v1.ExportPrivateChatRequest.from:type_name -> google.protobuf.Timestamp
+	158, // 23: github.com.Nixonxp.discord.gateway.api.v1.ExportPrivateChatRequest.to:type_name -> google.protobuf.Timestamp
+	70,  // 24: github.com.Nixonxp.discord.gateway.api.v1.ListTemplatesResponse.templates:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ServerTemplate
+	158, // 25: github.com.Nixonxp.discord.gateway.api.v1.ServerTemplate.created_at:type_name -> google.protobuf.Timestamp
+	77,  // 26: github.com.Nixonxp.discord.gateway.api.v1.ListWebhooksResponse.webhooks:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Webhook
+	158, // 27: github.com.Nixonxp.discord.gateway.api.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 28: github.com.Nixonxp.discord.gateway.api.v1.ListOutgoingWebhooksResponse.webhooks:type_name -> github.com.Nixonxp.discord.gateway.api.v1.OutgoingWebhook
+	158, // 29: github.com.Nixonxp.discord.gateway.api.v1.OutgoingWebhook.disabled_at:type_name -> google.protobuf.Timestamp
+	158, // 30: github.com.Nixonxp.discord.gateway.api.v1.OutgoingWebhook.created_at:type_name -> google.protobuf.Timestamp
+	86,  // 31: github.com.Nixonxp.discord.gateway.api.v1.ListOutgoingDeliveriesResponse.deliveries:type_name -> github.com.Nixonxp.discord.gateway.api.v1.OutgoingDelivery
+	158, // 32: github.com.Nixonxp.discord.gateway.api.v1.OutgoingDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	158, // 33: github.com.Nixonxp.discord.gateway.api.v1.OutgoingDelivery.created_at:type_name -> google.protobuf.Timestamp
+	158, // 34: github.com.Nixonxp.discord.gateway.api.v1.OutgoingDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	95,  // 35: github.com.Nixonxp.discord.gateway.api.v1.CreateBotResponse.bot:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Bot
+	95,  // 36: github.com.Nixonxp.discord.gateway.api.v1.ListBotsResponse.bots:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Bot
+	158, // 37: github.com.Nixonxp.discord.gateway.api.v1.Bot.created_at:type_name -> google.protobuf.Timestamp
+	96,  // 38: github.com.Nixonxp.discord.gateway.api.v1.CreateCommandRequest.options:type_name -> github.com.Nixonxp.discord.gateway.api.v1.CommandOption
+	102, // 39: github.com.Nixonxp.discord.gateway.api.v1.ListCommandsResponse.commands:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Command
+	96,  // 40: github.com.Nixonxp.discord.gateway.api.v1.Command.options:type_name -> github.com.Nixonxp.discord.gateway.api.v1.CommandOption
+	158, // 41: github.com.Nixonxp.discord.gateway.api.v1.Command.created_at:type_name -> google.protobuf.Timestamp
+	110, // 42: github.com.Nixonxp.discord.gateway.api.v1.ListServerEmojiResponse.emoji:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Emoji
+	158, // 43: github.com.Nixonxp.discord.gateway.api.v1.Emoji.created_at:type_name -> google.protobuf.Timestamp
+	112, // 44: github.com.Nixonxp.discord.gateway.api.v1.AddChannelRequest.voice:type_name -> github.com.Nixonxp.discord.gateway.api.v1.VoiceSettings
+	113, // 45: github.com.Nixonxp.discord.gateway.api.v1.AddChannelRequest.forum:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ForumSettings
+	112, // 46: github.com.Nixonxp.discord.gateway.api.v1.UpdateChannelRequest.voice:type_name -> github.com.Nixonxp.discord.gateway.api.v1.VoiceSettings
+	113, // 47: github.com.Nixonxp.discord.gateway.api.v1.UpdateChannelRequest.forum:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ForumSettings
+	132, // 48: github.com.Nixonxp.discord.gateway.api.v1.ListMyChannelsResponse.channels:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Channel
+	129, // 49: github.com.Nixonxp.discord.gateway.api.v1.ListChannelMembersResponse.members:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ChannelMember
+	128, // 50: github.com.Nixonxp.discord.gateway.api.v1.ListChannelJoinRequestsResponse.requests:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ChannelJoinRequest
+	158, // 51: github.com.Nixonxp.discord.gateway.api.v1.ChannelJoinRequest.requested_at:type_name -> google.protobuf.Timestamp
+	158, // 52: github.com.Nixonxp.discord.gateway.api.v1.ChannelMember.joined_at:type_name -> google.protobuf.Timestamp
+	112, // 53: github.com.Nixonxp.discord.gateway.api.v1.Channel.voice:type_name -> github.com.Nixonxp.discord.gateway.api.v1.VoiceSettings
+	113, // 54: github.com.Nixonxp.discord.gateway.api.v1.Channel.forum:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ForumSettings
+	158, // 55: github.com.Nixonxp.discord.gateway.api.v1.Channel.archived_at:type_name -> google.protobuf.Timestamp
+	133, // 56: github.com.Nixonxp.discord.gateway.api.v1.ReorderChannelsRequest.positions:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ChannelPosition
+	138, // 57: github.com.Nixonxp.discord.gateway.api.v1.GetChannelMessagesResponse.messages:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ChannelMessage
+	158, // 58: github.com.Nixonxp.discord.gateway.api.v1.ChannelMessage.timestamp:type_name -> google.protobuf.Timestamp
+	139, // 59: github.com.Nixonxp.discord.gateway.api.v1.ChannelMessage.reference:type_name -> github.com.Nixonxp.discord.gateway.api.v1.MessageReference
+	158, // 60: github.com.Nixonxp.discord.gateway.api.v1.ForumPost.created_at:type_name -> google.protobuf.Timestamp
+	158, // 61: github.com.Nixonxp.discord.gateway.api.v1.ForumPost.last_activity_at:type_name -> google.protobuf.Timestamp
+	140, // 62: github.com.Nixonxp.discord.gateway.api.v1.ListForumPostsResponse.posts:type_name -> github.com.Nixonxp.discord.gateway.api.v1.ForumPost
+	132, // 63: github.com.Nixonxp.discord.gateway.api.v1.ListServerChannelsResponse.channels:type_name -> github.com.Nixonxp.discord.gateway.api.v1.Channel
+	64,  // [64:64] is the sub-list for method output_type
+	64,  // [64:64] is the sub-list for method input_type
+	64,  // [64:64] is the sub-list for extension type_name
+	64,  // [64:64] is the sub-list for extension extendee
+	0,   // [0:64] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_messages_proto_init() }
+func file_api_v1_messages_proto_init() {
+	if File_api_v1_messages_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthLoginCallbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthLoginCallbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFriendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFriendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Friend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserInvitesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendInvite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToFriendByUserIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptFriendInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineFriendInviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferOwnershipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMemberNicknameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServerByUserIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServerByUserIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteUserToServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishMessageOnServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesFromServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageAuthor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateModerationRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteModerationRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModerationActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportServerChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPrivateChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServerTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServerFromTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateWebhookTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOutgoingWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingWebhooksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingWebhooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableOutgoingWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOutgoingWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutgoingWebhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutgoingDeliveriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutgoingDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetBotTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetBotTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2973,8 +12757,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_api_v1_messages_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeBotTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2985,8 +12769,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_api_v1_messages_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBotToServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2997,8 +12781,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponse); i {
+		file_api_v1_messages_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3009,8 +12793,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshRequest); i {
+		file_api_v1_messages_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3021,8 +12805,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshResponse); i {
+		file_api_v1_messages_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3033,8 +12817,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthLoginRequest); i {
+		file_api_v1_messages_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommandsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3045,8 +12829,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthLoginResponse); i {
+		file_api_v1_messages_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommandsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3057,8 +12841,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthLoginCallbackRequest); i {
+		file_api_v1_messages_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3069,8 +12853,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthLoginCallbackResponse); i {
+		file_api_v1_messages_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondInteractionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3081,8 +12865,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequest); i {
+		file_api_v1_messages_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Command); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3093,8 +12877,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserBody); i {
+		file_api_v1_messages_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEmojiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3105,8 +12889,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDataResponse); i {
+		file_api_v1_messages_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerEmojiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3117,8 +12901,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByLoginRequest); i {
+		file_api_v1_messages_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerEmojiResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3129,8 +12913,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFriendsRequest); i {
+		file_api_v1_messages_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameEmojiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3141,8 +12925,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFriendsResponse); i {
+		file_api_v1_messages_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEmojiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3153,8 +12937,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Friend); i {
+		file_api_v1_messages_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEmojiImageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3165,8 +12949,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserInvitesRequest); i {
+		file_api_v1_messages_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmojiImage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3177,8 +12961,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserInvitesResponse); i {
+		file_api_v1_messages_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Emoji); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3189,8 +12973,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FriendInvite); i {
+		file_api_v1_messages_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3201,8 +12985,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddToFriendByUserIdRequest); i {
+		file_api_v1_messages_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoiceSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3213,8 +12997,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptFriendInviteRequest); i {
+		file_api_v1_messages_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForumSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3225,8 +13009,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeclineFriendInviteRequest); i {
+		file_api_v1_messages_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3237,8 +13021,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionResponse); i {
+		file_api_v1_messages_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3249,8 +13033,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3261,8 +13045,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateServerResponse); i {
+		file_api_v1_messages_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3273,8 +13057,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3285,8 +13069,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3297,8 +13081,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyChannelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3309,8 +13093,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchServerResponse); i {
+		file_api_v1_messages_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyChannelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3321,8 +13105,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerInfo); i {
+		file_api_v1_messages_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3333,8 +13117,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrorMessage); i {
+		file_api_v1_messages_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3345,8 +13129,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchServerByUserIdRequest); i {
+		file_api_v1_messages_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAccessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3357,8 +13141,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchServerByUserIdResponse); i {
+		file_api_v1_messages_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestChannelAccessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3369,8 +13153,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteUserToServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelJoinRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3381,8 +13165,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishMessageOnServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelJoinRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3393,8 +13177,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesFromServerRequest); i {
+		file_api_v1_messages_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelJoinRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3405,8 +13189,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesResponse); i {
+		file_api_v1_messages_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3417,8 +13201,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_api_v1_messages_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsChannelMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3429,8 +13213,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddChannelRequest); i {
+		file_api_v1_messages_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsChannelMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3441,8 +13225,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteChannelRequest); i {
+		file_api_v1_messages_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Channel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3453,8 +13237,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinChannelRequest); i {
+		file_api_v1_messages_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3465,8 +13249,8 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveChannelRequest); i {
+		file_api_v1_messages_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderChannelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3477,7 +13261,199 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_messages_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChannelMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForumPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateForumPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForumPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListForumPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendForumPostMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForumPostMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishChannelMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServerChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_messages_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendUserPrivateMessageRequest); i {
 			case 0:
 				return &v.state
@@ -3489,7 +13465,7 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_messages_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserPrivateMessagesRequest); i {
 			case 0:
 				return &v.state
@@ -3501,7 +13477,7 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_messages_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteFromFriendRequest); i {
 			case 0:
 				return &v.state
@@ -3513,7 +13489,7 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_messages_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePrivateChatRequest); i {
 			case 0:
 				return &v.state
@@ -3525,7 +13501,7 @@ func file_api_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_messages_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePrivateChatResponse); i {
 			case 0:
 				return &v.state
@@ -3538,13 +13514,15 @@ func file_api_v1_messages_proto_init() {
 			}
 		}
 	}
+	file_api_v1_messages_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_api_v1_messages_proto_msgTypes[114].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_messages_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   48,
+			NumMessages:   158,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,34 +19,96 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GatewayService_Register_FullMethodName               = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/Register"
-	GatewayService_Login_FullMethodName                  = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/Login"
-	GatewayService_Refresh_FullMethodName                = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/Refresh"
-	GatewayService_OauthLogin_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/OauthLogin"
-	GatewayService_OauthLoginCallback_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/OauthLoginCallback"
-	GatewayService_UpdateUser_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UpdateUser"
-	GatewayService_GetUserByLogin_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserByLogin"
-	GatewayService_GetUserFriends_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserFriends"
-	GatewayService_GetUserInvites_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserInvites"
-	GatewayService_AddToFriendByUserId_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AddToFriendByUserId"
-	GatewayService_AcceptFriendInvite_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AcceptFriendInvite"
-	GatewayService_DeleteFromFriend_FullMethodName       = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteFromFriend"
-	GatewayService_DeclineFriendInvite_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeclineFriendInvite"
-	GatewayService_CreateServer_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateServer"
-	GatewayService_SearchServer_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SearchServer"
-	GatewayService_SubscribeServer_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SubscribeServer"
-	GatewayService_UnsubscribeServer_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UnsubscribeServer"
-	GatewayService_SearchServerByUserId_FullMethodName   = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SearchServerByUserId"
-	GatewayService_InviteUserToServer_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/InviteUserToServer"
-	GatewayService_PublishMessageOnServer_FullMethodName = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/PublishMessageOnServer"
-	GatewayService_GetMessagesFromServer_FullMethodName  = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetMessagesFromServer"
-	GatewayService_AddChannel_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AddChannel"
-	GatewayService_DeleteChannel_FullMethodName          = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteChannel"
-	GatewayService_JoinChannel_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/JoinChannel"
-	GatewayService_LeaveChannel_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/LeaveChannel"
-	GatewayService_SendUserPrivateMessage_FullMethodName = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SendUserPrivateMessage"
-	GatewayService_CreatePrivateChat_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreatePrivateChat"
-	GatewayService_GetUserPrivateMessages_FullMethodName = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserPrivateMessages"
+	GatewayService_Register_FullMethodName                  = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/Register"
+	GatewayService_Login_FullMethodName                     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/Login"
+	GatewayService_Refresh_FullMethodName                   = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/Refresh"
+	GatewayService_OauthLogin_FullMethodName                = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/OauthLogin"
+	GatewayService_OauthLoginCallback_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/OauthLoginCallback"
+	GatewayService_UpdateUser_FullMethodName                = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UpdateUser"
+	GatewayService_GetUserByLogin_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserByLogin"
+	GatewayService_GetUserFriends_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserFriends"
+	GatewayService_GetUserInvites_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserInvites"
+	GatewayService_AddToFriendByUserId_FullMethodName       = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AddToFriendByUserId"
+	GatewayService_AcceptFriendInvite_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AcceptFriendInvite"
+	GatewayService_DeleteFromFriend_FullMethodName          = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteFromFriend"
+	GatewayService_DeclineFriendInvite_FullMethodName       = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeclineFriendInvite"
+	GatewayService_CreateServer_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateServer"
+	GatewayService_SearchServer_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SearchServer"
+	GatewayService_GetServer_FullMethodName                 = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetServer"
+	GatewayService_UpdateServer_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UpdateServer"
+	GatewayService_DeleteServer_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteServer"
+	GatewayService_RestoreServer_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RestoreServer"
+	GatewayService_TransferOwnership_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/TransferOwnership"
+	GatewayService_ListServerMembers_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListServerMembers"
+	GatewayService_GetMember_FullMethodName                 = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetMember"
+	GatewayService_SetMemberNickname_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SetMemberNickname"
+	GatewayService_SubscribeServer_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SubscribeServer"
+	GatewayService_UnsubscribeServer_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UnsubscribeServer"
+	GatewayService_SearchServerByUserId_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SearchServerByUserId"
+	GatewayService_InviteUserToServer_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/InviteUserToServer"
+	GatewayService_PublishMessageOnServer_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/PublishMessageOnServer"
+	GatewayService_GetMessagesFromServer_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetMessagesFromServer"
+	GatewayService_CreateModerationRule_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateModerationRule"
+	GatewayService_DeleteModerationRule_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteModerationRule"
+	GatewayService_GetModerationRules_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetModerationRules"
+	GatewayService_GetModerationActions_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetModerationActions"
+	GatewayService_GetAuditLog_FullMethodName               = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetAuditLog"
+	GatewayService_CreateServerTemplate_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateServerTemplate"
+	GatewayService_ListTemplates_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListTemplates"
+	GatewayService_CreateServerFromTemplate_FullMethodName  = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateServerFromTemplate"
+	GatewayService_CreateWebhook_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateWebhook"
+	GatewayService_ListWebhooks_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListWebhooks"
+	GatewayService_RegenerateWebhookToken_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RegenerateWebhookToken"
+	GatewayService_DeleteWebhook_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteWebhook"
+	GatewayService_ExecuteWebhook_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ExecuteWebhook"
+	GatewayService_CreateOutgoingWebhook_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateOutgoingWebhook"
+	GatewayService_ListOutgoingWebhooks_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListOutgoingWebhooks"
+	GatewayService_EnableOutgoingWebhook_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/EnableOutgoingWebhook"
+	GatewayService_DeleteOutgoingWebhook_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteOutgoingWebhook"
+	GatewayService_ListOutgoingDeliveries_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListOutgoingDeliveries"
+	GatewayService_CreateBot_FullMethodName                 = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateBot"
+	GatewayService_ListBots_FullMethodName                  = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListBots"
+	GatewayService_ResetBotToken_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ResetBotToken"
+	GatewayService_RevokeBotToken_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RevokeBotToken"
+	GatewayService_AddBotToServer_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AddBotToServer"
+	GatewayService_CreateCommand_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateCommand"
+	GatewayService_ListCommands_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListCommands"
+	GatewayService_DeleteCommand_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteCommand"
+	GatewayService_RespondInteraction_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RespondInteraction"
+	GatewayService_CreateEmoji_FullMethodName               = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateEmoji"
+	GatewayService_ListServerEmoji_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListServerEmoji"
+	GatewayService_RenameEmoji_FullMethodName               = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RenameEmoji"
+	GatewayService_DeleteEmoji_FullMethodName               = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteEmoji"
+	GatewayService_AddChannel_FullMethodName                = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/AddChannel"
+	GatewayService_UpdateChannel_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UpdateChannel"
+	GatewayService_DeleteChannel_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/DeleteChannel"
+	GatewayService_RestoreChannel_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RestoreChannel"
+	GatewayService_ArchiveChannel_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ArchiveChannel"
+	GatewayService_UnarchiveChannel_FullMethodName          = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UnarchiveChannel"
+	GatewayService_JoinChannel_FullMethodName               = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/JoinChannel"
+	GatewayService_LeaveChannel_FullMethodName              = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/LeaveChannel"
+	GatewayService_ListMyChannels_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListMyChannels"
+	GatewayService_ListChannelMembers_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListChannelMembers"
+	GatewayService_IsChannelMember_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/IsChannelMember"
+	GatewayService_GrantChannelAccess_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GrantChannelAccess"
+	GatewayService_RevokeChannelAccess_FullMethodName       = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RevokeChannelAccess"
+	GatewayService_RequestChannelAccess_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/RequestChannelAccess"
+	GatewayService_ListChannelJoinRequests_FullMethodName   = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListChannelJoinRequests"
+	GatewayService_ApproveChannelJoinRequest_FullMethodName = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ApproveChannelJoinRequest"
+	GatewayService_SendChannelMessage_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SendChannelMessage"
+	GatewayService_GetChannelMessages_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetChannelMessages"
+	GatewayService_CreateForumPost_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreateForumPost"
+	GatewayService_ListForumPosts_FullMethodName            = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListForumPosts"
+	GatewayService_SendForumPostMessage_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SendForumPostMessage"
+	GatewayService_GetForumPostMessages_FullMethodName      = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetForumPostMessages"
+	GatewayService_FollowChannel_FullMethodName             = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/FollowChannel"
+	GatewayService_UnfollowChannel_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/UnfollowChannel"
+	GatewayService_PublishChannelMessage_FullMethodName     = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/PublishChannelMessage"
+	GatewayService_ListServerChannels_FullMethodName        = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ListServerChannels"
+	GatewayService_ReorderChannels_FullMethodName           = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/ReorderChannels"
+	GatewayService_SendUserPrivateMessage_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/SendUserPrivateMessage"
+	GatewayService_CreatePrivateChat_FullMethodName         = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/CreatePrivateChat"
+	GatewayService_GetUserPrivateMessages_FullMethodName    = "/github.com.Nixonxp.discord.gateway.api.v1.GatewayService/GetUserPrivateMessages"
 )
 
 // GatewayServiceClient is the client API for GatewayService service.
@@ -83,6 +145,22 @@ type GatewayServiceClient interface {
 	CreateServer(ctx context.Context, in *CreateServerRequest, opts ...grpc.CallOption) (*CreateServerResponse, error)
 	// Поиск сервера
 	SearchServer(ctx context.Context, in *SearchServerRequest, opts ...grpc.CallOption) (*SearchServerResponse, error)
+	// Получить профиль сервера
+	GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	// Изменить сервер
+	UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	// Удалить сервер, владелец может восстановить его в течение 7 дней
+	DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Восстановить удаленный сервер
+	RestoreServer(ctx context.Context, in *RestoreServerRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	// Передать владение сервером
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	// Список участников сервера
+	ListServerMembers(ctx context.Context, in *ListServerMembersRequest, opts ...grpc.CallOption) (*ListServerMembersResponse, error)
+	// Участник сервера
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*ServerMember, error)
+	// Изменить никнейм участника сервера
+	SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*ServerMember, error)
 	// Подписаться на сервер
 	SubscribeServer(ctx context.Context, in *SubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	// Отписаться от сервера
@@ -95,14 +173,122 @@ type GatewayServiceClient interface {
 	PublishMessageOnServer(ctx context.Context, in *PublishMessageOnServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	// Получить опубликованные сообщения сервера
 	GetMessagesFromServer(ctx context.Context, in *GetMessagesFromServerRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error)
+	// Добавить правило автомодерации сервера
+	CreateModerationRule(ctx context.Context, in *CreateModerationRuleRequest, opts ...grpc.CallOption) (*ModerationRule, error)
+	// Удалить правило автомодерации сервера
+	DeleteModerationRule(ctx context.Context, in *DeleteModerationRuleRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Получить правила автомодерации сервера
+	GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error)
+	// Получить сработавшие действия автомодерации сервера
+	GetModerationActions(ctx context.Context, in *GetModerationActionsRequest, opts ...grpc.CallOption) (*GetModerationActionsResponse, error)
+	// Журнал действий администрирования сервера
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// Создать шаблон из сервера
+	CreateServerTemplate(ctx context.Context, in *CreateServerTemplateRequest, opts ...grpc.CallOption) (*ServerTemplate, error)
+	// Список шаблонов серверов пользователя
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	// Создать сервер из шаблона
+	CreateServerFromTemplate(ctx context.Context, in *CreateServerFromTemplateRequest, opts ...grpc.CallOption) (*ServerProfile, error)
+	// Создать вебхук сервера, токен возвращается только в ответе
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Получить вебхуки сервера
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// Перевыпустить токен вебхука, старый токен перестает работать
+	RegenerateWebhookToken(ctx context.Context, in *RegenerateWebhookTokenRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// Удалить вебхук
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Отправить сообщение на сервер через вебхук, авторизация по токену вебхука
+	ExecuteWebhook(ctx context.Context, in *ExecuteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Подписать URL интеграции на события сервера, секрет подписи возвращается только в ответе
+	CreateOutgoingWebhook(ctx context.Context, in *CreateOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error)
+	// Получить исходящие вебхуки сервера
+	ListOutgoingWebhooks(ctx context.Context, in *ListOutgoingWebhooksRequest, opts ...grpc.CallOption) (*ListOutgoingWebhooksResponse, error)
+	// Включить исходящий вебхук, отключенный после ошибок доставки
+	EnableOutgoingWebhook(ctx context.Context, in *EnableOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error)
+	// Удалить исходящий вебхук вместе с журналом доставок
+	DeleteOutgoingWebhook(ctx context.Context, in *DeleteOutgoingWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Журнал последних доставок исходящего вебхука
+	ListOutgoingDeliveries(ctx context.Context, in *ListOutgoingDeliveriesRequest, opts ...grpc.CallOption) (*ListOutgoingDeliveriesResponse, error)
+	// CreateBot - register a bot owned by the current user
+	CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error)
+	// ListBots - bots of the current user
+	ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*ListBotsResponse, error)
+	// ResetBotToken - issue a new token, the previous token stops working
+	ResetBotToken(ctx context.Context, in *ResetBotTokenRequest, opts ...grpc.CallOption) (*ResetBotTokenResponse, error)
+	// RevokeBotToken - revoke the token of the bot
+	RevokeBotToken(ctx context.Context, in *RevokeBotTokenRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// AddBotToServer - add a public or own bot to the server
+	AddBotToServer(ctx context.Context, in *AddBotToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// CreateCommand - register a slash command, invocations are sent to the handler url
+	CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*Command, error)
+	// ListCommands - slash commands of the server
+	ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error)
+	// DeleteCommand - delete the slash command
+	DeleteCommand(ctx context.Context, in *DeleteCommandRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// RespondInteraction - reply of the command handler, authorized by the interaction token
+	RespondInteraction(ctx context.Context, in *RespondInteractionRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// CreateEmoji - upload a custom emoji of the server
+	CreateEmoji(ctx context.Context, in *CreateEmojiRequest, opts ...grpc.CallOption) (*Emoji, error)
+	// ListServerEmoji - custom emoji of the server
+	ListServerEmoji(ctx context.Context, in *ListServerEmojiRequest, opts ...grpc.CallOption) (*ListServerEmojiResponse, error)
+	// RenameEmoji - rename the custom emoji
+	RenameEmoji(ctx context.Context, in *RenameEmojiRequest, opts ...grpc.CallOption) (*Emoji, error)
+	// DeleteEmoji - delete the custom emoji
+	DeleteEmoji(ctx context.Context, in *DeleteEmojiRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	// Добавить канал
 	AddChannel(ctx context.Context, in *AddChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Изменить канал
+	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error)
 	// Удалить канал
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Восстановить удаленный канал
+	RestoreChannel(ctx context.Context, in *RestoreChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Архивировать канал
+	ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Вернуть канал из архива
+	UnarchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	// Присоединиться к каналу
 	JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	// Покинуть канал
 	LeaveChannel(ctx context.Context, in *LeaveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Список каналов текущего пользователя
+	ListMyChannels(ctx context.Context, in *ListMyChannelsRequest, opts ...grpc.CallOption) (*ListMyChannelsResponse, error)
+	// Список участников канала
+	ListChannelMembers(ctx context.Context, in *ListChannelMembersRequest, opts ...grpc.CallOption) (*ListChannelMembersResponse, error)
+	// Проверить участие в канале
+	IsChannelMember(ctx context.Context, in *IsChannelMemberRequest, opts ...grpc.CallOption) (*IsChannelMemberResponse, error)
+	// Выдать доступ к приватному каналу
+	GrantChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Отозвать доступ к приватному каналу
+	RevokeChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Запросить доступ к приватному каналу
+	RequestChannelAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Список заявок на вступление в канал
+	ListChannelJoinRequests(ctx context.Context, in *ListChannelJoinRequestsRequest, opts ...grpc.CallOption) (*ListChannelJoinRequestsResponse, error)
+	// Одобрить заявку на вступление в канал
+	ApproveChannelJoinRequest(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Отправить сообщение в канал
+	SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Сообщения канала
+	GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error)
+	// Создать пост в форуме
+	CreateForumPost(ctx context.Context, in *CreateForumPostRequest, opts ...grpc.CallOption) (*ForumPost, error)
+	// Посты форума
+	ListForumPosts(ctx context.Context, in *ListForumPostsRequest, opts ...grpc.CallOption) (*ListForumPostsResponse, error)
+	// Отправить сообщение в пост форума
+	SendForumPostMessage(ctx context.Context, in *SendForumPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Сообщения поста форума
+	GetForumPostMessages(ctx context.Context, in *GetForumPostMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error)
+	// Подписать свой канал на канал объявлений
+	FollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Отписать свой канал от канала объявлений
+	UnfollowChannel(ctx context.Context, in *UnfollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Опубликовать сообщение канала объявлений у подписчиков
+	PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Список каналов сервера
+	ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	// Изменить порядок каналов сервера
+	ReorderChannels(ctx context.Context, in *ReorderChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
 	// Отправить сообщение пользователю
 	SendUserPrivateMessage(ctx context.Context, in *SendUserPrivateMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error)
 	// Создать новый чат с пользователем
@@ -254,6 +440,78 @@ func (c *gatewayServiceClient) SearchServer(ctx context.Context, in *SearchServe
 	return out, nil
 }
 
+func (c *gatewayServiceClient) GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, GatewayService_GetServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, GatewayService_UpdateServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) DeleteServer(ctx context.Context, in *DeleteServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RestoreServer(ctx context.Context, in *RestoreServerRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, GatewayService_RestoreServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, GatewayService_TransferOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListServerMembers(ctx context.Context, in *ListServerMembersRequest, opts ...grpc.CallOption) (*ListServerMembersResponse, error) {
+	out := new(ListServerMembersResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListServerMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*ServerMember, error) {
+	out := new(ServerMember)
+	err := c.cc.Invoke(ctx, GatewayService_GetMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*ServerMember, error) {
+	out := new(ServerMember)
+	err := c.cc.Invoke(ctx, GatewayService_SetMemberNickname_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayServiceClient) SubscribeServer(ctx context.Context, in *SubscribeServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
 	out := new(ActionResponse)
 	err := c.cc.Invoke(ctx, GatewayService_SubscribeServer_FullMethodName, in, out, opts...)
@@ -308,679 +566,2591 @@ func (c *gatewayServiceClient) GetMessagesFromServer(ctx context.Context, in *Ge
 	return out, nil
 }
 
-func (c *gatewayServiceClient) AddChannel(ctx context.Context, in *AddChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, GatewayService_AddChannel_FullMethodName, in, out, opts...)
+func (c *gatewayServiceClient) CreateModerationRule(ctx context.Context, in *CreateModerationRuleRequest, opts ...grpc.CallOption) (*ModerationRule, error) {
+	out := new(ModerationRule)
+	err := c.cc.Invoke(ctx, GatewayService_CreateModerationRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gatewayServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+func (c *gatewayServiceClient) DeleteModerationRule(ctx context.Context, in *DeleteModerationRuleRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
 	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, GatewayService_DeleteChannel_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteModerationRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gatewayServiceClient) JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, GatewayService_JoinChannel_FullMethodName, in, out, opts...)
+func (c *gatewayServiceClient) GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error) {
+	out := new(GetModerationRulesResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetModerationRules_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gatewayServiceClient) LeaveChannel(ctx context.Context, in *LeaveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, GatewayService_LeaveChannel_FullMethodName, in, out, opts...)
+func (c *gatewayServiceClient) GetModerationActions(ctx context.Context, in *GetModerationActionsRequest, opts ...grpc.CallOption) (*GetModerationActionsResponse, error) {
+	out := new(GetModerationActionsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetModerationActions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gatewayServiceClient) SendUserPrivateMessage(ctx context.Context, in *SendUserPrivateMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
-	out := new(ActionResponse)
-	err := c.cc.Invoke(ctx, GatewayService_SendUserPrivateMessage_FullMethodName, in, out, opts...)
+func (c *gatewayServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetAuditLog_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gatewayServiceClient) CreatePrivateChat(ctx context.Context, in *CreatePrivateChatRequest, opts ...grpc.CallOption) (*CreatePrivateChatResponse, error) {
-	out := new(CreatePrivateChatResponse)
-	err := c.cc.Invoke(ctx, GatewayService_CreatePrivateChat_FullMethodName, in, out, opts...)
+func (c *gatewayServiceClient) CreateServerTemplate(ctx context.Context, in *CreateServerTemplateRequest, opts ...grpc.CallOption) (*ServerTemplate, error) {
+	out := new(ServerTemplate)
+	err := c.cc.Invoke(ctx, GatewayService_CreateServerTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *gatewayServiceClient) GetUserPrivateMessages(ctx context.Context, in *GetUserPrivateMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
-	out := new(GetMessagesResponse)
-	err := c.cc.Invoke(ctx, GatewayService_GetUserPrivateMessages_FullMethodName, in, out, opts...)
+func (c *gatewayServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GatewayServiceServer is the server API for GatewayService service.
-// All implementations must embed UnimplementedGatewayServiceServer
-// for forward compatibility
-type GatewayServiceServer interface {
-	// Регистрация пользователя
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	// Аутентификация пользователя
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// Обновление токена пользователя
-	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
-	// Аутентификация пользователя через OAuth
-	OauthLogin(context.Context, *OauthLoginRequest) (*OauthLoginResponse, error)
-	// Аутентификация пользователя через код oauth
-	OauthLoginCallback(context.Context, *OauthLoginCallbackRequest) (*OauthLoginCallbackResponse, error)
-	// Обновление пользователя
-	UpdateUser(context.Context, *UpdateUserRequest) (*UserDataResponse, error)
-	// Поиск пользователя по логину
-	GetUserByLogin(context.Context, *GetUserByLoginRequest) (*UserDataResponse, error)
-	// Получить список друзей пользователя
-	GetUserFriends(context.Context, *GetUserFriendsRequest) (*GetUserFriendsResponse, error)
-	// Получить список заявок в друзья пользователя
-	GetUserInvites(context.Context, *GetUserInvitesRequest) (*GetUserInvitesResponse, error)
-	// Добавление пользователя в друзья
-	AddToFriendByUserId(context.Context, *AddToFriendByUserIdRequest) (*ActionResponse, error)
-	// Принять пользователя в друзья
-	AcceptFriendInvite(context.Context, *AcceptFriendInviteRequest) (*ActionResponse, error)
-	// Удалить из друзей
-	DeleteFromFriend(context.Context, *DeleteFromFriendRequest) (*ActionResponse, error)
-	// Отклонить заявку  пользователя в друзья
-	DeclineFriendInvite(context.Context, *DeclineFriendInviteRequest) (*ActionResponse, error)
-	// Добавить сервер
-	CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error)
-	// Поиск сервера
-	SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error)
-	// Подписаться на сервер
-	SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error)
-	// Отписаться от сервера
-	UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error)
-	// Поиск сервера по пользователю
-	SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error)
-	// Пригласить пользователя на сервер
-	InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error)
-	// Опубликовать сообщение на сервере
-	PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error)
-	// Получить опубликованные сообщения сервера
-	GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error)
-	// Добавить канал
-	AddChannel(context.Context, *AddChannelRequest) (*ActionResponse, error)
-	// Удалить канал
-	DeleteChannel(context.Context, *DeleteChannelRequest) (*ActionResponse, error)
-	// Присоединиться к каналу
-	JoinChannel(context.Context, *JoinChannelRequest) (*ActionResponse, error)
-	// Покинуть канал
-	LeaveChannel(context.Context, *LeaveChannelRequest) (*ActionResponse, error)
-	// Отправить сообщение пользователю
-	SendUserPrivateMessage(context.Context, *SendUserPrivateMessageRequest) (*ActionResponse, error)
-	// Создать новый чат с пользователем
-	CreatePrivateChat(context.Context, *CreatePrivateChatRequest) (*CreatePrivateChatResponse, error)
-	// Получить сообщения с пользователем
-	GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error)
-	mustEmbedUnimplementedGatewayServiceServer()
+func (c *gatewayServiceClient) CreateServerFromTemplate(ctx context.Context, in *CreateServerFromTemplateRequest, opts ...grpc.CallOption) (*ServerProfile, error) {
+	out := new(ServerProfile)
+	err := c.cc.Invoke(ctx, GatewayService_CreateServerFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGatewayServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGatewayServiceServer struct {
+func (c *gatewayServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, GatewayService_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedGatewayServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (c *gatewayServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *gatewayServiceClient) RegenerateWebhookToken(ctx context.Context, in *RegenerateWebhookTokenRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, GatewayService_RegenerateWebhookToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+
+func (c *gatewayServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) OauthLogin(context.Context, *OauthLoginRequest) (*OauthLoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OauthLogin not implemented")
+
+func (c *gatewayServiceClient) ExecuteWebhook(ctx context.Context, in *ExecuteWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ExecuteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) OauthLoginCallback(context.Context, *OauthLoginCallbackRequest) (*OauthLoginCallbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OauthLoginCallback not implemented")
+
+func (c *gatewayServiceClient) CreateOutgoingWebhook(ctx context.Context, in *CreateOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error) {
+	out := new(OutgoingWebhook)
+	err := c.cc.Invoke(ctx, GatewayService_CreateOutgoingWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *gatewayServiceClient) ListOutgoingWebhooks(ctx context.Context, in *ListOutgoingWebhooksRequest, opts ...grpc.CallOption) (*ListOutgoingWebhooksResponse, error) {
+	out := new(ListOutgoingWebhooksResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListOutgoingWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) GetUserByLogin(context.Context, *GetUserByLoginRequest) (*UserDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByLogin not implemented")
+
+func (c *gatewayServiceClient) EnableOutgoingWebhook(ctx context.Context, in *EnableOutgoingWebhookRequest, opts ...grpc.CallOption) (*OutgoingWebhook, error) {
+	out := new(OutgoingWebhook)
+	err := c.cc.Invoke(ctx, GatewayService_EnableOutgoingWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) GetUserFriends(context.Context, *GetUserFriendsRequest) (*GetUserFriendsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserFriends not implemented")
+
+func (c *gatewayServiceClient) DeleteOutgoingWebhook(ctx context.Context, in *DeleteOutgoingWebhookRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteOutgoingWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) GetUserInvites(context.Context, *GetUserInvitesRequest) (*GetUserInvitesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserInvites not implemented")
+
+func (c *gatewayServiceClient) ListOutgoingDeliveries(ctx context.Context, in *ListOutgoingDeliveriesRequest, opts ...grpc.CallOption) (*ListOutgoingDeliveriesResponse, error) {
+	out := new(ListOutgoingDeliveriesResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListOutgoingDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) AddToFriendByUserId(context.Context, *AddToFriendByUserIdRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToFriendByUserId not implemented")
+
+func (c *gatewayServiceClient) CreateBot(ctx context.Context, in *CreateBotRequest, opts ...grpc.CallOption) (*CreateBotResponse, error) {
+	out := new(CreateBotResponse)
+	err := c.cc.Invoke(ctx, GatewayService_CreateBot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) AcceptFriendInvite(context.Context, *AcceptFriendInviteRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendInvite not implemented")
+
+func (c *gatewayServiceClient) ListBots(ctx context.Context, in *ListBotsRequest, opts ...grpc.CallOption) (*ListBotsResponse, error) {
+	out := new(ListBotsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListBots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) DeleteFromFriend(context.Context, *DeleteFromFriendRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromFriend not implemented")
+
+func (c *gatewayServiceClient) ResetBotToken(ctx context.Context, in *ResetBotTokenRequest, opts ...grpc.CallOption) (*ResetBotTokenResponse, error) {
+	out := new(ResetBotTokenResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ResetBotToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) DeclineFriendInvite(context.Context, *DeclineFriendInviteRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendInvite not implemented")
+
+func (c *gatewayServiceClient) RevokeBotToken(ctx context.Context, in *RevokeBotTokenRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RevokeBotToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateServer not implemented")
+
+func (c *gatewayServiceClient) AddBotToServer(ctx context.Context, in *AddBotToServerRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_AddBotToServer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchServer not implemented")
+
+func (c *gatewayServiceClient) CreateCommand(ctx context.Context, in *CreateCommandRequest, opts ...grpc.CallOption) (*Command, error) {
+	out := new(Command)
+	err := c.cc.Invoke(ctx, GatewayService_CreateCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServiceServer) SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeServer not implemented")
+
+func (c *gatewayServiceClient) ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error) {
+	out := new(ListCommandsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListCommands_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) DeleteCommand(ctx context.Context, in *DeleteCommandRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RespondInteraction(ctx context.Context, in *RespondInteractionRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RespondInteraction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) CreateEmoji(ctx context.Context, in *CreateEmojiRequest, opts ...grpc.CallOption) (*Emoji, error) {
+	out := new(Emoji)
+	err := c.cc.Invoke(ctx, GatewayService_CreateEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListServerEmoji(ctx context.Context, in *ListServerEmojiRequest, opts ...grpc.CallOption) (*ListServerEmojiResponse, error) {
+	out := new(ListServerEmojiResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListServerEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RenameEmoji(ctx context.Context, in *RenameEmojiRequest, opts ...grpc.CallOption) (*Emoji, error) {
+	out := new(Emoji)
+	err := c.cc.Invoke(ctx, GatewayService_RenameEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) DeleteEmoji(ctx context.Context, in *DeleteEmojiRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteEmoji_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) AddChannel(ctx context.Context, in *AddChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_AddChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
+	out := new(Channel)
+	err := c.cc.Invoke(ctx, GatewayService_UpdateChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_DeleteChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RestoreChannel(ctx context.Context, in *RestoreChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RestoreChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ArchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ArchiveChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) UnarchiveChannel(ctx context.Context, in *ArchiveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_UnarchiveChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_JoinChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) LeaveChannel(ctx context.Context, in *LeaveChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_LeaveChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListMyChannels(ctx context.Context, in *ListMyChannelsRequest, opts ...grpc.CallOption) (*ListMyChannelsResponse, error) {
+	out := new(ListMyChannelsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListMyChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListChannelMembers(ctx context.Context, in *ListChannelMembersRequest, opts ...grpc.CallOption) (*ListChannelMembersResponse, error) {
+	out := new(ListChannelMembersResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListChannelMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) IsChannelMember(ctx context.Context, in *IsChannelMemberRequest, opts ...grpc.CallOption) (*IsChannelMemberResponse, error) {
+	out := new(IsChannelMemberResponse)
+	err := c.cc.Invoke(ctx, GatewayService_IsChannelMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GrantChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GrantChannelAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RevokeChannelAccess(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RevokeChannelAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RequestChannelAccess(ctx context.Context, in *RequestChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RequestChannelAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListChannelJoinRequests(ctx context.Context, in *ListChannelJoinRequestsRequest, opts ...grpc.CallOption) (*ListChannelJoinRequestsResponse, error) {
+	out := new(ListChannelJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListChannelJoinRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ApproveChannelJoinRequest(ctx context.Context, in *ChannelAccessRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ApproveChannelJoinRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) SendChannelMessage(ctx context.Context, in *SendChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_SendChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GetChannelMessages(ctx context.Context, in *GetChannelMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error) {
+	out := new(GetChannelMessagesResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetChannelMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) CreateForumPost(ctx context.Context, in *CreateForumPostRequest, opts ...grpc.CallOption) (*ForumPost, error) {
+	out := new(ForumPost)
+	err := c.cc.Invoke(ctx, GatewayService_CreateForumPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListForumPosts(ctx context.Context, in *ListForumPostsRequest, opts ...grpc.CallOption) (*ListForumPostsResponse, error) {
+	out := new(ListForumPostsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListForumPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) SendForumPostMessage(ctx context.Context, in *SendForumPostMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_SendForumPostMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GetForumPostMessages(ctx context.Context, in *GetForumPostMessagesRequest, opts ...grpc.CallOption) (*GetChannelMessagesResponse, error) {
+	out := new(GetChannelMessagesResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetForumPostMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) FollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_FollowChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) UnfollowChannel(ctx context.Context, in *UnfollowChannelRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_UnfollowChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) PublishChannelMessage(ctx context.Context, in *PublishChannelMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_PublishChannelMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ReorderChannels(ctx context.Context, in *ReorderChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ReorderChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) SendUserPrivateMessage(ctx context.Context, in *SendUserPrivateMessageRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_SendUserPrivateMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) CreatePrivateChat(ctx context.Context, in *CreatePrivateChatRequest, opts ...grpc.CallOption) (*CreatePrivateChatResponse, error) {
+	out := new(CreatePrivateChatResponse)
+	err := c.cc.Invoke(ctx, GatewayService_CreatePrivateChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GetUserPrivateMessages(ctx context.Context, in *GetUserPrivateMessagesRequest, opts ...grpc.CallOption) (*GetMessagesResponse, error) {
+	out := new(GetMessagesResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetUserPrivateMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayServiceServer is the server API for GatewayService service.
+// All implementations must embed UnimplementedGatewayServiceServer
+// for forward compatibility
+type GatewayServiceServer interface {
+	// Регистрация пользователя
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Аутентификация пользователя
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Обновление токена пользователя
+	Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error)
+	// Аутентификация пользователя через OAuth
+	OauthLogin(context.Context, *OauthLoginRequest) (*OauthLoginResponse, error)
+	// Аутентификация пользователя через код oauth
+	OauthLoginCallback(context.Context, *OauthLoginCallbackRequest) (*OauthLoginCallbackResponse, error)
+	// Обновление пользователя
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserDataResponse, error)
+	// Поиск пользователя по логину
+	GetUserByLogin(context.Context, *GetUserByLoginRequest) (*UserDataResponse, error)
+	// Получить список друзей пользователя
+	GetUserFriends(context.Context, *GetUserFriendsRequest) (*GetUserFriendsResponse, error)
+	// Получить список заявок в друзья пользователя
+	GetUserInvites(context.Context, *GetUserInvitesRequest) (*GetUserInvitesResponse, error)
+	// Добавление пользователя в друзья
+	AddToFriendByUserId(context.Context, *AddToFriendByUserIdRequest) (*ActionResponse, error)
+	// Принять пользователя в друзья
+	AcceptFriendInvite(context.Context, *AcceptFriendInviteRequest) (*ActionResponse, error)
+	// Удалить из друзей
+	DeleteFromFriend(context.Context, *DeleteFromFriendRequest) (*ActionResponse, error)
+	// Отклонить заявку  пользователя в друзья
+	DeclineFriendInvite(context.Context, *DeclineFriendInviteRequest) (*ActionResponse, error)
+	// Добавить сервер
+	CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error)
+	// Поиск сервера
+	SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error)
+	// Получить профиль сервера
+	GetServer(context.Context, *GetServerRequest) (*ServerProfile, error)
+	// Изменить сервер
+	UpdateServer(context.Context, *UpdateServerRequest) (*ServerProfile, error)
+	// Удалить сервер, владелец может восстановить его в течение 7 дней
+	DeleteServer(context.Context, *DeleteServerRequest) (*ActionResponse, error)
+	// Восстановить удаленный сервер
+	RestoreServer(context.Context, *RestoreServerRequest) (*ServerProfile, error)
+	// Передать владение сервером
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*ServerProfile, error)
+	// Список участников сервера
+	ListServerMembers(context.Context, *ListServerMembersRequest) (*ListServerMembersResponse, error)
+	// Участник сервера
+	GetMember(context.Context, *GetMemberRequest) (*ServerMember, error)
+	// Изменить никнейм участника сервера
+	SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*ServerMember, error)
+	// Подписаться на сервер
+	SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error)
+	// Отписаться от сервера
+	UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error)
+	// Поиск сервера по пользователю
+	SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error)
+	// Пригласить пользователя на сервер
+	InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error)
+	// Опубликовать сообщение на сервере
+	PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error)
+	// Получить опубликованные сообщения сервера
+	GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error)
+	// Добавить правило автомодерации сервера
+	CreateModerationRule(context.Context, *CreateModerationRuleRequest) (*ModerationRule, error)
+	// Удалить правило автомодерации сервера
+	DeleteModerationRule(context.Context, *DeleteModerationRuleRequest) (*ActionResponse, error)
+	// Получить правила автомодерации сервера
+	GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error)
+	// Получить сработавшие действия автомодерации сервера
+	GetModerationActions(context.Context, *GetModerationActionsRequest) (*GetModerationActionsResponse, error)
+	// Журнал действий администрирования сервера
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	// Создать шаблон из сервера
+	CreateServerTemplate(context.Context, *CreateServerTemplateRequest) (*ServerTemplate, error)
+	// Список шаблонов серверов пользователя
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	// Создать сервер из шаблона
+	CreateServerFromTemplate(context.Context, *CreateServerFromTemplateRequest) (*ServerProfile, error)
+	// Создать вебхук сервера, токен возвращается только в ответе
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	// Получить вебхуки сервера
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// Перевыпустить токен вебхука, старый токен перестает работать
+	RegenerateWebhookToken(context.Context, *RegenerateWebhookTokenRequest) (*Webhook, error)
+	// Удалить вебхук
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*ActionResponse, error)
+	// Отправить сообщение на сервер через вебхук, авторизация по токену вебхука
+	ExecuteWebhook(context.Context, *ExecuteWebhookRequest) (*ActionResponse, error)
+	// Подписать URL интеграции на события сервера, секрет подписи возвращается только в ответе
+	CreateOutgoingWebhook(context.Context, *CreateOutgoingWebhookRequest) (*OutgoingWebhook, error)
+	// Получить исходящие вебхуки сервера
+	ListOutgoingWebhooks(context.Context, *ListOutgoingWebhooksRequest) (*ListOutgoingWebhooksResponse, error)
+	// Включить исходящий вебхук, отключенный после ошибок доставки
+	EnableOutgoingWebhook(context.Context, *EnableOutgoingWebhookRequest) (*OutgoingWebhook, error)
+	// Удалить исходящий вебхук вместе с журналом доставок
+	DeleteOutgoingWebhook(context.Context, *DeleteOutgoingWebhookRequest) (*ActionResponse, error)
+	// Журнал последних доставок исходящего вебхука
+	ListOutgoingDeliveries(context.Context, *ListOutgoingDeliveriesRequest) (*ListOutgoingDeliveriesResponse, error)
+	// CreateBot - register a bot owned by the current user
+	CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error)
+	// ListBots - bots of the current user
+	ListBots(context.Context, *ListBotsRequest) (*ListBotsResponse, error)
+	// ResetBotToken - issue a new token, the previous token stops working
+	ResetBotToken(context.Context, *ResetBotTokenRequest) (*ResetBotTokenResponse, error)
+	// RevokeBotToken - revoke the token of the bot
+	RevokeBotToken(context.Context, *RevokeBotTokenRequest) (*ActionResponse, error)
+	// AddBotToServer - add a public or own bot to the server
+	AddBotToServer(context.Context, *AddBotToServerRequest) (*ActionResponse, error)
+	// CreateCommand - register a slash command, invocations are sent to the handler url
+	CreateCommand(context.Context, *CreateCommandRequest) (*Command, error)
+	// ListCommands - slash commands of the server
+	ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error)
+	// DeleteCommand - delete the slash command
+	DeleteCommand(context.Context, *DeleteCommandRequest) (*ActionResponse, error)
+	// RespondInteraction - reply of the command handler, authorized by the interaction token
+	RespondInteraction(context.Context, *RespondInteractionRequest) (*ActionResponse, error)
+	// CreateEmoji - upload a custom emoji of the server
+	CreateEmoji(context.Context, *CreateEmojiRequest) (*Emoji, error)
+	// ListServerEmoji - custom emoji of the server
+	ListServerEmoji(context.Context, *ListServerEmojiRequest) (*ListServerEmojiResponse, error)
+	// RenameEmoji - rename the custom emoji
+	RenameEmoji(context.Context, *RenameEmojiRequest) (*Emoji, error)
+	// DeleteEmoji - delete the custom emoji
+	DeleteEmoji(context.Context, *DeleteEmojiRequest) (*ActionResponse, error)
+	// Добавить канал
+	AddChannel(context.Context, *AddChannelRequest) (*ActionResponse, error)
+	// Изменить канал
+	UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error)
+	// Удалить канал
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*ActionResponse, error)
+	// Восстановить удаленный канал
+	RestoreChannel(context.Context, *RestoreChannelRequest) (*ActionResponse, error)
+	// Архивировать канал
+	ArchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error)
+	// Вернуть канал из архива
+	UnarchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error)
+	// Присоединиться к каналу
+	JoinChannel(context.Context, *JoinChannelRequest) (*ActionResponse, error)
+	// Покинуть канал
+	LeaveChannel(context.Context, *LeaveChannelRequest) (*ActionResponse, error)
+	// Список каналов текущего пользователя
+	ListMyChannels(context.Context, *ListMyChannelsRequest) (*ListMyChannelsResponse, error)
+	// Список участников канала
+	ListChannelMembers(context.Context, *ListChannelMembersRequest) (*ListChannelMembersResponse, error)
+	// Проверить участие в канале
+	IsChannelMember(context.Context, *IsChannelMemberRequest) (*IsChannelMemberResponse, error)
+	// Выдать доступ к приватному каналу
+	GrantChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error)
+	// Отозвать доступ к приватному каналу
+	RevokeChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error)
+	// Запросить доступ к приватному каналу
+	RequestChannelAccess(context.Context, *RequestChannelAccessRequest) (*ActionResponse, error)
+	// Список заявок на вступление в канал
+	ListChannelJoinRequests(context.Context, *ListChannelJoinRequestsRequest) (*ListChannelJoinRequestsResponse, error)
+	// Одобрить заявку на вступление в канал
+	ApproveChannelJoinRequest(context.Context, *ChannelAccessRequest) (*ActionResponse, error)
+	// Отправить сообщение в канал
+	SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error)
+	// Сообщения канала
+	GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetChannelMessagesResponse, error)
+	// Создать пост в форуме
+	CreateForumPost(context.Context, *CreateForumPostRequest) (*ForumPost, error)
+	// Посты форума
+	ListForumPosts(context.Context, *ListForumPostsRequest) (*ListForumPostsResponse, error)
+	// Отправить сообщение в пост форума
+	SendForumPostMessage(context.Context, *SendForumPostMessageRequest) (*ActionResponse, error)
+	// Сообщения поста форума
+	GetForumPostMessages(context.Context, *GetForumPostMessagesRequest) (*GetChannelMessagesResponse, error)
+	// Подписать свой канал на канал объявлений
+	FollowChannel(context.Context, *FollowChannelRequest) (*ActionResponse, error)
+	// Отписать свой канал от канала объявлений
+	UnfollowChannel(context.Context, *UnfollowChannelRequest) (*ActionResponse, error)
+	// Опубликовать сообщение канала объявлений у подписчиков
+	PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error)
+	// Список каналов сервера
+	ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error)
+	// Изменить порядок каналов сервера
+	ReorderChannels(context.Context, *ReorderChannelsRequest) (*ListServerChannelsResponse, error)
+	// Отправить сообщение пользователю
+	SendUserPrivateMessage(context.Context, *SendUserPrivateMessageRequest) (*ActionResponse, error)
+	// Создать новый чат с пользователем
+	CreatePrivateChat(context.Context, *CreatePrivateChatRequest) (*CreatePrivateChatResponse, error)
+	// Получить сообщения с пользователем
+	GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error)
+	mustEmbedUnimplementedGatewayServiceServer()
+}
+
+// UnimplementedGatewayServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGatewayServiceServer struct {
+}
+
+func (UnimplementedGatewayServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedGatewayServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedGatewayServiceServer) Refresh(context.Context, *RefreshRequest) (*RefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedGatewayServiceServer) OauthLogin(context.Context, *OauthLoginRequest) (*OauthLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OauthLogin not implemented")
+}
+func (UnimplementedGatewayServiceServer) OauthLoginCallback(context.Context, *OauthLoginCallbackRequest) (*OauthLoginCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OauthLoginCallback not implemented")
+}
+func (UnimplementedGatewayServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetUserByLogin(context.Context, *GetUserByLoginRequest) (*UserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByLogin not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetUserFriends(context.Context, *GetUserFriendsRequest) (*GetUserFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFriends not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetUserInvites(context.Context, *GetUserInvitesRequest) (*GetUserInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserInvites not implemented")
+}
+func (UnimplementedGatewayServiceServer) AddToFriendByUserId(context.Context, *AddToFriendByUserIdRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToFriendByUserId not implemented")
+}
+func (UnimplementedGatewayServiceServer) AcceptFriendInvite(context.Context, *AcceptFriendInviteRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendInvite not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteFromFriend(context.Context, *DeleteFromFriendRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromFriend not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeclineFriendInvite(context.Context, *DeclineFriendInviteRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendInvite not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateServer(context.Context, *CreateServerRequest) (*CreateServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) SearchServer(context.Context, *SearchServerRequest) (*SearchServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetServer(context.Context, *GetServerRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) UpdateServer(context.Context, *UpdateServerRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteServer(context.Context, *DeleteServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) RestoreServer(context.Context, *RestoreServerRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListServerMembers(context.Context, *ListServerMembersRequest) (*ListServerMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerMembers not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetMember(context.Context, *GetMemberRequest) (*ServerMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMember not implemented")
+}
+func (UnimplementedGatewayServiceServer) SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*ServerMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberNickname not implemented")
+}
+func (UnimplementedGatewayServiceServer) SubscribeServer(context.Context, *SubscribeServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeServer not implemented")
 }
 func (UnimplementedGatewayServiceServer) UnsubscribeServer(context.Context, *UnsubscribeServerRequest) (*ActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeServer not implemented")
 }
-func (UnimplementedGatewayServiceServer) SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchServerByUserId not implemented")
+func (UnimplementedGatewayServiceServer) SearchServerByUserId(context.Context, *SearchServerByUserIdRequest) (*SearchServerByUserIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchServerByUserId not implemented")
+}
+func (UnimplementedGatewayServiceServer) InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteUserToServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMessageOnServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesFromServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateModerationRule(context.Context, *CreateModerationRuleRequest) (*ModerationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateModerationRule not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteModerationRule(context.Context, *DeleteModerationRuleRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteModerationRule not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationRules not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetModerationActions(context.Context, *GetModerationActionsRequest) (*GetModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationActions not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateServerTemplate(context.Context, *CreateServerTemplateRequest) (*ServerTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerTemplate not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateServerFromTemplate(context.Context, *CreateServerFromTemplateRequest) (*ServerProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerFromTemplate not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedGatewayServiceServer) RegenerateWebhookToken(context.Context, *RegenerateWebhookTokenRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateWebhookToken not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedGatewayServiceServer) ExecuteWebhook(context.Context, *ExecuteWebhookRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteWebhook not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateOutgoingWebhook(context.Context, *CreateOutgoingWebhookRequest) (*OutgoingWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOutgoingWebhook not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListOutgoingWebhooks(context.Context, *ListOutgoingWebhooksRequest) (*ListOutgoingWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingWebhooks not implemented")
+}
+func (UnimplementedGatewayServiceServer) EnableOutgoingWebhook(context.Context, *EnableOutgoingWebhookRequest) (*OutgoingWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableOutgoingWebhook not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteOutgoingWebhook(context.Context, *DeleteOutgoingWebhookRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOutgoingWebhook not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListOutgoingDeliveries(context.Context, *ListOutgoingDeliveriesRequest) (*ListOutgoingDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingDeliveries not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateBot(context.Context, *CreateBotRequest) (*CreateBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListBots(context.Context, *ListBotsRequest) (*ListBotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBots not implemented")
+}
+func (UnimplementedGatewayServiceServer) ResetBotToken(context.Context, *ResetBotTokenRequest) (*ResetBotTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetBotToken not implemented")
+}
+func (UnimplementedGatewayServiceServer) RevokeBotToken(context.Context, *RevokeBotTokenRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBotToken not implemented")
+}
+func (UnimplementedGatewayServiceServer) AddBotToServer(context.Context, *AddBotToServerRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBotToServer not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateCommand(context.Context, *CreateCommandRequest) (*Command, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommand not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteCommand(context.Context, *DeleteCommandRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommand not implemented")
+}
+func (UnimplementedGatewayServiceServer) RespondInteraction(context.Context, *RespondInteractionRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondInteraction not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateEmoji(context.Context, *CreateEmojiRequest) (*Emoji, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmoji not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListServerEmoji(context.Context, *ListServerEmojiRequest) (*ListServerEmojiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerEmoji not implemented")
+}
+func (UnimplementedGatewayServiceServer) RenameEmoji(context.Context, *RenameEmojiRequest) (*Emoji, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameEmoji not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteEmoji(context.Context, *DeleteEmojiRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmoji not implemented")
+}
+func (UnimplementedGatewayServiceServer) AddChannel(context.Context, *AddChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) RestoreChannel(context.Context, *RestoreChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) ArchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) UnarchiveChannel(context.Context, *ArchiveChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) JoinChannel(context.Context, *JoinChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) LeaveChannel(context.Context, *LeaveChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListMyChannels(context.Context, *ListMyChannelsRequest) (*ListMyChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyChannels not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListChannelMembers(context.Context, *ListChannelMembersRequest) (*ListChannelMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelMembers not implemented")
+}
+func (UnimplementedGatewayServiceServer) IsChannelMember(context.Context, *IsChannelMemberRequest) (*IsChannelMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsChannelMember not implemented")
+}
+func (UnimplementedGatewayServiceServer) GrantChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantChannelAccess not implemented")
+}
+func (UnimplementedGatewayServiceServer) RevokeChannelAccess(context.Context, *ChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeChannelAccess not implemented")
+}
+func (UnimplementedGatewayServiceServer) RequestChannelAccess(context.Context, *RequestChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestChannelAccess not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListChannelJoinRequests(context.Context, *ListChannelJoinRequestsRequest) (*ListChannelJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelJoinRequests not implemented")
+}
+func (UnimplementedGatewayServiceServer) ApproveChannelJoinRequest(context.Context, *ChannelAccessRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChannelJoinRequest not implemented")
+}
+func (UnimplementedGatewayServiceServer) SendChannelMessage(context.Context, *SendChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChannelMessage not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetChannelMessages(context.Context, *GetChannelMessagesRequest) (*GetChannelMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMessages not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreateForumPost(context.Context, *CreateForumPostRequest) (*ForumPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForumPost not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListForumPosts(context.Context, *ListForumPostsRequest) (*ListForumPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForumPosts not implemented")
+}
+func (UnimplementedGatewayServiceServer) SendForumPostMessage(context.Context, *SendForumPostMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendForumPostMessage not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetForumPostMessages(context.Context, *GetForumPostMessagesRequest) (*GetChannelMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForumPostMessages not implemented")
+}
+func (UnimplementedGatewayServiceServer) FollowChannel(context.Context, *FollowChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) UnfollowChannel(context.Context, *UnfollowChannelRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowChannel not implemented")
+}
+func (UnimplementedGatewayServiceServer) PublishChannelMessage(context.Context, *PublishChannelMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishChannelMessage not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerChannels not implemented")
+}
+func (UnimplementedGatewayServiceServer) ReorderChannels(context.Context, *ReorderChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChannels not implemented")
+}
+func (UnimplementedGatewayServiceServer) SendUserPrivateMessage(context.Context, *SendUserPrivateMessageRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendUserPrivateMessage not implemented")
+}
+func (UnimplementedGatewayServiceServer) CreatePrivateChat(context.Context, *CreatePrivateChatRequest) (*CreatePrivateChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivateChat not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPrivateMessages not implemented")
+}
+func (UnimplementedGatewayServiceServer) mustEmbedUnimplementedGatewayServiceServer() {}
+
+// UnsafeGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GatewayServiceServer will
+// result in compilation errors.
+type UnsafeGatewayServiceServer interface {
+	mustEmbedUnimplementedGatewayServiceServer()
+}
+
+func RegisterGatewayServiceServer(s grpc.ServiceRegistrar, srv GatewayServiceServer) {
+	s.RegisterService(&GatewayService_ServiceDesc, srv)
+}
+
+func _GatewayService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).Refresh(ctx, req.(*RefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_OauthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).OauthLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_OauthLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).OauthLogin(ctx, req.(*OauthLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_OauthLoginCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthLoginCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).OauthLoginCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_OauthLoginCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).OauthLoginCallback(ctx, req.(*OauthLoginCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetUserByLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetUserByLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetUserByLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetUserByLogin(ctx, req.(*GetUserByLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetUserFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetUserFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetUserFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetUserFriends(ctx, req.(*GetUserFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetUserInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetUserInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetUserInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetUserInvites(ctx, req.(*GetUserInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_AddToFriendByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToFriendByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).AddToFriendByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_AddToFriendByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).AddToFriendByUserId(ctx, req.(*AddToFriendByUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_AcceptFriendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).AcceptFriendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_AcceptFriendInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).AcceptFriendInvite(ctx, req.(*AcceptFriendInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteFromFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFromFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteFromFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteFromFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteFromFriend(ctx, req.(*DeleteFromFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeclineFriendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineFriendInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeclineFriendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeclineFriendInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeclineFriendInvite(ctx, req.(*DeclineFriendInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_CreateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateServer(ctx, req.(*CreateServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_SearchServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).SearchServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_SearchServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).SearchServer(ctx, req.(*SearchServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetServer(ctx, req.(*GetServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_UpdateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).UpdateServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_UpdateServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).UpdateServer(ctx, req.(*UpdateServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteServer(ctx, req.(*DeleteServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_RestoreServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RestoreServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_RestoreServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RestoreServer(ctx, req.(*RestoreServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListServerMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListServerMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListServerMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListServerMembers(ctx, req.(*ListServerMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_SetMemberNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberNicknameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).SetMemberNickname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_SetMemberNickname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).SetMemberNickname(ctx, req.(*SetMemberNicknameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_SubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).SubscribeServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_SubscribeServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).SubscribeServer(ctx, req.(*SubscribeServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_UnsubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).UnsubscribeServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_UnsubscribeServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).UnsubscribeServer(ctx, req.(*UnsubscribeServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_SearchServerByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchServerByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).SearchServerByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_SearchServerByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).SearchServerByUserId(ctx, req.(*SearchServerByUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_InviteUserToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserToServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).InviteUserToServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_InviteUserToServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).InviteUserToServer(ctx, req.(*InviteUserToServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_PublishMessageOnServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMessageOnServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).PublishMessageOnServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_PublishMessageOnServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).PublishMessageOnServer(ctx, req.(*PublishMessageOnServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetMessagesFromServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesFromServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetMessagesFromServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetMessagesFromServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetMessagesFromServer(ctx, req.(*GetMessagesFromServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_CreateModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateModerationRule(ctx, req.(*CreateModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteModerationRule(ctx, req.(*DeleteModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetModerationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetModerationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetModerationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetModerationRules(ctx, req.(*GetModerationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetModerationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetModerationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetModerationActions(ctx, req.(*GetModerationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_CreateServerTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateServerTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateServerTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateServerTemplate(ctx, req.(*CreateServerTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_CreateServerFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateServerFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateServerFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateServerFromTemplate(ctx, req.(*CreateServerFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_RegenerateWebhookToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateWebhookTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RegenerateWebhookToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_RegenerateWebhookToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RegenerateWebhookToken(ctx, req.(*RegenerateWebhookTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ExecuteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ExecuteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ExecuteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ExecuteWebhook(ctx, req.(*ExecuteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_CreateOutgoingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOutgoingWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateOutgoingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateOutgoingWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateOutgoingWebhook(ctx, req.(*CreateOutgoingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListOutgoingWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListOutgoingWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListOutgoingWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListOutgoingWebhooks(ctx, req.(*ListOutgoingWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_EnableOutgoingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableOutgoingWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).EnableOutgoingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_EnableOutgoingWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).EnableOutgoingWebhook(ctx, req.(*EnableOutgoingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteOutgoingWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOutgoingWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteOutgoingWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteOutgoingWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteOutgoingWebhook(ctx, req.(*DeleteOutgoingWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) InviteUserToServer(context.Context, *InviteUserToServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InviteUserToServer not implemented")
+
+func _GatewayService_ListOutgoingDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListOutgoingDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListOutgoingDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListOutgoingDeliveries(ctx, req.(*ListOutgoingDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) PublishMessageOnServer(context.Context, *PublishMessageOnServerRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishMessageOnServer not implemented")
+
+func _GatewayService_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateBot(ctx, req.(*CreateBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) GetMessagesFromServer(context.Context, *GetMessagesFromServerRequest) (*GetMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesFromServer not implemented")
+
+func _GatewayService_ListBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListBots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListBots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListBots(ctx, req.(*ListBotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) AddChannel(context.Context, *AddChannelRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddChannel not implemented")
+
+func _GatewayService_ResetBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetBotTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ResetBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ResetBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ResetBotToken(ctx, req.(*ResetBotTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+
+func _GatewayService_RevokeBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBotTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RevokeBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_RevokeBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RevokeBotToken(ctx, req.(*RevokeBotTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) JoinChannel(context.Context, *JoinChannelRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
+
+func _GatewayService_AddBotToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotToServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).AddBotToServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_AddBotToServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).AddBotToServer(ctx, req.(*AddBotToServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) LeaveChannel(context.Context, *LeaveChannelRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveChannel not implemented")
+
+func _GatewayService_CreateCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateCommand(ctx, req.(*CreateCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) SendUserPrivateMessage(context.Context, *SendUserPrivateMessageRequest) (*ActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendUserPrivateMessage not implemented")
+
+func _GatewayService_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListCommands(ctx, req.(*ListCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) CreatePrivateChat(context.Context, *CreatePrivateChatRequest) (*CreatePrivateChatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivateChat not implemented")
+
+func _GatewayService_DeleteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteCommand(ctx, req.(*DeleteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) GetUserPrivateMessages(context.Context, *GetUserPrivateMessagesRequest) (*GetMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPrivateMessages not implemented")
+
+func _GatewayService_RespondInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondInteractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RespondInteraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_RespondInteraction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RespondInteraction(ctx, req.(*RespondInteractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGatewayServiceServer) mustEmbedUnimplementedGatewayServiceServer() {}
 
-// UnsafeGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GatewayServiceServer will
-// result in compilation errors.
-type UnsafeGatewayServiceServer interface {
-	mustEmbedUnimplementedGatewayServiceServer()
+func _GatewayService_CreateEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmojiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CreateEmoji(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CreateEmoji_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CreateEmoji(ctx, req.(*CreateEmojiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGatewayServiceServer(s grpc.ServiceRegistrar, srv GatewayServiceServer) {
-	s.RegisterService(&GatewayService_ServiceDesc, srv)
+func _GatewayService_ListServerEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerEmojiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListServerEmoji(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListServerEmoji_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListServerEmoji(ctx, req.(*ListServerEmojiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _GatewayService_RenameEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameEmojiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).Register(ctx, in)
+		return srv.(GatewayServiceServer).RenameEmoji(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_Register_FullMethodName,
+		FullMethod: GatewayService_RenameEmoji_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RenameEmoji(ctx, req.(*RenameEmojiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmojiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteEmoji(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteEmoji_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).DeleteEmoji(ctx, req.(*DeleteEmojiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_AddChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).AddChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_AddChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).AddChannel(ctx, req.(*AddChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).UpdateChannel(ctx, req.(*UpdateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_DeleteChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(GatewayServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _GatewayService_RestoreChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).Login(ctx, in)
+		return srv.(GatewayServiceServer).RestoreChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_Login_FullMethodName,
+		FullMethod: GatewayService_RestoreChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(GatewayServiceServer).RestoreChannel(ctx, req.(*RestoreChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshRequest)
+func _GatewayService_ArchiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).Refresh(ctx, in)
+		return srv.(GatewayServiceServer).ArchiveChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_Refresh_FullMethodName,
+		FullMethod: GatewayService_ArchiveChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).Refresh(ctx, req.(*RefreshRequest))
+		return srv.(GatewayServiceServer).ArchiveChannel(ctx, req.(*ArchiveChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_OauthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthLoginRequest)
+func _GatewayService_UnarchiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).OauthLogin(ctx, in)
+		return srv.(GatewayServiceServer).UnarchiveChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_OauthLogin_FullMethodName,
+		FullMethod: GatewayService_UnarchiveChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).OauthLogin(ctx, req.(*OauthLoginRequest))
+		return srv.(GatewayServiceServer).UnarchiveChannel(ctx, req.(*ArchiveChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_OauthLoginCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthLoginCallbackRequest)
+func _GatewayService_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).OauthLoginCallback(ctx, in)
+		return srv.(GatewayServiceServer).JoinChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_OauthLoginCallback_FullMethodName,
+		FullMethod: GatewayService_JoinChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).OauthLoginCallback(ctx, req.(*OauthLoginCallbackRequest))
+		return srv.(GatewayServiceServer).JoinChannel(ctx, req.(*JoinChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _GatewayService_LeaveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).UpdateUser(ctx, in)
+		return srv.(GatewayServiceServer).LeaveChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_UpdateUser_FullMethodName,
+		FullMethod: GatewayService_LeaveChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(GatewayServiceServer).LeaveChannel(ctx, req.(*LeaveChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_GetUserByLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByLoginRequest)
+func _GatewayService_ListMyChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).GetUserByLogin(ctx, in)
+		return srv.(GatewayServiceServer).ListMyChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_GetUserByLogin_FullMethodName,
+		FullMethod: GatewayService_ListMyChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).GetUserByLogin(ctx, req.(*GetUserByLoginRequest))
+		return srv.(GatewayServiceServer).ListMyChannels(ctx, req.(*ListMyChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_GetUserFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFriendsRequest)
+func _GatewayService_ListChannelMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).GetUserFriends(ctx, in)
+		return srv.(GatewayServiceServer).ListChannelMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_GetUserFriends_FullMethodName,
+		FullMethod: GatewayService_ListChannelMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).GetUserFriends(ctx, req.(*GetUserFriendsRequest))
+		return srv.(GatewayServiceServer).ListChannelMembers(ctx, req.(*ListChannelMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_GetUserInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserInvitesRequest)
+func _GatewayService_IsChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsChannelMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).GetUserInvites(ctx, in)
+		return srv.(GatewayServiceServer).IsChannelMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_GetUserInvites_FullMethodName,
+		FullMethod: GatewayService_IsChannelMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).GetUserInvites(ctx, req.(*GetUserInvitesRequest))
+		return srv.(GatewayServiceServer).IsChannelMember(ctx, req.(*IsChannelMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_AddToFriendByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToFriendByUserIdRequest)
+func _GatewayService_GrantChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).AddToFriendByUserId(ctx, in)
+		return srv.(GatewayServiceServer).GrantChannelAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_AddToFriendByUserId_FullMethodName,
+		FullMethod: GatewayService_GrantChannelAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).AddToFriendByUserId(ctx, req.(*AddToFriendByUserIdRequest))
+		return srv.(GatewayServiceServer).GrantChannelAccess(ctx, req.(*ChannelAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_AcceptFriendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcceptFriendInviteRequest)
+func _GatewayService_RevokeChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).AcceptFriendInvite(ctx, in)
+		return srv.(GatewayServiceServer).RevokeChannelAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_AcceptFriendInvite_FullMethodName,
+		FullMethod: GatewayService_RevokeChannelAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).AcceptFriendInvite(ctx, req.(*AcceptFriendInviteRequest))
+		return srv.(GatewayServiceServer).RevokeChannelAccess(ctx, req.(*ChannelAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_DeleteFromFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFromFriendRequest)
+func _GatewayService_RequestChannelAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestChannelAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).DeleteFromFriend(ctx, in)
+		return srv.(GatewayServiceServer).RequestChannelAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_DeleteFromFriend_FullMethodName,
+		FullMethod: GatewayService_RequestChannelAccess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).DeleteFromFriend(ctx, req.(*DeleteFromFriendRequest))
+		return srv.(GatewayServiceServer).RequestChannelAccess(ctx, req.(*RequestChannelAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_DeclineFriendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeclineFriendInviteRequest)
+func _GatewayService_ListChannelJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelJoinRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).DeclineFriendInvite(ctx, in)
+		return srv.(GatewayServiceServer).ListChannelJoinRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_DeclineFriendInvite_FullMethodName,
+		FullMethod: GatewayService_ListChannelJoinRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).DeclineFriendInvite(ctx, req.(*DeclineFriendInviteRequest))
+		return srv.(GatewayServiceServer).ListChannelJoinRequests(ctx, req.(*ListChannelJoinRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_CreateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateServerRequest)
+func _GatewayService_ApproveChannelJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).CreateServer(ctx, in)
+		return srv.(GatewayServiceServer).ApproveChannelJoinRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_CreateServer_FullMethodName,
+		FullMethod: GatewayService_ApproveChannelJoinRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).CreateServer(ctx, req.(*CreateServerRequest))
+		return srv.(GatewayServiceServer).ApproveChannelJoinRequest(ctx, req.(*ChannelAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_SearchServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchServerRequest)
+func _GatewayService_SendChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChannelMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).SearchServer(ctx, in)
+		return srv.(GatewayServiceServer).SendChannelMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_SearchServer_FullMethodName,
+		FullMethod: GatewayService_SendChannelMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).SearchServer(ctx, req.(*SearchServerRequest))
+		return srv.(GatewayServiceServer).SendChannelMessage(ctx, req.(*SendChannelMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_SubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubscribeServerRequest)
+func _GatewayService_GetChannelMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).SubscribeServer(ctx, in)
+		return srv.(GatewayServiceServer).GetChannelMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_SubscribeServer_FullMethodName,
+		FullMethod: GatewayService_GetChannelMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).SubscribeServer(ctx, req.(*SubscribeServerRequest))
+		return srv.(GatewayServiceServer).GetChannelMessages(ctx, req.(*GetChannelMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_UnsubscribeServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsubscribeServerRequest)
+func _GatewayService_CreateForumPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateForumPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).UnsubscribeServer(ctx, in)
+		return srv.(GatewayServiceServer).CreateForumPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_UnsubscribeServer_FullMethodName,
+		FullMethod: GatewayService_CreateForumPost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).UnsubscribeServer(ctx, req.(*UnsubscribeServerRequest))
+		return srv.(GatewayServiceServer).CreateForumPost(ctx, req.(*CreateForumPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_SearchServerByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchServerByUserIdRequest)
+func _GatewayService_ListForumPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForumPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).SearchServerByUserId(ctx, in)
+		return srv.(GatewayServiceServer).ListForumPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_SearchServerByUserId_FullMethodName,
+		FullMethod: GatewayService_ListForumPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).SearchServerByUserId(ctx, req.(*SearchServerByUserIdRequest))
+		return srv.(GatewayServiceServer).ListForumPosts(ctx, req.(*ListForumPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_InviteUserToServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InviteUserToServerRequest)
+func _GatewayService_SendForumPostMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendForumPostMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).InviteUserToServer(ctx, in)
+		return srv.(GatewayServiceServer).SendForumPostMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_InviteUserToServer_FullMethodName,
+		FullMethod: GatewayService_SendForumPostMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).InviteUserToServer(ctx, req.(*InviteUserToServerRequest))
+		return srv.(GatewayServiceServer).SendForumPostMessage(ctx, req.(*SendForumPostMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_PublishMessageOnServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishMessageOnServerRequest)
+func _GatewayService_GetForumPostMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForumPostMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).PublishMessageOnServer(ctx, in)
+		return srv.(GatewayServiceServer).GetForumPostMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_PublishMessageOnServer_FullMethodName,
+		FullMethod: GatewayService_GetForumPostMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).PublishMessageOnServer(ctx, req.(*PublishMessageOnServerRequest))
+		return srv.(GatewayServiceServer).GetForumPostMessages(ctx, req.(*GetForumPostMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_GetMessagesFromServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessagesFromServerRequest)
+func _GatewayService_FollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).GetMessagesFromServer(ctx, in)
+		return srv.(GatewayServiceServer).FollowChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_GetMessagesFromServer_FullMethodName,
+		FullMethod: GatewayService_FollowChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).GetMessagesFromServer(ctx, req.(*GetMessagesFromServerRequest))
+		return srv.(GatewayServiceServer).FollowChannel(ctx, req.(*FollowChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_AddChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddChannelRequest)
+func _GatewayService_UnfollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowChannelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).AddChannel(ctx, in)
+		return srv.(GatewayServiceServer).UnfollowChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_AddChannel_FullMethodName,
+		FullMethod: GatewayService_UnfollowChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).AddChannel(ctx, req.(*AddChannelRequest))
+		return srv.(GatewayServiceServer).UnfollowChannel(ctx, req.(*UnfollowChannelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteChannelRequest)
+func _GatewayService_PublishChannelMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishChannelMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).DeleteChannel(ctx, in)
+		return srv.(GatewayServiceServer).PublishChannelMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_DeleteChannel_FullMethodName,
+		FullMethod: GatewayService_PublishChannelMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+		return srv.(GatewayServiceServer).PublishChannelMessage(ctx, req.(*PublishChannelMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinChannelRequest)
+func _GatewayService_ListServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).JoinChannel(ctx, in)
+		return srv.(GatewayServiceServer).ListServerChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_JoinChannel_FullMethodName,
+		FullMethod: GatewayService_ListServerChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).JoinChannel(ctx, req.(*JoinChannelRequest))
+		return srv.(GatewayServiceServer).ListServerChannels(ctx, req.(*ListServerChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GatewayService_LeaveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveChannelRequest)
+func _GatewayService_ReorderChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChannelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServiceServer).LeaveChannel(ctx, in)
+		return srv.(GatewayServiceServer).ReorderChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GatewayService_LeaveChannel_FullMethodName,
+		FullMethod: GatewayService_ReorderChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServiceServer).LeaveChannel(ctx, req.(*LeaveChannelRequest))
+		return srv.(GatewayServiceServer).ReorderChannels(ctx, req.(*ReorderChannelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1106,6 +3276,38 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchServer",
 			Handler:    _GatewayService_SearchServer_Handler,
 		},
+		{
+			MethodName: "GetServer",
+			Handler:    _GatewayService_GetServer_Handler,
+		},
+		{
+			MethodName: "UpdateServer",
+			Handler:    _GatewayService_UpdateServer_Handler,
+		},
+		{
+			MethodName: "DeleteServer",
+			Handler:    _GatewayService_DeleteServer_Handler,
+		},
+		{
+			MethodName: "RestoreServer",
+			Handler:    _GatewayService_RestoreServer_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _GatewayService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "ListServerMembers",
+			Handler:    _GatewayService_ListServerMembers_Handler,
+		},
+		{
+			MethodName: "GetMember",
+			Handler:    _GatewayService_GetMember_Handler,
+		},
+		{
+			MethodName: "SetMemberNickname",
+			Handler:    _GatewayService_SetMemberNickname_Handler,
+		},
 		{
 			MethodName: "SubscribeServer",
 			Handler:    _GatewayService_SubscribeServer_Handler,
@@ -1130,14 +3332,154 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessagesFromServer",
 			Handler:    _GatewayService_GetMessagesFromServer_Handler,
 		},
+		{
+			MethodName: "CreateModerationRule",
+			Handler:    _GatewayService_CreateModerationRule_Handler,
+		},
+		{
+			MethodName: "DeleteModerationRule",
+			Handler:    _GatewayService_DeleteModerationRule_Handler,
+		},
+		{
+			MethodName: "GetModerationRules",
+			Handler:    _GatewayService_GetModerationRules_Handler,
+		},
+		{
+			MethodName: "GetModerationActions",
+			Handler:    _GatewayService_GetModerationActions_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _GatewayService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateServerTemplate",
+			Handler:    _GatewayService_CreateServerTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _GatewayService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateServerFromTemplate",
+			Handler:    _GatewayService_CreateServerFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _GatewayService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _GatewayService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "RegenerateWebhookToken",
+			Handler:    _GatewayService_RegenerateWebhookToken_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _GatewayService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ExecuteWebhook",
+			Handler:    _GatewayService_ExecuteWebhook_Handler,
+		},
+		{
+			MethodName: "CreateOutgoingWebhook",
+			Handler:    _GatewayService_CreateOutgoingWebhook_Handler,
+		},
+		{
+			MethodName: "ListOutgoingWebhooks",
+			Handler:    _GatewayService_ListOutgoingWebhooks_Handler,
+		},
+		{
+			MethodName: "EnableOutgoingWebhook",
+			Handler:    _GatewayService_EnableOutgoingWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteOutgoingWebhook",
+			Handler:    _GatewayService_DeleteOutgoingWebhook_Handler,
+		},
+		{
+			MethodName: "ListOutgoingDeliveries",
+			Handler:    _GatewayService_ListOutgoingDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _GatewayService_CreateBot_Handler,
+		},
+		{
+			MethodName: "ListBots",
+			Handler:    _GatewayService_ListBots_Handler,
+		},
+		{
+			MethodName: "ResetBotToken",
+			Handler:    _GatewayService_ResetBotToken_Handler,
+		},
+		{
+			MethodName: "RevokeBotToken",
+			Handler:    _GatewayService_RevokeBotToken_Handler,
+		},
+		{
+			MethodName: "AddBotToServer",
+			Handler:    _GatewayService_AddBotToServer_Handler,
+		},
+		{
+			MethodName: "CreateCommand",
+			Handler:    _GatewayService_CreateCommand_Handler,
+		},
+		{
+			MethodName: "ListCommands",
+			Handler:    _GatewayService_ListCommands_Handler,
+		},
+		{
+			MethodName: "DeleteCommand",
+			Handler:    _GatewayService_DeleteCommand_Handler,
+		},
+		{
+			MethodName: "RespondInteraction",
+			Handler:    _GatewayService_RespondInteraction_Handler,
+		},
+		{
+			MethodName: "CreateEmoji",
+			Handler:    _GatewayService_CreateEmoji_Handler,
+		},
+		{
+			MethodName: "ListServerEmoji",
+			Handler:    _GatewayService_ListServerEmoji_Handler,
+		},
+		{
+			MethodName: "RenameEmoji",
+			Handler:    _GatewayService_RenameEmoji_Handler,
+		},
+		{
+			MethodName: "DeleteEmoji",
+			Handler:    _GatewayService_DeleteEmoji_Handler,
+		},
 		{
 			MethodName: "AddChannel",
 			Handler:    _GatewayService_AddChannel_Handler,
 		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _GatewayService_UpdateChannel_Handler,
+		},
 		{
 			MethodName: "DeleteChannel",
 			Handler:    _GatewayService_DeleteChannel_Handler,
 		},
+		{
+			MethodName: "RestoreChannel",
+			Handler:    _GatewayService_RestoreChannel_Handler,
+		},
+		{
+			MethodName: "ArchiveChannel",
+			Handler:    _GatewayService_ArchiveChannel_Handler,
+		},
+		{
+			MethodName: "UnarchiveChannel",
+			Handler:    _GatewayService_UnarchiveChannel_Handler,
+		},
 		{
 			MethodName: "JoinChannel",
 			Handler:    _GatewayService_JoinChannel_Handler,
@@ -1146,6 +3488,82 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveChannel",
 			Handler:    _GatewayService_LeaveChannel_Handler,
 		},
+		{
+			MethodName: "ListMyChannels",
+			Handler:    _GatewayService_ListMyChannels_Handler,
+		},
+		{
+			MethodName: "ListChannelMembers",
+			Handler:    _GatewayService_ListChannelMembers_Handler,
+		},
+		{
+			MethodName: "IsChannelMember",
+			Handler:    _GatewayService_IsChannelMember_Handler,
+		},
+		{
+			MethodName: "GrantChannelAccess",
+			Handler:    _GatewayService_GrantChannelAccess_Handler,
+		},
+		{
+			MethodName: "RevokeChannelAccess",
+			Handler:    _GatewayService_RevokeChannelAccess_Handler,
+		},
+		{
+			MethodName: "RequestChannelAccess",
+			Handler:    _GatewayService_RequestChannelAccess_Handler,
+		},
+		{
+			MethodName: "ListChannelJoinRequests",
+			Handler:    _GatewayService_ListChannelJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveChannelJoinRequest",
+			Handler:    _GatewayService_ApproveChannelJoinRequest_Handler,
+		},
+		{
+			MethodName: "SendChannelMessage",
+			Handler:    _GatewayService_SendChannelMessage_Handler,
+		},
+		{
+			MethodName: "GetChannelMessages",
+			Handler:    _GatewayService_GetChannelMessages_Handler,
+		},
+		{
+			MethodName: "CreateForumPost",
+			Handler:    _GatewayService_CreateForumPost_Handler,
+		},
+		{
+			MethodName: "ListForumPosts",
+			Handler:    _GatewayService_ListForumPosts_Handler,
+		},
+		{
+			MethodName: "SendForumPostMessage",
+			Handler:    _GatewayService_SendForumPostMessage_Handler,
+		},
+		{
+			MethodName: "GetForumPostMessages",
+			Handler:    _GatewayService_GetForumPostMessages_Handler,
+		},
+		{
+			MethodName: "FollowChannel",
+			Handler:    _GatewayService_FollowChannel_Handler,
+		},
+		{
+			MethodName: "UnfollowChannel",
+			Handler:    _GatewayService_UnfollowChannel_Handler,
+		},
+		{
+			MethodName: "PublishChannelMessage",
+			Handler:    _GatewayService_PublishChannelMessage_Handler,
+		},
+		{
+			MethodName: "ListServerChannels",
+			Handler:    _GatewayService_ListServerChannels_Handler,
+		},
+		{
+			MethodName: "ReorderChannels",
+			Handler:    _GatewayService_ReorderChannels_Handler,
+		},
 		{
 			MethodName: "SendUserPrivateMessage",
 			Handler:    _GatewayService_SendUserPrivateMessage_Handler,
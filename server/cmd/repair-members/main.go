@@ -0,0 +1,66 @@
+// Command repair-members removes duplicated server subscriptions, subscribes owners
+// and recomputes member counts straight in Mongo, then builds the unique membership index.
+//
+//	repair-members
+package main
+
+import (
+	"context"
+	config "github.com/Nixonxp/discord/server/configs"
+	server_repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	subscribe_repository "github.com/Nixonxp/discord/server/internal/app/repository/subscribe_storage"
+	server_usc "github.com/Nixonxp/discord/server/internal/app/usecases/server"
+	logger "github.com/Nixonxp/discord/server/pkg/logger"
+	mongoCollection "github.com/Nixonxp/discord/server/pkg/mongo"
+	"log"
+)
+
+func main() {
+	if err := run(context.Background(), config.GetConfig()); err != nil {
+		log.Fatalf("repair members error: %v", err)
+	}
+}
+
+func run(ctx context.Context, cfg *config.Config) error {
+	serverCollection, err := mongoCollection.NewCollection(ctx,
+		cfg.Application.ServiceCollection,
+		&mongoCollection.Config{
+			MongoHost:     cfg.Application.MongoHost,
+			MongoDb:       cfg.Application.MongoDb,
+			MongoPort:     cfg.Application.MongoPort,
+			MongoUser:     cfg.Application.MongoUser,
+			MongoPassword: cfg.Application.MongoPassword,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer serverCollection.DisconnectClient()
+
+	subscribeCollection, err := serverCollection.NewCollection(cfg.Application.ServerSubscribeCollection)
+	if err != nil {
+		return err
+	}
+
+	l, err := logger.NewLogger(logger.NewDefaultConfig())
+	if err != nil {
+		return err
+	}
+
+	subscribeRepo := subscribe_repository.NewMongoSubscribeRepository(subscribeCollection, l)
+	serverUsecase := server_usc.NewServerUsecase(server_usc.Deps{
+		ServerRepo:    server_repository.NewMongoServerRepository(serverCollection, l),
+		SubscribeRepo: subscribeRepo,
+		Log:           l,
+	})
+
+	result, err := serverUsecase.RepairMembers(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("duplicates removed: %d, owners subscribed: %d, counts updated: %d",
+		result.DuplicatesRemoved, result.OwnersSubscribed, result.CountsUpdated)
+
+	return subscribeRepo.EnsureIndexes(ctx)
+}
@@ -1,14 +1,32 @@
 package config
 
+import "time"
+
 type ApplicationConfig struct {
-	Port                      string `envconfig:"APP_PORT" default:":8480"`
-	MetricsPort               string `envconfig:"METRICS_PORT" default:":8482"`
-	ChatServiceHost           string `envconfig:"METRICS_PORT" default:":8682"`
-	MongoHost                 string `envconfig:"MONGO_HOST" default:"localhost"`
-	MongoDb                   string `envconfig:"MONGO_DB" default:"discord"`
-	MongoPort                 string `envconfig:"MONGO_PORT" default:"27117"`
-	MongoUser                 string `envconfig:"MONGO_USER" default:"discord"`
-	MongoPassword             string `envconfig:"MONGO_PASSWORD" default:"example"`
-	ServiceCollection         string `envconfig:"MONGO_SERVICE_COLLECTION" default:"servers"`
-	ServerSubscribeCollection string `envconfig:"MONGO_SERVICE_COLLECTION" default:"server_subscribe"`
+	Port                       string        `envconfig:"APP_PORT" default:":8480"`
+	MetricsPort                string        `envconfig:"METRICS_PORT" default:":8482"`
+	ChatServiceHost            string        `envconfig:"METRICS_PORT" default:":8682"`
+	ChannelServiceHost         string        `envconfig:"CHANNEL_SERVICE_HOST" default:":8580"`
+	UserServiceHost            string        `envconfig:"USER_SERVICE_HOST" default:":8380"`
+	MongoHost                  string        `envconfig:"MONGO_HOST" default:"localhost"`
+	MongoDb                    string        `envconfig:"MONGO_DB" default:"discord"`
+	MongoPort                  string        `envconfig:"MONGO_PORT" default:"27117"`
+	MongoUser                  string        `envconfig:"MONGO_USER" default:"discord"`
+	MongoPassword              string        `envconfig:"MONGO_PASSWORD" default:"example"`
+	ServiceCollection          string        `envconfig:"MONGO_SERVICE_COLLECTION" default:"servers"`
+	ServerSubscribeCollection  string        `envconfig:"MONGO_SERVICE_COLLECTION" default:"server_subscribe"`
+	ServerAuditCollection      string        `envconfig:"MONGO_SERVER_AUDIT_COLLECTION" default:"server_audit_log"`
+	ServerTemplateCollection   string        `envconfig:"MONGO_SERVER_TEMPLATE_COLLECTION" default:"server_templates"`
+	ServerWebhookCollection    string        `envconfig:"MONGO_SERVER_WEBHOOK_COLLECTION" default:"server_webhooks"`
+	OutgoingWebhookCollection  string        `envconfig:"MONGO_OUTGOING_WEBHOOK_COLLECTION" default:"server_outgoing_webhooks"`
+	OutgoingDeliveryCollection string        `envconfig:"MONGO_OUTGOING_DELIVERY_COLLECTION" default:"server_outgoing_deliveries"`
+	CommandCollection          string        `envconfig:"MONGO_COMMAND_COLLECTION" default:"server_commands"`
+	InteractionCollection      string        `envconfig:"MONGO_INTERACTION_COLLECTION" default:"server_interactions"`
+	EmojiCollection            string        `envconfig:"MONGO_EMOJI_COLLECTION" default:"server_emoji"`
+	BlobStoreDir               string        `envconfig:"BLOB_STORE_DIR" default:"./data/blobs"`
+	KafkaAddress               string        `envconfig:"KAFKA_ADDRESS" default:"localhost:9092"`
+	KafkaServerEventsTopic     string        `envconfig:"KAFKA_SERVER_EVENTS_TOPIC" default:"server_events"`
+	OutgoingDeliveryInterval   time.Duration `envconfig:"OUTGOING_DELIVERY_INTERVAL" default:"5s"`
+	OutgoingRequestTimeout     time.Duration `envconfig:"OUTGOING_REQUEST_TIMEOUT" default:"10s"`
+	ServerPurgeInterval        time.Duration `envconfig:"SERVER_PURGE_INTERVAL" default:"10m"`
 }
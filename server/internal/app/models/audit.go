@@ -0,0 +1,131 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
+
+type AuditEntryID uuid.UUID
+
+func (v AuditEntryID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const (
+	AuditActionServerUpdate            = "server.update"
+	AuditActionServerDelete            = "server.delete"
+	AuditActionServerRestore           = "server.restore"
+	AuditActionServerTransferOwnership = "server.transfer_ownership"
+	AuditActionServerTemplateCreate    = "server.template_create"
+	AuditActionMemberInvite            = "member.invite"
+	AuditActionMemberSubscribe         = "member.subscribe"
+	AuditActionMemberUnsubscribe       = "member.unsubscribe"
+	AuditActionMemberNickname          = "member.nickname"
+	AuditActionMemberBotAdd            = "member.bot_add"
+	AuditActionChannelCreate           = "channel.create"
+	AuditActionChannelDelete           = "channel.delete"
+	AuditActionChannelUpdate           = "channel.update"
+	AuditActionChannelAccessGrant      = "channel.access_grant"
+	AuditActionChannelAccessRevoke     = "channel.access_revoke"
+	AuditActionChannelFollow           = "channel.follow"
+	AuditActionChannelUnfollow         = "channel.unfollow"
+	AuditActionChannelArchive          = "channel.archive"
+	AuditActionChannelUnarchive        = "channel.unarchive"
+	AuditActionChannelRestore          = "channel.restore"
+	AuditActionModerationRuleCreate    = "moderation_rule.create"
+	AuditActionModerationRuleDelete    = "moderation_rule.delete"
+	AuditActionWebhookCreate           = "webhook.create"
+	AuditActionWebhookRegenerateToken  = "webhook.regenerate_token"
+	AuditActionWebhookDelete           = "webhook.delete"
+	AuditActionOutgoingWebhookCreate   = "outgoing_webhook.create"
+	AuditActionOutgoingWebhookEnable   = "outgoing_webhook.enable"
+	AuditActionOutgoingWebhookDelete   = "outgoing_webhook.delete"
+	AuditActionCommandCreate           = "command.create"
+	AuditActionCommandDelete           = "command.delete"
+	AuditActionEmojiCreate             = "emoji.create"
+	AuditActionEmojiUpdate             = "emoji.update"
+	AuditActionEmojiDelete             = "emoji.delete"
+)
+
+const (
+	AuditTargetServer          = "server"
+	AuditTargetUser            = "user"
+	AuditTargetChannel         = "channel"
+	AuditTargetModerationRule  = "moderation_rule"
+	AuditTargetTemplate        = "template"
+	AuditTargetWebhook         = "webhook"
+	AuditTargetOutgoingWebhook = "outgoing_webhook"
+	AuditTargetCommand         = "command"
+	AuditTargetEmoji           = "emoji"
+)
+
+const (
+	AuditLogDefaultLimit = 50
+	AuditLogMaxLimit     = 200
+)
+
+// AuditEntry - administrative action in a server, Before and After hold only changed values
+type AuditEntry struct {
+	Id         AuditEntryID      `bson:"_id"`
+	ServerId   ServerID          `bson:"server_id"`
+	ActorId    UserID            `bson:"actor_id"`
+	Action     string            `bson:"action"`
+	TargetType string            `bson:"target_type"`
+	TargetId   string            `bson:"target_id"`
+	Before     map[string]string `bson:"before,omitempty"`
+	After      map[string]string `bson:"after,omitempty"`
+	CreatedAt  time.Time         `bson:"created_at"`
+}
+
+// AuditLogFilter - entries of one server, newest first, empty fields match everything
+type AuditLogFilter struct {
+	ServerId ServerID
+	ActorId  *UserID
+	Action   string
+	After    *AuditLogCursor
+	Limit    int
+}
+
+// AuditLogCursor - position of the last entry of a page
+type AuditLogCursor struct {
+	CreatedAt int64  `json:"t"`
+	Id        string `json:"id"`
+}
+
+func NewAuditLogCursor(entry *AuditEntry) *AuditLogCursor {
+	return &AuditLogCursor{
+		CreatedAt: entry.CreatedAt.UnixMilli(),
+		Id:        entry.Id.String(),
+	}
+}
+
+func (c *AuditLogCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeAuditLogCursor(cursor string) (*AuditLogCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	c := &AuditLogCursor{}
+	err = json.Unmarshal(data, c)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err = uuid.Parse(c.Id); err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	return c, nil
+}
+
+type AuditLogResult struct {
+	Entries    []*AuditEntry
+	NextCursor string
+}
@@ -0,0 +1,254 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/google/uuid"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type CommandID uuid.UUID
+
+func (v CommandID) String() string {
+	return uuid.UUID(v).String()
+}
+
+type InteractionID uuid.UUID
+
+func (v InteractionID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const (
+	CommandOptionString  = "string"
+	CommandOptionInteger = "integer"
+	CommandOptionBoolean = "boolean"
+	CommandOptionUser    = "user"
+)
+
+var CommandOptionTypes = map[string]bool{
+	CommandOptionString:  true,
+	CommandOptionInteger: true,
+	CommandOptionBoolean: true,
+	CommandOptionUser:    true,
+}
+
+const (
+	ServerCommandLimit = 50
+	CommandOptionLimit = 10
+	// InteractionResponseTimeout - the handler replies through the interaction token before it passes
+	InteractionResponseTimeout = 15 * time.Minute
+	// InteractionEventType - event header of the requests sent to command handlers
+	InteractionEventType = "interaction.command"
+	CommandPrefix        = "/"
+	commandSecretSize    = 32
+	interactionTokenSize = 32
+)
+
+var commandNamePattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// ValidCommandName - command and option names are lowercase words
+func ValidCommandName(name string) bool {
+	return commandNamePattern.MatchString(name)
+}
+
+type CommandOption struct {
+	Name        string `bson:"name"`
+	Description string `bson:"description"`
+	Type        string `bson:"type"`
+	Required    bool   `bson:"required"`
+}
+
+// NormalizeValue - checks the argument against the option type, user mentions "<@id>" become plain ids
+func (o CommandOption) NormalizeValue(value string) (string, bool) {
+	switch o.Type {
+	case CommandOptionInteger:
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", false
+		}
+
+		return strconv.FormatInt(number, 10), true
+	case CommandOptionBoolean:
+		flag, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", false
+		}
+
+		return strconv.FormatBool(flag), true
+	case CommandOptionUser:
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "<@"), ">")
+		userId, err := uuid.Parse(value)
+		if err != nil {
+			return "", false
+		}
+
+		return userId.String(), true
+	}
+
+	return value, true
+}
+
+// Command - slash command of a server, invocations are sent to the handler url signed with the secret
+type Command struct {
+	Id          CommandID       `bson:"_id"`
+	ServerId    ServerID        `bson:"server_id"`
+	Name        string          `bson:"name"`
+	Description string          `bson:"description"`
+	Options     []CommandOption `bson:"options"`
+	HandlerUrl  string          `bson:"handler_url"`
+	Secret      string          `bson:"secret"`
+	CreatorId   UserID          `bson:"creator_id"`
+	CreatedAt   time.Time       `bson:"created_at"`
+}
+
+func (c *Command) SetNewSecret() error {
+	data := make([]byte, commandSecretSize)
+	if _, err := rand.Read(data); err != nil {
+		return err
+	}
+
+	c.Secret = hex.EncodeToString(data)
+
+	return nil
+}
+
+// CommandInput - command invocation parsed from a message text
+type CommandInput struct {
+	Name string
+	Args []string
+}
+
+// ParseCommandInput - "/name arg ..." where double quotes keep spaces in an argument,
+// nil when the text is a plain message
+func ParseCommandInput(text string) *CommandInput {
+	if !strings.HasPrefix(text, CommandPrefix) {
+		return nil
+	}
+
+	fields := splitCommandArgs(strings.TrimPrefix(text, CommandPrefix))
+	if len(fields) == 0 {
+		return nil
+	}
+
+	name := strings.ToLower(fields[0])
+	if !ValidCommandName(name) {
+		return nil
+	}
+
+	return &CommandInput{
+		Name: name,
+		Args: fields[1:],
+	}
+}
+
+// splitCommandArgs - an unterminated quote takes the rest of the text
+func splitCommandArgs(text string) []string {
+	var (
+		args    []string
+		current strings.Builder
+		quoted  bool
+		started bool
+	)
+
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case unicode.IsSpace(r) && !quoted:
+			if started {
+				args = append(args, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+
+	if started {
+		args = append(args, current.String())
+	}
+
+	return args
+}
+
+// Interaction - one command invocation, the handler replies with its token before ExpiresAt
+type Interaction struct {
+	Id        InteractionID     `bson:"_id"`
+	CommandId CommandID         `bson:"command_id"`
+	ServerId  ServerID          `bson:"server_id"`
+	UserId    UserID            `bson:"user_id"`
+	Options   map[string]string `bson:"options"`
+	TokenHash string            `bson:"token_hash"`
+	Responded bool              `bson:"responded"`
+	ExpiresAt time.Time         `bson:"expires_at"`
+	CreatedAt time.Time         `bson:"created_at"`
+	// Token - plain token, set only right after creation to be sent to the handler
+	Token string `bson:"-"`
+}
+
+// SetNewToken - generates a token and keeps its hash
+func (i *Interaction) SetNewToken() error {
+	data := make([]byte, interactionTokenSize)
+	if _, err := rand.Read(data); err != nil {
+		return err
+	}
+
+	i.Token = base64.RawURLEncoding.EncodeToString(data)
+	i.TokenHash = HashWebhookToken(i.Token)
+
+	return nil
+}
+
+// MatchToken - compares hashes in constant time
+func (i *Interaction) MatchToken(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(i.TokenHash), []byte(HashWebhookToken(token))) == 1
+}
+
+func (i *Interaction) Expired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt)
+}
+
+// InteractionEvent - invocation as it is sent to the command handler
+type InteractionEvent struct {
+	Id          string            `json:"id"`
+	Token       string            `json:"token"`
+	ServerId    string            `json:"server_id"`
+	UserId      string            `json:"user_id"`
+	CommandId   string            `json:"command_id"`
+	CommandName string            `json:"command_name"`
+	Options     map[string]string `json:"options"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+}
+
+func NewInteractionEvent(interaction Interaction, command Command) InteractionEvent {
+	return InteractionEvent{
+		Id:          interaction.Id.String(),
+		Token:       interaction.Token,
+		ServerId:    interaction.ServerId.String(),
+		UserId:      interaction.UserId.String(),
+		CommandId:   command.Id.String(),
+		CommandName: command.Name,
+		Options:     interaction.Options,
+		ExpiresAt:   interaction.ExpiresAt,
+	}
+}
+
+func (e InteractionEvent) Payload() (string, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
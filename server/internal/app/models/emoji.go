@@ -0,0 +1,105 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+type EmojiID uuid.UUID
+
+func (v EmojiID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const (
+	ServerEmojiLimit = 50
+	// EmojiMaxSize - upload limit of an emoji image in bytes
+	EmojiMaxSize = 256 << 10
+)
+
+// EmojiContentTypes - image types accepted for emoji, detected from the uploaded bytes
+var EmojiContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/gif":  true,
+	"image/jpeg": true,
+	"image/webp": true,
+}
+
+var (
+	emojiNamePattern  = regexp.MustCompile(`^[a-zA-Z0-9_]{2,32}$`)
+	emojiTokenPattern = regexp.MustCompile(`<:[a-zA-Z0-9_]{2,32}:[0-9a-f-]{36}>|:([a-zA-Z0-9_]{2,32}):`)
+)
+
+// ValidEmojiName - emoji are used in text as :name:
+func ValidEmojiName(name string) bool {
+	return emojiNamePattern.MatchString(name)
+}
+
+// DetectEmojiContentType - empty when the data is not an accepted image
+func DetectEmojiContentType(data []byte) string {
+	contentType := http.DetectContentType(data)
+	if !EmojiContentTypes[contentType] {
+		return ""
+	}
+
+	return contentType
+}
+
+// Emoji - custom emoji of a server, the image is kept in the blob store under BlobKey
+type Emoji struct {
+	Id          EmojiID   `bson:"_id"`
+	ServerId    ServerID  `bson:"server_id"`
+	Name        string    `bson:"name"`
+	ContentType string    `bson:"content_type"`
+	Size        int64     `bson:"size"`
+	BlobKey     string    `bson:"blob_key"`
+	CreatorId   UserID    `bson:"creator_id"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// EmojiBlobKey - images are grouped by server
+func EmojiBlobKey(serverId ServerID, id EmojiID) string {
+	return "emoji/" + serverId.String() + "/" + id.String()
+}
+
+// EmojiImage - image of an emoji as it is served
+type EmojiImage struct {
+	ContentType string
+	Data        []byte
+}
+
+// HasEmojiTokens - the text has :name: tokens that may refer to custom emoji
+func HasEmojiTokens(text string) bool {
+	for _, match := range emojiTokenPattern.FindAllStringSubmatch(text, -1) {
+		if match[1] != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveEmojiTokens - replaces :name: of known emoji with <:name:id>,
+// already resolved tokens and unknown names are kept as they are
+func ResolveEmojiTokens(text string, emoji []*Emoji) string {
+	byName := make(map[string]*Emoji, len(emoji))
+	for _, e := range emoji {
+		byName[e.Name] = e
+	}
+
+	return emojiTokenPattern.ReplaceAllStringFunc(text, func(token string) string {
+		match := emojiTokenPattern.FindStringSubmatch(token)
+		if match[1] == "" {
+			return token
+		}
+
+		e, ok := byName[match[1]]
+		if !ok {
+			return token
+		}
+
+		return "<:" + e.Name + ":" + e.Id.String() + ">"
+	})
+}
@@ -3,9 +3,12 @@ package models
 import "errors"
 
 var (
-	ErrAlreadyExists = errors.New("already exists")
-	ErrUnimplemented = errors.New("unimplemented")
-	ErrNotFound      = errors.New("not found")
-	Unauthenticated  = errors.New("unauthenticated")
-	PermissionDenied = errors.New("permission denied")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrUnimplemented      = errors.New("unimplemented")
+	ErrNotFound           = errors.New("not found")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	Unauthenticated       = errors.New("unauthenticated")
+	PermissionDenied      = errors.New("permission denied")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrUnavailable        = errors.New("unavailable")
 )
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type ModerationRule struct {
+	Id             string
+	ServerId       string
+	Type           string
+	Words          []string
+	Pattern        string
+	Limit          int
+	Action         string
+	TimeoutSeconds int64
+	CreatedAt      time.Time
+}
+
+type ModerationAction struct {
+	Id        string
+	ServerId  string
+	RuleId    string
+	RuleType  string
+	Action    string
+	AuthorId  string
+	ChatId    string
+	Text      string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
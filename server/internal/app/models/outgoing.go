@@ -0,0 +1,158 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/google/uuid"
+	"strconv"
+	"time"
+)
+
+type OutgoingWebhookID uuid.UUID
+
+func (v OutgoingWebhookID) String() string {
+	return uuid.UUID(v).String()
+}
+
+type OutgoingDeliveryID uuid.UUID
+
+func (v OutgoingDeliveryID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const (
+	OutgoingEventMessageCreated = "message.created"
+	OutgoingEventMemberJoined   = "member.joined"
+	OutgoingEventMemberLeft     = "member.left"
+	OutgoingEventChannelCreated = "channel.created"
+	OutgoingEventChannelDeleted = "channel.deleted"
+)
+
+var OutgoingEventTypes = map[string]bool{
+	OutgoingEventMessageCreated: true,
+	OutgoingEventMemberJoined:   true,
+	OutgoingEventMemberLeft:     true,
+	OutgoingEventChannelCreated: true,
+	OutgoingEventChannelDeleted: true,
+}
+
+const (
+	OutgoingDeliveryPending   = "pending"
+	OutgoingDeliverySucceeded = "succeeded"
+	OutgoingDeliveryFailed    = "failed"
+)
+
+const (
+	ServerOutgoingWebhookLimit = 10
+	// OutgoingDeliveryMaxAttempts - the delivery is failed after the last attempt
+	OutgoingDeliveryMaxAttempts = 6
+	OutgoingRetryBaseDelay      = 30 * time.Second
+	// OutgoingWebhookDisableAfter - consecutive failed attempts of any deliveries that disable the webhook
+	OutgoingWebhookDisableAfter = 10
+	OutgoingDeliveryBatchSize   = 100
+	OutgoingDeliveryLogLimit    = 50
+	outgoingSecretSize          = 32
+)
+
+// OutgoingWebhook - callback of a server subscribed to platform events,
+// the secret signs every request and is returned only on creation
+type OutgoingWebhook struct {
+	Id                  OutgoingWebhookID `bson:"_id"`
+	ServerId            ServerID          `bson:"server_id"`
+	Url                 string            `bson:"url"`
+	Events              []string          `bson:"events"`
+	Secret              string            `bson:"secret"`
+	CreatorId           UserID            `bson:"creator_id"`
+	Enabled             bool              `bson:"enabled"`
+	ConsecutiveFailures int               `bson:"consecutive_failures"`
+	DisabledAt          time.Time         `bson:"disabled_at,omitempty"`
+	CreatedAt           time.Time         `bson:"created_at"`
+}
+
+func (w *OutgoingWebhook) SetNewSecret() error {
+	data := make([]byte, outgoingSecretSize)
+	if _, err := rand.Read(data); err != nil {
+		return err
+	}
+
+	w.Secret = hex.EncodeToString(data)
+
+	return nil
+}
+
+// OutgoingEvent - platform event as it is sent to the callbacks
+type OutgoingEvent struct {
+	Id         string            `json:"id"`
+	Type       string            `json:"type"`
+	ServerId   string            `json:"server_id"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	Data       map[string]string `json:"data"`
+}
+
+func NewOutgoingEvent(eventType string, serverId ServerID, data map[string]string) OutgoingEvent {
+	return OutgoingEvent{
+		Id:         uuid.New().String(),
+		Type:       eventType,
+		ServerId:   serverId.String(),
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+}
+
+func (e OutgoingEvent) Payload() (string, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// OutgoingDelivery - one event for one webhook, the payload is kept so retries send the same body
+type OutgoingDelivery struct {
+	Id             OutgoingDeliveryID `bson:"_id"`
+	WebhookId      OutgoingWebhookID  `bson:"webhook_id"`
+	ServerId       ServerID           `bson:"server_id"`
+	EventId        string             `bson:"event_id"`
+	EventType      string             `bson:"event_type"`
+	Payload        string             `bson:"payload"`
+	Status         string             `bson:"status"`
+	Attempts       int                `bson:"attempts"`
+	NextAttemptAt  time.Time          `bson:"next_attempt_at"`
+	LastStatusCode int                `bson:"last_status_code"`
+	LastError      string             `bson:"last_error"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+}
+
+// OutgoingRetryDelay - exponential backoff after the given failed attempt
+func OutgoingRetryDelay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	return OutgoingRetryBaseDelay << (attempt - 1)
+}
+
+// OutgoingRequest - signed http call to the webhook url
+type OutgoingRequest struct {
+	Url        string
+	Secret     string
+	DeliveryId string
+	EventType  string
+	Payload    string
+	Timestamp  time.Time
+}
+
+// SignOutgoingPayload - hex hmac-sha256 of "<unix timestamp>.<body>", receivers recompute it with the secret
+func SignOutgoingPayload(secret string, timestamp time.Time, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(payload))
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,7 +1,11 @@
 package models
 
-import "time"
-import "github.com/google/uuid"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/google/uuid"
+	"time"
+)
 
 type ServerID uuid.UUID
 
@@ -15,10 +19,133 @@ func (v UserID) String() string {
 	return uuid.UUID(v).String()
 }
 
+const (
+	ServerVisibilityPublic  = "public"
+	ServerVisibilityPrivate = "private"
+)
+
+const (
+	ServerSearchDefaultLimit = 20
+	ServerSearchMaxLimit     = 100
+	ServerMaxTags            = 10
+)
+
+const (
+	// ServerRestoreGracePeriod - time after deletion during which the owner can restore the server,
+	// then the server is purged and ServerDeleted is published for other services
+	ServerRestoreGracePeriod = 7 * 24 * time.Hour
+	ServerPurgeBatchSize     = 100
+)
+
 type ServerInfo struct {
-	Id      ServerID `bson:"_id"`
-	Name    string   `bson:"name"`
-	OwnerId UserID   `bson:"owner_id"`
+	Id             ServerID  `bson:"_id"`
+	Name           string    `bson:"name"`
+	OwnerId        UserID    `bson:"owner_id"`
+	Description    string    `bson:"description"`
+	IconUrl        string    `bson:"icon_url"`
+	BannerUrl      string    `bson:"banner_url"`
+	Visibility     string    `bson:"visibility"`
+	Tags           []string  `bson:"tags"`
+	Category       string    `bson:"category"`
+	Discoverable   bool      `bson:"discoverable"`
+	LastActivityAt time.Time `bson:"last_activity_at"`
+	// MemberCount - number of subscriptions, owner is subscribed on server creation
+	MemberCount int64 `bson:"member_count"`
+	// DeletedAt - set while the deleted server waits for purge
+	DeletedAt time.Time `bson:"deleted_at,omitempty"`
+}
+
+// ServerProfileUpdate - profile fields to change, nil fields are left unchanged
+type ServerProfileUpdate struct {
+	Name         *string
+	Description  *string
+	IconUrl      *string
+	BannerUrl    *string
+	Visibility   *string
+	Tags         []string
+	Category     *string
+	Discoverable *bool
+}
+
+// IsPrivate - servers created before visibility was introduced are public
+func (s *ServerInfo) IsPrivate() bool {
+	return s.Visibility == ServerVisibilityPrivate
+}
+
+func (s *ServerInfo) IsDeleted() bool {
+	return !s.DeletedAt.IsZero()
+}
+
+// RestoreDeadline - the server is purged after this time
+func (s *ServerInfo) RestoreDeadline() time.Time {
+	return s.DeletedAt.Add(ServerRestoreGracePeriod)
+}
+
+// SearchServersFilter - discovery search, only discoverable public servers are matched
+type SearchServersFilter struct {
+	Query    string
+	Tags     []string
+	Category string
+	After    *ServerSearchCursor
+	Limit    int
+}
+
+// ServerSearchCursor - position of the last server of a page in discovery ranking
+type ServerSearchCursor struct {
+	MemberCount    int64  `json:"m"`
+	LastActivityAt int64  `json:"t"`
+	Id             string `json:"id"`
+}
+
+func NewServerSearchCursor(server *ServerInfo) *ServerSearchCursor {
+	return &ServerSearchCursor{
+		MemberCount:    server.MemberCount,
+		LastActivityAt: server.LastActivityAt.UnixMilli(),
+		Id:             server.Id.String(),
+	}
+}
+
+func (c *ServerSearchCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeServerSearchCursor(cursor string) (*ServerSearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	c := &ServerSearchCursor{}
+	err = json.Unmarshal(data, c)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err = uuid.Parse(c.Id); err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	return c, nil
+}
+
+// RepairMembersResult - changes made by members repair
+type RepairMembersResult struct {
+	DuplicatesRemoved int64
+	OwnersSubscribed  int64
+	CountsUpdated     int64
+}
+
+type SearchServersResult struct {
+	Servers    []*ServerInfo
+	NextCursor string
+}
+
+// ServerDeletedEvent - published when a deleted server is purged
+type ServerDeletedEvent struct {
+	ServerId  ServerID
+	OwnerId   UserID
+	DeletedAt time.Time
 }
 
 type ActionInfo struct {
@@ -37,4 +164,13 @@ type Message struct {
 	Id        string
 	Text      string
 	Timestamp time.Time
+	OwnerId   string
+	// AuthorNickname - nickname of the owner in the server, empty when not set
+	AuthorNickname string
+	// WebhookId - webhook that posted the message, Username and AvatarUrl are shown instead of the owner profile
+	WebhookId string
+	Username  string
+	AvatarUrl string
+	// VisibleTo - ephemeral message shown only to this user
+	VisibleTo string
 }
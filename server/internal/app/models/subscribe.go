@@ -1,6 +1,9 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type SubscribeID uuid.UUID
 
@@ -12,4 +15,35 @@ type SubscribeInfo struct {
 	Id       SubscribeID `bson:"_id"`
 	ServerId ServerID    `bson:"server_id"`
 	UserId   UserID      `bson:"user_id"`
+	JoinedAt time.Time   `bson:"joined_at"`
+	// Nickname - name of the user inside this server, empty when not set
+	Nickname string `bson:"nickname,omitempty"`
+}
+
+const (
+	MembersDefaultLimit = 50
+	MembersMaxLimit     = 200
+
+	MemberNicknameMaxLength = 32
+)
+
+// Bot - bot account of the user service, private bots are added to servers by their owner only
+type Bot struct {
+	Id      UserID
+	OwnerId UserID
+	Public  bool
+}
+
+// Member - server member, JoinedAt is zero for the owner without subscription
+// and for subscriptions created before join time was recorded
+type Member struct {
+	UserId   UserID
+	JoinedAt time.Time
+	IsOwner  bool
+	Nickname string
+}
+
+type ListMembersResult struct {
+	Members    []*Member
+	NextCursor string
 }
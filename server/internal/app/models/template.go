@@ -0,0 +1,75 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type TemplateID uuid.UUID
+
+func (v TemplateID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const ServerTemplateMaxChannels = 100
+
+// ServerTemplate - snapshot of server settings and channels, members and messages are not copied
+type ServerTemplate struct {
+	Id             TemplateID        `bson:"_id"`
+	Name           string            `bson:"name"`
+	SourceServerId ServerID          `bson:"source_server_id"`
+	OwnerId        UserID            `bson:"owner_id"`
+	Description    string            `bson:"description"`
+	IconUrl        string            `bson:"icon_url"`
+	BannerUrl      string            `bson:"banner_url"`
+	Visibility     string            `bson:"visibility"`
+	Tags           []string          `bson:"tags"`
+	Category       string            `bson:"category"`
+	Channels       []TemplateChannel `bson:"channels"`
+	CreatedAt      time.Time         `bson:"created_at"`
+}
+
+// TemplateChannel - channel settings without members and access lists
+type TemplateChannel struct {
+	Name string `bson:"name"`
+	// Type - empty for templates created before types were copied, they are text channels
+	Type string `bson:"type"`
+	// ParentIndex - index of the category in the template channels, nil for channels outside of categories
+	ParentIndex *int                  `bson:"parent_index,omitempty"`
+	Topic       string                `bson:"topic"`
+	Nsfw        bool                  `bson:"nsfw"`
+	Voice       *ChannelVoiceSettings `bson:"voice,omitempty"`
+	Forum       *ChannelForumSettings `bson:"forum,omitempty"`
+	Private     bool                  `bson:"private"`
+}
+
+type ChannelVoiceSettings struct {
+	Bitrate   int `bson:"bitrate"`
+	UserLimit int `bson:"user_limit"`
+}
+
+type ChannelForumSettings struct {
+	DefaultSort string   `bson:"default_sort"`
+	Tags        []string `bson:"tags"`
+}
+
+type Channel struct {
+	Id       string
+	Name     string
+	OwnerId  string
+	ServerId string
+	Type     string
+	// ParentId - empty for channels outside of categories
+	ParentId string
+	Topic    string
+	Nsfw     bool
+	Voice    *ChannelVoiceSettings
+	Forum    *ChannelForumSettings
+	Private  bool
+}
+
+const ChannelTypeCategory = "category"
+
+func (c *Channel) IsCategory() bool {
+	return c.Type == ChannelTypeCategory
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/google/uuid"
+	"time"
+)
+
+type WebhookID uuid.UUID
+
+func (v WebhookID) String() string {
+	return uuid.UUID(v).String()
+}
+
+const (
+	ServerWebhookLimit = 15
+	webhookTokenSize   = 32
+)
+
+// Webhook - incoming webhook of a server, only the hash of the token is stored
+type Webhook struct {
+	Id        WebhookID `bson:"_id"`
+	ServerId  ServerID  `bson:"server_id"`
+	Name      string    `bson:"name"`
+	AvatarUrl string    `bson:"avatar_url"`
+	TokenHash string    `bson:"token_hash"`
+	CreatorId UserID    `bson:"creator_id"`
+	CreatedAt time.Time `bson:"created_at"`
+	// Token - plain token, set only right after creation or regeneration
+	Token string `bson:"-"`
+}
+
+// SetNewToken - generates a token and keeps its hash
+func (w *Webhook) SetNewToken() error {
+	data := make([]byte, webhookTokenSize)
+	if _, err := rand.Read(data); err != nil {
+		return err
+	}
+
+	w.Token = base64.RawURLEncoding.EncodeToString(data)
+	w.TokenHash = HashWebhookToken(w.Token)
+
+	return nil
+}
+
+// MatchToken - compares hashes in constant time
+func (w *Webhook) MatchToken(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(w.TokenHash), []byte(HashWebhookToken(token))) == 1
+}
+
+func HashWebhookToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,65 @@
+package queue
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/pkg/api/chat"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	// EventSchemaVersion - version of EventEnvelope written by this producer
+	EventSchemaVersion = 1
+
+	contentTypeHeader   = "content-type"
+	eventTypeHeader     = "event-type"
+	protobufContentType = "application/x-protobuf"
+)
+
+// NewServerDeletedEvent - wraps event into envelope and injects the span from ctx as trace context
+func NewServerDeletedEvent(ctx context.Context, deleted models.ServerDeletedEvent) *chat.EventEnvelope {
+	event := &chat.EventEnvelope{
+		EventId:       uuid.New().String(),
+		EventType:     chat.EventType_EVENT_TYPE_SERVER_DELETED,
+		SchemaVersion: EventSchemaVersion,
+		OccurredAt:    timestamppb.New(deleted.DeletedAt),
+		ProducedAt:    timestamppb.New(time.Now()),
+		TraceContext:  map[string]string{},
+		Payload: &chat.EventEnvelope_ServerDeleted{
+			ServerDeleted: &chat.ServerDeletedEvent{
+				ServerId:  deleted.ServerId.String(),
+				OwnerId:   deleted.OwnerId.String(),
+				DeletedAt: timestamppb.New(deleted.DeletedAt),
+			},
+		},
+	}
+
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(event.TraceContext))
+	}
+
+	return event
+}
+
+// EncodeEvent - marshals envelope into kafka message keyed by server id,
+// so events of one server stay in one partition
+func EncodeEvent(key string, event *chat.EventEnvelope) (kafka.Message, error) {
+	value, err := proto.Marshal(event)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	return kafka.Message{
+		Key:   []byte(key),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: contentTypeHeader, Value: []byte(protobufContentType)},
+			{Key: eventTypeHeader, Value: []byte(event.GetEventType().String())},
+		},
+	}, nil
+}
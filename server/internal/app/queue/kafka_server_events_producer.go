@@ -0,0 +1,35 @@
+package queue
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaServerEvents struct {
+	conn *kafka.Conn
+}
+
+var _ usecases.KafkaProducerServiceInterface = (*KafkaServerEvents)(nil)
+
+func NewKafkaServerEvents(conn *kafka.Conn) *KafkaServerEvents {
+	return &KafkaServerEvents{
+		conn: conn,
+	}
+}
+
+func (m *KafkaServerEvents) PublishServerDeleted(ctx context.Context, event models.ServerDeletedEvent) error {
+	message, err := EncodeEvent(event.ServerId.String(), NewServerDeletedEvent(ctx, event))
+	if err != nil {
+		return pkgerrors.Wrap("failed to encode event", err)
+	}
+
+	_, err = m.conn.WriteMessages(message)
+	if err != nil {
+		return pkgerrors.Wrap("failed to send messages", err)
+	}
+
+	return nil
+}
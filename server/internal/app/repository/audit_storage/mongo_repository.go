@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type MongoAuditRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.AuditStorage = (*MongoAuditRepository)(nil)
+
+func NewMongoAuditRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoAuditRepository {
+	return &MongoAuditRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - server_id_created_at_id serves audit log pages, the id breaks ties of the cursor
+func (r *MongoAuditRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("server_id_created_at_id"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create audit indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoAuditRepository) CreateEntry(ctx context.Context, entry models.AuditEntry) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addEntry := bson.M{
+		"server_id":   entry.ServerId,
+		"actor_id":    entry.ActorId,
+		"action":      entry.Action,
+		"target_type": entry.TargetType,
+		"target_id":   entry.TargetId,
+		"before":      entry.Before,
+		"after":       entry.After,
+		"created_at":  entry.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(entry.Id)}, bson.M{
+		"$set": addEntry,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("action", entry.Action).Error("create audit entry error repo")
+		return err
+	}
+
+	return nil
+}
+
+// ListEntries - entries of the server ordered from the newest
+func (r *MongoAuditRepository) ListEntries(ctx context.Context, filter models.AuditLogFilter) ([]*models.AuditEntry, error) {
+	query := bson.M{"server_id": filter.ServerId}
+	if filter.ActorId != nil {
+		query["actor_id"] = *filter.ActorId
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+	if filter.After != nil {
+		afterTime := time.UnixMilli(filter.After.CreatedAt)
+		afterId := uuid.MustParse(filter.After.Id)
+		query["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": afterTime}},
+			bson.M{"created_at": afterTime, "_id": bson.M{"$lt": afterId}},
+		}
+	}
+
+	option := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(filter.Limit))
+
+	cursor, err := r.mongo.Find(ctx, query, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", filter.ServerId.String()).Error("list audit entries error repo")
+		return nil, err
+	}
+
+	var entries []*models.AuditEntry
+	err = cursor.All(ctx, &entries)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", filter.ServerId.String()).Error("list audit entries error repo")
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoCommandRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.CommandStorage = (*MongoCommandRepository)(nil)
+
+func NewMongoCommandRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoCommandRepository {
+	return &MongoCommandRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - unique server_id_name keeps command names unique in a server
+func (r *MongoCommandRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().SetName("server_id_name").SetUnique(true),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create command indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoCommandRepository) CreateCommand(ctx context.Context, command models.Command) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addCommand := bson.M{
+		"server_id":   command.ServerId,
+		"name":        command.Name,
+		"description": command.Description,
+		"options":     command.Options,
+		"handler_url": command.HandlerUrl,
+		"secret":      command.Secret,
+		"creator_id":  command.CreatorId,
+		"created_at":  command.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(command.Id)}, bson.M{
+		"$set": addCommand,
+	}, option)
+
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ErrAlreadyExists
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", command.ServerId.String()).Error("create command error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoCommandRepository) GetCommandById(ctx context.Context, id models.CommandID) (*models.Command, error) {
+	return r.findOne(ctx, bson.M{"_id": uuid.UUID(id)})
+}
+
+func (r *MongoCommandRepository) GetCommandByName(ctx context.Context, serverId models.ServerID, name string) (*models.Command, error) {
+	return r.findOne(ctx, bson.M{"server_id": serverId, "name": name})
+}
+
+func (r *MongoCommandRepository) findOne(ctx context.Context, filter bson.M) (*models.Command, error) {
+	command := &models.Command{}
+	err := r.mongo.FindOne(ctx, filter).Decode(command)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).Error("get command error repo")
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// ListByServerId - commands of the server ordered by name
+func (r *MongoCommandRepository) ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Command, error) {
+	option := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"server_id": serverId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list commands error repo")
+		return nil, err
+	}
+
+	commands := make([]*models.Command, 0)
+	err = cursor.All(ctx, &commands)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list commands error repo")
+		return nil, err
+	}
+
+	return commands, nil
+}
+
+func (r *MongoCommandRepository) CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	count, err := r.mongo.CountDocuments(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("count commands error repo")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *MongoCommandRepository) DeleteCommand(ctx context.Context, id models.CommandID) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(id)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("delete command error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoCommandRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("delete server commands error repo")
+		return err
+	}
+
+	return nil
+}
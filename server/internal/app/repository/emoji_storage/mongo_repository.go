@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoEmojiRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.EmojiStorage = (*MongoEmojiRepository)(nil)
+
+func NewMongoEmojiRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoEmojiRepository {
+	return &MongoEmojiRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - unique server_id_name keeps emoji names unique in a server
+func (r *MongoEmojiRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().SetName("server_id_name").SetUnique(true),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create emoji indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoEmojiRepository) CreateEmoji(ctx context.Context, emoji models.Emoji) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addEmoji := bson.M{
+		"server_id":    emoji.ServerId,
+		"name":         emoji.Name,
+		"content_type": emoji.ContentType,
+		"size":         emoji.Size,
+		"blob_key":     emoji.BlobKey,
+		"creator_id":   emoji.CreatorId,
+		"created_at":   emoji.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(emoji.Id)}, bson.M{
+		"$set": addEmoji,
+	}, option)
+
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ErrAlreadyExists
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", emoji.ServerId.String()).Error("create emoji error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoEmojiRepository) GetEmojiById(ctx context.Context, id models.EmojiID) (*models.Emoji, error) {
+	return r.findOne(ctx, bson.M{"_id": uuid.UUID(id)})
+}
+
+func (r *MongoEmojiRepository) GetEmojiByName(ctx context.Context, serverId models.ServerID, name string) (*models.Emoji, error) {
+	return r.findOne(ctx, bson.M{"server_id": serverId, "name": name})
+}
+
+func (r *MongoEmojiRepository) findOne(ctx context.Context, filter bson.M) (*models.Emoji, error) {
+	emoji := &models.Emoji{}
+	err := r.mongo.FindOne(ctx, filter).Decode(emoji)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).Error("get emoji error repo")
+		return nil, err
+	}
+
+	return emoji, nil
+}
+
+// ListByServerId - emoji of the server ordered by name
+func (r *MongoEmojiRepository) ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Emoji, error) {
+	option := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"server_id": serverId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list emoji error repo")
+		return nil, err
+	}
+
+	emoji := make([]*models.Emoji, 0)
+	err = cursor.All(ctx, &emoji)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list emoji error repo")
+		return nil, err
+	}
+
+	return emoji, nil
+}
+
+func (r *MongoEmojiRepository) CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	count, err := r.mongo.CountDocuments(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("count emoji error repo")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *MongoEmojiRepository) RenameEmoji(ctx context.Context, id models.EmojiID, name string) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$set": bson.M{"name": name},
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ErrAlreadyExists
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("rename emoji error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoEmojiRepository) DeleteEmoji(ctx context.Context, id models.EmojiID) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(id)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("delete emoji error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoEmojiRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("delete server emoji error repo")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// interactionRetention - expired interactions are kept for a day, so late replies get a clear error
+const interactionRetention = 24 * time.Hour
+
+type MongoInteractionRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.InteractionStorage = (*MongoInteractionRepository)(nil)
+
+func NewMongoInteractionRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoInteractionRepository {
+	return &MongoInteractionRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - expires_at_ttl removes interactions after the retention period
+func (r *MongoInteractionRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at_ttl").SetExpireAfterSeconds(int32(interactionRetention.Seconds())),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create interaction indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoInteractionRepository) CreateInteraction(ctx context.Context, interaction models.Interaction) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addInteraction := bson.M{
+		"command_id": interaction.CommandId,
+		"server_id":  interaction.ServerId,
+		"user_id":    interaction.UserId,
+		"options":    interaction.Options,
+		"token_hash": interaction.TokenHash,
+		"responded":  interaction.Responded,
+		"expires_at": interaction.ExpiresAt,
+		"created_at": interaction.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(interaction.Id)}, bson.M{
+		"$set": addInteraction,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", interaction.ServerId.String()).Error("create interaction error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoInteractionRepository) GetInteractionById(ctx context.Context, id models.InteractionID) (*models.Interaction, error) {
+	interaction := &models.Interaction{}
+	err := r.mongo.FindOne(ctx, bson.M{"_id": uuid.UUID(id)}).Decode(interaction)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("get interaction error repo")
+		return nil, err
+	}
+
+	return interaction, nil
+}
+
+// MarkResponded - false when the interaction is already answered or expired
+func (r *MongoInteractionRepository) MarkResponded(ctx context.Context, id models.InteractionID, now time.Time) (bool, error) {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{
+		"_id":        uuid.UUID(id),
+		"responded":  false,
+		"expires_at": bson.M{"$gt": now},
+	}, bson.M{
+		"$set": bson.M{"responded": true},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("mark interaction responded error repo")
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+func (r *MongoInteractionRepository) ReleaseResponded(ctx context.Context, id models.InteractionID) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$set": bson.M{"responded": false},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("release interaction error repo")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type MongoOutgoingDeliveryRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.OutgoingDeliveryStorage = (*MongoOutgoingDeliveryRepository)(nil)
+
+func NewMongoOutgoingDeliveryRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoOutgoingDeliveryRepository {
+	return &MongoOutgoingDeliveryRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - status_next_attempt_at is scanned by the delivery worker,
+// webhook_id_created_at serves the delivery log of a webhook
+func (r *MongoOutgoingDeliveryRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+			Options: options.Index().SetName("status_next_attempt_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "webhook_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("webhook_id_created_at"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create outgoing delivery indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoOutgoingDeliveryRepository) CreateDelivery(ctx context.Context, delivery models.OutgoingDelivery) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addDelivery := bson.M{
+		"webhook_id":       delivery.WebhookId,
+		"server_id":        delivery.ServerId,
+		"event_id":         delivery.EventId,
+		"event_type":       delivery.EventType,
+		"payload":          delivery.Payload,
+		"status":           delivery.Status,
+		"attempts":         delivery.Attempts,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"last_status_code": delivery.LastStatusCode,
+		"last_error":       delivery.LastError,
+		"created_at":       delivery.CreatedAt,
+		"updated_at":       delivery.UpdatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(delivery.Id)}, bson.M{
+		"$set": addDelivery,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("webhook_id", delivery.WebhookId.String()).Error("create outgoing delivery error repo")
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAttempt - stores the result of an attempt
+func (r *MongoOutgoingDeliveryRepository) UpdateAttempt(ctx context.Context, delivery models.OutgoingDelivery) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(delivery.Id)}, bson.M{
+		"$set": bson.M{
+			"status":           delivery.Status,
+			"attempts":         delivery.Attempts,
+			"next_attempt_at":  delivery.NextAttemptAt,
+			"last_status_code": delivery.LastStatusCode,
+			"last_error":       delivery.LastError,
+			"updated_at":       delivery.UpdatedAt,
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", delivery.Id.String()).Error("update outgoing delivery error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListDue - pending deliveries whose next attempt time has come, oldest first
+func (r *MongoOutgoingDeliveryRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*models.OutgoingDelivery, error) {
+	option := options.Find().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	return r.find(ctx, bson.M{
+		"status":          models.OutgoingDeliveryPending,
+		"next_attempt_at": bson.M{"$lte": now},
+	}, option)
+}
+
+// ListByWebhookId - delivery log of the webhook, newest first
+func (r *MongoOutgoingDeliveryRepository) ListByWebhookId(ctx context.Context, webhookId models.OutgoingWebhookID, limit int) ([]*models.OutgoingDelivery, error) {
+	option := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+
+	return r.find(ctx, bson.M{"webhook_id": webhookId}, option)
+}
+
+func (r *MongoOutgoingDeliveryRepository) find(ctx context.Context, filter bson.M, option *options.FindOptions) ([]*models.OutgoingDelivery, error) {
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list outgoing deliveries error repo")
+		return nil, err
+	}
+
+	deliveries := make([]*models.OutgoingDelivery, 0)
+	err = cursor.All(ctx, &deliveries)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list outgoing deliveries error repo")
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *MongoOutgoingDeliveryRepository) DeleteByWebhookId(ctx context.Context, webhookId models.OutgoingWebhookID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"webhook_id": webhookId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("webhook_id", webhookId.String()).Error("delete outgoing deliveries error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoOutgoingDeliveryRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("delete server outgoing deliveries error repo")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type MongoOutgoingWebhookRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.OutgoingWebhookStorage = (*MongoOutgoingWebhookRepository)(nil)
+
+func NewMongoOutgoingWebhookRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoOutgoingWebhookRepository {
+	return &MongoOutgoingWebhookRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - server_id_events finds webhooks of a server subscribed to an event
+func (r *MongoOutgoingWebhookRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "events", Value: 1}},
+			Options: options.Index().SetName("server_id_events"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create outgoing webhook indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoOutgoingWebhookRepository) CreateOutgoingWebhook(ctx context.Context, webhook models.OutgoingWebhook) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addWebhook := bson.M{
+		"server_id":            webhook.ServerId,
+		"url":                  webhook.Url,
+		"events":               webhook.Events,
+		"secret":               webhook.Secret,
+		"creator_id":           webhook.CreatorId,
+		"enabled":              webhook.Enabled,
+		"consecutive_failures": webhook.ConsecutiveFailures,
+		"created_at":           webhook.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(webhook.Id)}, bson.M{
+		"$set": addWebhook,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", webhook.ServerId.String()).Error("create outgoing webhook error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoOutgoingWebhookRepository) GetOutgoingWebhookById(ctx context.Context, id models.OutgoingWebhookID) (*models.OutgoingWebhook, error) {
+	webhook := &models.OutgoingWebhook{}
+	err := r.mongo.FindOne(ctx, bson.M{"_id": uuid.UUID(id)}).Decode(webhook)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("get outgoing webhook error repo")
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// ListByServerId - webhooks of the server ordered from the oldest
+func (r *MongoOutgoingWebhookRepository) ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.OutgoingWebhook, error) {
+	return r.find(ctx, bson.M{"server_id": serverId})
+}
+
+// ListSubscribed - enabled webhooks of the server subscribed to the event type
+func (r *MongoOutgoingWebhookRepository) ListSubscribed(ctx context.Context, serverId models.ServerID, eventType string) ([]*models.OutgoingWebhook, error) {
+	return r.find(ctx, bson.M{
+		"server_id": serverId,
+		"events":    eventType,
+		"enabled":   true,
+	})
+}
+
+func (r *MongoOutgoingWebhookRepository) find(ctx context.Context, filter bson.M) ([]*models.OutgoingWebhook, error) {
+	option := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list outgoing webhooks error repo")
+		return nil, err
+	}
+
+	webhooks := make([]*models.OutgoingWebhook, 0)
+	err = cursor.All(ctx, &webhooks)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list outgoing webhooks error repo")
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *MongoOutgoingWebhookRepository) CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	count, err := r.mongo.CountDocuments(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("count outgoing webhooks error repo")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Enable - turns the webhook on and forgets previous failures
+func (r *MongoOutgoingWebhookRepository) Enable(ctx context.Context, id models.OutgoingWebhookID) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$set":   bson.M{"enabled": true, "consecutive_failures": 0},
+		"$unset": bson.M{"disabled_at": ""},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("enable outgoing webhook error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoOutgoingWebhookRepository) RecordSuccess(ctx context.Context, id models.OutgoingWebhookID) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$set": bson.M{"consecutive_failures": 0},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("record outgoing webhook success error repo")
+		return err
+	}
+
+	return nil
+}
+
+// RecordFailure - counts the failed attempt and disables the webhook when the limit is reached,
+// returns true when this call disabled it
+func (r *MongoOutgoingWebhookRepository) RecordFailure(ctx context.Context, id models.OutgoingWebhookID, disableAfter int, at time.Time) (bool, error) {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$inc": bson.M{"consecutive_failures": 1},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("record outgoing webhook failure error repo")
+		return false, err
+	}
+
+	result, err := r.mongo.UpdateOne(ctx, bson.M{
+		"_id":                  uuid.UUID(id),
+		"enabled":              true,
+		"consecutive_failures": bson.M{"$gte": disableAfter},
+	}, bson.M{
+		"$set": bson.M{"enabled": false, "disabled_at": at},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("disable outgoing webhook error repo")
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+func (r *MongoOutgoingWebhookRepository) DeleteOutgoingWebhook(ctx context.Context, id models.OutgoingWebhookID) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(id)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("delete outgoing webhook error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoOutgoingWebhookRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("delete server outgoing webhooks error repo")
+		return err
+	}
+
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
+	"time"
 )
 
 type MongoCollectionInterface interface {
@@ -20,6 +22,14 @@ type MongoCollectionInterface interface {
 		opts ...*options.FindOneOptions) *mongo.SingleResult
 	DeleteOne(ctx context.Context, filter interface{},
 		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	DeleteMany(ctx context.Context, filter interface{},
+		opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	CountDocuments(ctx context.Context, filter interface{},
+		opts ...*options.CountOptions) (int64, error)
+	Aggregate(ctx context.Context, pipeline interface{},
+		opts ...*options.AggregateOptions) (*mongo.Cursor, error)
+	CreateIndexes(ctx context.Context, models []mongo.IndexModel,
+		opts ...*options.CreateIndexesOptions) ([]string, error)
 }
 
 type MongoServerRepository struct {
@@ -36,6 +46,27 @@ func NewMongoServerRepository(mongo MongoCollectionInterface, log *log.Logger) *
 	}
 }
 
+// EnsureIndexes - name index for prefix search and name_text text index for word search, a $text clause
+// under $or needs every other clause of the $or indexed too
+func (r *MongoServerRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().SetName("name"),
+		},
+		{
+			Keys:    bson.D{{Key: "name", Value: "text"}},
+			Options: options.Index().SetName("name_text"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create server indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
 func (r *MongoServerRepository) CreateServer(ctx context.Context, server models.ServerInfo) error {
 	upsert := true
 
@@ -43,11 +74,19 @@ func (r *MongoServerRepository) CreateServer(ctx context.Context, server models.
 	option.Upsert = &upsert
 
 	addMessage := bson.M{
-		"name":     server.Name,
-		"owner_id": server.OwnerId,
+		"name":         server.Name,
+		"owner_id":     server.OwnerId,
+		"description":  server.Description,
+		"icon_url":     server.IconUrl,
+		"banner_url":   server.BannerUrl,
+		"visibility":   server.Visibility,
+		"tags":         server.Tags,
+		"category":     server.Category,
+		"discoverable": server.Discoverable,
+		"member_count": server.MemberCount,
 	}
 
-	_, err := r.mongo.UpdateOne(ctx, bson.D{{"_id", uuid.UUID(server.Id)}}, bson.M{
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(server.Id)}, bson.M{
 		"$set": addMessage,
 	}, option)
 
@@ -59,27 +98,105 @@ func (r *MongoServerRepository) CreateServer(ctx context.Context, server models.
 	return nil
 }
 
-func (r *MongoServerRepository) SearchServers(ctx context.Context, serverName string) ([]*models.ServerInfo, error) {
-	filter := bson.D{{"name", serverName}}
-	cursor, err := r.mongo.Find(ctx, filter)
+// SearchServers - discoverable public servers ranked by member count and recent activity
+func (r *MongoServerRepository) SearchServers(ctx context.Context, filter models.SearchServersFilter) ([]*models.ServerInfo, error) {
+	match := bson.M{
+		"discoverable": true,
+		"visibility":   bson.M{"$ne": models.ServerVisibilityPrivate},
+		"deleted_at":   bson.M{"$exists": false},
+	}
+	if filter.Query != "" {
+		// prefix of the name, or any of its words through the text index
+		match["$or"] = bson.A{
+			bson.M{"name": bson.M{"$regex": namePrefixPattern(filter.Query), "$options": "i"}},
+			bson.M{"$text": bson.M{"$search": filter.Query}},
+		}
+	}
+	if len(filter.Tags) > 0 {
+		match["tags"] = bson.M{"$all": filter.Tags}
+	}
+	if filter.Category != "" {
+		match["category"] = filter.Category
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		// servers saved before counters and activity were introduced rank last
+		{{Key: "$addFields", Value: bson.M{
+			"member_count":     bson.M{"$ifNull": bson.A{"$member_count", 0}},
+			"last_activity_at": bson.M{"$ifNull": bson.A{"$last_activity_at", time.Time{}}},
+		}}},
+	}
+
+	if filter.After != nil {
+		afterId, err := uuid.Parse(filter.After.Id)
+		if err != nil {
+			return nil, models.ErrInvalidArgument
+		}
+		afterActivity := time.UnixMilli(filter.After.LastActivityAt)
+
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"member_count": bson.M{"$lt": filter.After.MemberCount}},
+			bson.M{"member_count": filter.After.MemberCount, "last_activity_at": bson.M{"$lt": afterActivity}},
+			bson.M{"member_count": filter.After.MemberCount, "last_activity_at": afterActivity, "_id": bson.M{"$gt": afterId}},
+		}}}})
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "member_count", Value: -1},
+			{Key: "last_activity_at", Value: -1},
+			{Key: "_id", Value: 1},
+		}}},
+		bson.D{{Key: "$limit", Value: filter.Limit}},
+	)
+
+	cursor, err := r.mongo.Aggregate(ctx, pipeline)
 	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("query", filter.Query).Error("search server error repo")
 		return nil, err
 	}
 
 	var servers []*models.ServerInfo
 	err = cursor.All(ctx, &servers)
 	if err != nil {
-		r.log.WithContext(ctx).WithError(err).WithField("serverName", serverName).Error("search server error repo")
+		r.log.WithContext(ctx).WithError(err).WithField("query", filter.Query).Error("search server error repo")
 		return nil, err
 	}
 
 	return servers, nil
 }
 
+// namePrefixPattern - anchored literal prefix, so the match never backtracks
+func namePrefixPattern(query string) string {
+	return "^" + regexp.QuoteMeta(query)
+}
+
+func (r *MongoServerRepository) TouchServerActivity(ctx context.Context, id models.ServerID, at time.Time) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$max": bson.M{"last_activity_at": at},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("touch server activity error repo")
+		return err
+	}
+
+	return nil
+}
+
 const notFoundErrorStr = "mongo: no documents in result"
 
+// GetServerById - deleted servers waiting for purge are not found
 func (r *MongoServerRepository) GetServerById(ctx context.Context, id string) (*models.ServerInfo, error) {
-	result := r.mongo.FindOne(context.Background(), bson.M{"_id": uuid.MustParse(id)})
+	return r.findServer(ctx, bson.M{"_id": uuid.MustParse(id), "deleted_at": bson.M{"$exists": false}})
+}
+
+func (r *MongoServerRepository) GetDeletedServerById(ctx context.Context, id string) (*models.ServerInfo, error) {
+	return r.findServer(ctx, bson.M{"_id": uuid.MustParse(id), "deleted_at": bson.M{"$exists": true}})
+}
+
+func (r *MongoServerRepository) findServer(ctx context.Context, filter bson.M) (*models.ServerInfo, error) {
+	result := r.mongo.FindOne(context.Background(), filter)
 
 	server := &models.ServerInfo{}
 	err := result.Decode(server)
@@ -88,9 +205,221 @@ func (r *MongoServerRepository) GetServerById(ctx context.Context, id string) (*
 			return nil, models.ErrNotFound
 		}
 
-		r.log.WithContext(ctx).WithError(err).WithField("id", id).Error("decode server struct error repo")
+		r.log.WithContext(ctx).WithError(err).WithField("id", filter["_id"]).Error("decode server struct error repo")
 		return nil, err
 	}
 
 	return server, nil
 }
+
+// UpdateServerProfile - only the changed fields are set, so concurrent owner and member count writes are kept
+func (r *MongoServerRepository) UpdateServerProfile(ctx context.Context, id models.ServerID, update models.ServerProfileUpdate) error {
+	fields := bson.M{}
+	if update.Name != nil {
+		fields["name"] = *update.Name
+	}
+	if update.Description != nil {
+		fields["description"] = *update.Description
+	}
+	if update.IconUrl != nil {
+		fields["icon_url"] = *update.IconUrl
+	}
+	if update.BannerUrl != nil {
+		fields["banner_url"] = *update.BannerUrl
+	}
+	if update.Visibility != nil {
+		fields["visibility"] = *update.Visibility
+	}
+	if update.Tags != nil {
+		fields["tags"] = update.Tags
+	}
+	if update.Category != nil {
+		fields["category"] = *update.Category
+	}
+	if update.Discoverable != nil {
+		fields["discoverable"] = *update.Discoverable
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{"$set": fields})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("update server profile error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateOwner - the owner is changed only if it is still the expected one, models.ErrNotFound otherwise
+func (r *MongoServerRepository) UpdateOwner(ctx context.Context, id models.ServerID, from models.UserID, to models.UserID) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id), "owner_id": from}, bson.M{
+		"$set": bson.M{"owner_id": to},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("update server owner error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoServerRepository) DeleteServer(ctx context.Context, id string) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.MustParse(id)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id).Error("delete server error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// MarkDeleted - hides the server until it is restored or purged
+func (r *MongoServerRepository) MarkDeleted(ctx context.Context, id models.ServerID, at time.Time) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id), "deleted_at": bson.M{"$exists": false}}, bson.M{
+		"$set": bson.M{"deleted_at": at},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("mark server deleted error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoServerRepository) RestoreServer(ctx context.Context, id models.ServerID) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id), "deleted_at": bson.M{"$exists": true}}, bson.M{
+		"$unset": bson.M{"deleted_at": ""},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("restore server error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// ListDeletedBefore - deleted servers whose deletion happened before the given time, oldest first
+func (r *MongoServerRepository) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.ServerInfo, error) {
+	option := options.Find().
+		SetSort(bson.D{{Key: "deleted_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": before}}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list deleted servers error repo")
+		return nil, err
+	}
+
+	var servers []*models.ServerInfo
+	err = cursor.All(ctx, &servers)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list deleted servers error repo")
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// GetDeletedIds - ids of the given servers that are deleted
+func (r *MongoServerRepository) GetDeletedIds(ctx context.Context, ids []models.ServerID) ([]models.ServerID, error) {
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		uuids[i] = uuid.UUID(id)
+	}
+
+	option := options.Find().SetProjection(bson.M{"_id": 1})
+	cursor, err := r.mongo.Find(ctx, bson.M{"_id": bson.M{"$in": uuids}, "deleted_at": bson.M{"$exists": true}}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("get deleted servers error repo")
+		return nil, err
+	}
+
+	var servers []*models.ServerInfo
+	err = cursor.All(ctx, &servers)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("get deleted servers error repo")
+		return nil, err
+	}
+
+	deleted := make([]models.ServerID, len(servers))
+	for i, server := range servers {
+		deleted[i] = server.Id
+	}
+
+	return deleted, nil
+}
+
+func (r *MongoServerRepository) SetMemberCount(ctx context.Context, id models.ServerID, count int64) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$set": bson.M{"member_count": count},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("set member count error repo")
+		return err
+	}
+
+	return nil
+}
+
+// IncMemberCount - $inc keeps concurrent joins and leaves from overwriting each other
+func (r *MongoServerRepository) IncMemberCount(ctx context.Context, id models.ServerID, delta int64) error {
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$inc": bson.M{"member_count": delta},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("inc member count error repo")
+		return err
+	}
+
+	return nil
+}
+
+// ListServers - all servers ordered by id, after is the last id of the previous batch
+func (r *MongoServerRepository) ListServers(ctx context.Context, after *models.ServerID, limit int) ([]*models.ServerInfo, error) {
+	filter := bson.M{}
+	if after != nil {
+		filter["_id"] = bson.M{"$gt": uuid.UUID(*after)}
+	}
+
+	option := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list servers error repo")
+		return nil, err
+	}
+
+	var servers []*models.ServerInfo
+	err = cursor.All(ctx, &servers)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("list servers error repo")
+		return nil, err
+	}
+
+	return servers, nil
+}
@@ -8,6 +8,7 @@ import (
 	log "github.com/Nixonxp/discord/server/pkg/logger"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
@@ -25,6 +26,27 @@ func NewMongoSubscribeRepository(mongo repository.MongoCollectionInterface, log
 	}
 }
 
+// EnsureIndexes - server_id_id pages member lists, unique server_id_user_id keeps one membership per user,
+// it can not be built while duplicated subscriptions exist
+func (r *MongoSubscribeRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("server_id_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("server_id_user_id").SetUnique(true),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create subscribe indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
 func (r *MongoSubscribeRepository) CreateSubscribe(ctx context.Context, subscribe models.SubscribeInfo) error {
 	upsert := true
 
@@ -34,12 +56,16 @@ func (r *MongoSubscribeRepository) CreateSubscribe(ctx context.Context, subscrib
 	addSubscribe := bson.M{
 		"server_id": subscribe.ServerId,
 		"user_id":   subscribe.UserId,
+		"joined_at": subscribe.JoinedAt,
 	}
 
-	_, err := r.mongo.UpdateOne(ctx, bson.D{{"_id", uuid.UUID(subscribe.Id)}}, bson.M{
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(subscribe.Id)}, bson.M{
 		"$set": addSubscribe,
 	}, option)
 
+	if mongo.IsDuplicateKeyError(err) {
+		return models.ErrAlreadyExists
+	}
 	if err != nil {
 		r.log.WithContext(ctx).WithError(err).WithField("server_id", subscribe.ServerId).Error("create subscribe error repo")
 		return err
@@ -68,7 +94,7 @@ func (r *MongoSubscribeRepository) DeleteSubscribe(ctx context.Context, serverId
 }
 
 func (r *MongoSubscribeRepository) GetByUserId(ctx context.Context, userId models.UserID) ([]*models.SubscribeInfo, error) {
-	filter := bson.D{{"user_id", userId}}
+	filter := bson.M{"user_id": userId}
 	cursor, err := r.mongo.Find(ctx, filter)
 	if err != nil {
 		return nil, err
@@ -83,3 +109,171 @@ func (r *MongoSubscribeRepository) GetByUserId(ctx context.Context, userId model
 
 	return subscribes, nil
 }
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoSubscribeRepository) GetSubscribe(ctx context.Context, serverId models.ServerID, userId models.UserID) (*models.SubscribeInfo, error) {
+	filter := bson.M{
+		"server_id": serverId,
+		"user_id":   userId,
+	}
+
+	subscribe := &models.SubscribeInfo{}
+	err := r.mongo.FindOne(ctx, filter).Decode(subscribe)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("get subscribe error repo")
+		return nil, err
+	}
+
+	return subscribe, nil
+}
+
+func (r *MongoSubscribeRepository) CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	count, err := r.mongo.CountDocuments(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("count subscribes error repo")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *MongoSubscribeRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("delete server subscribes error repo")
+		return err
+	}
+
+	return nil
+}
+
+// ListByServerId - subscriptions of the server ordered by id, after is the last id of the previous page
+func (r *MongoSubscribeRepository) ListByServerId(ctx context.Context, serverId models.ServerID, after *models.SubscribeID, limit int) ([]*models.SubscribeInfo, error) {
+	filter := bson.M{"server_id": serverId}
+	if after != nil {
+		filter["_id"] = bson.M{"$gt": uuid.UUID(*after)}
+	}
+
+	option := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.mongo.Find(ctx, filter, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("list subscribes error repo")
+		return nil, err
+	}
+
+	var subscribes []*models.SubscribeInfo
+	err = cursor.All(ctx, &subscribes)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("list subscribes error repo")
+		return nil, err
+	}
+
+	return subscribes, nil
+}
+
+// GetByUserIds - subscriptions of the given users in the server, users without subscription are skipped
+func (r *MongoSubscribeRepository) GetByUserIds(ctx context.Context, serverId models.ServerID, userIds []models.UserID) ([]*models.SubscribeInfo, error) {
+	filter := bson.M{
+		"server_id": serverId,
+		"user_id":   bson.M{"$in": userIds},
+	}
+
+	cursor, err := r.mongo.Find(ctx, filter)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("get subscribes by users error repo")
+		return nil, err
+	}
+
+	var subscribes []*models.SubscribeInfo
+	err = cursor.All(ctx, &subscribes)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("get subscribes by users error repo")
+		return nil, err
+	}
+
+	return subscribes, nil
+}
+
+// SetNickname - empty nickname removes the field
+func (r *MongoSubscribeRepository) SetNickname(ctx context.Context, serverId models.ServerID, userId models.UserID, nickname string) error {
+	filter := bson.M{
+		"server_id": serverId,
+		"user_id":   userId,
+	}
+
+	update := bson.M{"$set": bson.M{"nickname": nickname}}
+	if nickname == "" {
+		update = bson.M{"$unset": bson.M{"nickname": ""}}
+	}
+
+	result, err := r.mongo.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId).Error("set nickname error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindDuplicates - ids of extra subscriptions for every (server_id, user_id) pair,
+// the earliest joined subscription of the pair is kept
+func (r *MongoSubscribeRepository) FindDuplicates(ctx context.Context) ([]models.SubscribeID, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "joined_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"server_id": "$server_id", "user_id": "$user_id"},
+			"ids":   bson.M{"$push": "$_id"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	}
+
+	cursor, err := r.mongo.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("find duplicated subscribes error repo")
+		return nil, err
+	}
+
+	var groups []struct {
+		Ids []models.SubscribeID `bson:"ids"`
+	}
+	err = cursor.All(ctx, &groups)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("find duplicated subscribes error repo")
+		return nil, err
+	}
+
+	duplicates := make([]models.SubscribeID, 0)
+	for _, group := range groups {
+		duplicates = append(duplicates, group.Ids[1:]...)
+	}
+
+	return duplicates, nil
+}
+
+func (r *MongoSubscribeRepository) DeleteByIds(ctx context.Context, ids []models.SubscribeID) (int64, error) {
+	uuids := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		uuids[i] = uuid.UUID(id)
+	}
+
+	result, err := r.mongo.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": uuids}})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("delete subscribes error repo")
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoTemplateRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.TemplateStorage = (*MongoTemplateRepository)(nil)
+
+func NewMongoTemplateRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoTemplateRepository {
+	return &MongoTemplateRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - owner_id_created_at serves template lists of a user, newest first
+func (r *MongoTemplateRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("owner_id_created_at"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create template indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoTemplateRepository) CreateTemplate(ctx context.Context, template models.ServerTemplate) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addTemplate := bson.M{
+		"name":             template.Name,
+		"source_server_id": template.SourceServerId,
+		"owner_id":         template.OwnerId,
+		"description":      template.Description,
+		"icon_url":         template.IconUrl,
+		"banner_url":       template.BannerUrl,
+		"visibility":       template.Visibility,
+		"tags":             template.Tags,
+		"category":         template.Category,
+		"channels":         template.Channels,
+		"created_at":       template.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(template.Id)}, bson.M{
+		"$set": addTemplate,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", template.SourceServerId.String()).Error("create template error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoTemplateRepository) GetTemplateById(ctx context.Context, id models.TemplateID) (*models.ServerTemplate, error) {
+	template := &models.ServerTemplate{}
+	err := r.mongo.FindOne(ctx, bson.M{"_id": uuid.UUID(id)}).Decode(template)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("get template error repo")
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ListByOwnerId - templates of the user ordered from the newest
+func (r *MongoTemplateRepository) ListByOwnerId(ctx context.Context, ownerId models.UserID) ([]*models.ServerTemplate, error) {
+	option := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"owner_id": ownerId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("owner_id", ownerId.String()).Error("list templates error repo")
+		return nil, err
+	}
+
+	templates := make([]*models.ServerTemplate, 0)
+	err = cursor.All(ctx, &templates)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("owner_id", ownerId.String()).Error("list templates error repo")
+		return nil, err
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoWebhookRepository struct {
+	mongo repository.MongoCollectionInterface
+	log   *log.Logger
+}
+
+var _ usecases.WebhookStorage = (*MongoWebhookRepository)(nil)
+
+func NewMongoWebhookRepository(mongo repository.MongoCollectionInterface, log *log.Logger) *MongoWebhookRepository {
+	return &MongoWebhookRepository{
+		mongo: mongo,
+		log:   log,
+	}
+}
+
+// EnsureIndexes - server_id_created_at serves webhook lists of a server
+func (r *MongoWebhookRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.mongo.CreateIndexes(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "server_id", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("server_id_created_at"),
+		},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).Error("create webhook indexes error repo")
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoWebhookRepository) CreateWebhook(ctx context.Context, webhook models.Webhook) error {
+	upsert := true
+
+	option := &options.UpdateOptions{}
+	option.Upsert = &upsert
+
+	addWebhook := bson.M{
+		"server_id":  webhook.ServerId,
+		"name":       webhook.Name,
+		"avatar_url": webhook.AvatarUrl,
+		"token_hash": webhook.TokenHash,
+		"creator_id": webhook.CreatorId,
+		"created_at": webhook.CreatedAt,
+	}
+
+	_, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(webhook.Id)}, bson.M{
+		"$set": addWebhook,
+	}, option)
+
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", webhook.ServerId.String()).Error("create webhook error repo")
+		return err
+	}
+
+	return nil
+}
+
+const notFoundErrorStr = "mongo: no documents in result"
+
+func (r *MongoWebhookRepository) GetWebhookById(ctx context.Context, id models.WebhookID) (*models.Webhook, error) {
+	webhook := &models.Webhook{}
+	err := r.mongo.FindOne(ctx, bson.M{"_id": uuid.UUID(id)}).Decode(webhook)
+	if err != nil {
+		if err.Error() == notFoundErrorStr {
+			return nil, models.ErrNotFound
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("get webhook error repo")
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// ListByServerId - webhooks of the server ordered from the oldest
+func (r *MongoWebhookRepository) ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Webhook, error) {
+	option := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.mongo.Find(ctx, bson.M{"server_id": serverId}, option)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list webhooks error repo")
+		return nil, err
+	}
+
+	webhooks := make([]*models.Webhook, 0)
+	err = cursor.All(ctx, &webhooks)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list webhooks error repo")
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *MongoWebhookRepository) CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	count, err := r.mongo.CountDocuments(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("count webhooks error repo")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *MongoWebhookRepository) UpdateTokenHash(ctx context.Context, id models.WebhookID, tokenHash string) error {
+	result, err := r.mongo.UpdateOne(ctx, bson.M{"_id": uuid.UUID(id)}, bson.M{
+		"$set": bson.M{"token_hash": tokenHash},
+	})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("update webhook token error repo")
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoWebhookRepository) DeleteWebhook(ctx context.Context, id models.WebhookID) error {
+	result, err := r.mongo.DeleteOne(ctx, bson.M{"_id": uuid.UUID(id)})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("id", id.String()).Error("delete webhook error repo")
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoWebhookRepository) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	_, err := r.mongo.DeleteMany(ctx, bson.M{"server_id": serverId})
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("delete server webhooks error repo")
+		return err
+	}
+
+	return nil
+}
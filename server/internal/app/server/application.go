@@ -37,8 +37,11 @@ func NewApplication(cfg *config.Config) *Application {
 		Services: []Service{
 			&srv.tracer,
 			&srv.chatSvcClient,
+			&srv.channelSvcClient,
+			&srv.userSvcClient,
 			&srv.logger,
 			&srv.mongo,
+			&srv.serverEvents,
 		},
 		ShutdownTimeout: terminationTimeout,
 		Cfg:             cfg,
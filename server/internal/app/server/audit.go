@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ServerServer) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Get audit log: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.GetAuditLog(ctx, usecases.GetAuditLogRequest{
+		ServerId:      req.GetServerId(),
+		ActorId:       req.GetActorId(),
+		Action:        req.GetAction(),
+		Cursor:        req.GetCursor(),
+		Limit:         int(req.GetLimit()),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pb.AuditEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = auditEntryToPb(entry)
+	}
+
+	return &pb.GetAuditLogResponse{
+		Entries:    entries,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// RecordAuditEntry - called by other services, the actor is taken from the request and checked against the server
+func (s *ServerServer) RecordAuditEntry(ctx context.Context, req *pb.RecordAuditEntryRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).WithField("action", req.GetAction()).Info("Record audit entry: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ServerUsecase.RecordAuditEntry(ctx, usecases.RecordAuditEntryRequest{
+		ServerId:   req.GetServerId(),
+		ActorId:    req.GetActorId(),
+		Action:     req.GetAction(),
+		TargetType: req.GetTargetType(),
+		TargetId:   req.GetTargetId(),
+		Before:     req.GetBefore(),
+		After:      req.GetAfter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func auditEntryToPb(entry *models.AuditEntry) *pb.AuditEntry {
+	return &pb.AuditEntry{
+		Id:         entry.Id.String(),
+		ServerId:   entry.ServerId.String(),
+		ActorId:    entry.ActorId.String(),
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetId:   entry.TargetId,
+		Before:     entry.Before,
+		After:      entry.After,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: entry.CreatedAt.Unix(),
+		},
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ServerServer) CreateCommand(ctx context.Context, req *pb.CreateCommandRequest) (*pb.Command, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Create command: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	options := make([]usecases.CommandOption, len(req.GetOptions()))
+	for i, option := range req.GetOptions() {
+		options[i] = usecases.CommandOption{
+			Name:        option.GetName(),
+			Description: option.GetDescription(),
+			Type:        option.GetType(),
+			Required:    option.GetRequired(),
+		}
+	}
+
+	result, err := s.ServerUsecase.CreateCommand(ctx, usecases.CreateCommandRequest{
+		ServerId:      req.GetServerId(),
+		Name:          req.GetName(),
+		Description:   req.GetDescription(),
+		Options:       options,
+		HandlerUrl:    req.GetHandlerUrl(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commandToPb(result), nil
+}
+
+func (s *ServerServer) ListCommands(ctx context.Context, req *pb.ListCommandsRequest) (*pb.ListCommandsResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("List commands: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListCommands(ctx, usecases.ListCommandsRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commands := make([]*pb.Command, len(result))
+	for i, command := range result {
+		commands[i] = commandToPb(command)
+	}
+
+	return &pb.ListCommandsResponse{
+		Commands: commands,
+	}, nil
+}
+
+func (s *ServerServer) DeleteCommand(ctx context.Context, req *pb.DeleteCommandRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("command id", req.GetCommandId()).Info("Delete command: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.DeleteCommand(ctx, usecases.DeleteCommandRequest{
+		ServerId:      req.GetServerId(),
+		CommandId:     req.GetCommandId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+// RespondInteraction - no user in the context, the token is checked by the usecase
+func (s *ServerServer) RespondInteraction(ctx context.Context, req *pb.RespondInteractionRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("interaction id", req.GetInteractionId()).Info("Respond interaction: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ServerUsecase.RespondInteraction(ctx, usecases.RespondInteractionRequest{
+		InteractionId: req.GetInteractionId(),
+		Token:         req.GetToken(),
+		Content:       req.GetContent(),
+		Ephemeral:     req.GetEphemeral(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func commandToPb(command *models.Command) *pb.Command {
+	options := make([]*pb.CommandOption, len(command.Options))
+	for i, option := range command.Options {
+		options[i] = &pb.CommandOption{
+			Name:        option.Name,
+			Description: option.Description,
+			Type:        option.Type,
+			Required:    option.Required,
+		}
+	}
+
+	return &pb.Command{
+		Id:          command.Id.String(),
+		ServerId:    command.ServerId.String(),
+		Name:        command.Name,
+		Description: command.Description,
+		Options:     options,
+		HandlerUrl:  command.HandlerUrl,
+		Secret:      command.Secret,
+		CreatorId:   command.CreatorId.String(),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: command.CreatedAt.Unix(),
+		},
+	}
+}
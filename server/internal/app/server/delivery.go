@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"time"
+)
+
+// runOutgoingDelivery - sends due outgoing webhook deliveries until ctx is done
+func runOutgoingDelivery(ctx context.Context, serverUsecase usecases.UsecaseInterface, interval time.Duration, l *log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			delivered, err := serverUsecase.DeliverOutgoingEvents(ctx)
+			if err != nil {
+				l.WithContext(ctx).WithError(err).Error("deliver outgoing events error")
+			}
+			if delivered > 0 {
+				l.WithContext(ctx).Debugf("handled outgoing deliveries: %d", delivered)
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ServerServer) CreateEmoji(ctx context.Context, req *pb.CreateEmojiRequest) (*pb.Emoji, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Create emoji: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.CreateEmoji(ctx, usecases.CreateEmojiRequest{
+		ServerId:      req.GetServerId(),
+		Name:          req.GetName(),
+		Image:         req.GetImage(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return emojiToPb(result), nil
+}
+
+func (s *ServerServer) ListServerEmoji(ctx context.Context, req *pb.ListServerEmojiRequest) (*pb.ListServerEmojiResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("List server emoji: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListServerEmoji(ctx, usecases.ListServerEmojiRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emoji := make([]*pb.Emoji, len(result))
+	for i, e := range result {
+		emoji[i] = emojiToPb(e)
+	}
+
+	return &pb.ListServerEmojiResponse{
+		Emoji: emoji,
+	}, nil
+}
+
+func (s *ServerServer) RenameEmoji(ctx context.Context, req *pb.RenameEmojiRequest) (*pb.Emoji, error) {
+	s.Log.WithContext(ctx).WithField("emoji id", req.GetEmojiId()).Info("Rename emoji: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.RenameEmoji(ctx, usecases.RenameEmojiRequest{
+		ServerId:      req.GetServerId(),
+		EmojiId:       req.GetEmojiId(),
+		Name:          req.GetName(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return emojiToPb(result), nil
+}
+
+func (s *ServerServer) DeleteEmoji(ctx context.Context, req *pb.DeleteEmojiRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("emoji id", req.GetEmojiId()).Info("Delete emoji: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.DeleteEmoji(ctx, usecases.DeleteEmojiRequest{
+		ServerId:      req.GetServerId(),
+		EmojiId:       req.GetEmojiId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+// GetEmojiImage - public, images are loaded by clients without the user token
+func (s *ServerServer) GetEmojiImage(ctx context.Context, req *pb.GetEmojiImageRequest) (*pb.EmojiImage, error) {
+	s.Log.WithContext(ctx).WithField("emoji id", req.GetEmojiId()).Info("Get emoji image: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ServerUsecase.GetEmojiImage(ctx, usecases.GetEmojiImageRequest{
+		EmojiId: req.GetEmojiId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmojiImage{
+		ContentType: result.ContentType,
+		Data:        result.Data,
+	}, nil
+}
+
+func emojiToPb(emoji *models.Emoji) *pb.Emoji {
+	return &pb.Emoji{
+		Id:          emoji.Id.String(),
+		ServerId:    emoji.ServerId.String(),
+		Name:        emoji.Name,
+		ContentType: emoji.ContentType,
+		Size:        emoji.Size,
+		CreatorId:   emoji.CreatorId.String(),
+		CreatedAt:   timestamppb.New(emoji.CreatedAt),
+	}
+}
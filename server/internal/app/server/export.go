@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bufio"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+)
+
+const exportChunkSize = 32 * 1024
+
+// exportChunkWriter - sends everything written to it as stream chunks
+type exportChunkWriter struct {
+	stream pb.ServerService_ExportServerChatServer
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	err := w.stream.Send(&pb.ExportChatChunk{
+		Data: data,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (s *ServerServer) ExportServerChat(req *pb.ExportServerChatRequest, stream pb.ServerService_ExportServerChatServer) error {
+	ctx := stream.Context()
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Export server chat")
+
+	if err := s.validator.Validate(req); err != nil {
+		return grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return models.Unauthenticated
+	}
+
+	request := usecases.ExportServerChatRequest{
+		ServerId:      req.GetServerId(),
+		Format:        req.GetFormat(),
+		CurrentUserId: userId,
+	}
+	if req.GetFrom() != nil {
+		request.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		request.To = req.GetTo().AsTime()
+	}
+
+	buf := bufio.NewWriterSize(&exportChunkWriter{stream: stream}, exportChunkSize)
+	err = s.ServerUsecase.ExportServerChat(ctx, request, buf)
+	if err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
@@ -4,8 +4,18 @@ import (
 	"context"
 	"fmt"
 	"github.com/Nixonxp/discord/server/internal/app/models"
+	audit_storage "github.com/Nixonxp/discord/server/internal/app/repository/audit_storage"
+	command_storage "github.com/Nixonxp/discord/server/internal/app/repository/command_storage"
+	emoji_storage "github.com/Nixonxp/discord/server/internal/app/repository/emoji_storage"
+	interaction_storage "github.com/Nixonxp/discord/server/internal/app/repository/interaction_storage"
+	outgoing_delivery_storage "github.com/Nixonxp/discord/server/internal/app/repository/outgoing_delivery_storage"
+	outgoing_webhook_storage "github.com/Nixonxp/discord/server/internal/app/repository/outgoing_webhook_storage"
 	repository "github.com/Nixonxp/discord/server/internal/app/repository/server_storage"
 	subscribe_storage "github.com/Nixonxp/discord/server/internal/app/repository/subscribe_storage"
+	template_storage "github.com/Nixonxp/discord/server/internal/app/repository/template_storage"
+	webhook_storage "github.com/Nixonxp/discord/server/internal/app/repository/webhook_storage"
+	"github.com/Nixonxp/discord/server/internal/app/services/blob"
+	"github.com/Nixonxp/discord/server/internal/app/services/outgoing"
 	"github.com/Nixonxp/discord/server/internal/app/usecases"
 	server_usc "github.com/Nixonxp/discord/server/internal/app/usecases/server"
 	middleware "github.com/Nixonxp/discord/server/internal/middleware/errors"
@@ -32,6 +42,7 @@ type Config struct {
 	ChainUnaryInterceptors  []grpc.UnaryServerInterceptor
 	UnaryInterceptors       []grpc.UnaryServerInterceptor
 	UnaryClientInterceptors []grpc.UnaryClientInterceptor
+	StreamInterceptors      []grpc.StreamServerInterceptor
 
 	ChatServiceUrl string
 }
@@ -60,7 +71,7 @@ type ServerServer struct {
 	grpcServer *grpc.Server
 }
 
-func NewServerServer(_ context.Context, s *MainServer) (*ServerServer, error) {
+func NewServerServer(ctx context.Context, s *MainServer) (*ServerServer, error) {
 	srv := &ServerServer{}
 
 	// validator
@@ -70,12 +81,50 @@ func NewServerServer(_ context.Context, s *MainServer) (*ServerServer, error) {
 			protovalidate.WithMessages(
 				&pb.CreateServerRequest{},
 				&pb.SearchServerRequest{},
+				&pb.GetServerRequest{},
+				&pb.UpdateServerRequest{},
+				&pb.DeleteServerRequest{},
+				&pb.RestoreServerRequest{},
+				&pb.TransferOwnershipRequest{},
+				&pb.ListServerMembersRequest{},
+				&pb.GetMemberRequest{},
+				&pb.SetMemberNicknameRequest{},
+				&pb.CreateServerTemplateRequest{},
+				&pb.ListTemplatesRequest{},
+				&pb.CreateServerFromTemplateRequest{},
 				&pb.SubscribeServerRequest{},
 				&pb.UnsubscribeServerRequest{},
 				&pb.SearchServerByUserIdRequest{},
 				&pb.InviteUserToServerRequest{},
+				&pb.AddBotToServerRequest{},
 				&pb.PublishMessageOnServerRequest{},
 				&pb.GetMessagesFromServerRequest{},
+				&pb.CreateModerationRuleRequest{},
+				&pb.DeleteModerationRuleRequest{},
+				&pb.GetModerationRulesRequest{},
+				&pb.GetModerationActionsRequest{},
+				&pb.ExportServerChatRequest{},
+				&pb.GetAuditLogRequest{},
+				&pb.RecordAuditEntryRequest{},
+				&pb.CreateWebhookRequest{},
+				&pb.ListWebhooksRequest{},
+				&pb.RegenerateWebhookTokenRequest{},
+				&pb.DeleteWebhookRequest{},
+				&pb.ExecuteWebhookRequest{},
+				&pb.CreateOutgoingWebhookRequest{},
+				&pb.ListOutgoingWebhooksRequest{},
+				&pb.EnableOutgoingWebhookRequest{},
+				&pb.DeleteOutgoingWebhookRequest{},
+				&pb.ListOutgoingDeliveriesRequest{},
+				&pb.CreateCommandRequest{},
+				&pb.ListCommandsRequest{},
+				&pb.DeleteCommandRequest{},
+				&pb.CreateEmojiRequest{},
+				&pb.ListServerEmojiRequest{},
+				&pb.RenameEmojiRequest{},
+				&pb.DeleteEmojiRequest{},
+				&pb.GetEmojiImageRequest{},
+				&pb.RespondInteractionRequest{},
 			),
 		)
 		if err != nil {
@@ -89,15 +138,129 @@ func NewServerServer(_ context.Context, s *MainServer) (*ServerServer, error) {
 		return nil, fmt.Errorf("failed to connect mongo: %v", err)
 	}
 
+	auditCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.ServerAuditCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	templateCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.ServerTemplateCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	webhookCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.ServerWebhookCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	outgoingWebhookCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.OutgoingWebhookCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	outgoingDeliveryCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.OutgoingDeliveryCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	commandCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.CommandCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	interactionCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.InteractionCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	emojiCollection, err := s.mongo.GetInstance().NewCollection(s.cfg.Application.EmojiCollection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect mongo: %v", err)
+	}
+
+	// indexes are created on every start, creating an index that already exists is a no-op
 	serverMongoRepo := repository.NewMongoServerRepository(s.mongo.GetInstance(), s.logger.GetInstance())
+	err = serverMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create server indexes: %v", err)
+	}
 	subscribeMongoRepo := subscribe_storage.NewMongoSubscribeRepository(subscribeCollection, s.logger.GetInstance())
+	err = subscribeMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscribe indexes, duplicated subscribes are removed by repair-members: %v", err)
+	}
+	auditMongoRepo := audit_storage.NewMongoAuditRepository(auditCollection, s.logger.GetInstance())
+	err = auditMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit indexes: %v", err)
+	}
+	templateMongoRepo := template_storage.NewMongoTemplateRepository(templateCollection, s.logger.GetInstance())
+	err = templateMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template indexes: %v", err)
+	}
+	webhookMongoRepo := webhook_storage.NewMongoWebhookRepository(webhookCollection, s.logger.GetInstance())
+	err = webhookMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook indexes: %v", err)
+	}
+
+	outgoingWebhookMongoRepo := outgoing_webhook_storage.NewMongoOutgoingWebhookRepository(outgoingWebhookCollection, s.logger.GetInstance())
+	err = outgoingWebhookMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outgoing webhook indexes: %v", err)
+	}
+
+	outgoingDeliveryMongoRepo := outgoing_delivery_storage.NewMongoOutgoingDeliveryRepository(outgoingDeliveryCollection, s.logger.GetInstance())
+	err = outgoingDeliveryMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outgoing delivery indexes: %v", err)
+	}
+
+	commandMongoRepo := command_storage.NewMongoCommandRepository(commandCollection, s.logger.GetInstance())
+	err = commandMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create command indexes: %v", err)
+	}
+
+	interactionMongoRepo := interaction_storage.NewMongoInteractionRepository(interactionCollection, s.logger.GetInstance())
+	err = interactionMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interaction indexes: %v", err)
+	}
+
+	emojiMongoRepo := emoji_storage.NewMongoEmojiRepository(emojiCollection, s.logger.GetInstance())
+	err = emojiMongoRepo.EnsureIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create emoji indexes: %v", err)
+	}
+
+	blobStore, err := blob.NewLocalStore(s.cfg.Application.BlobStoreDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob store: %v", err)
+	}
 
 	serverUsecase := server_usc.NewServerUsecase(server_usc.Deps{
-		ServerRepo:    serverMongoRepo,
-		SubscribeRepo: subscribeMongoRepo,
-		ChatService:   s.chatSvcClient.GetInstance(),
-		Log:           s.logger.GetInstance(),
+		ServerRepo:      serverMongoRepo,
+		SubscribeRepo:   subscribeMongoRepo,
+		ChatService:     s.chatSvcClient.GetInstance(),
+		AuditRepo:       auditMongoRepo,
+		TemplateRepo:    templateMongoRepo,
+		WebhookRepo:     webhookMongoRepo,
+		OutgoingRepo:    outgoingWebhookMongoRepo,
+		DeliveryRepo:    outgoingDeliveryMongoRepo,
+		CommandRepo:     commandMongoRepo,
+		InteractionRepo: interactionMongoRepo,
+		EmojiRepo:       emojiMongoRepo,
+		BlobStore:       blobStore,
+		OutgoingSender:  outgoing.NewHttpSender(s.cfg.Application.OutgoingRequestTimeout),
+		ChannelService:  s.channelSvcClient.GetInstance(),
+		UserService:     s.userSvcClient.GetInstance(),
+		ServerEvents:    s.serverEvents.GetInstance(),
+		Log:             s.logger.GetInstance(),
 	})
+	go runServerPurge(ctx, serverUsecase, s.cfg.Application.ServerPurgeInterval, s.logger.GetInstance())
+	go runOutgoingDelivery(ctx, serverUsecase, s.cfg.Application.OutgoingDeliveryInterval, s.logger.GetInstance())
 
 	globalLimiter := rate_limiter.NewRateLimiter(10000)
 	grpcConfig := Config{
@@ -111,6 +274,10 @@ func NewServerServer(_ context.Context, s *MainServer) (*ServerServer, error) {
 		UnaryInterceptors: []grpc.UnaryServerInterceptor{
 			middleware.ErrorsUnaryInterceptor(),
 		},
+		StreamInterceptors: []grpc.StreamServerInterceptor{
+			grpc_opentracing.OpenTracingStreamServerInterceptor(opentracing.GlobalTracer()),
+			middleware.ErrorsStreamInterceptor(),
+		},
 	}
 
 	srv.ServerUsecase = serverUsecase
@@ -119,6 +286,7 @@ func NewServerServer(_ context.Context, s *MainServer) (*ServerServer, error) {
 	grpcServerOptions := UnaryInterceptorsToGrpcServerOptions(grpcConfig.UnaryInterceptors...)
 	grpcServerOptions = append(grpcServerOptions,
 		grpc.ChainUnaryInterceptor(grpcConfig.ChainUnaryInterceptors...),
+		grpc.ChainStreamInterceptor(grpcConfig.StreamInterceptors...),
 	)
 
 	grpcServer := grpc.NewServer(grpcServerOptions...)
@@ -177,25 +345,247 @@ func (s *ServerServer) SearchServer(ctx context.Context, req *pb.SearchServerReq
 	}
 
 	result, err := s.ServerUsecase.SearchServer(ctx, usecases.SearchServerRequest{
-		Name: req.GetName(),
+		Name:     req.GetName(),
+		Tags:     req.GetTags(),
+		Category: req.GetCategory(),
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	servers := make([]*pb.ServerInfo, len(result))
-	for i, srv := range result {
+	servers := make([]*pb.ServerInfo, len(result.Servers))
+	for i, srv := range result.Servers {
 		servers[i] = &pb.ServerInfo{
-			Id:   srv.Id.String(),
-			Name: srv.Name,
+			Id:          srv.Id.String(),
+			Name:        srv.Name,
+			Description: srv.Description,
+			IconUrl:     srv.IconUrl,
+			Tags:        srv.Tags,
+			Category:    srv.Category,
+			MemberCount: srv.MemberCount,
 		}
 	}
 
 	return &pb.SearchServerResponse{
-		Servers: servers,
+		Servers:    servers,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func (s *ServerServer) GetServer(ctx context.Context, req *pb.GetServerRequest) (*pb.ServerProfile, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Get server: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.GetServer(ctx, usecases.GetServerRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serverProfileToPb(result), nil
+}
+
+func (s *ServerServer) UpdateServer(ctx context.Context, req *pb.UpdateServerRequest) (*pb.ServerProfile, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Update server: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	request := usecases.UpdateServerRequest{
+		ServerId:      req.GetServerId(),
+		Name:          req.Name,
+		Description:   req.Description,
+		IconUrl:       req.IconUrl,
+		BannerUrl:     req.BannerUrl,
+		Visibility:    req.Visibility,
+		Category:      req.Category,
+		Discoverable:  req.Discoverable,
+		CurrentUserId: userId,
+	}
+	if req.GetTags() != nil {
+		request.Tags = append([]string{}, req.GetTags().GetTags()...)
+	}
+
+	result, err := s.ServerUsecase.UpdateServer(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return serverProfileToPb(result), nil
+}
+
+func (s *ServerServer) DeleteServer(ctx context.Context, req *pb.DeleteServerRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Delete server: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.DeleteServer(ctx, usecases.DeleteServerRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ServerServer) RestoreServer(ctx context.Context, req *pb.RestoreServerRequest) (*pb.ServerProfile, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Restore server: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.RestoreServer(ctx, usecases.RestoreServerRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serverProfileToPb(result), nil
+}
+
+func (s *ServerServer) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.ServerProfile, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Transfer ownership: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.TransferOwnership(ctx, usecases.TransferOwnershipRequest{
+		ServerId:      req.GetServerId(),
+		NewOwnerId:    req.GetNewOwnerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serverProfileToPb(result), nil
+}
+
+func (s *ServerServer) ListServerMembers(ctx context.Context, req *pb.ListServerMembersRequest) (*pb.ListServerMembersResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("List server members: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListServerMembers(ctx, usecases.ListServerMembersRequest{
+		ServerId:      req.GetServerId(),
+		Cursor:        req.GetCursor(),
+		Limit:         int(req.GetLimit()),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*pb.Member, len(result.Members))
+	for i, member := range result.Members {
+		members[i] = memberToPb(member)
+	}
+
+	return &pb.ListServerMembersResponse{
+		Members:    members,
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
+func (s *ServerServer) GetMember(ctx context.Context, req *pb.GetMemberRequest) (*pb.Member, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Get member: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.GetMember(ctx, usecases.GetMemberRequest{
+		ServerId:      req.GetServerId(),
+		UserId:        req.GetUserId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return memberToPb(result), nil
+}
+
+func (s *ServerServer) SetMemberNickname(ctx context.Context, req *pb.SetMemberNicknameRequest) (*pb.Member, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Set member nickname: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.SetMemberNickname(ctx, usecases.SetMemberNicknameRequest{
+		ServerId:      req.GetServerId(),
+		UserId:        req.GetUserId(),
+		Nickname:      req.GetNickname(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return memberToPb(result), nil
+}
+
 func (s *ServerServer) SubscribeServer(ctx context.Context, req *pb.SubscribeServerRequest) (*pb.ActionResponse, error) {
 	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Subscribe server: received")
 
@@ -277,14 +667,41 @@ func (s *ServerServer) InviteUserToServer(ctx context.Context, req *pb.InviteUse
 		return nil, grpcutils.RPCValidationError(err)
 	}
 
-	_, err := auth.GetUserIdFromContext(ctx)
+	userId, err := auth.GetUserIdFromContext(ctx)
 	if err != nil {
 		return nil, models.Unauthenticated
 	}
 
 	result, err := s.ServerUsecase.InviteUserToServer(ctx, usecases.InviteUserToServerRequest{
-		UserId:   req.UserId,
-		ServerId: req.ServerId,
+		UserId:        req.UserId,
+		ServerId:      req.ServerId,
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ServerServer) AddBotToServer(ctx context.Context, req *pb.AddBotToServerRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).WithField("bot id", req.GetBotId()).Info("Add bot to server")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.AddBotToServer(ctx, usecases.AddBotToServerRequest{
+		ServerId:      req.GetServerId(),
+		BotId:         req.GetBotId(),
+		CurrentUserId: userId,
 	})
 	if err != nil {
 		return nil, err
@@ -349,6 +766,12 @@ func (s *ServerServer) GetMessagesFromServer(ctx context.Context, req *pb.GetMes
 			Timestamp: &timestamppb.Timestamp{
 				Seconds: v.Timestamp.Unix(),
 			},
+			OwnerId:        v.OwnerId,
+			AuthorNickname: v.AuthorNickname,
+			WebhookId:      v.WebhookId,
+			Username:       v.Username,
+			AvatarUrl:      v.AvatarUrl,
+			Ephemeral:      v.VisibleTo != "",
 		}
 	}
 
@@ -356,3 +779,182 @@ func (s *ServerServer) GetMessagesFromServer(ctx context.Context, req *pb.GetMes
 		Messages: messages,
 	}, nil
 }
+
+func (s *ServerServer) CreateModerationRule(ctx context.Context, req *pb.CreateModerationRuleRequest) (*pb.ModerationRule, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Create moderation rule")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.CreateModerationRule(ctx, usecases.CreateModerationRuleRequest{
+		ServerId:       req.GetServerId(),
+		Type:           req.GetType(),
+		Words:          req.GetWords(),
+		Pattern:        req.GetPattern(),
+		Limit:          int(req.GetLimit()),
+		Action:         req.GetAction(),
+		TimeoutSeconds: req.GetTimeoutSeconds(),
+		CurrentUserId:  userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moderationRuleToPb(result), nil
+}
+
+func (s *ServerServer) DeleteModerationRule(ctx context.Context, req *pb.DeleteModerationRuleRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("rule id", req.GetRuleId()).Info("Delete moderation rule")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.DeleteModerationRule(ctx, usecases.DeleteModerationRuleRequest{
+		ServerId:      req.GetServerId(),
+		RuleId:        req.GetRuleId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ServerServer) GetModerationRules(ctx context.Context, req *pb.GetModerationRulesRequest) (*pb.GetModerationRulesResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Get moderation rules")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.GetModerationRules(ctx, usecases.GetModerationRulesRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*pb.ModerationRule, len(result))
+	for k, v := range result {
+		rules[k] = moderationRuleToPb(v)
+	}
+
+	return &pb.GetModerationRulesResponse{
+		Rules: rules,
+	}, nil
+}
+
+func (s *ServerServer) GetModerationActions(ctx context.Context, req *pb.GetModerationActionsRequest) (*pb.GetModerationActionsResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Get moderation actions")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.GetModerationActions(ctx, usecases.GetModerationActionsRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]*pb.ModerationAction, len(result))
+	for k, v := range result {
+		actions[k] = &pb.ModerationAction{
+			Id:       v.Id,
+			ServerId: v.ServerId,
+			RuleId:   v.RuleId,
+			RuleType: v.RuleType,
+			Action:   v.Action,
+			AuthorId: v.AuthorId,
+			ChatId:   v.ChatId,
+			Text:     v.Text,
+			CreatedAt: &timestamppb.Timestamp{
+				Seconds: v.CreatedAt.Unix(),
+			},
+		}
+		if !v.ExpiresAt.IsZero() {
+			actions[k].ExpiresAt = &timestamppb.Timestamp{
+				Seconds: v.ExpiresAt.Unix(),
+			}
+		}
+	}
+
+	return &pb.GetModerationActionsResponse{
+		Actions: actions,
+	}, nil
+}
+
+func moderationRuleToPb(rule *models.ModerationRule) *pb.ModerationRule {
+	return &pb.ModerationRule{
+		Id:             rule.Id,
+		ServerId:       rule.ServerId,
+		Type:           rule.Type,
+		Words:          rule.Words,
+		Pattern:        rule.Pattern,
+		Limit:          int32(rule.Limit),
+		Action:         rule.Action,
+		TimeoutSeconds: rule.TimeoutSeconds,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: rule.CreatedAt.Unix(),
+		},
+	}
+}
+
+func serverProfileToPb(server *models.ServerInfo) *pb.ServerProfile {
+	return &pb.ServerProfile{
+		Id:           server.Id.String(),
+		Name:         server.Name,
+		OwnerId:      server.OwnerId.String(),
+		Description:  server.Description,
+		IconUrl:      server.IconUrl,
+		BannerUrl:    server.BannerUrl,
+		Visibility:   server.Visibility,
+		MemberCount:  server.MemberCount,
+		Tags:         server.Tags,
+		Category:     server.Category,
+		Discoverable: server.Discoverable,
+	}
+}
+
+func memberToPb(member *models.Member) *pb.Member {
+	result := &pb.Member{
+		UserId:   member.UserId.String(),
+		IsOwner:  member.IsOwner,
+		Nickname: member.Nickname,
+	}
+	if !member.JoinedAt.IsZero() {
+		result.JoinedAt = &timestamppb.Timestamp{
+			Seconds: member.JoinedAt.Unix(),
+		}
+	}
+
+	return result
+}
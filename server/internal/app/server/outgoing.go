@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ServerServer) CreateOutgoingWebhook(ctx context.Context, req *pb.CreateOutgoingWebhookRequest) (*pb.OutgoingWebhook, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Create outgoing webhook: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.CreateOutgoingWebhook(ctx, usecases.CreateOutgoingWebhookRequest{
+		ServerId:      req.GetServerId(),
+		Url:           req.GetUrl(),
+		Events:        req.GetEvents(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outgoingWebhookToPb(result), nil
+}
+
+func (s *ServerServer) ListOutgoingWebhooks(ctx context.Context, req *pb.ListOutgoingWebhooksRequest) (*pb.ListOutgoingWebhooksResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("List outgoing webhooks: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListOutgoingWebhooks(ctx, usecases.ListOutgoingWebhooksRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*pb.OutgoingWebhook, len(result))
+	for i, webhook := range result {
+		webhooks[i] = outgoingWebhookToPb(webhook)
+	}
+
+	return &pb.ListOutgoingWebhooksResponse{
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (s *ServerServer) EnableOutgoingWebhook(ctx context.Context, req *pb.EnableOutgoingWebhookRequest) (*pb.OutgoingWebhook, error) {
+	s.Log.WithContext(ctx).WithField("webhook id", req.GetWebhookId()).Info("Enable outgoing webhook: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.EnableOutgoingWebhook(ctx, usecases.EnableOutgoingWebhookRequest{
+		ServerId:      req.GetServerId(),
+		WebhookId:     req.GetWebhookId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outgoingWebhookToPb(result), nil
+}
+
+func (s *ServerServer) DeleteOutgoingWebhook(ctx context.Context, req *pb.DeleteOutgoingWebhookRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("webhook id", req.GetWebhookId()).Info("Delete outgoing webhook: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.DeleteOutgoingWebhook(ctx, usecases.DeleteOutgoingWebhookRequest{
+		ServerId:      req.GetServerId(),
+		WebhookId:     req.GetWebhookId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func (s *ServerServer) ListOutgoingDeliveries(ctx context.Context, req *pb.ListOutgoingDeliveriesRequest) (*pb.ListOutgoingDeliveriesResponse, error) {
+	s.Log.WithContext(ctx).WithField("webhook id", req.GetWebhookId()).Info("List outgoing deliveries: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListOutgoingDeliveries(ctx, usecases.ListOutgoingDeliveriesRequest{
+		ServerId:      req.GetServerId(),
+		WebhookId:     req.GetWebhookId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*pb.OutgoingDelivery, len(result))
+	for i, delivery := range result {
+		deliveries[i] = &pb.OutgoingDelivery{
+			Id:             delivery.Id.String(),
+			WebhookId:      delivery.WebhookId.String(),
+			EventId:        delivery.EventId,
+			EventType:      delivery.EventType,
+			Status:         delivery.Status,
+			Attempts:       int64(delivery.Attempts),
+			LastStatusCode: int64(delivery.LastStatusCode),
+			LastError:      delivery.LastError,
+			NextAttemptAt: &timestamppb.Timestamp{
+				Seconds: delivery.NextAttemptAt.Unix(),
+			},
+			CreatedAt: &timestamppb.Timestamp{
+				Seconds: delivery.CreatedAt.Unix(),
+			},
+			UpdatedAt: &timestamppb.Timestamp{
+				Seconds: delivery.UpdatedAt.Unix(),
+			},
+		}
+	}
+
+	return &pb.ListOutgoingDeliveriesResponse{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func outgoingWebhookToPb(webhook *models.OutgoingWebhook) *pb.OutgoingWebhook {
+	result := &pb.OutgoingWebhook{
+		Id:                  webhook.Id.String(),
+		ServerId:            webhook.ServerId.String(),
+		Url:                 webhook.Url,
+		Events:              webhook.Events,
+		Secret:              webhook.Secret,
+		CreatorId:           webhook.CreatorId.String(),
+		Enabled:             webhook.Enabled,
+		ConsecutiveFailures: int64(webhook.ConsecutiveFailures),
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: webhook.CreatedAt.Unix(),
+		},
+	}
+	if !webhook.DisabledAt.IsZero() {
+		result.DisabledAt = &timestamppb.Timestamp{
+			Seconds: webhook.DisabledAt.Unix(),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"time"
+)
+
+// runServerPurge - purges deleted servers with expired grace period until ctx is done
+func runServerPurge(ctx context.Context, serverUsecase usecases.UsecaseInterface, interval time.Duration, l *log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := serverUsecase.PurgeDeletedServers(ctx)
+			if err != nil {
+				l.WithContext(ctx).WithError(err).Error("purge deleted servers error")
+			}
+			if purged > 0 {
+				l.WithContext(ctx).Infof("purged deleted servers: %d", purged)
+			}
+		}
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	config "github.com/Nixonxp/discord/server/configs"
 	"github.com/Nixonxp/discord/server/internal/app/services"
+	channel_svc "github.com/Nixonxp/discord/server/internal/app/services/channel"
 	chat_svc "github.com/Nixonxp/discord/server/internal/app/services/chat"
+	kafka_svc "github.com/Nixonxp/discord/server/internal/app/services/kafka"
+	user_svc "github.com/Nixonxp/discord/server/internal/app/services/user"
 	"github.com/Nixonxp/discord/server/pkg/servers"
 	"sync"
 	"time"
@@ -20,12 +23,15 @@ type Server interface {
 var terminationTimeout = time.Second * 10
 
 type MainServer struct {
-	tracer        services.Tracing
-	logger        services.Logger
-	mongo         services.Mongo
-	chatSvcClient chat_svc.ChatClient
-	servers       []Server
-	cfg           *config.Config
+	tracer           services.Tracing
+	logger           services.Logger
+	mongo            services.Mongo
+	chatSvcClient    chat_svc.ChatClient
+	channelSvcClient channel_svc.ChannelClient
+	userSvcClient    user_svc.UserClient
+	serverEvents     kafka_svc.KafkaServerEventsProducer
+	servers          []Server
+	cfg              *config.Config
 }
 
 func (s *MainServer) AddServer(srv Server) {
@@ -72,5 +78,10 @@ func (s *MainServer) AppStart(ctx context.Context, cfg *config.Config) error {
 	}
 	wg.Wait()
 
+	// interactions accepted before the grpc server stopped are still sent to command handlers
+	if err := srv.ServerUsecase.WaitDispatches(shutdownCtx); err != nil {
+		s.logger.GetInstance().WithError(err).Info("failed to dispatch interactions before shutdown")
+	}
+
 	return nil
 }
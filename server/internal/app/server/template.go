@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ServerServer) CreateServerTemplate(ctx context.Context, req *pb.CreateServerTemplateRequest) (*pb.ServerTemplate, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Create server template: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.CreateServerTemplate(ctx, usecases.CreateServerTemplateRequest{
+		ServerId:      req.GetServerId(),
+		Name:          req.GetName(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templateToPb(result), nil
+}
+
+func (s *ServerServer) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	s.Log.WithContext(ctx).Info("List templates: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListTemplates(ctx, usecases.ListTemplatesRequest{
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*pb.ServerTemplate, len(result))
+	for i, template := range result {
+		templates[i] = templateToPb(template)
+	}
+
+	return &pb.ListTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+func (s *ServerServer) CreateServerFromTemplate(ctx context.Context, req *pb.CreateServerFromTemplateRequest) (*pb.ServerProfile, error) {
+	s.Log.WithContext(ctx).WithField("template id", req.GetTemplateId()).Info("Create server from template: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.CreateServerFromTemplate(ctx, usecases.CreateServerFromTemplateRequest{
+		TemplateId:    req.GetTemplateId(),
+		Name:          req.GetName(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serverProfileToPb(result), nil
+}
+
+func templateToPb(template *models.ServerTemplate) *pb.ServerTemplate {
+	channels := make([]string, len(template.Channels))
+	for i, channel := range template.Channels {
+		channels[i] = channel.Name
+	}
+
+	return &pb.ServerTemplate{
+		Id:             template.Id.String(),
+		Name:           template.Name,
+		SourceServerId: template.SourceServerId.String(),
+		OwnerId:        template.OwnerId.String(),
+		Description:    template.Description,
+		IconUrl:        template.IconUrl,
+		BannerUrl:      template.BannerUrl,
+		Visibility:     template.Visibility,
+		Tags:           template.Tags,
+		Category:       template.Category,
+		Channels:       channels,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: template.CreatedAt.Unix(),
+		},
+	}
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/server/pkg/api/v1"
+	"github.com/Nixonxp/discord/server/pkg/auth"
+	grpcutils "github.com/Nixonxp/discord/server/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ServerServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("Create webhook: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.CreateWebhook(ctx, usecases.CreateWebhookRequest{
+		ServerId:      req.GetServerId(),
+		Name:          req.GetName(),
+		AvatarUrl:     req.GetAvatarUrl(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookToPb(result), nil
+}
+
+func (s *ServerServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	s.Log.WithContext(ctx).WithField("server id", req.GetServerId()).Info("List webhooks: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.ListWebhooks(ctx, usecases.ListWebhooksRequest{
+		ServerId:      req.GetServerId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*pb.Webhook, len(result))
+	for i, webhook := range result {
+		webhooks[i] = webhookToPb(webhook)
+	}
+
+	return &pb.ListWebhooksResponse{
+		Webhooks: webhooks,
+	}, nil
+}
+
+func (s *ServerServer) RegenerateWebhookToken(ctx context.Context, req *pb.RegenerateWebhookTokenRequest) (*pb.Webhook, error) {
+	s.Log.WithContext(ctx).WithField("webhook id", req.GetWebhookId()).Info("Regenerate webhook token: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.RegenerateWebhookToken(ctx, usecases.RegenerateWebhookTokenRequest{
+		ServerId:      req.GetServerId(),
+		WebhookId:     req.GetWebhookId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookToPb(result), nil
+}
+
+func (s *ServerServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("webhook id", req.GetWebhookId()).Info("Delete webhook: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	userId, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.ServerUsecase.DeleteWebhook(ctx, usecases.DeleteWebhookRequest{
+		ServerId:      req.GetServerId(),
+		WebhookId:     req.GetWebhookId(),
+		CurrentUserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+// ExecuteWebhook - no user in the context, the token is checked by the usecase
+func (s *ServerServer) ExecuteWebhook(ctx context.Context, req *pb.ExecuteWebhookRequest) (*pb.ActionResponse, error) {
+	s.Log.WithContext(ctx).WithField("webhook id", req.GetWebhookId()).Info("Execute webhook: received")
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.ServerUsecase.ExecuteWebhook(ctx, usecases.ExecuteWebhookRequest{
+		WebhookId: req.GetWebhookId(),
+		Token:     req.GetToken(),
+		Content:   req.GetContent(),
+		Username:  req.GetUsername(),
+		AvatarUrl: req.GetAvatarUrl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func webhookToPb(webhook *models.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:        webhook.Id.String(),
+		ServerId:  webhook.ServerId.String(),
+		Name:      webhook.Name,
+		AvatarUrl: webhook.AvatarUrl,
+		CreatorId: webhook.CreatorId.String(),
+		Token:     webhook.Token,
+		CreatedAt: &timestamppb.Timestamp{
+			Seconds: webhook.CreatedAt.Unix(),
+		},
+	}
+}
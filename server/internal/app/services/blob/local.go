@@ -0,0 +1,113 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	dirPerm  = 0o750
+	filePerm = 0o640
+)
+
+// LocalStore - keeps blobs as files under the root directory
+type LocalStore struct {
+	root string
+}
+
+var _ usecases.BlobStore = (*LocalStore)(nil)
+
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, dirPerm); err != nil {
+		return nil, fmt.Errorf("create blob root: %w", err)
+	}
+
+	return &LocalStore{
+		root: root,
+	}, nil
+}
+
+// Put - the file is written next to its final path and renamed, readers never see a partial blob
+func (s *LocalStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("create blob dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return fmt.Errorf("create blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err := tmp.Chmod(filePerm); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write blob: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("store blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, models.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("read blob: %w", err)
+	}
+
+	return data, nil
+}
+
+// Delete - deleting a missing blob is not an error
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// path - keys can not leave the root directory
+func (s *LocalStore) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q: %w", key, models.ErrInvalidArgument)
+	}
+
+	return filepath.Join(s.root, clean), nil
+}
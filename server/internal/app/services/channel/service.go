@@ -0,0 +1,115 @@
+package channel
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/pkg/api/channel"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/metadata"
+)
+
+// ListServerChannels - lists on behalf of the user, the channel service checks that the user is a member of the server
+func (s *ChannelClient) ListServerChannels(ctx context.Context, serverId string, userId string) ([]*models.Channel, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.ListServerChannels")
+	defer span.Finish()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "userId", userId)
+	response, err := s.client.ListServerChannels(ctx, &channel.ListServerChannelsRequest{
+		ServerId: serverId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channelsFromPb(response.GetChannels()), nil
+}
+
+func (s *ChannelClient) CreateServerChannels(ctx context.Context, req usecases.CreateServerChannelsRequest) ([]*models.Channel, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.CreateServerChannels")
+	defer span.Finish()
+
+	response, err := s.client.CreateServerChannels(ctx, &channel.CreateServerChannelsRequest{
+		ServerId: req.ServerId,
+		OwnerId:  req.OwnerId,
+		Channels: templateChannelsToPb(req.Channels),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channelsFromPb(response.GetChannels()), nil
+}
+
+func (s *ChannelClient) DeleteServerChannels(ctx context.Context, serverId string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "channel_service.DeleteServerChannels")
+	defer span.Finish()
+
+	_, err := s.client.DeleteServerChannels(ctx, &channel.DeleteServerChannelsRequest{
+		ServerId: serverId,
+	})
+
+	return err
+}
+
+func channelsFromPb(channels []*channel.Channel) []*models.Channel {
+	result := make([]*models.Channel, len(channels))
+	for i, c := range channels {
+		result[i] = &models.Channel{
+			Id:       c.GetId(),
+			Name:     c.GetName(),
+			OwnerId:  c.GetOwnerId(),
+			ServerId: c.GetServerId(),
+			Type:     c.GetType(),
+			ParentId: c.GetParentId(),
+			Topic:    c.GetTopic(),
+			Nsfw:     c.GetNsfw(),
+			Private:  c.GetPrivate(),
+		}
+		if c.GetVoice() != nil {
+			result[i].Voice = &models.ChannelVoiceSettings{
+				Bitrate:   int(c.GetVoice().GetBitrate()),
+				UserLimit: int(c.GetVoice().GetUserLimit()),
+			}
+		}
+		if c.GetForum() != nil {
+			result[i].Forum = &models.ChannelForumSettings{
+				DefaultSort: c.GetForum().GetDefaultSort(),
+				Tags:        c.GetForum().GetTags(),
+			}
+		}
+	}
+
+	return result
+}
+
+func templateChannelsToPb(channels []models.TemplateChannel) []*channel.ServerChannel {
+	result := make([]*channel.ServerChannel, len(channels))
+	for i, c := range channels {
+		result[i] = &channel.ServerChannel{
+			Name:    c.Name,
+			Type:    c.Type,
+			Topic:   c.Topic,
+			Nsfw:    c.Nsfw,
+			Private: c.Private,
+		}
+		if c.ParentIndex != nil {
+			parentIndex := int32(*c.ParentIndex)
+			result[i].ParentIndex = &parentIndex
+		}
+		if c.Voice != nil {
+			result[i].Voice = &channel.VoiceSettings{
+				Bitrate:   int32(c.Voice.Bitrate),
+				UserLimit: int32(c.Voice.UserLimit),
+			}
+		}
+		if c.Forum != nil {
+			result[i].Forum = &channel.ForumSettings{
+				DefaultSort: c.Forum.DefaultSort,
+				Tags:        c.Forum.Tags,
+			}
+		}
+	}
+
+	return result
+}
@@ -40,6 +40,9 @@ func (s *ChatClient) Init(ctx context.Context, cfg *config.Config) error {
 			ratelimitCustom.UnaryClientInterceptor(ratelimitCustom.NewLimiter(10000)),
 			grpc_opentracing.OpenTracingClientInterceptor(opentracing.GlobalTracer(), grpc_opentracing.LogPayloads()),
 		),
+		grpc.WithChainStreamInterceptor(
+			grpc_opentracing.OpenTracingStreamClientInterceptor(opentracing.GlobalTracer()),
+		),
 		grpc.WithInsecure())
 	if err != nil {
 		return err
@@ -0,0 +1,47 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/pkg/api/chat"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+)
+
+// ExportChat - copies exported server chat stream into w
+func (s *ChatClient) ExportChat(ctx context.Context, req usecases.ExportServerChatRequest, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.ExportChat")
+	defer span.Finish()
+
+	request := &chat.ExportChatRequest{
+		ServerId: req.ServerId,
+		Format:   req.Format,
+	}
+	if !req.From.IsZero() {
+		request.From = timestamppb.New(req.From)
+	}
+	if !req.To.IsZero() {
+		request.To = timestamppb.New(req.To)
+	}
+
+	stream, err := s.client.ExportChat(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package chat
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/pkg/api/chat"
+	"github.com/opentracing/opentracing-go"
+	"time"
+)
+
+func (s *ChatClient) CreateModerationRule(ctx context.Context, req usecases.CreateModerationRuleRequest) (*models.ModerationRule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.CreateModerationRule")
+	defer span.Finish()
+	response, err := s.client.CreateModerationRule(ctx, &chat.CreateModerationRuleRequest{
+		ServerId:       req.ServerId,
+		Type:           req.Type,
+		Words:          req.Words,
+		Pattern:        req.Pattern,
+		Limit:          int32(req.Limit),
+		Action:         req.Action,
+		TimeoutSeconds: req.TimeoutSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moderationRuleFromPb(response), nil
+}
+
+func (s *ChatClient) DeleteModerationRule(ctx context.Context, req usecases.DeleteModerationRuleRequest) (*models.ActionInfo, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.DeleteModerationRule")
+	defer span.Finish()
+	response, err := s.client.DeleteModerationRule(ctx, &chat.DeleteModerationRuleRequest{
+		ServerId: req.ServerId,
+		RuleId:   req.RuleId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ActionInfo{
+		Success: response.GetSuccess(),
+	}, nil
+}
+
+func (s *ChatClient) GetModerationRules(ctx context.Context, req usecases.GetModerationRulesRequest) ([]*models.ModerationRule, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.GetModerationRules")
+	defer span.Finish()
+	response, err := s.client.GetModerationRules(ctx, &chat.GetModerationRulesRequest{
+		ServerId: req.ServerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*models.ModerationRule, len(response.GetRules()))
+	for k, v := range response.GetRules() {
+		rules[k] = moderationRuleFromPb(v)
+	}
+
+	return rules, nil
+}
+
+func (s *ChatClient) GetModerationActions(ctx context.Context, req usecases.GetModerationActionsRequest) ([]*models.ModerationAction, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.GetModerationActions")
+	defer span.Finish()
+	response, err := s.client.GetModerationActions(ctx, &chat.GetModerationActionsRequest{
+		ServerId: req.ServerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]*models.ModerationAction, len(response.GetActions()))
+	for k, v := range response.GetActions() {
+		actions[k] = &models.ModerationAction{
+			Id:        v.GetId(),
+			ServerId:  v.GetServerId(),
+			RuleId:    v.GetRuleId(),
+			RuleType:  v.GetRuleType(),
+			Action:    v.GetAction(),
+			AuthorId:  v.GetAuthorId(),
+			ChatId:    v.GetChatId(),
+			Text:      v.GetText(),
+			CreatedAt: time.Unix(v.GetCreatedAt().GetSeconds(), int64(v.GetCreatedAt().GetNanos())),
+		}
+		if v.GetExpiresAt() != nil {
+			actions[k].ExpiresAt = time.Unix(v.GetExpiresAt().GetSeconds(), int64(v.GetExpiresAt().GetNanos()))
+		}
+	}
+
+	return actions, nil
+}
+
+func moderationRuleFromPb(rule *chat.ModerationRule) *models.ModerationRule {
+	return &models.ModerationRule{
+		Id:             rule.GetId(),
+		ServerId:       rule.GetServerId(),
+		Type:           rule.GetType(),
+		Words:          rule.GetWords(),
+		Pattern:        rule.GetPattern(),
+		Limit:          int(rule.GetLimit()),
+		Action:         rule.GetAction(),
+		TimeoutSeconds: rule.GetTimeoutSeconds(),
+		CreatedAt:      time.Unix(rule.GetCreatedAt().GetSeconds(), int64(rule.GetCreatedAt().GetNanos())),
+	}
+}
@@ -13,8 +13,13 @@ func (s *ChatClient) SendServerMessage(ctx context.Context, msg usecases.Publish
 	span, ctx := opentracing.StartSpanFromContext(ctx, "chat_service.SendServerMessage")
 	defer span.Finish()
 	response, err := s.client.SendServerMessage(ctx, &chat.SendServerMessageRequest{
-		ServerId: msg.ServerId,
-		Text:     msg.Text,
+		ServerId:  msg.ServerId,
+		Text:      msg.Text,
+		UserId:    msg.CurrentUserId,
+		WebhookId: msg.WebhookId,
+		Username:  msg.Username,
+		AvatarUrl: msg.AvatarUrl,
+		VisibleTo: msg.VisibleTo,
 	})
 	if err != nil {
 		return nil, err
@@ -30,6 +35,7 @@ func (s *ChatClient) GetServerMessages(ctx context.Context, msg usecases.GetMess
 	defer span.Finish()
 	response, err := s.client.GetServerMessages(ctx, &chat.GetServerMessagesRequest{
 		ServerId: msg.ServerId,
+		UserId:   msg.CurrentUserId,
 	})
 	if err != nil {
 		return nil, err
@@ -40,7 +46,12 @@ func (s *ChatClient) GetServerMessages(ctx context.Context, msg usecases.GetMess
 		messages[k] = &models.Message{
 			Id:        v.Id,
 			Text:      v.Text,
+			OwnerId:   v.GetOwnerId(),
 			Timestamp: time.Unix(v.GetTimestamp().Seconds, int64(v.GetTimestamp().GetNanos())),
+			WebhookId: v.GetWebhookId(),
+			Username:  v.GetUsername(),
+			AvatarUrl: v.GetAvatarUrl(),
+			VisibleTo: v.GetVisibleTo(),
 		}
 	}
 
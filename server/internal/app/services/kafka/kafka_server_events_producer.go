@@ -0,0 +1,42 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	config "github.com/Nixonxp/discord/server/configs"
+	"github.com/Nixonxp/discord/server/internal/app/queue"
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaServerEventsProducer struct {
+	conn   *kafka.Conn
+	events *queue.KafkaServerEvents
+}
+
+func (k *KafkaServerEventsProducer) Init(ctx context.Context, cfg *config.Config) error {
+	var err error
+	k.conn, err = kafka.DialLeader(ctx, "tcp", cfg.Application.KafkaAddress, cfg.Application.KafkaServerEventsTopic, 0)
+	if err != nil {
+		return fmt.Errorf("failed to dial leader: %w", err)
+	}
+
+	k.events = queue.NewKafkaServerEvents(k.conn)
+
+	return nil
+}
+
+func (k *KafkaServerEventsProducer) GetInstance() *queue.KafkaServerEvents {
+	return k.events
+}
+
+func (k *KafkaServerEventsProducer) Ident() string {
+	return "kafka server events producer"
+}
+
+func (k *KafkaServerEventsProducer) Close(_ context.Context) error {
+	err := k.conn.Close()
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package outgoing
+
+import (
+	"context"
+	"fmt"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	userAgent = "discord-server-webhooks/1.0"
+
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// HttpSender - posts signed events to the callback urls
+type HttpSender struct {
+	client *http.Client
+}
+
+var _ usecases.OutgoingSenderInterface = (*HttpSender)(nil)
+
+func NewHttpSender(timeout time.Duration) *HttpSender {
+	return &HttpSender{
+		client: &http.Client{
+			Timeout: timeout,
+			// a redirect could move the signed payload to another host
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send - returns the response status code, any status except 2xx is an error
+func (s *HttpSender) Send(ctx context.Context, req models.OutgoingRequest) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.Url, strings.NewReader(req.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryId)
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(req.Timestamp.Unix(), 10))
+	httpReq.Header.Set(SignatureHeader, models.SignOutgoingPayload(req.Secret, req.Timestamp, req.Payload))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// the body is drained so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/pkg/api/user"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserClient) GetBot(ctx context.Context, botId string) (*models.Bot, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user_service.GetBot")
+	defer span.Finish()
+
+	response, err := s.client.GetBot(ctx, &user.GetBotRequest{
+		BotId: botId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+
+	id, err := uuid.Parse(response.GetId())
+	if err != nil {
+		return nil, err
+	}
+	ownerId, err := uuid.Parse(response.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Bot{
+		Id:      models.UserID(id),
+		OwnerId: models.UserID(ownerId),
+		Public:  response.GetPublic(),
+	}, nil
+}
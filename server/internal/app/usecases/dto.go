@@ -1,12 +1,77 @@
 package usecases
 
+import (
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"time"
+)
+
 type CreateServerRequest struct {
 	Name          string
 	CurrentUserId string
 }
 
 type SearchServerRequest struct {
-	Name string
+	Name     string
+	Tags     []string
+	Category string
+	Cursor   string
+	Limit    int
+}
+
+type GetServerRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+// UpdateServerRequest - nil fields are left unchanged, empty non nil Tags clear tags
+type UpdateServerRequest struct {
+	ServerId      string
+	Name          *string
+	Description   *string
+	IconUrl       *string
+	BannerUrl     *string
+	Visibility    *string
+	Tags          []string
+	Category      *string
+	Discoverable  *bool
+	CurrentUserId string
+}
+
+type DeleteServerRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type RestoreServerRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type TransferOwnershipRequest struct {
+	ServerId      string
+	NewOwnerId    string
+	CurrentUserId string
+}
+
+type ListServerMembersRequest struct {
+	ServerId      string
+	Cursor        string
+	Limit         int
+	CurrentUserId string
+}
+
+type GetMemberRequest struct {
+	ServerId      string
+	UserId        string
+	CurrentUserId string
+}
+
+// SetMemberNicknameRequest - empty Nickname removes the nickname
+type SetMemberNicknameRequest struct {
+	ServerId      string
+	UserId        string
+	Nickname      string
+	CurrentUserId string
 }
 
 type SubscribeServerRequest struct {
@@ -24,17 +89,233 @@ type SearchServerByUserIdRequest struct {
 }
 
 type InviteUserToServerRequest struct {
-	UserId   string
-	ServerId string
+	UserId        string
+	ServerId      string
+	CurrentUserId string
+}
+
+type AddBotToServerRequest struct {
+	ServerId      string
+	BotId         string
+	CurrentUserId string
 }
 
 type PublishMessageOnServerRequest struct {
 	ServerId      string
 	Text          string
 	CurrentUserId string
+	// WebhookId, Username and AvatarUrl are set for webhook messages, CurrentUserId holds the webhook id then
+	WebhookId string
+	Username  string
+	AvatarUrl string
+	// VisibleTo makes the message ephemeral
+	VisibleTo string
 }
 
 type GetMessagesFromServerRequest struct {
 	ServerId      string
 	CurrentUserId string
 }
+
+type CreateModerationRuleRequest struct {
+	ServerId       string
+	Type           string
+	Words          []string
+	Pattern        string
+	Limit          int
+	Action         string
+	TimeoutSeconds int64
+	CurrentUserId  string
+}
+
+type DeleteModerationRuleRequest struct {
+	ServerId      string
+	RuleId        string
+	CurrentUserId string
+}
+
+type GetModerationRulesRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type GetModerationActionsRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type ExportServerChatRequest struct {
+	ServerId      string
+	Format        string
+	From          time.Time
+	To            time.Time
+	CurrentUserId string
+}
+
+type GetAuditLogRequest struct {
+	ServerId      string
+	ActorId       string
+	Action        string
+	Cursor        string
+	Limit         int
+	CurrentUserId string
+}
+
+// RecordAuditEntryRequest - action made in another service, e.g. channel create
+type RecordAuditEntryRequest struct {
+	ServerId   string
+	ActorId    string
+	Action     string
+	TargetType string
+	TargetId   string
+	Before     map[string]string
+	After      map[string]string
+}
+
+type CreateServerTemplateRequest struct {
+	ServerId      string
+	Name          string
+	CurrentUserId string
+}
+
+type ListTemplatesRequest struct {
+	CurrentUserId string
+}
+
+type CreateServerFromTemplateRequest struct {
+	TemplateId    string
+	Name          string
+	CurrentUserId string
+}
+
+type CreateWebhookRequest struct {
+	ServerId      string
+	Name          string
+	AvatarUrl     string
+	CurrentUserId string
+}
+
+type ListWebhooksRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type RegenerateWebhookTokenRequest struct {
+	ServerId      string
+	WebhookId     string
+	CurrentUserId string
+}
+
+type DeleteWebhookRequest struct {
+	ServerId      string
+	WebhookId     string
+	CurrentUserId string
+}
+
+// ExecuteWebhookRequest - public call authorized by the webhook token, empty overrides fall back to the webhook
+type ExecuteWebhookRequest struct {
+	WebhookId string
+	Token     string
+	Content   string
+	Username  string
+	AvatarUrl string
+}
+
+type CreateOutgoingWebhookRequest struct {
+	ServerId      string
+	Url           string
+	Events        []string
+	CurrentUserId string
+}
+
+type ListOutgoingWebhooksRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type EnableOutgoingWebhookRequest struct {
+	ServerId      string
+	WebhookId     string
+	CurrentUserId string
+}
+
+type DeleteOutgoingWebhookRequest struct {
+	ServerId      string
+	WebhookId     string
+	CurrentUserId string
+}
+
+type ListOutgoingDeliveriesRequest struct {
+	ServerId      string
+	WebhookId     string
+	CurrentUserId string
+}
+
+type CreateServerChannelsRequest struct {
+	ServerId string
+	OwnerId  string
+	Channels []models.TemplateChannel
+}
+
+type CommandOption struct {
+	Name        string
+	Description string
+	Type        string
+	Required    bool
+}
+
+type CreateCommandRequest struct {
+	ServerId      string
+	Name          string
+	Description   string
+	Options       []CommandOption
+	HandlerUrl    string
+	CurrentUserId string
+}
+
+type ListCommandsRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type DeleteCommandRequest struct {
+	ServerId      string
+	CommandId     string
+	CurrentUserId string
+}
+
+type RespondInteractionRequest struct {
+	InteractionId string
+	Token         string
+	Content       string
+	Ephemeral     bool
+}
+
+type CreateEmojiRequest struct {
+	ServerId      string
+	Name          string
+	Image         []byte
+	CurrentUserId string
+}
+
+type ListServerEmojiRequest struct {
+	ServerId      string
+	CurrentUserId string
+}
+
+type RenameEmojiRequest struct {
+	ServerId      string
+	EmojiId       string
+	Name          string
+	CurrentUserId string
+}
+
+type DeleteEmojiRequest struct {
+	ServerId      string
+	EmojiId       string
+	CurrentUserId string
+}
+
+type GetEmojiImageRequest struct {
+	EmojiId string
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	models "github.com/Nixonxp/discord/server/internal/app/models"
 	mock "github.com/stretchr/testify/mock"
@@ -32,6 +33,84 @@ func (_m *ServerStorage) CreateServer(ctx context.Context, server models.ServerI
 	return r0
 }
 
+// DeleteServer provides a mock function with given fields: ctx, id
+func (_m *ServerStorage) DeleteServer(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDeletedIds provides a mock function with given fields: ctx, ids
+func (_m *ServerStorage) GetDeletedIds(ctx context.Context, ids []models.ServerID) ([]models.ServerID, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedIds")
+	}
+
+	var r0 []models.ServerID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ServerID) ([]models.ServerID, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.ServerID) []models.ServerID); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ServerID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.ServerID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedServerById provides a mock function with given fields: ctx, id
+func (_m *ServerStorage) GetDeletedServerById(ctx context.Context, id string) (*models.ServerInfo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedServerById")
+	}
+
+	var r0 *models.ServerInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.ServerInfo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.ServerInfo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ServerInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetServerById provides a mock function with given fields: ctx, id
 func (_m *ServerStorage) GetServerById(ctx context.Context, id string) (*models.ServerInfo, error) {
 	ret := _m.Called(ctx, id)
@@ -62,9 +141,123 @@ func (_m *ServerStorage) GetServerById(ctx context.Context, id string) (*models.
 	return r0, r1
 }
 
-// SearchServers provides a mock function with given fields: ctx, serverName
-func (_m *ServerStorage) SearchServers(ctx context.Context, serverName string) ([]*models.ServerInfo, error) {
-	ret := _m.Called(ctx, serverName)
+// IncMemberCount provides a mock function with given fields: ctx, id, delta
+func (_m *ServerStorage) IncMemberCount(ctx context.Context, id models.ServerID, delta int64) error {
+	ret := _m.Called(ctx, id, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncMemberCount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, int64) error); ok {
+		r0 = rf(ctx, id, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListDeletedBefore provides a mock function with given fields: ctx, before, limit
+func (_m *ServerStorage) ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.ServerInfo, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeletedBefore")
+	}
+
+	var r0 []*models.ServerInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*models.ServerInfo, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*models.ServerInfo); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ServerInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServers provides a mock function with given fields: ctx, after, limit
+func (_m *ServerStorage) ListServers(ctx context.Context, after *models.ServerID, limit int) ([]*models.ServerInfo, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListServers")
+	}
+
+	var r0 []*models.ServerInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ServerID, int) ([]*models.ServerInfo, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ServerID, int) []*models.ServerInfo); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ServerInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.ServerID, int) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkDeleted provides a mock function with given fields: ctx, id, at
+func (_m *ServerStorage) MarkDeleted(ctx context.Context, id models.ServerID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreServer provides a mock function with given fields: ctx, id
+func (_m *ServerStorage) RestoreServer(ctx context.Context, id models.ServerID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreServer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchServers provides a mock function with given fields: ctx, filter
+func (_m *ServerStorage) SearchServers(ctx context.Context, filter models.SearchServersFilter) ([]*models.ServerInfo, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SearchServers")
@@ -72,19 +265,19 @@ func (_m *ServerStorage) SearchServers(ctx context.Context, serverName string) (
 
 	var r0 []*models.ServerInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.ServerInfo, error)); ok {
-		return rf(ctx, serverName)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SearchServersFilter) ([]*models.ServerInfo, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.ServerInfo); ok {
-		r0 = rf(ctx, serverName)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SearchServersFilter) []*models.ServerInfo); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.ServerInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, serverName)
+	if rf, ok := ret.Get(1).(func(context.Context, models.SearchServersFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -92,6 +285,78 @@ func (_m *ServerStorage) SearchServers(ctx context.Context, serverName string) (
 	return r0, r1
 }
 
+// SetMemberCount provides a mock function with given fields: ctx, id, count
+func (_m *ServerStorage) SetMemberCount(ctx context.Context, id models.ServerID, count int64) error {
+	ret := _m.Called(ctx, id, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMemberCount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, int64) error); ok {
+		r0 = rf(ctx, id, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TouchServerActivity provides a mock function with given fields: ctx, id, at
+func (_m *ServerStorage) TouchServerActivity(ctx context.Context, id models.ServerID, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchServerActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateOwner provides a mock function with given fields: ctx, id, from, to
+func (_m *ServerStorage) UpdateOwner(ctx context.Context, id models.ServerID, from models.UserID, to models.UserID) error {
+	ret := _m.Called(ctx, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOwner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, models.UserID, models.UserID) error); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateServerProfile provides a mock function with given fields: ctx, id, update
+func (_m *ServerStorage) UpdateServerProfile(ctx context.Context, id models.ServerID, update models.ServerProfileUpdate) error {
+	ret := _m.Called(ctx, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateServerProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, models.ServerProfileUpdate) error); ok {
+		r0 = rf(ctx, id, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewServerStorage creates a new instance of ServerStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewServerStorage(t interface {
@@ -4,11 +4,11 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 
 	models "github.com/Nixonxp/discord/server/internal/app/models"
-	mock "github.com/stretchr/testify/mock"
-
 	usecases "github.com/Nixonxp/discord/server/internal/app/usecases"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ServiceChatInterface is an autogenerated mock type for the ServiceChatInterface type
@@ -16,6 +16,144 @@ type ServiceChatInterface struct {
 	mock.Mock
 }
 
+// CreateModerationRule provides a mock function with given fields: ctx, req
+func (_m *ServiceChatInterface) CreateModerationRule(ctx context.Context, req usecases.CreateModerationRuleRequest) (*models.ModerationRule, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateModerationRule")
+	}
+
+	var r0 *models.ModerationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateModerationRuleRequest) (*models.ModerationRule, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.CreateModerationRuleRequest) *models.ModerationRule); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ModerationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.CreateModerationRuleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteModerationRule provides a mock function with given fields: ctx, req
+func (_m *ServiceChatInterface) DeleteModerationRule(ctx context.Context, req usecases.DeleteModerationRuleRequest) (*models.ActionInfo, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteModerationRule")
+	}
+
+	var r0 *models.ActionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DeleteModerationRuleRequest) (*models.ActionInfo, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.DeleteModerationRuleRequest) *models.ActionInfo); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ActionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.DeleteModerationRuleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportChat provides a mock function with given fields: ctx, req, w
+func (_m *ServiceChatInterface) ExportChat(ctx context.Context, req usecases.ExportServerChatRequest, w io.Writer) error {
+	ret := _m.Called(ctx, req, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.ExportServerChatRequest, io.Writer) error); ok {
+		r0 = rf(ctx, req, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetModerationActions provides a mock function with given fields: ctx, req
+func (_m *ServiceChatInterface) GetModerationActions(ctx context.Context, req usecases.GetModerationActionsRequest) ([]*models.ModerationAction, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModerationActions")
+	}
+
+	var r0 []*models.ModerationAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetModerationActionsRequest) ([]*models.ModerationAction, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetModerationActionsRequest) []*models.ModerationAction); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ModerationAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetModerationActionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetModerationRules provides a mock function with given fields: ctx, req
+func (_m *ServiceChatInterface) GetModerationRules(ctx context.Context, req usecases.GetModerationRulesRequest) ([]*models.ModerationRule, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModerationRules")
+	}
+
+	var r0 []*models.ModerationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetModerationRulesRequest) ([]*models.ModerationRule, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, usecases.GetModerationRulesRequest) []*models.ModerationRule); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ModerationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, usecases.GetModerationRulesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetServerMessages provides a mock function with given fields: ctx, msg
 func (_m *ServiceChatInterface) GetServerMessages(ctx context.Context, msg usecases.GetMessagesFromServerRequest) (*models.GetMessagesInfo, error) {
 	ret := _m.Called(ctx, msg)
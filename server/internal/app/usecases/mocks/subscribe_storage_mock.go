@@ -14,6 +14,34 @@ type SubscribeStorage struct {
 	mock.Mock
 }
 
+// CountByServerId provides a mock function with given fields: ctx, serverId
+func (_m *SubscribeStorage) CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error) {
+	ret := _m.Called(ctx, serverId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByServerId")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) (int64, error)); ok {
+		return rf(ctx, serverId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) int64); ok {
+		r0 = rf(ctx, serverId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID) error); ok {
+		r1 = rf(ctx, serverId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSubscribe provides a mock function with given fields: ctx, server
 func (_m *SubscribeStorage) CreateSubscribe(ctx context.Context, server models.SubscribeInfo) error {
 	ret := _m.Called(ctx, server)
@@ -32,6 +60,52 @@ func (_m *SubscribeStorage) CreateSubscribe(ctx context.Context, server models.S
 	return r0
 }
 
+// DeleteByIds provides a mock function with given fields: ctx, ids
+func (_m *SubscribeStorage) DeleteByIds(ctx context.Context, ids []models.SubscribeID) (int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIds")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.SubscribeID) (int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.SubscribeID) int64); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.SubscribeID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByServerId provides a mock function with given fields: ctx, serverId
+func (_m *SubscribeStorage) DeleteByServerId(ctx context.Context, serverId models.ServerID) error {
+	ret := _m.Called(ctx, serverId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByServerId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID) error); ok {
+		r0 = rf(ctx, serverId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSubscribe provides a mock function with given fields: ctx, serverId, userId
 func (_m *SubscribeStorage) DeleteSubscribe(ctx context.Context, serverId models.ServerID, userId models.UserID) error {
 	ret := _m.Called(ctx, serverId, userId)
@@ -50,6 +124,36 @@ func (_m *SubscribeStorage) DeleteSubscribe(ctx context.Context, serverId models
 	return r0
 }
 
+// FindDuplicates provides a mock function with given fields: ctx
+func (_m *SubscribeStorage) FindDuplicates(ctx context.Context) ([]models.SubscribeID, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicates")
+	}
+
+	var r0 []models.SubscribeID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.SubscribeID, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.SubscribeID); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SubscribeID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByUserId provides a mock function with given fields: ctx, userId
 func (_m *SubscribeStorage) GetByUserId(ctx context.Context, userId models.UserID) ([]*models.SubscribeInfo, error) {
 	ret := _m.Called(ctx, userId)
@@ -80,6 +184,114 @@ func (_m *SubscribeStorage) GetByUserId(ctx context.Context, userId models.UserI
 	return r0, r1
 }
 
+// GetByUserIds provides a mock function with given fields: ctx, serverId, userIds
+func (_m *SubscribeStorage) GetByUserIds(ctx context.Context, serverId models.ServerID, userIds []models.UserID) ([]*models.SubscribeInfo, error) {
+	ret := _m.Called(ctx, serverId, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserIds")
+	}
+
+	var r0 []*models.SubscribeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, []models.UserID) ([]*models.SubscribeInfo, error)); ok {
+		return rf(ctx, serverId, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, []models.UserID) []*models.SubscribeInfo); ok {
+		r0 = rf(ctx, serverId, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SubscribeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID, []models.UserID) error); ok {
+		r1 = rf(ctx, serverId, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubscribe provides a mock function with given fields: ctx, serverId, userId
+func (_m *SubscribeStorage) GetSubscribe(ctx context.Context, serverId models.ServerID, userId models.UserID) (*models.SubscribeInfo, error) {
+	ret := _m.Called(ctx, serverId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscribe")
+	}
+
+	var r0 *models.SubscribeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, models.UserID) (*models.SubscribeInfo, error)); ok {
+		return rf(ctx, serverId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, models.UserID) *models.SubscribeInfo); ok {
+		r0 = rf(ctx, serverId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SubscribeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID, models.UserID) error); ok {
+		r1 = rf(ctx, serverId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByServerId provides a mock function with given fields: ctx, serverId, after, limit
+func (_m *SubscribeStorage) ListByServerId(ctx context.Context, serverId models.ServerID, after *models.SubscribeID, limit int) ([]*models.SubscribeInfo, error) {
+	ret := _m.Called(ctx, serverId, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByServerId")
+	}
+
+	var r0 []*models.SubscribeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, *models.SubscribeID, int) ([]*models.SubscribeInfo, error)); ok {
+		return rf(ctx, serverId, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, *models.SubscribeID, int) []*models.SubscribeInfo); ok {
+		r0 = rf(ctx, serverId, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SubscribeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ServerID, *models.SubscribeID, int) error); ok {
+		r1 = rf(ctx, serverId, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetNickname provides a mock function with given fields: ctx, serverId, userId, nickname
+func (_m *SubscribeStorage) SetNickname(ctx context.Context, serverId models.ServerID, userId models.UserID, nickname string) error {
+	ret := _m.Called(ctx, serverId, userId, nickname)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNickname")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ServerID, models.UserID, string) error); ok {
+		r0 = rf(ctx, serverId, userId, nickname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewSubscribeStorage creates a new instance of SubscribeStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSubscribeStorage(t interface {
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// writeAudit - every audit entry is written through here, new actions only need a constant in models
+func (u *ServerUsecase) writeAudit(ctx context.Context, entry models.AuditEntry) error {
+	entry.Id = models.AuditEntryID(uuid.New())
+	entry.CreatedAt = time.Now()
+
+	return u.AuditRepo.CreateEntry(ctx, entry)
+}
+
+// recordAudit - the action is already done, so a failed write is logged and does not fail the action
+func (u *ServerUsecase) recordAudit(ctx context.Context, entry models.AuditEntry) {
+	err := u.writeAudit(ctx, entry)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).
+			WithField("server_id", entry.ServerId.String()).
+			WithField("action", entry.Action).
+			Error("record audit entry error")
+	}
+}
+
+// serverAuditValues - server settings as they are shown in the audit log
+func serverAuditValues(server *models.ServerInfo) map[string]string {
+	return map[string]string{
+		"name":         server.Name,
+		"owner_id":     server.OwnerId.String(),
+		"description":  server.Description,
+		"icon_url":     server.IconUrl,
+		"banner_url":   server.BannerUrl,
+		"visibility":   server.Visibility,
+		"tags":         strings.Join(server.Tags, ","),
+		"category":     server.Category,
+		"discoverable": strconv.FormatBool(server.Discoverable),
+	}
+}
+
+// auditChanges - only the values that differ, nil maps when nothing changed
+func auditChanges(before map[string]string, after map[string]string) (map[string]string, map[string]string) {
+	var changedBefore, changedAfter map[string]string
+	for key, value := range after {
+		if before[key] == value {
+			continue
+		}
+		if changedBefore == nil {
+			changedBefore = make(map[string]string)
+			changedAfter = make(map[string]string)
+		}
+		changedBefore[key] = before[key]
+		changedAfter[key] = value
+	}
+
+	return changedBefore, changedAfter
+}
+
+// GetAuditLog - newest entries first, only the owner can read the log
+func (u *ServerUsecase) GetAuditLog(ctx context.Context, req usecases.GetAuditLogRequest) (*models.AuditLogResult, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get audit log", err)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = models.AuditLogDefaultLimit
+	}
+	if limit > models.AuditLogMaxLimit {
+		limit = models.AuditLogMaxLimit
+	}
+
+	filter := models.AuditLogFilter{
+		ServerId: server.Id,
+		Action:   req.Action,
+		// one extra entry shows whether there is a next page
+		Limit: limit + 1,
+	}
+	if req.ActorId != "" {
+		actorID := models.UserID(uuid.MustParse(req.ActorId))
+		filter.ActorId = &actorID
+	}
+	if req.Cursor != "" {
+		filter.After, err = models.DecodeAuditLogCursor(req.Cursor)
+		if err != nil {
+			return nil, pkgerrors.Wrap("get audit log: bad cursor", err)
+		}
+	}
+
+	entries, err := u.AuditRepo.ListEntries(ctx, filter)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get audit log", err)
+	}
+
+	result := &models.AuditLogResult{
+		Entries: entries,
+	}
+	if len(entries) > limit {
+		result.Entries = entries[:limit]
+		result.NextCursor = models.NewAuditLogCursor(result.Entries[limit-1]).Encode()
+	}
+
+	return result, nil
+}
+
+// RecordAuditEntry - records an action made in another service, the actor has to own or be a member
+// of the server, so entries and webhook events can not be written into a foreign server
+func (u *ServerUsecase) RecordAuditEntry(ctx context.Context, req usecases.RecordAuditEntryRequest) (*models.ActionInfo, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("record audit entry", err)
+	}
+
+	err = u.checkServerMember(ctx, server, req.ActorId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("record audit entry", err)
+	}
+
+	err = u.writeAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    models.UserID(uuid.MustParse(req.ActorId)),
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Before:     req.Before,
+		After:      req.After,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("record audit entry", err)
+	}
+
+	// channels live in another service, their audit entries are the only place the server sees them
+	switch req.Action {
+	case models.AuditActionChannelCreate:
+		u.emitOutgoingEvent(ctx, server.Id, models.OutgoingEventChannelCreated, map[string]string{
+			"channel_id": req.TargetId,
+			"actor_id":   req.ActorId,
+			"name":       req.After["name"],
+		})
+	case models.AuditActionChannelDelete:
+		u.emitOutgoingEvent(ctx, server.Id, models.OutgoingEventChannelDeleted, map[string]string{
+			"channel_id": req.TargetId,
+			"actor_id":   req.ActorId,
+			"name":       req.Before["name"],
+		})
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,518 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ServerUsecase_GetAuditLog(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	actorID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+	firstEntry := &models.AuditEntry{
+		Id:         models.AuditEntryID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900")),
+		ServerId:   serverID,
+		ActorId:    actorID,
+		Action:     models.AuditActionMemberSubscribe,
+		TargetType: models.AuditTargetUser,
+		TargetId:   actorID.String(),
+		CreatedAt:  time.UnixMilli(1700000002000),
+	}
+	secondEntry := &models.AuditEntry{
+		Id:         models.AuditEntryID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b901")),
+		ServerId:   serverID,
+		ActorId:    actorID,
+		Action:     models.AuditActionMemberSubscribe,
+		TargetType: models.AuditTargetUser,
+		TargetId:   actorID.String(),
+		CreatedAt:  time.UnixMilli(1700000001000),
+	}
+	cursor := models.NewAuditLogCursor(firstEntry)
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetAuditLogRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.AuditLogResult
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Next page cursor is returned",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetAuditLogRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					ActorId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Action:        models.AuditActionMemberSubscribe,
+					Limit:         1,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.AuditLogResult{
+				Entries:    []*models.AuditEntry{firstEntry},
+				NextCursor: cursor.Encode(),
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: ownerID,
+					}, nil)
+
+				f.AuditRepo.On("ListEntries", ctx, models.AuditLogFilter{
+					ServerId: serverID,
+					ActorId:  &actorID,
+					Action:   models.AuditActionMemberSubscribe,
+					Limit:    2,
+				}).
+					Return([]*models.AuditEntry{firstEntry, secondEntry}, nil)
+			},
+		},
+		{
+			name: "Test 2. Positive. Last page",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetAuditLogRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Cursor:        cursor.Encode(),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.AuditLogResult{
+				Entries: []*models.AuditEntry{secondEntry},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: ownerID,
+					}, nil)
+
+				f.AuditRepo.On("ListEntries", ctx, models.AuditLogFilter{
+					ServerId: serverID,
+					After:    cursor,
+					Limit:    models.AuditLogDefaultLimit + 1,
+				}).
+					Return([]*models.AuditEntry{secondEntry}, nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetAuditLogRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "get audit log: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: ownerID,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNotCalled(t, "ListEntries", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Bad cursor",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetAuditLogRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Cursor:        "bad cursor",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "get audit log: bad cursor: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: ownerID,
+					}, nil)
+			},
+		},
+		{
+			name: "Test 5. Negative. ListEntries returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetAuditLogRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "get audit log: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: ownerID,
+					}, nil)
+
+				f.AuditRepo.On("ListEntries", ctx, mock.Anything).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetAuditLog(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GetAuditLog() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_RecordAuditEntry(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
+		DeliveryRepo  *mocks.OutgoingDeliveryStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	actorID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+	outgoingWebhook := models.OutgoingWebhook{
+		Id:       models.OutgoingWebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900")),
+		ServerId: serverID,
+		Events:   []string{models.OutgoingEventChannelCreated},
+		Enabled:  true,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RecordAuditEntryRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RecordAuditEntryRequest{
+					ServerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					ActorId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Action:     models.AuditActionChannelCreate,
+					TargetType: models.AuditTargetChannel,
+					TargetId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b700",
+					After:      map[string]string{"name": "general"},
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: actorID,
+					}, nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.ServerId == serverID &&
+						entry.ActorId == actorID &&
+						entry.Action == models.AuditActionChannelCreate &&
+						entry.After["name"] == "general" &&
+						!entry.CreatedAt.IsZero()
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventChannelCreated).
+					Return([]*models.OutgoingWebhook{&outgoingWebhook}, nil)
+
+				f.DeliveryRepo.On("CreateDelivery", ctx, mock.MatchedBy(func(delivery models.OutgoingDelivery) bool {
+					return delivery.WebhookId == outgoingWebhook.Id &&
+						delivery.ServerId == serverID &&
+						delivery.EventType == models.OutgoingEventChannelCreated &&
+						delivery.Status == models.OutgoingDeliveryPending &&
+						strings.Contains(delivery.Payload, `"channel_id":"284fef68-7e3e-4d1d-96a0-8c96f7b3b700"`) &&
+						strings.Contains(delivery.Payload, `"name":"general"`)
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.DeliveryRepo.AssertNumberOfCalls(t, "CreateDelivery", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Other actions are not sent to outgoing webhooks",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RecordAuditEntryRequest{
+					ServerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					ActorId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Action:     "channel.update",
+					TargetType: models.AuditTargetChannel,
+					TargetId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b700",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: actorID,
+					}, nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNotCalled(t, "ListSubscribed", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. GetServerById returns not found error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RecordAuditEntryRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					ActorId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Action:   models.AuditActionChannelCreate,
+				},
+			},
+			wantErr:     true,
+			errorString: "record audit entry: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. CreateEntry returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RecordAuditEntryRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					ActorId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Action:   models.AuditActionChannelDelete,
+				},
+			},
+			wantErr:     true,
+			errorString: "record audit entry: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: actorID,
+					}, nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+		},
+		{
+			name: "Test 5. Negative. Actor is not a member of the server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RecordAuditEntryRequest{
+					ServerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					ActorId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b801",
+					Action:     models.AuditActionChannelCreate,
+					TargetType: models.AuditTargetChannel,
+					TargetId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b700",
+				},
+			},
+			wantErr:     true,
+			errorString: "record audit entry: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						OwnerId: actorID,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b801"))).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+				f.OutgoingRepo.AssertNotCalled(t, "ListSubscribed", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
+				DeliveryRepo:  mocks.NewOutgoingDeliveryStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+				OutgoingRepo:  f.OutgoingRepo,
+				DeliveryRepo:  f.DeliveryRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RecordAuditEntry(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RecordAuditEntry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
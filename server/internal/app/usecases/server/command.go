@@ -0,0 +1,368 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CreateCommand - only the owner registers commands, the secret is returned only here
+func (u *ServerUsecase) CreateCommand(ctx context.Context, req usecases.CreateCommandRequest) (*models.Command, error) {
+	name := strings.ToLower(req.Name)
+	if !models.ValidCommandName(name) {
+		return nil, pkgerrors.Wrap("create command: invalid name", models.ErrInvalidArgument)
+	}
+
+	handlerUrl, err := url.Parse(req.HandlerUrl)
+	if err != nil || handlerUrl.Scheme != "https" || handlerUrl.Host == "" {
+		return nil, pkgerrors.Wrap("create command: handler url must be https", models.ErrInvalidArgument)
+	}
+
+	options, err := commandOptions(req.Options)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create command", err)
+	}
+
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create command", err)
+	}
+
+	count, err := u.CommandRepo.CountByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create command", err)
+	}
+	if count >= models.ServerCommandLimit {
+		return nil, pkgerrors.Wrap("create command: too many commands", models.ErrInvalidArgument)
+	}
+
+	_, err = u.CommandRepo.GetCommandByName(ctx, server.Id, name)
+	if err == nil {
+		return nil, pkgerrors.Wrap("create command: name is taken", models.ErrAlreadyExists)
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, pkgerrors.Wrap("create command", err)
+	}
+
+	command := models.Command{
+		Id:          models.CommandID(uuid.New()),
+		ServerId:    server.Id,
+		Name:        name,
+		Description: req.Description,
+		Options:     options,
+		HandlerUrl:  handlerUrl.String(),
+		CreatorId:   server.OwnerId,
+		CreatedAt:   time.Now(),
+	}
+	err = command.SetNewSecret()
+	if err != nil {
+		return nil, pkgerrors.Wrap("create command: generate secret", err)
+	}
+
+	err = u.CommandRepo.CreateCommand(ctx, command)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create command", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionCommandCreate,
+		TargetType: models.AuditTargetCommand,
+		TargetId:   command.Id.String(),
+		After: map[string]string{
+			"name":        command.Name,
+			"handler_url": command.HandlerUrl,
+		},
+	})
+
+	return &command, nil
+}
+
+// commandOptions - known types, unique names and required options before the optional ones
+func commandOptions(options []usecases.CommandOption) ([]models.CommandOption, error) {
+	if len(options) > models.CommandOptionLimit {
+		return nil, pkgerrors.Wrap("too many options", models.ErrInvalidArgument)
+	}
+
+	result := make([]models.CommandOption, len(options))
+	seen := make(map[string]bool, len(options))
+	optional := false
+	for i, option := range options {
+		name := strings.ToLower(option.Name)
+		if !models.ValidCommandName(name) {
+			return nil, pkgerrors.Wrap("invalid option name "+option.Name, models.ErrInvalidArgument)
+		}
+		if seen[name] {
+			return nil, pkgerrors.Wrap("duplicate option "+name, models.ErrInvalidArgument)
+		}
+		seen[name] = true
+
+		if !models.CommandOptionTypes[option.Type] {
+			return nil, pkgerrors.Wrap("unknown option type "+option.Type, models.ErrInvalidArgument)
+		}
+
+		if option.Required && optional {
+			return nil, pkgerrors.Wrap("required option "+name+" after an optional one", models.ErrInvalidArgument)
+		}
+		optional = !option.Required
+
+		result[i] = models.CommandOption{
+			Name:        name,
+			Description: option.Description,
+			Type:        option.Type,
+			Required:    option.Required,
+		}
+	}
+
+	return result, nil
+}
+
+// ListCommands - members see the commands they can invoke, secrets are not returned
+func (u *ServerUsecase) ListCommands(ctx context.Context, req usecases.ListCommandsRequest) ([]*models.Command, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list commands", err)
+	}
+
+	err = u.checkServerMember(ctx, server, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list commands", err)
+	}
+
+	commands, err := u.CommandRepo.ListByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list commands", err)
+	}
+
+	for _, command := range commands {
+		command.Secret = ""
+	}
+
+	return commands, nil
+}
+
+// DeleteCommand - interactions already sent to the handler can not be answered after it
+func (u *ServerUsecase) DeleteCommand(ctx context.Context, req usecases.DeleteCommandRequest) (*models.ActionInfo, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete command", err)
+	}
+
+	command, err := u.CommandRepo.GetCommandById(ctx, models.CommandID(uuid.MustParse(req.CommandId)))
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete command", err)
+	}
+
+	if command.ServerId != server.Id {
+		return nil, pkgerrors.Wrap("delete command", models.ErrNotFound)
+	}
+
+	err = u.CommandRepo.DeleteCommand(ctx, command.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete command", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionCommandDelete,
+		TargetType: models.AuditTargetCommand,
+		TargetId:   command.Id.String(),
+		Before:     map[string]string{"name": command.Name},
+	})
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// interactionDispatchTimeout - time given to the command handler to accept the interaction
+const interactionDispatchTimeout = 30 * time.Second
+
+// invokeCommand - stores the interaction and sends it to the command handler in background,
+// the invocation text is not posted and the handler replies through the interaction token
+func (u *ServerUsecase) invokeCommand(ctx context.Context, server *models.ServerInfo, command *models.Command, input *models.CommandInput, userId string) error {
+	options, err := bindCommandOptions(command, input.Args)
+	if err != nil {
+		return pkgerrors.Wrap("command "+command.Name, err)
+	}
+
+	now := time.Now()
+	interaction := models.Interaction{
+		Id:        models.InteractionID(uuid.New()),
+		CommandId: command.Id,
+		ServerId:  server.Id,
+		UserId:    models.UserID(uuid.MustParse(userId)),
+		Options:   options,
+		ExpiresAt: now.Add(models.InteractionResponseTimeout),
+		CreatedAt: now,
+	}
+	err = interaction.SetNewToken()
+	if err != nil {
+		return pkgerrors.Wrap("generate interaction token", err)
+	}
+
+	payload, err := models.NewInteractionEvent(interaction, *command).Payload()
+	if err != nil {
+		return pkgerrors.Wrap("encode interaction", err)
+	}
+
+	err = u.InteractionRepo.CreateInteraction(ctx, interaction)
+	if err != nil {
+		return pkgerrors.Wrap("create interaction", err)
+	}
+
+	u.dispatches.Add(1)
+	go func() {
+		defer u.dispatches.Done()
+		u.dispatchInteraction(ctx, command, interaction, payload)
+	}()
+
+	return nil
+}
+
+// dispatchInteraction - runs detached from the request context, so a slow handler does not hold
+// the message request, failures are only logged
+func (u *ServerUsecase) dispatchInteraction(ctx context.Context, command *models.Command, interaction models.Interaction, payload string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), interactionDispatchTimeout)
+	defer cancel()
+
+	statusCode, err := u.OutgoingSender.Send(ctx, models.OutgoingRequest{
+		Url:        command.HandlerUrl,
+		Secret:     command.Secret,
+		DeliveryId: interaction.Id.String(),
+		EventType:  models.InteractionEventType,
+		Payload:    payload,
+		Timestamp:  interaction.CreatedAt,
+	})
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).
+			WithField("command_id", command.Id.String()).
+			WithField("interaction_id", interaction.Id.String()).
+			WithField("status_code", statusCode).
+			Warn("command handler did not accept the interaction")
+	}
+}
+
+// WaitDispatches - blocks until interactions being sent to command handlers are done or ctx is done,
+// called on shutdown after the grpc server stopped taking messages
+func (u *ServerUsecase) WaitDispatches(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		u.dispatches.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// bindCommandOptions - arguments are bound to the options in order, the last string option takes the rest of the line
+func bindCommandOptions(command *models.Command, args []string) (map[string]string, error) {
+	values := make(map[string]string, len(command.Options))
+	for i, option := range command.Options {
+		if i >= len(args) {
+			if option.Required {
+				return nil, pkgerrors.Wrap("missing option "+option.Name, models.ErrInvalidArgument)
+			}
+			continue
+		}
+
+		value := args[i]
+		if i == len(command.Options)-1 && option.Type == models.CommandOptionString {
+			value = strings.Join(args[i:], " ")
+		}
+
+		normalized, ok := option.NormalizeValue(value)
+		if !ok {
+			return nil, pkgerrors.Wrap("option "+option.Name+" must be "+option.Type, models.ErrInvalidArgument)
+		}
+		values[option.Name] = normalized
+	}
+
+	if len(args) > len(command.Options) && !takesRestOfLine(command) {
+		return nil, pkgerrors.Wrap("too many arguments", models.ErrInvalidArgument)
+	}
+
+	return values, nil
+}
+
+func takesRestOfLine(command *models.Command) bool {
+	if len(command.Options) == 0 {
+		return false
+	}
+
+	return command.Options[len(command.Options)-1].Type == models.CommandOptionString
+}
+
+// RespondInteraction - posts the single handler reply before the interaction expires,
+// the token replaces user authentication
+func (u *ServerUsecase) RespondInteraction(ctx context.Context, req usecases.RespondInteractionRequest) (*models.ActionInfo, error) {
+	interaction, err := u.InteractionRepo.GetInteractionById(ctx, models.InteractionID(uuid.MustParse(req.InteractionId)))
+	if err != nil {
+		return nil, pkgerrors.Wrap("respond interaction", err)
+	}
+
+	if !interaction.MatchToken(req.Token) {
+		return nil, pkgerrors.Wrap("respond interaction: invalid token", models.Unauthenticated)
+	}
+
+	now := time.Now()
+	if interaction.Expired(now) {
+		return nil, pkgerrors.Wrap("respond interaction: interaction expired", models.ErrFailedPrecondition)
+	}
+
+	command, err := u.CommandRepo.GetCommandById(ctx, interaction.CommandId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("respond interaction: command", err)
+	}
+
+	server, err := u.ServerRepo.GetServerById(ctx, interaction.ServerId.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap("respond interaction", err)
+	}
+
+	marked, err := u.InteractionRepo.MarkResponded(ctx, interaction.Id, now)
+	if err != nil {
+		return nil, pkgerrors.Wrap("respond interaction", err)
+	}
+	if !marked {
+		return nil, pkgerrors.Wrap("respond interaction: already responded", models.ErrAlreadyExists)
+	}
+
+	msg := usecases.PublishMessageOnServerRequest{
+		ServerId:      server.Id.String(),
+		Text:          req.Content,
+		CurrentUserId: command.Id.String(),
+		WebhookId:     command.Id.String(),
+		Username:      command.Name,
+	}
+	if req.Ephemeral {
+		msg.VisibleTo = interaction.UserId.String()
+	}
+
+	err = u.sendServerMessage(ctx, server, msg)
+	if err != nil {
+		// the reply was not posted, so the handler may send another one
+		releaseErr := u.InteractionRepo.ReleaseResponded(ctx, interaction.Id)
+		if releaseErr != nil {
+			u.Log.WithContext(ctx).WithError(releaseErr).WithField("interaction_id", interaction.Id.String()).Error("release interaction error")
+		}
+		return nil, pkgerrors.Wrap("respond interaction", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
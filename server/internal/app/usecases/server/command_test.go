@@ -0,0 +1,942 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ServerUsecase_CreateCommand(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo  *mocks.ServerStorage
+		Log         *log.Logger
+		AuditRepo   *mocks.AuditStorage
+		CommandRepo *mocks.CommandStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateCommandRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.Command
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Names are lowercased",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateCommandRequest{
+					ServerId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:        "Remind",
+					Description: "reminds later",
+					Options: []usecases.CommandOption{
+						{Name: "Minutes", Type: models.CommandOptionInteger, Required: true},
+						{Name: "text", Type: models.CommandOptionString},
+					},
+					HandlerUrl:    "https://example.com/commands",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.Command{
+				ServerId:    serverID,
+				Name:        "remind",
+				Description: "reminds later",
+				Options: []models.CommandOption{
+					{Name: "minutes", Type: models.CommandOptionInteger, Required: true},
+					{Name: "text", Type: models.CommandOptionString},
+				},
+				HandlerUrl: "https://example.com/commands",
+				CreatorId:  ownerID,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(3), nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "remind").
+					Return(nil, models.ErrNotFound)
+
+				f.CommandRepo.On("CreateCommand",
+					ctx,
+					mock.MatchedBy(func(command models.Command) bool {
+						return command.ServerId == serverID &&
+							command.Name == "remind" &&
+							len(command.Options) == 2 &&
+							command.Secret != ""
+					}),
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.Action == models.AuditActionCommandCreate &&
+							entry.TargetType == models.AuditTargetCommand &&
+							entry.After["name"] == "remind"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.CommandRepo.AssertNumberOfCalls(t, "CreateCommand", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Invalid name",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateCommandRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "remind me",
+					HandlerUrl:    "https://example.com/commands",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create command: invalid name: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Required option after an optional one",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateCommandRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:     "remind",
+					Options: []usecases.CommandOption{
+						{Name: "text", Type: models.CommandOptionString},
+						{Name: "minutes", Type: models.CommandOptionInteger, Required: true},
+					},
+					HandlerUrl:    "https://example.com/commands",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create command: required option minutes after an optional one: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Unknown option type",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateCommandRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:     "remind",
+					Options: []usecases.CommandOption{
+						{Name: "when", Type: "date"},
+					},
+					HandlerUrl:    "https://example.com/commands",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create command: unknown option type date: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Name is taken",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateCommandRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "remind",
+					HandlerUrl:    "https://example.com/commands",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create command: name is taken: already exists",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(3), nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "remind").
+					Return(&models.Command{ServerId: serverID, Name: "remind"}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.CommandRepo.AssertNotCalled(t, "CreateCommand", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateCommandRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "remind",
+					HandlerUrl:    "https://example.com/commands",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create command: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.CommandRepo.AssertNotCalled(t, "CountByServerId", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:  mocks.NewServerStorage(t),
+				Log:         &log.Logger{},
+				AuditRepo:   mocks.NewAuditStorage(t),
+				CommandRepo: mocks.NewCommandStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:  f.ServerRepo,
+				Log:         f.Log,
+				AuditRepo:   f.AuditRepo,
+				CommandRepo: f.CommandRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateCommand(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateCommand() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			// id, secret and creation time are generated
+			if got != nil {
+				assert.Len(t, got.Secret, 64)
+				got.Id = models.CommandID{}
+				got.Secret = ""
+				got.CreatedAt = time.Time{}
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_PublishMessageOnServer_Command(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo      *mocks.ServerStorage
+		Log             *log.Logger
+		ChatService     *mocks.ServiceChatInterface
+		OutgoingRepo    *mocks.OutgoingWebhookStorage
+		OutgoingSender  *mocks.OutgoingSenderInterface
+		CommandRepo     *mocks.CommandStorage
+		InteractionRepo *mocks.InteractionStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	mentionedID := "284fef68-7e3e-4d1d-96a0-8c96f7b3b796"
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+	command := models.Command{
+		Id:       models.CommandID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900")),
+		ServerId: serverID,
+		Name:     "remind",
+		Options: []models.CommandOption{
+			{Name: "who", Type: models.CommandOptionUser, Required: true},
+			{Name: "minutes", Type: models.CommandOptionInteger, Required: true},
+			{Name: "text", Type: models.CommandOptionString},
+		},
+		HandlerUrl: "https://example.com/commands",
+		Secret:     "0123456789abcdef",
+	}
+
+	// the handler is called after the request returns, so its context is not the request one
+	dispatchCtx := mock.MatchedBy(func(c context.Context) bool {
+		_, hasDeadline := c.Deadline()
+		return c.Err() == nil && hasDeadline
+	})
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		req usecases.PublishMessageOnServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Interaction is sent to the handler",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          `/Remind <@` + mentionedID + `> 15 "stand up" now`,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "remind").
+					Return(&command, nil)
+
+				f.InteractionRepo.On("CreateInteraction",
+					ctx,
+					mock.MatchedBy(func(interaction models.Interaction) bool {
+						return interaction.CommandId == command.Id &&
+							interaction.UserId == ownerID &&
+							interaction.Options["who"] == mentionedID &&
+							interaction.Options["minutes"] == "15" &&
+							interaction.Options["text"] == "stand up now" &&
+							interaction.TokenHash == models.HashWebhookToken(interaction.Token) &&
+							interaction.ExpiresAt.Sub(interaction.CreatedAt) == models.InteractionResponseTimeout
+					}),
+				).
+					Return(nil)
+
+				f.OutgoingSender.On("Send",
+					dispatchCtx,
+					mock.MatchedBy(func(req models.OutgoingRequest) bool {
+						event := models.InteractionEvent{}
+						err := json.Unmarshal([]byte(req.Payload), &event)
+
+						return err == nil &&
+							req.Url == command.HandlerUrl &&
+							req.Secret == command.Secret &&
+							req.EventType == models.InteractionEventType &&
+							req.DeliveryId == event.Id &&
+							event.Token != "" &&
+							event.CommandName == "remind" &&
+							event.UserId == ownerID.String()
+					}),
+				).
+					Return(http.StatusOK, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingSender.AssertNumberOfCalls(t, "Send", 1)
+				f.ChatService.AssertNotCalled(t, "SendServerMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 2. Positive. Unknown command is posted as text",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "/shrug",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "shrug").
+					Return(nil, models.ErrNotFound)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "/shrug",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+				f.InteractionRepo.AssertNotCalled(t, "CreateInteraction", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Option has a wrong type",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "/remind <@" + mentionedID + "> soon",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "publish message on server: command remind: option minutes must be integer: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "remind").
+					Return(&command, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.InteractionRepo.AssertNotCalled(t, "CreateInteraction", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Required option is missing",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "/remind <@" + mentionedID + ">",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "publish message on server: command remind: missing option minutes: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "remind").
+					Return(&command, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.InteractionRepo.AssertNotCalled(t, "CreateInteraction", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Positive. Handler rejection is only logged",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "/remind <@" + mentionedID + "> 15",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("GetCommandByName", ctx, serverID, "remind").
+					Return(&command, nil)
+
+				f.InteractionRepo.On("CreateInteraction", ctx, mock.AnythingOfType("models.Interaction")).
+					Return(nil)
+
+				f.OutgoingSender.On("Send", dispatchCtx, mock.AnythingOfType("models.OutgoingRequest")).
+					Return(http.StatusBadGateway, errors.New("unexpected status code 502"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingSender.AssertNumberOfCalls(t, "Send", 1)
+				f.ChatService.AssertNotCalled(t, "SendServerMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Positive. Interaction is sent after the request is cancelled",
+			args: args{
+				ctx: cancelledCtx,
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "/remind <@" + mentionedID + "> 15",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", cancelledCtx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.CommandRepo.On("GetCommandByName", cancelledCtx, serverID, "remind").
+					Return(&command, nil)
+
+				f.InteractionRepo.On("CreateInteraction", cancelledCtx, mock.AnythingOfType("models.Interaction")).
+					Return(nil)
+
+				f.OutgoingSender.On("Send", dispatchCtx, mock.AnythingOfType("models.OutgoingRequest")).
+					Return(http.StatusOK, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingSender.AssertNumberOfCalls(t, "Send", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			logger, err := log.NewLogger(log.NewDefaultConfig())
+			assert.NoError(t, err)
+
+			f := &fields{
+				ServerRepo:      mocks.NewServerStorage(t),
+				Log:             logger,
+				ChatService:     mocks.NewServiceChatInterface(t),
+				OutgoingRepo:    mocks.NewOutgoingWebhookStorage(t),
+				OutgoingSender:  mocks.NewOutgoingSenderInterface(t),
+				CommandRepo:     mocks.NewCommandStorage(t),
+				InteractionRepo: mocks.NewInteractionStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:      f.ServerRepo,
+				Log:             f.Log,
+				ChatService:     f.ChatService,
+				OutgoingRepo:    f.OutgoingRepo,
+				OutgoingSender:  f.OutgoingSender,
+				CommandRepo:     f.CommandRepo,
+				InteractionRepo: f.InteractionRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PublishMessageOnServer(tt.args.ctx, tt.args.req)
+			assert.NoError(t, au.WaitDispatches(ctx))
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.PublishMessageOnServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, &models.ActionInfo{Success: true}, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_WaitDispatches(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+
+	tests := []struct {
+		name    string
+		pending bool
+		wantErr error
+	}{
+		{
+			name: "Test 1. Positive. No interactions in flight",
+		},
+		{
+			name:    "Test 2. Negative. Interaction still in flight when ctx is done",
+			pending: true,
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			au := NewServerUsecase(Deps{Log: &log.Logger{}}).(*ServerUsecase)
+			if tt.pending {
+				au.dispatches.Add(1)
+				defer au.dispatches.Done()
+			}
+
+			waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+
+			// act
+			err := au.WaitDispatches(waitCtx)
+
+			// assert
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_RespondInteraction(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo      *mocks.ServerStorage
+		Log             *log.Logger
+		ChatService     *mocks.ServiceChatInterface
+		OutgoingRepo    *mocks.OutgoingWebhookStorage
+		CommandRepo     *mocks.CommandStorage
+		InteractionRepo *mocks.InteractionStorage
+	}
+
+	const token = "interaction-token"
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796"))
+	interactionID := models.InteractionID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b901"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+	}
+	command := models.Command{
+		Id:       models.CommandID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900")),
+		ServerId: serverID,
+		Name:     "remind",
+	}
+	newInteraction := func(expiresAt time.Time) *models.Interaction {
+		return &models.Interaction{
+			Id:        interactionID,
+			CommandId: command.Id,
+			ServerId:  serverID,
+			UserId:    userID,
+			TokenHash: models.HashWebhookToken(token),
+			ExpiresAt: expiresAt,
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RespondInteractionRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Reply is posted on behalf of the command",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RespondInteractionRequest{
+					InteractionId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					Token:         token,
+					Content:       "done",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.InteractionRepo.On("GetInteractionById", ctx, interactionID).
+					Return(newInteraction(time.Now().Add(time.Minute)), nil)
+
+				f.CommandRepo.On("GetCommandById", ctx, command.Id).
+					Return(&command, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.InteractionRepo.On("MarkResponded", ctx, interactionID, mock.AnythingOfType("time.Time")).
+					Return(true, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "done",
+					CurrentUserId: command.Id.String(),
+					WebhookId:     command.Id.String(),
+					Username:      "remind",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Ephemeral reply is visible only to the invoker",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RespondInteractionRequest{
+					InteractionId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					Token:         token,
+					Content:       "only you see this",
+					Ephemeral:     true,
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.InteractionRepo.On("GetInteractionById", ctx, interactionID).
+					Return(newInteraction(time.Now().Add(time.Minute)), nil)
+
+				f.CommandRepo.On("GetCommandById", ctx, command.Id).
+					Return(&command, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.InteractionRepo.On("MarkResponded", ctx, interactionID, mock.AnythingOfType("time.Time")).
+					Return(true, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "only you see this",
+					CurrentUserId: command.Id.String(),
+					WebhookId:     command.Id.String(),
+					Username:      "remind",
+					VisibleTo:     userID.String(),
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNotCalled(t, "ListSubscribed", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Invalid token",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RespondInteractionRequest{
+					InteractionId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					Token:         "wrong",
+					Content:       "done",
+				},
+			},
+			wantErr:     true,
+			errorString: "respond interaction: invalid token: unauthenticated",
+
+			on: func(f *fields) {
+				f.InteractionRepo.On("GetInteractionById", ctx, interactionID).
+					Return(newInteraction(time.Now().Add(time.Minute)), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.InteractionRepo.AssertNotCalled(t, "MarkResponded", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Interaction expired",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RespondInteractionRequest{
+					InteractionId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					Token:         token,
+					Content:       "done",
+				},
+			},
+			wantErr:     true,
+			errorString: "respond interaction: interaction expired: failed precondition",
+
+			on: func(f *fields) {
+				f.InteractionRepo.On("GetInteractionById", ctx, interactionID).
+					Return(newInteraction(time.Now().Add(-time.Second)), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.InteractionRepo.AssertNotCalled(t, "MarkResponded", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Already responded",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RespondInteractionRequest{
+					InteractionId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					Token:         token,
+					Content:       "done",
+				},
+			},
+			wantErr:     true,
+			errorString: "respond interaction: already responded: already exists",
+
+			on: func(f *fields) {
+				f.InteractionRepo.On("GetInteractionById", ctx, interactionID).
+					Return(newInteraction(time.Now().Add(time.Minute)), nil)
+
+				f.CommandRepo.On("GetCommandById", ctx, command.Id).
+					Return(&command, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.InteractionRepo.On("MarkResponded", ctx, interactionID, mock.AnythingOfType("time.Time")).
+					Return(false, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendServerMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Rejected reply can be sent again",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RespondInteractionRequest{
+					InteractionId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					Token:         token,
+					Content:       "done",
+				},
+			},
+			wantErr:     true,
+			errorString: "respond interaction: some error",
+
+			on: func(f *fields) {
+				f.InteractionRepo.On("GetInteractionById", ctx, interactionID).
+					Return(newInteraction(time.Now().Add(time.Minute)), nil)
+
+				f.CommandRepo.On("GetCommandById", ctx, command.Id).
+					Return(&command, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.InteractionRepo.On("MarkResponded", ctx, interactionID, mock.AnythingOfType("time.Time")).
+					Return(true, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, mock.AnythingOfType("usecases.PublishMessageOnServerRequest")).
+					Return(nil, errors.New("some error"))
+
+				f.InteractionRepo.On("ReleaseResponded", ctx, interactionID).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.InteractionRepo.AssertNumberOfCalls(t, "ReleaseResponded", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:      mocks.NewServerStorage(t),
+				Log:             &log.Logger{},
+				ChatService:     mocks.NewServiceChatInterface(t),
+				OutgoingRepo:    mocks.NewOutgoingWebhookStorage(t),
+				CommandRepo:     mocks.NewCommandStorage(t),
+				InteractionRepo: mocks.NewInteractionStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:      f.ServerRepo,
+				Log:             f.Log,
+				ChatService:     f.ChatService,
+				OutgoingRepo:    f.OutgoingRepo,
+				CommandRepo:     f.CommandRepo,
+				InteractionRepo: f.InteractionRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RespondInteraction(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RespondInteraction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, &models.ActionInfo{Success: true}, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"time"
+)
+
+func (u *ServerUsecase) RestoreServer(ctx context.Context, req usecases.RestoreServerRequest) (*models.ServerInfo, error) {
+	server, err := u.ServerRepo.GetDeletedServerById(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("restore server", err)
+	}
+
+	if server.OwnerId.String() != req.CurrentUserId {
+		return nil, pkgerrors.Wrap("restore server", models.PermissionDenied)
+	}
+
+	// the server is waiting for purge
+	if time.Now().After(server.RestoreDeadline()) {
+		return nil, pkgerrors.Wrap("restore server: grace period is over", models.ErrNotFound)
+	}
+
+	err = u.ServerRepo.RestoreServer(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("restore server", err)
+	}
+	server.DeletedAt = time.Time{}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionServerRestore,
+		TargetType: models.AuditTargetServer,
+		TargetId:   server.Id.String(),
+		After:      map[string]string{"name": server.Name},
+	})
+
+	return serverProfile(server), nil
+}
+
+// PurgeDeletedServers - removes one batch of servers whose grace period is over,
+// returns the number of purged servers
+func (u *ServerUsecase) PurgeDeletedServers(ctx context.Context) (int, error) {
+	servers, err := u.ServerRepo.ListDeletedBefore(ctx, time.Now().Add(-models.ServerRestoreGracePeriod), models.ServerPurgeBatchSize)
+	if err != nil {
+		return 0, pkgerrors.Wrap("purge deleted servers", err)
+	}
+
+	for i, server := range servers {
+		err = u.purgeServer(ctx, server)
+		if err != nil {
+			return i, pkgerrors.Wrap("purge deleted servers", err)
+		}
+	}
+
+	return len(servers), nil
+}
+
+// purgeServer - the event is published before the server is removed,
+// a failed purge is retried on the next run and consumers get the event again
+func (u *ServerUsecase) purgeServer(ctx context.Context, server *models.ServerInfo) error {
+	err := u.ServerEvents.PublishServerDeleted(ctx, models.ServerDeletedEvent{
+		ServerId:  server.Id,
+		OwnerId:   server.OwnerId,
+		DeletedAt: server.DeletedAt,
+	})
+	if err != nil {
+		return pkgerrors.Wrap("publish server deleted", err)
+	}
+
+	err = u.SubscribeRepo.DeleteByServerId(ctx, server.Id)
+	if err != nil {
+		return pkgerrors.Wrap("delete server subscribes", err)
+	}
+
+	err = u.WebhookRepo.DeleteByServerId(ctx, server.Id)
+	if err != nil {
+		return pkgerrors.Wrap("delete server webhooks", err)
+	}
+
+	err = u.OutgoingRepo.DeleteByServerId(ctx, server.Id)
+	if err != nil {
+		return pkgerrors.Wrap("delete server outgoing webhooks", err)
+	}
+
+	err = u.DeliveryRepo.DeleteByServerId(ctx, server.Id)
+	if err != nil {
+		return pkgerrors.Wrap("delete server outgoing deliveries", err)
+	}
+
+	// interactions expire by themselves and can not be answered without the command
+	err = u.CommandRepo.DeleteByServerId(ctx, server.Id)
+	if err != nil {
+		return pkgerrors.Wrap("delete server commands", err)
+	}
+
+	err = u.purgeServerEmoji(ctx, server.Id)
+	if err != nil {
+		return pkgerrors.Wrap("delete server emoji", err)
+	}
+
+	err = u.ServerRepo.DeleteServer(ctx, server.Id.String())
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return pkgerrors.Wrap("delete server", err)
+	}
+
+	return nil
+}
+
+// purgeServerEmoji - images are deleted before the records, a retried purge finds the left ones
+func (u *ServerUsecase) purgeServerEmoji(ctx context.Context, serverId models.ServerID) error {
+	emoji, err := u.EmojiRepo.ListByServerId(ctx, serverId)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range emoji {
+		err = u.BlobStore.Delete(ctx, e.BlobKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return u.EmojiRepo.DeleteByServerId(ctx, serverId)
+}
@@ -0,0 +1,610 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ServerUsecase_RestoreServer(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AuditRepo     *mocks.AuditStorage
+		ServerEvents  *mocks.KafkaProducerServiceInterface
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	deletedServer := func(deletedAt time.Time) *models.ServerInfo {
+		return &models.ServerInfo{
+			Id:         serverID,
+			Name:       "server",
+			OwnerId:    ownerID,
+			Visibility: models.ServerVisibilityPublic,
+			DeletedAt:  deletedAt,
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RestoreServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ServerInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RestoreServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ServerInfo{
+				Id:         serverID,
+				Name:       "server",
+				OwnerId:    ownerID,
+				Visibility: models.ServerVisibilityPublic,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetDeletedServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(deletedServer(time.Now().Add(-time.Hour)), nil)
+
+				f.ServerRepo.On("RestoreServer", ctx, serverID).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionServerRestore && entry.TargetId == serverID.String()
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "RestoreServer", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Server is not deleted",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RestoreServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "restore server: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetDeletedServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "RestoreServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RestoreServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "restore server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetDeletedServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(deletedServer(time.Now().Add(-time.Hour)), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "RestoreServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Grace period is over",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RestoreServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "restore server: grace period is over: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetDeletedServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(deletedServer(time.Now().Add(-models.ServerRestoreGracePeriod-time.Minute)), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "RestoreServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. RestoreServer returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RestoreServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "restore server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetDeletedServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(deletedServer(time.Now().Add(-time.Hour)), nil)
+
+				f.ServerRepo.On("RestoreServer", ctx, serverID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				ServerEvents:  mocks.NewKafkaProducerServiceInterface(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AuditRepo:     f.AuditRepo,
+				ServerEvents:  f.ServerEvents,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RestoreServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RestoreServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_PurgeDeletedServers(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AuditRepo     *mocks.AuditStorage
+		ServerEvents  *mocks.KafkaProducerServiceInterface
+		WebhookRepo   *mocks.WebhookStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
+		DeliveryRepo  *mocks.OutgoingDeliveryStorage
+		CommandRepo   *mocks.CommandStorage
+		EmojiRepo     *mocks.EmojiStorage
+		BlobStore     *mocks.BlobStore
+	}
+
+	deletedAt := time.Now().Add(-models.ServerRestoreGracePeriod - time.Hour)
+	firstID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	secondID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	servers := []*models.ServerInfo{
+		{Id: firstID, Name: "first", OwnerId: ownerID, DeletedAt: deletedAt},
+		{Id: secondID, Name: "second", OwnerId: ownerID, DeletedAt: deletedAt},
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        int
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:    2,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, models.ServerDeletedEvent{
+					ServerId:  firstID,
+					OwnerId:   ownerID,
+					DeletedAt: deletedAt,
+				}).
+					Return(nil)
+				f.ServerEvents.On("PublishServerDeleted", ctx, models.ServerDeletedEvent{
+					ServerId:  secondID,
+					OwnerId:   ownerID,
+					DeletedAt: deletedAt,
+				}).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+				f.SubscribeRepo.On("DeleteByServerId", ctx, secondID).
+					Return(nil)
+
+				f.WebhookRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+				f.WebhookRepo.On("DeleteByServerId", ctx, secondID).
+					Return(nil)
+
+				f.OutgoingRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+				f.OutgoingRepo.On("DeleteByServerId", ctx, secondID).
+					Return(nil)
+
+				f.DeliveryRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+				f.DeliveryRepo.On("DeleteByServerId", ctx, secondID).
+					Return(nil)
+
+				f.CommandRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+				f.CommandRepo.On("DeleteByServerId", ctx, secondID).
+					Return(nil)
+
+				f.EmojiRepo.On("ListByServerId", ctx, firstID).
+					Return([]*models.Emoji{
+						{ServerId: firstID, Name: "party", BlobKey: "emoji/first/party"},
+					}, nil)
+				f.EmojiRepo.On("ListByServerId", ctx, secondID).
+					Return([]*models.Emoji{}, nil)
+				f.BlobStore.On("Delete", ctx, "emoji/first/party").
+					Return(nil)
+				f.EmojiRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+				f.EmojiRepo.On("DeleteByServerId", ctx, secondID).
+					Return(nil)
+
+				f.ServerRepo.On("DeleteServer", ctx, firstID.String()).
+					Return(nil)
+				// removed by a previous run after the subscribes
+				f.ServerRepo.On("DeleteServer", ctx, secondID.String()).
+					Return(models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerEvents.AssertNumberOfCalls(t, "PublishServerDeleted", 2)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteByServerId", 2)
+				f.WebhookRepo.AssertNumberOfCalls(t, "DeleteByServerId", 2)
+				f.OutgoingRepo.AssertNumberOfCalls(t, "DeleteByServerId", 2)
+				f.DeliveryRepo.AssertNumberOfCalls(t, "DeleteByServerId", 2)
+				f.CommandRepo.AssertNumberOfCalls(t, "DeleteByServerId", 2)
+				f.BlobStore.AssertNumberOfCalls(t, "Delete", 1)
+				f.EmojiRepo.AssertNumberOfCalls(t, "DeleteByServerId", 2)
+				f.ServerRepo.AssertNumberOfCalls(t, "DeleteServer", 2)
+			},
+		},
+		{
+			name: "Test 2. Positive. Nothing to purge",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:    0,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return([]*models.ServerInfo{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerEvents.AssertNotCalled(t, "PublishServerDeleted", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. PublishServerDeleted returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: publish server deleted: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerEvents.AssertNumberOfCalls(t, "PublishServerDeleted", 1)
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. DeleteByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: delete server subscribes: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", ctx, firstID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Webhooks DeleteByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: delete server webhooks: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.WebhookRepo.On("DeleteByServerId", ctx, firstID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Outgoing webhooks DeleteByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: delete server outgoing webhooks: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.WebhookRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.OutgoingRepo.On("DeleteByServerId", ctx, firstID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.DeliveryRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. Commands DeleteByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: delete server commands: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.WebhookRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.OutgoingRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.DeliveryRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.CommandRepo.On("DeleteByServerId", ctx, firstID).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 8. Negative. Emoji image Delete returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: delete server emoji: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(servers, nil)
+
+				f.ServerEvents.On("PublishServerDeleted", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.WebhookRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.OutgoingRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.DeliveryRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.CommandRepo.On("DeleteByServerId", ctx, firstID).
+					Return(nil)
+
+				f.EmojiRepo.On("ListByServerId", ctx, firstID).
+					Return([]*models.Emoji{
+						{ServerId: firstID, Name: "party", BlobKey: "emoji/first/party"},
+					}, nil)
+				f.BlobStore.On("Delete", ctx, "emoji/first/party").
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				// the records are kept so the next run deletes the left images
+				f.EmojiRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 9. Negative. ListDeletedBefore returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:        0,
+			wantErr:     true,
+			errorString: "purge deleted servers: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("ListDeletedBefore", ctx, mock.AnythingOfType("time.Time"), models.ServerPurgeBatchSize).
+					Return(nil, errors.New("some error"))
+			},
+			assert: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				ServerEvents:  mocks.NewKafkaProducerServiceInterface(t),
+				WebhookRepo:   mocks.NewWebhookStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
+				DeliveryRepo:  mocks.NewOutgoingDeliveryStorage(t),
+				CommandRepo:   mocks.NewCommandStorage(t),
+				EmojiRepo:     mocks.NewEmojiStorage(t),
+				BlobStore:     mocks.NewBlobStore(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AuditRepo:     f.AuditRepo,
+				ServerEvents:  f.ServerEvents,
+				WebhookRepo:   f.WebhookRepo,
+				OutgoingRepo:  f.OutgoingRepo,
+				DeliveryRepo:  f.DeliveryRepo,
+				CommandRepo:   f.CommandRepo,
+				EmojiRepo:     f.EmojiRepo,
+				BlobStore:     f.BlobStore,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PurgeDeletedServers(tt.args.ctx)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.PurgeDeletedServers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
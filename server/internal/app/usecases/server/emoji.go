@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"strconv"
+	"time"
+)
+
+// CreateEmoji - only the owner uploads emoji, the image type is detected from its bytes
+func (u *ServerUsecase) CreateEmoji(ctx context.Context, req usecases.CreateEmojiRequest) (*models.Emoji, error) {
+	if !models.ValidEmojiName(req.Name) {
+		return nil, pkgerrors.Wrap("create emoji: invalid name", models.ErrInvalidArgument)
+	}
+
+	if len(req.Image) == 0 {
+		return nil, pkgerrors.Wrap("create emoji: image is empty", models.ErrInvalidArgument)
+	}
+	if len(req.Image) > models.EmojiMaxSize {
+		return nil, pkgerrors.Wrap("create emoji: image is too large", models.ErrInvalidArgument)
+	}
+
+	contentType := models.DetectEmojiContentType(req.Image)
+	if contentType == "" {
+		return nil, pkgerrors.Wrap("create emoji: unsupported image type", models.ErrInvalidArgument)
+	}
+
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create emoji", err)
+	}
+
+	count, err := u.EmojiRepo.CountByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create emoji", err)
+	}
+	if count >= models.ServerEmojiLimit {
+		return nil, pkgerrors.Wrap("create emoji: too many emoji", models.ErrInvalidArgument)
+	}
+
+	err = u.checkEmojiNameFree(ctx, server.Id, req.Name)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create emoji", err)
+	}
+
+	id := models.EmojiID(uuid.New())
+	emoji := models.Emoji{
+		Id:          id,
+		ServerId:    server.Id,
+		Name:        req.Name,
+		ContentType: contentType,
+		Size:        int64(len(req.Image)),
+		BlobKey:     models.EmojiBlobKey(server.Id, id),
+		CreatorId:   server.OwnerId,
+		CreatedAt:   time.Now(),
+	}
+
+	err = u.BlobStore.Put(ctx, emoji.BlobKey, req.Image)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create emoji: store image", err)
+	}
+
+	err = u.EmojiRepo.CreateEmoji(ctx, emoji)
+	if err != nil {
+		u.deleteEmojiImage(ctx, &emoji)
+		return nil, pkgerrors.Wrap("create emoji", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionEmojiCreate,
+		TargetType: models.AuditTargetEmoji,
+		TargetId:   emoji.Id.String(),
+		After: map[string]string{
+			"name": emoji.Name,
+			"size": strconv.FormatInt(emoji.Size, 10),
+		},
+	})
+
+	return &emoji, nil
+}
+
+// ListServerEmoji - members see the emoji they can use in messages
+func (u *ServerUsecase) ListServerEmoji(ctx context.Context, req usecases.ListServerEmojiRequest) ([]*models.Emoji, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list server emoji", err)
+	}
+
+	err = u.checkServerMember(ctx, server, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list server emoji", err)
+	}
+
+	emoji, err := u.EmojiRepo.ListByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list server emoji", err)
+	}
+
+	return emoji, nil
+}
+
+// RenameEmoji - messages sent before keep the old name in their tokens, the id still resolves
+func (u *ServerUsecase) RenameEmoji(ctx context.Context, req usecases.RenameEmojiRequest) (*models.Emoji, error) {
+	if !models.ValidEmojiName(req.Name) {
+		return nil, pkgerrors.Wrap("rename emoji: invalid name", models.ErrInvalidArgument)
+	}
+
+	server, emoji, err := u.getOwnedEmoji(ctx, req.ServerId, req.EmojiId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("rename emoji", err)
+	}
+
+	if emoji.Name == req.Name {
+		return emoji, nil
+	}
+
+	err = u.checkEmojiNameFree(ctx, server.Id, req.Name)
+	if err != nil {
+		return nil, pkgerrors.Wrap("rename emoji", err)
+	}
+
+	err = u.EmojiRepo.RenameEmoji(ctx, emoji.Id, req.Name)
+	if err != nil {
+		return nil, pkgerrors.Wrap("rename emoji", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionEmojiUpdate,
+		TargetType: models.AuditTargetEmoji,
+		TargetId:   emoji.Id.String(),
+		Before:     map[string]string{"name": emoji.Name},
+		After:      map[string]string{"name": req.Name},
+	})
+
+	emoji.Name = req.Name
+
+	return emoji, nil
+}
+
+// DeleteEmoji - tokens in sent messages stay, clients show them as text when the image is gone
+func (u *ServerUsecase) DeleteEmoji(ctx context.Context, req usecases.DeleteEmojiRequest) (*models.ActionInfo, error) {
+	server, emoji, err := u.getOwnedEmoji(ctx, req.ServerId, req.EmojiId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete emoji", err)
+	}
+
+	err = u.EmojiRepo.DeleteEmoji(ctx, emoji.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete emoji", err)
+	}
+
+	u.deleteEmojiImage(ctx, emoji)
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionEmojiDelete,
+		TargetType: models.AuditTargetEmoji,
+		TargetId:   emoji.Id.String(),
+		Before:     map[string]string{"name": emoji.Name},
+	})
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// GetEmojiImage - emoji are shown to everybody who sees a message with them, so no membership is required
+func (u *ServerUsecase) GetEmojiImage(ctx context.Context, req usecases.GetEmojiImageRequest) (*models.EmojiImage, error) {
+	emoji, err := u.EmojiRepo.GetEmojiById(ctx, models.EmojiID(uuid.MustParse(req.EmojiId)))
+	if err != nil {
+		return nil, pkgerrors.Wrap("get emoji image", err)
+	}
+
+	data, err := u.BlobStore.Get(ctx, emoji.BlobKey)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get emoji image", err)
+	}
+
+	return &models.EmojiImage{
+		ContentType: emoji.ContentType,
+		Data:        data,
+	}, nil
+}
+
+func (u *ServerUsecase) getOwnedEmoji(ctx context.Context, serverId string, emojiId string, userId string) (*models.ServerInfo, *models.Emoji, error) {
+	server, err := u.getOwnedServer(ctx, serverId, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	emoji, err := u.EmojiRepo.GetEmojiById(ctx, models.EmojiID(uuid.MustParse(emojiId)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if emoji.ServerId != server.Id {
+		return nil, nil, models.ErrNotFound
+	}
+
+	return server, emoji, nil
+}
+
+func (u *ServerUsecase) checkEmojiNameFree(ctx context.Context, serverId models.ServerID, name string) error {
+	_, err := u.EmojiRepo.GetEmojiByName(ctx, serverId, name)
+	if err == nil {
+		return pkgerrors.Wrap("name is taken", models.ErrAlreadyExists)
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// deleteEmojiImage - a left image only takes space, so the error is logged
+func (u *ServerUsecase) deleteEmojiImage(ctx context.Context, emoji *models.Emoji) {
+	err := u.BlobStore.Delete(ctx, emoji.BlobKey)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("emoji_id", emoji.Id.String()).Error("delete emoji image error")
+	}
+}
+
+// resolveEmoji - a failed lookup leaves the tokens as text, the message is still sent
+func (u *ServerUsecase) resolveEmoji(ctx context.Context, server *models.ServerInfo, text string) string {
+	emoji, err := u.EmojiRepo.ListByServerId(ctx, server.Id)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", server.Id.String()).Error("list emoji for message error")
+		return text
+	}
+
+	return models.ResolveEmojiTokens(text, emoji)
+}
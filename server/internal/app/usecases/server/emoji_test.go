@@ -0,0 +1,709 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"time"
+)
+
+var pngImage = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func Test_usecase_ServerUsecase_CreateEmoji(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo *mocks.ServerStorage
+		Log        *log.Logger
+		AuditRepo  *mocks.AuditStorage
+		EmojiRepo  *mocks.EmojiStorage
+		BlobStore  *mocks.BlobStore
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateEmojiRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.Emoji
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party_parrot",
+					Image:         pngImage,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.Emoji{
+				ServerId:    serverID,
+				Name:        "party_parrot",
+				ContentType: "image/png",
+				Size:        int64(len(pngImage)),
+				CreatorId:   ownerID,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(3), nil)
+
+				f.EmojiRepo.On("GetEmojiByName", ctx, serverID, "party_parrot").
+					Return(nil, models.ErrNotFound)
+
+				f.BlobStore.On("Put",
+					ctx,
+					mock.MatchedBy(func(key string) bool {
+						return strings.HasPrefix(key, "emoji/"+serverID.String()+"/")
+					}),
+					pngImage,
+				).
+					Return(nil)
+
+				f.EmojiRepo.On("CreateEmoji",
+					ctx,
+					mock.MatchedBy(func(emoji models.Emoji) bool {
+						return emoji.ServerId == serverID &&
+							emoji.Name == "party_parrot" &&
+							emoji.BlobKey == models.EmojiBlobKey(serverID, emoji.Id)
+					}),
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.Action == models.AuditActionEmojiCreate &&
+							entry.TargetType == models.AuditTargetEmoji &&
+							entry.After["name"] == "party_parrot"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BlobStore.AssertNumberOfCalls(t, "Put", 1)
+				f.EmojiRepo.AssertNumberOfCalls(t, "CreateEmoji", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Invalid name",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party parrot",
+					Image:         pngImage,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create emoji: invalid name: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Image is not a picture",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party",
+					Image:         []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create emoji: unsupported image type: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Image is too large",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party",
+					Image:         append(append([]byte{}, pngImage...), bytes.Repeat([]byte{0}, models.EmojiMaxSize)...),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create emoji: image is too large: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Quota is reached",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party",
+					Image:         pngImage,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create emoji: too many emoji: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(models.ServerEmojiLimit), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BlobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Name is taken",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party",
+					Image:         pngImage,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create emoji: name is taken: already exists",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(3), nil)
+
+				f.EmojiRepo.On("GetEmojiByName", ctx, serverID, "party").
+					Return(&models.Emoji{ServerId: serverID, Name: "party"}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BlobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Negative. Stored image is removed when the record is not created",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "party",
+					Image:         pngImage,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create emoji: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(3), nil)
+
+				f.EmojiRepo.On("GetEmojiByName", ctx, serverID, "party").
+					Return(nil, models.ErrNotFound)
+
+				f.BlobStore.On("Put", ctx, mock.AnythingOfType("string"), pngImage).
+					Return(nil)
+
+				f.EmojiRepo.On("CreateEmoji", ctx, mock.AnythingOfType("models.Emoji")).
+					Return(errors.New("some error"))
+
+				f.BlobStore.On("Delete", ctx, mock.AnythingOfType("string")).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BlobStore.AssertNumberOfCalls(t, "Delete", 1)
+				f.AuditRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo: mocks.NewServerStorage(t),
+				Log:        &log.Logger{},
+				AuditRepo:  mocks.NewAuditStorage(t),
+				EmojiRepo:  mocks.NewEmojiStorage(t),
+				BlobStore:  mocks.NewBlobStore(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo: f.ServerRepo,
+				Log:        f.Log,
+				AuditRepo:  f.AuditRepo,
+				EmojiRepo:  f.EmojiRepo,
+				BlobStore:  f.BlobStore,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateEmoji(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateEmoji() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			// id, blob key and creation time are generated
+			if got != nil {
+				got.Id = models.EmojiID{}
+				got.BlobKey = ""
+				got.CreatedAt = time.Time{}
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_RenameEmoji(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo *mocks.ServerStorage
+		Log        *log.Logger
+		AuditRepo  *mocks.AuditStorage
+		EmojiRepo  *mocks.EmojiStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	emojiID := models.EmojiID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+	newEmoji := func(serverId models.ServerID) *models.Emoji {
+		return &models.Emoji{
+			Id:       emojiID,
+			ServerId: serverId,
+			Name:     "party",
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.RenameEmojiRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.Emoji
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RenameEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					EmojiId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Name:          "celebrate",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.Emoji{
+				Id:       emojiID,
+				ServerId: serverID,
+				Name:     "celebrate",
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("GetEmojiById", ctx, emojiID).
+					Return(newEmoji(serverID), nil)
+
+				f.EmojiRepo.On("GetEmojiByName", ctx, serverID, "celebrate").
+					Return(nil, models.ErrNotFound)
+
+				f.EmojiRepo.On("RenameEmoji", ctx, emojiID, "celebrate").
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.Action == models.AuditActionEmojiUpdate &&
+							entry.Before["name"] == "party" &&
+							entry.After["name"] == "celebrate"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.EmojiRepo.AssertNumberOfCalls(t, "RenameEmoji", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Emoji of another server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RenameEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					EmojiId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Name:          "celebrate",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "rename emoji: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("GetEmojiById", ctx, emojiID).
+					Return(newEmoji(models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001"))), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.EmojiRepo.AssertNotCalled(t, "RenameEmoji", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Name is taken",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RenameEmojiRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					EmojiId:       "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Name:          "celebrate",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "rename emoji: name is taken: already exists",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("GetEmojiById", ctx, emojiID).
+					Return(newEmoji(serverID), nil)
+
+				f.EmojiRepo.On("GetEmojiByName", ctx, serverID, "celebrate").
+					Return(&models.Emoji{ServerId: serverID, Name: "celebrate"}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.EmojiRepo.AssertNotCalled(t, "RenameEmoji", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo: mocks.NewServerStorage(t),
+				Log:        &log.Logger{},
+				AuditRepo:  mocks.NewAuditStorage(t),
+				EmojiRepo:  mocks.NewEmojiStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo: f.ServerRepo,
+				Log:        f.Log,
+				AuditRepo:  f.AuditRepo,
+				EmojiRepo:  f.EmojiRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RenameEmoji(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RenameEmoji() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_PublishMessageOnServer_Emoji(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo   *mocks.ServerStorage
+		Log          *log.Logger
+		ChatService  *mocks.ServiceChatInterface
+		OutgoingRepo *mocks.OutgoingWebhookStorage
+		EmojiRepo    *mocks.EmojiStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+	emoji := []*models.Emoji{
+		{Id: models.EmojiID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")), ServerId: serverID, Name: "party"},
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.PublishMessageOnServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Known emoji are resolved",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "release :party: at 12:30 :unknown: <:party:284fef68-7e3e-4d1d-96a0-8c96f7b3b801>",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("ListByServerId", ctx, serverID).
+					Return(emoji, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "release <:party:284fef68-7e3e-4d1d-96a0-8c96f7b3b800> at 12:30 :unknown: <:party:284fef68-7e3e-4d1d-96a0-8c96f7b3b801>",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Text is sent as it is when emoji can not be listed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "release :party:",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.EmojiRepo.On("ListByServerId", ctx, serverID).
+					Return(nil, errors.New("some error"))
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "release :party:",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Text without tokens does not list emoji",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "meet at 12:30",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Text:          "meet at 12:30",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.EmojiRepo.AssertNotCalled(t, "ListByServerId", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			logger, err := log.NewLogger(log.NewDefaultConfig())
+			assert.NoError(t, err)
+
+			f := &fields{
+				ServerRepo:   mocks.NewServerStorage(t),
+				Log:          logger,
+				ChatService:  mocks.NewServiceChatInterface(t),
+				OutgoingRepo: mocks.NewOutgoingWebhookStorage(t),
+				EmojiRepo:    mocks.NewEmojiStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:   f.ServerRepo,
+				Log:          f.Log,
+				ChatService:  f.ChatService,
+				OutgoingRepo: f.OutgoingRepo,
+				EmojiRepo:    f.EmojiRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PublishMessageOnServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.PublishMessageOnServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, &models.ActionInfo{Success: true}, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
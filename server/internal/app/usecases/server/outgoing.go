@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const outgoingWebhookDisabledError = "webhook is disabled"
+
+// CreateOutgoingWebhook - only the owner registers callbacks, the secret is returned only here
+func (u *ServerUsecase) CreateOutgoingWebhook(ctx context.Context, req usecases.CreateOutgoingWebhookRequest) (*models.OutgoingWebhook, error) {
+	callbackUrl, err := url.Parse(req.Url)
+	if err != nil || callbackUrl.Scheme != "https" || callbackUrl.Host == "" {
+		return nil, pkgerrors.Wrap("create outgoing webhook: url must be https", models.ErrInvalidArgument)
+	}
+
+	events, err := outgoingEvents(req.Events)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create outgoing webhook", err)
+	}
+
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create outgoing webhook", err)
+	}
+
+	count, err := u.OutgoingRepo.CountByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create outgoing webhook", err)
+	}
+	if count >= models.ServerOutgoingWebhookLimit {
+		return nil, pkgerrors.Wrap("create outgoing webhook: too many webhooks", models.ErrInvalidArgument)
+	}
+
+	webhook := models.OutgoingWebhook{
+		Id:        models.OutgoingWebhookID(uuid.New()),
+		ServerId:  server.Id,
+		Url:       callbackUrl.String(),
+		Events:    events,
+		CreatorId: server.OwnerId,
+		Enabled:   true,
+		CreatedAt: time.Now(),
+	}
+	err = webhook.SetNewSecret()
+	if err != nil {
+		return nil, pkgerrors.Wrap("create outgoing webhook: generate secret", err)
+	}
+
+	err = u.OutgoingRepo.CreateOutgoingWebhook(ctx, webhook)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create outgoing webhook", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionOutgoingWebhookCreate,
+		TargetType: models.AuditTargetOutgoingWebhook,
+		TargetId:   webhook.Id.String(),
+		After: map[string]string{
+			"url":    webhook.Url,
+			"events": strings.Join(webhook.Events, ","),
+		},
+	})
+
+	return &webhook, nil
+}
+
+// outgoingEvents - known event types without duplicates in the requested order
+func outgoingEvents(events []string) ([]string, error) {
+	result := make([]string, 0, len(events))
+	seen := make(map[string]bool, len(events))
+	for _, event := range events {
+		if !models.OutgoingEventTypes[event] {
+			return nil, pkgerrors.Wrap("unknown event type "+event, models.ErrInvalidArgument)
+		}
+		if seen[event] {
+			continue
+		}
+		seen[event] = true
+		result = append(result, event)
+	}
+
+	if len(result) == 0 {
+		return nil, pkgerrors.Wrap("no event types", models.ErrInvalidArgument)
+	}
+
+	return result, nil
+}
+
+// ListOutgoingWebhooks - secrets are not returned
+func (u *ServerUsecase) ListOutgoingWebhooks(ctx context.Context, req usecases.ListOutgoingWebhooksRequest) ([]*models.OutgoingWebhook, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list outgoing webhooks", err)
+	}
+
+	webhooks, err := u.OutgoingRepo.ListByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list outgoing webhooks", err)
+	}
+
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+
+	return webhooks, nil
+}
+
+// EnableOutgoingWebhook - turns on a webhook disabled after failures, deliveries failed meanwhile are not resent
+func (u *ServerUsecase) EnableOutgoingWebhook(ctx context.Context, req usecases.EnableOutgoingWebhookRequest) (*models.OutgoingWebhook, error) {
+	server, webhook, err := u.getOwnedOutgoingWebhook(ctx, req.ServerId, req.WebhookId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("enable outgoing webhook", err)
+	}
+
+	err = u.OutgoingRepo.Enable(ctx, webhook.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("enable outgoing webhook", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionOutgoingWebhookEnable,
+		TargetType: models.AuditTargetOutgoingWebhook,
+		TargetId:   webhook.Id.String(),
+	})
+
+	webhook.Enabled = true
+	webhook.ConsecutiveFailures = 0
+	webhook.DisabledAt = time.Time{}
+	webhook.Secret = ""
+
+	return webhook, nil
+}
+
+// DeleteOutgoingWebhook - removes the webhook with its delivery log
+func (u *ServerUsecase) DeleteOutgoingWebhook(ctx context.Context, req usecases.DeleteOutgoingWebhookRequest) (*models.ActionInfo, error) {
+	server, webhook, err := u.getOwnedOutgoingWebhook(ctx, req.ServerId, req.WebhookId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete outgoing webhook", err)
+	}
+
+	err = u.OutgoingRepo.DeleteOutgoingWebhook(ctx, webhook.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete outgoing webhook", err)
+	}
+
+	// pending deliveries of a deleted webhook are failed by the worker anyway
+	err = u.DeliveryRepo.DeleteByWebhookId(ctx, webhook.Id)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("webhook_id", webhook.Id.String()).Error("delete outgoing deliveries error")
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionOutgoingWebhookDelete,
+		TargetType: models.AuditTargetOutgoingWebhook,
+		TargetId:   webhook.Id.String(),
+		Before:     map[string]string{"url": webhook.Url},
+	})
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// ListOutgoingDeliveries - the latest deliveries of the webhook, newest first
+func (u *ServerUsecase) ListOutgoingDeliveries(ctx context.Context, req usecases.ListOutgoingDeliveriesRequest) ([]*models.OutgoingDelivery, error) {
+	_, webhook, err := u.getOwnedOutgoingWebhook(ctx, req.ServerId, req.WebhookId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list outgoing deliveries", err)
+	}
+
+	deliveries, err := u.DeliveryRepo.ListByWebhookId(ctx, webhook.Id, models.OutgoingDeliveryLogLimit)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list outgoing deliveries", err)
+	}
+
+	return deliveries, nil
+}
+
+// getOwnedOutgoingWebhook - webhook of another server is reported as not found
+func (u *ServerUsecase) getOwnedOutgoingWebhook(ctx context.Context, serverId string, webhookId string, userId string) (*models.ServerInfo, *models.OutgoingWebhook, error) {
+	server, err := u.getOwnedServer(ctx, serverId, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook, err := u.OutgoingRepo.GetOutgoingWebhookById(ctx, models.OutgoingWebhookID(uuid.MustParse(webhookId)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if webhook.ServerId != server.Id {
+		return nil, nil, models.ErrNotFound
+	}
+
+	return server, webhook, nil
+}
+
+// emitOutgoingEvent - queues the event for every subscribed webhook,
+// the action is already done so a failure is logged and does not fail it
+func (u *ServerUsecase) emitOutgoingEvent(ctx context.Context, serverId models.ServerID, eventType string, data map[string]string) {
+	webhooks, err := u.OutgoingRepo.ListSubscribed(ctx, serverId, eventType)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("list outgoing webhooks error")
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	event := models.NewOutgoingEvent(eventType, serverId, data)
+	payload, err := event.Payload()
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("encode outgoing event error")
+		return
+	}
+
+	now := time.Now()
+	for _, webhook := range webhooks {
+		err = u.DeliveryRepo.CreateDelivery(ctx, models.OutgoingDelivery{
+			Id:            models.OutgoingDeliveryID(uuid.New()),
+			WebhookId:     webhook.Id,
+			ServerId:      serverId,
+			EventId:       event.Id,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        models.OutgoingDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			u.Log.WithContext(ctx).WithError(err).WithField("webhook_id", webhook.Id.String()).Error("create outgoing delivery error")
+		}
+	}
+}
+
+// DeliverOutgoingEvents - makes one attempt for every due delivery of a batch, returns the number of handled deliveries
+func (u *ServerUsecase) DeliverOutgoingEvents(ctx context.Context) (int, error) {
+	deliveries, err := u.DeliveryRepo.ListDue(ctx, time.Now(), models.OutgoingDeliveryBatchSize)
+	if err != nil {
+		return 0, pkgerrors.Wrap("deliver outgoing events", err)
+	}
+
+	// webhooks are shared by deliveries of the batch, failures change them between attempts
+	webhooks := make(map[models.OutgoingWebhookID]*models.OutgoingWebhook)
+	for i, delivery := range deliveries {
+		err = u.deliver(ctx, delivery, webhooks)
+		if err != nil {
+			return i, pkgerrors.Wrap("deliver outgoing events", err)
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (u *ServerUsecase) deliver(ctx context.Context, delivery *models.OutgoingDelivery, webhooks map[models.OutgoingWebhookID]*models.OutgoingWebhook) error {
+	webhook, ok := webhooks[delivery.WebhookId]
+	if !ok {
+		var err error
+		webhook, err = u.OutgoingRepo.GetOutgoingWebhookById(ctx, delivery.WebhookId)
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			return pkgerrors.Wrap("get outgoing webhook", err)
+		}
+		webhooks[delivery.WebhookId] = webhook
+	}
+
+	now := time.Now()
+	delivery.UpdatedAt = now
+
+	if webhook == nil || !webhook.Enabled {
+		delivery.Status = models.OutgoingDeliveryFailed
+		delivery.LastError = outgoingWebhookDisabledError
+		return u.updateDelivery(ctx, delivery)
+	}
+
+	statusCode, sendErr := u.OutgoingSender.Send(ctx, models.OutgoingRequest{
+		Url:        webhook.Url,
+		Secret:     webhook.Secret,
+		DeliveryId: delivery.Id.String(),
+		EventType:  delivery.EventType,
+		Payload:    delivery.Payload,
+		Timestamp:  now,
+	})
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+
+	if sendErr == nil {
+		delivery.Status = models.OutgoingDeliverySucceeded
+		delivery.LastError = ""
+		err := u.updateDelivery(ctx, delivery)
+		if err != nil {
+			return err
+		}
+
+		if webhook.ConsecutiveFailures > 0 {
+			err = u.OutgoingRepo.RecordSuccess(ctx, webhook.Id)
+			if err != nil {
+				return pkgerrors.Wrap("record outgoing webhook success", err)
+			}
+			webhook.ConsecutiveFailures = 0
+		}
+
+		return nil
+	}
+
+	delivery.LastError = sendErr.Error()
+	if delivery.Attempts >= models.OutgoingDeliveryMaxAttempts {
+		delivery.Status = models.OutgoingDeliveryFailed
+	} else {
+		delivery.NextAttemptAt = now.Add(models.OutgoingRetryDelay(delivery.Attempts))
+	}
+
+	err := u.updateDelivery(ctx, delivery)
+	if err != nil {
+		return err
+	}
+
+	disabled, err := u.OutgoingRepo.RecordFailure(ctx, webhook.Id, models.OutgoingWebhookDisableAfter, now)
+	if err != nil {
+		return pkgerrors.Wrap("record outgoing webhook failure", err)
+	}
+	webhook.ConsecutiveFailures++
+
+	if disabled {
+		webhook.Enabled = false
+		u.Log.WithContext(ctx).
+			WithField("webhook_id", webhook.Id.String()).
+			WithField("server_id", webhook.ServerId.String()).
+			Warn("outgoing webhook disabled after repeated failures")
+	}
+
+	return nil
+}
+
+func (u *ServerUsecase) updateDelivery(ctx context.Context, delivery *models.OutgoingDelivery) error {
+	err := u.DeliveryRepo.UpdateAttempt(ctx, *delivery)
+	if err != nil {
+		return pkgerrors.Wrap("update outgoing delivery", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,728 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/services/outgoing"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ServerUsecase_CreateOutgoingWebhook(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo   *mocks.ServerStorage
+		Log          *log.Logger
+		AuditRepo    *mocks.AuditStorage
+		OutgoingRepo *mocks.OutgoingWebhookStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateOutgoingWebhookRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.OutgoingWebhook
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Duplicated events are removed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateOutgoingWebhookRequest{
+					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Url:      "https://example.com/hooks",
+					Events: []string{
+						models.OutgoingEventMessageCreated,
+						models.OutgoingEventMemberJoined,
+						models.OutgoingEventMessageCreated,
+					},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.OutgoingWebhook{
+				ServerId:  serverID,
+				Url:       "https://example.com/hooks",
+				Events:    []string{models.OutgoingEventMessageCreated, models.OutgoingEventMemberJoined},
+				CreatorId: ownerID,
+				Enabled:   true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.OutgoingRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(1), nil)
+
+				f.OutgoingRepo.On("CreateOutgoingWebhook",
+					ctx,
+					mock.MatchedBy(func(webhook models.OutgoingWebhook) bool {
+						return webhook.ServerId == serverID &&
+							webhook.Url == "https://example.com/hooks" &&
+							len(webhook.Events) == 2 &&
+							webhook.Enabled &&
+							webhook.Secret != ""
+					}),
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.Action == models.AuditActionOutgoingWebhookCreate &&
+							entry.TargetType == models.AuditTargetOutgoingWebhook &&
+							entry.After["url"] == "https://example.com/hooks"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNumberOfCalls(t, "CreateOutgoingWebhook", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Url is not https",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateOutgoingWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Url:           "http://example.com/hooks",
+					Events:        []string{models.OutgoingEventMessageCreated},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create outgoing webhook: url must be https: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Unknown event type",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateOutgoingWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Url:           "https://example.com/hooks",
+					Events:        []string{"server.exploded"},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create outgoing webhook: unknown event type server.exploded: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Not owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateOutgoingWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Url:           "https://example.com/hooks",
+					Events:        []string{models.OutgoingEventMessageCreated},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b001",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create outgoing webhook: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNotCalled(t, "CreateOutgoingWebhook", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Too many webhooks",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateOutgoingWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Url:           "https://example.com/hooks",
+					Events:        []string{models.OutgoingEventMessageCreated},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create outgoing webhook: too many webhooks: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.OutgoingRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(models.ServerOutgoingWebhookLimit), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNotCalled(t, "CreateOutgoingWebhook", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:   mocks.NewServerStorage(t),
+				Log:          &log.Logger{},
+				AuditRepo:    mocks.NewAuditStorage(t),
+				OutgoingRepo: mocks.NewOutgoingWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:   f.ServerRepo,
+				Log:          f.Log,
+				AuditRepo:    f.AuditRepo,
+				OutgoingRepo: f.OutgoingRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateOutgoingWebhook(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateOutgoingWebhook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			// id, secret and creation time are generated
+			if got != nil {
+				assert.Len(t, got.Secret, 64)
+				got.Id = models.OutgoingWebhookID{}
+				got.Secret = ""
+				got.CreatedAt = time.Time{}
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_DeleteOutgoingWebhook(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo   *mocks.ServerStorage
+		Log          *log.Logger
+		AuditRepo    *mocks.AuditStorage
+		OutgoingRepo *mocks.OutgoingWebhookStorage
+		DeliveryRepo *mocks.OutgoingDeliveryStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	webhookID := models.OutgoingWebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.DeleteOutgoingWebhookRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteOutgoingWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(&models.OutgoingWebhook{
+						Id:       webhookID,
+						ServerId: serverID,
+						Url:      "https://example.com/hooks",
+					}, nil)
+
+				f.OutgoingRepo.On("DeleteOutgoingWebhook", ctx, webhookID).
+					Return(nil)
+
+				f.DeliveryRepo.On("DeleteByWebhookId", ctx, webhookID).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.Action == models.AuditActionOutgoingWebhookDelete &&
+							entry.TargetId == webhookID.String() &&
+							entry.Before["url"] == "https://example.com/hooks"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.DeliveryRepo.AssertNumberOfCalls(t, "DeleteByWebhookId", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Webhook of another server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteOutgoingWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b900",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete outgoing webhook: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(&models.OutgoingWebhook{
+						Id:       webhookID,
+						ServerId: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001")),
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNotCalled(t, "DeleteOutgoingWebhook", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:   mocks.NewServerStorage(t),
+				Log:          &log.Logger{},
+				AuditRepo:    mocks.NewAuditStorage(t),
+				OutgoingRepo: mocks.NewOutgoingWebhookStorage(t),
+				DeliveryRepo: mocks.NewOutgoingDeliveryStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:   f.ServerRepo,
+				Log:          f.Log,
+				AuditRepo:    f.AuditRepo,
+				OutgoingRepo: f.OutgoingRepo,
+				DeliveryRepo: f.DeliveryRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.DeleteOutgoingWebhook(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.DeleteOutgoingWebhook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+// outgoingReceiver - local stand-in of an integration, answers every request with the status
+type outgoingReceiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []receivedRequest
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   string
+}
+
+func (r *outgoingReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: string(body)})
+	r.mu.Unlock()
+
+	w.WriteHeader(r.status)
+}
+
+func (r *outgoingReceiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.requests
+}
+
+func Test_usecase_ServerUsecase_DeliverOutgoingEvents(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		Log          *log.Logger
+		OutgoingRepo *mocks.OutgoingWebhookStorage
+		DeliveryRepo *mocks.OutgoingDeliveryStorage
+		Receiver     *outgoingReceiver
+		ReceiverUrl  string
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	webhookID := models.OutgoingWebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900"))
+	const secret = "0123456789abcdef"
+	const payload = `{"id":"event","type":"message.created"}`
+
+	newWebhook := func(f *fields, enabled bool, failures int) *models.OutgoingWebhook {
+		return &models.OutgoingWebhook{
+			Id:                  webhookID,
+			ServerId:            serverID,
+			Url:                 f.ReceiverUrl,
+			Events:              []string{models.OutgoingEventMessageCreated},
+			Secret:              secret,
+			Enabled:             enabled,
+			ConsecutiveFailures: failures,
+		}
+	}
+	newDelivery := func(id string, attempts int) *models.OutgoingDelivery {
+		return &models.OutgoingDelivery{
+			Id:        models.OutgoingDeliveryID(uuid.MustParse(id)),
+			WebhookId: webhookID,
+			ServerId:  serverID,
+			EventId:   "event",
+			EventType: models.OutgoingEventMessageCreated,
+			Payload:   payload,
+			Status:    models.OutgoingDeliveryPending,
+			Attempts:  attempts,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		receiverStatus int
+		want           int
+		wantErr        bool
+		errorString    string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name:           "Test 1. Positive. Signed requests are accepted",
+			receiverStatus: http.StatusNoContent,
+			want:           2,
+			wantErr:        false,
+
+			on: func(f *fields) {
+				f.DeliveryRepo.On("ListDue", ctx, mock.AnythingOfType("time.Time"), models.OutgoingDeliveryBatchSize).
+					Return([]*models.OutgoingDelivery{
+						newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b901", 0),
+						newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b902", 2),
+					}, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(newWebhook(f, true, 3), nil)
+
+				f.DeliveryRepo.On("UpdateAttempt", ctx, mock.MatchedBy(func(delivery models.OutgoingDelivery) bool {
+					return delivery.Status == models.OutgoingDeliverySucceeded &&
+						delivery.LastStatusCode == http.StatusNoContent &&
+						delivery.LastError == ""
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("RecordSuccess", ctx, webhookID).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				// the webhook is loaded once per batch and its failures are reset once
+				f.OutgoingRepo.AssertNumberOfCalls(t, "GetOutgoingWebhookById", 1)
+				f.OutgoingRepo.AssertNumberOfCalls(t, "RecordSuccess", 1)
+				f.DeliveryRepo.AssertNumberOfCalls(t, "UpdateAttempt", 2)
+
+				requests := f.Receiver.received()
+				assert.Len(t, requests, 2)
+				for _, req := range requests {
+					unix, err := strconv.ParseInt(req.header.Get(outgoing.TimestampHeader), 10, 64)
+					assert.NoError(t, err)
+
+					assert.Equal(t, payload, req.body)
+					assert.Equal(t, models.OutgoingEventMessageCreated, req.header.Get(outgoing.EventHeader))
+					assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+					assert.Equal(t,
+						models.SignOutgoingPayload(secret, time.Unix(unix, 0), req.body),
+						req.header.Get(outgoing.SignatureHeader),
+					)
+				}
+				assert.Equal(t, "284fef68-7e3e-4d1d-96a0-8c96f7b3b901", requests[0].header.Get(outgoing.DeliveryHeader))
+			},
+		},
+		{
+			name:           "Test 2. Positive. Failed attempt is retried with backoff",
+			receiverStatus: http.StatusInternalServerError,
+			want:           1,
+			wantErr:        false,
+
+			on: func(f *fields) {
+				f.DeliveryRepo.On("ListDue", ctx, mock.AnythingOfType("time.Time"), models.OutgoingDeliveryBatchSize).
+					Return([]*models.OutgoingDelivery{
+						newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b901", 2),
+					}, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(newWebhook(f, true, 0), nil)
+
+				f.DeliveryRepo.On("UpdateAttempt", ctx, mock.MatchedBy(func(delivery models.OutgoingDelivery) bool {
+					delay := time.Until(delivery.NextAttemptAt)
+					return delivery.Status == models.OutgoingDeliveryPending &&
+						delivery.Attempts == 3 &&
+						delivery.LastStatusCode == http.StatusInternalServerError &&
+						delivery.LastError == "unexpected status code 500" &&
+						delay > 4*models.OutgoingRetryBaseDelay-time.Minute &&
+						delay <= 4*models.OutgoingRetryBaseDelay
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("RecordFailure", ctx, webhookID, models.OutgoingWebhookDisableAfter, mock.AnythingOfType("time.Time")).
+					Return(false, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				assert.Len(t, f.Receiver.received(), 1)
+			},
+		},
+		{
+			name:           "Test 3. Positive. Last attempt fails the delivery and disables the webhook",
+			receiverStatus: http.StatusBadGateway,
+			want:           1,
+			wantErr:        false,
+
+			on: func(f *fields) {
+				f.DeliveryRepo.On("ListDue", ctx, mock.AnythingOfType("time.Time"), models.OutgoingDeliveryBatchSize).
+					Return([]*models.OutgoingDelivery{
+						newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b901", models.OutgoingDeliveryMaxAttempts-1),
+					}, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(newWebhook(f, true, models.OutgoingWebhookDisableAfter-1), nil)
+
+				f.DeliveryRepo.On("UpdateAttempt", ctx, mock.MatchedBy(func(delivery models.OutgoingDelivery) bool {
+					return delivery.Status == models.OutgoingDeliveryFailed &&
+						delivery.Attempts == models.OutgoingDeliveryMaxAttempts &&
+						delivery.LastStatusCode == http.StatusBadGateway
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("RecordFailure", ctx, webhookID, models.OutgoingWebhookDisableAfter, mock.AnythingOfType("time.Time")).
+					Return(true, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNumberOfCalls(t, "RecordFailure", 1)
+			},
+		},
+		{
+			name:           "Test 4. Positive. Deliveries of a disabled webhook are not sent",
+			receiverStatus: http.StatusOK,
+			want:           2,
+			wantErr:        false,
+
+			on: func(f *fields) {
+				deleted := newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b902", 0)
+				deleted.WebhookId = models.OutgoingWebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b999"))
+
+				f.DeliveryRepo.On("ListDue", ctx, mock.AnythingOfType("time.Time"), models.OutgoingDeliveryBatchSize).
+					Return([]*models.OutgoingDelivery{
+						newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b901", 0),
+						deleted,
+					}, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(newWebhook(f, false, models.OutgoingWebhookDisableAfter), nil)
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, deleted.WebhookId).
+					Return(nil, models.ErrNotFound)
+
+				f.DeliveryRepo.On("UpdateAttempt", ctx, mock.MatchedBy(func(delivery models.OutgoingDelivery) bool {
+					return delivery.Status == models.OutgoingDeliveryFailed &&
+						delivery.Attempts == 0 &&
+						delivery.LastError == "webhook is disabled"
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.DeliveryRepo.AssertNumberOfCalls(t, "UpdateAttempt", 2)
+				assert.Empty(t, f.Receiver.received())
+			},
+		},
+		{
+			name:           "Test 5. Negative. UpdateAttempt returns error",
+			receiverStatus: http.StatusOK,
+			want:           0,
+			wantErr:        true,
+			errorString:    "deliver outgoing events: update outgoing delivery: some error",
+
+			on: func(f *fields) {
+				f.DeliveryRepo.On("ListDue", ctx, mock.AnythingOfType("time.Time"), models.OutgoingDeliveryBatchSize).
+					Return([]*models.OutgoingDelivery{
+						newDelivery("284fef68-7e3e-4d1d-96a0-8c96f7b3b901", 0),
+					}, nil)
+
+				f.OutgoingRepo.On("GetOutgoingWebhookById", ctx, webhookID).
+					Return(newWebhook(f, true, 0), nil)
+
+				f.DeliveryRepo.On("UpdateAttempt", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: nil,
+		},
+		{
+			name:           "Test 6. Negative. ListDue returns error",
+			receiverStatus: http.StatusOK,
+			want:           0,
+			wantErr:        true,
+			errorString:    "deliver outgoing events: some error",
+
+			on: func(f *fields) {
+				f.DeliveryRepo.On("ListDue", ctx, mock.AnythingOfType("time.Time"), models.OutgoingDeliveryBatchSize).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.OutgoingRepo.AssertNotCalled(t, "GetOutgoingWebhookById", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			receiver := &outgoingReceiver{status: tt.receiverStatus}
+			receiverServer := httptest.NewServer(receiver)
+			defer receiverServer.Close()
+
+			logger, err := log.NewLogger(log.NewDefaultConfig())
+			assert.NoError(t, err)
+
+			f := &fields{
+				Log:          logger,
+				OutgoingRepo: mocks.NewOutgoingWebhookStorage(t),
+				DeliveryRepo: mocks.NewOutgoingDeliveryStorage(t),
+				Receiver:     receiver,
+				ReceiverUrl:  receiverServer.URL,
+			}
+			au := NewServerUsecase(Deps{
+				Log:            f.Log,
+				OutgoingRepo:   f.OutgoingRepo,
+				DeliveryRepo:   f.DeliveryRepo,
+				OutgoingSender: outgoing.NewHttpSender(5 * time.Second),
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.DeliverOutgoingEvents(ctx)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.DeliverOutgoingEvents() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
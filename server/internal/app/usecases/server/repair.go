@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// repairBatchSize - servers loaded per page while recomputing member counts
+const repairBatchSize = 500
+
+// RepairMembers - removes duplicated subscriptions, subscribes owners and recomputes member counts,
+// has to run before the unique membership index can be built on existing data
+func (u *ServerUsecase) RepairMembers(ctx context.Context) (*models.RepairMembersResult, error) {
+	result := &models.RepairMembersResult{}
+
+	duplicates, err := u.SubscribeRepo.FindDuplicates(ctx)
+	if err != nil {
+		return nil, pkgerrors.Wrap("repair members: find duplicates", err)
+	}
+
+	if len(duplicates) > 0 {
+		result.DuplicatesRemoved, err = u.SubscribeRepo.DeleteByIds(ctx, duplicates)
+		if err != nil {
+			return nil, pkgerrors.Wrap("repair members: delete duplicates", err)
+		}
+	}
+
+	var after *models.ServerID
+	for {
+		servers, err := u.ServerRepo.ListServers(ctx, after, repairBatchSize)
+		if err != nil {
+			return nil, pkgerrors.Wrap("repair members: list servers", err)
+		}
+
+		for _, server := range servers {
+			err = u.repairServerMembers(ctx, server, result)
+			if err != nil {
+				return nil, pkgerrors.Wrap("repair members: server "+server.Id.String(), err)
+			}
+		}
+
+		if len(servers) < repairBatchSize {
+			break
+		}
+		after = &servers[len(servers)-1].Id
+	}
+
+	return result, nil
+}
+
+func (u *ServerUsecase) repairServerMembers(ctx context.Context, server *models.ServerInfo, result *models.RepairMembersResult) error {
+	_, err := u.SubscribeRepo.GetSubscribe(ctx, server.Id, server.OwnerId)
+	if errors.Is(err, models.ErrNotFound) {
+		err = u.SubscribeRepo.CreateSubscribe(ctx, models.SubscribeInfo{
+			Id:       models.SubscribeID(uuid.New()),
+			ServerId: server.Id,
+			UserId:   server.OwnerId,
+			JoinedAt: time.Now(),
+		})
+		if err == nil {
+			result.OwnersSubscribed++
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	count, err := u.SubscribeRepo.CountByServerId(ctx, server.Id)
+	if err != nil {
+		return err
+	}
+
+	if count == server.MemberCount {
+		return nil
+	}
+
+	err = u.ServerRepo.SetMemberCount(ctx, server.Id, count)
+	if err != nil {
+		return err
+	}
+	result.CountsUpdated++
+
+	return nil
+}
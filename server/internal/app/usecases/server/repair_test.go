@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_usecase_ServerUsecase_RepairMembers(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	firstServerID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	secondServerID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	duplicates := []models.SubscribeID{
+		models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b900")),
+		models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b901")),
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.RepairMembersResult
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Duplicates removed, owner subscribed and counts updated",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want: &models.RepairMembersResult{
+				DuplicatesRemoved: 2,
+				OwnersSubscribed:  1,
+				CountsUpdated:     1,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("FindDuplicates", ctx).
+					Return(duplicates, nil)
+
+				f.SubscribeRepo.On("DeleteByIds", ctx, duplicates).
+					Return(int64(2), nil)
+
+				f.ServerRepo.On("ListServers", ctx, (*models.ServerID)(nil), repairBatchSize).
+					Return([]*models.ServerInfo{
+						{
+							Id:          firstServerID,
+							OwnerId:     ownerID,
+							MemberCount: 3,
+						},
+						{
+							Id:          secondServerID,
+							OwnerId:     ownerID,
+							MemberCount: 2,
+						},
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, firstServerID, ownerID).
+					Return(nil, models.ErrNotFound)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.MatchedBy(func(s models.SubscribeInfo) bool {
+					return s.ServerId == firstServerID && s.UserId == ownerID && !s.JoinedAt.IsZero()
+				})).
+					Return(nil)
+
+				f.SubscribeRepo.On("CountByServerId", ctx, firstServerID).
+					Return(int64(2), nil)
+
+				f.ServerRepo.On("SetMemberCount", ctx, firstServerID, int64(2)).
+					Return(nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, secondServerID, ownerID).
+					Return(&models.SubscribeInfo{
+						ServerId: secondServerID,
+						UserId:   ownerID,
+					}, nil)
+
+				f.SubscribeRepo.On("CountByServerId", ctx, secondServerID).
+					Return(int64(2), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "SetMemberCount", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Nothing to repair",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			want:    &models.RepairMembersResult{},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("FindDuplicates", ctx).
+					Return([]models.SubscribeID{}, nil)
+
+				f.ServerRepo.On("ListServers", ctx, (*models.ServerID)(nil), repairBatchSize).
+					Return([]*models.ServerInfo{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteByIds", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. FindDuplicates returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			wantErr:     true,
+			errorString: "repair members: find duplicates: some error",
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("FindDuplicates", ctx).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "ListServers", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. CountByServerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+			},
+			wantErr:     true,
+			errorString: "repair members: server 284fef68-7e3e-4d1d-96a0-8c96f7b3b000: some error",
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("FindDuplicates", ctx).
+					Return([]models.SubscribeID{}, nil)
+
+				f.ServerRepo.On("ListServers", ctx, (*models.ServerID)(nil), repairBatchSize).
+					Return([]*models.ServerInfo{
+						{
+							Id:      firstServerID,
+							OwnerId: ownerID,
+						},
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, firstServerID, ownerID).
+					Return(&models.SubscribeInfo{
+						ServerId: firstServerID,
+						UserId:   ownerID,
+					}, nil)
+
+				f.SubscribeRepo.On("CountByServerId", ctx, firstServerID).
+					Return(int64(0), errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RepairMembers(tt.args.ctx)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RepairMembers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
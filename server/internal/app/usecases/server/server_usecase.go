@@ -8,17 +8,38 @@ import (
 	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
 	log "github.com/Nixonxp/discord/server/pkg/logger"
 	"github.com/google/uuid"
+	"io"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 )
 
 type Deps struct {
-	ServerRepo    usecases.ServerStorage
-	SubscribeRepo usecases.SubscribeStorage
-	ChatService   usecases.ServiceChatInterface
-	Log           *log.Logger
+	ServerRepo      usecases.ServerStorage
+	SubscribeRepo   usecases.SubscribeStorage
+	ChatService     usecases.ServiceChatInterface
+	AuditRepo       usecases.AuditStorage
+	TemplateRepo    usecases.TemplateStorage
+	WebhookRepo     usecases.WebhookStorage
+	OutgoingRepo    usecases.OutgoingWebhookStorage
+	DeliveryRepo    usecases.OutgoingDeliveryStorage
+	CommandRepo     usecases.CommandStorage
+	InteractionRepo usecases.InteractionStorage
+	EmojiRepo       usecases.EmojiStorage
+	BlobStore       usecases.BlobStore
+	OutgoingSender  usecases.OutgoingSenderInterface
+	ChannelService  usecases.ServiceChannelInterface
+	UserService     usecases.ServiceUserInterface
+	ServerEvents    usecases.KafkaProducerServiceInterface
+	Log             *log.Logger
 }
 
 type ServerUsecase struct {
 	Deps
+
+	// dispatches - interactions being sent to command handlers
+	dispatches sync.WaitGroup
 }
 
 var _ usecases.UsecaseInterface = (*ServerUsecase)(nil)
@@ -34,9 +55,11 @@ func (u *ServerUsecase) CreateServer(ctx context.Context, req usecases.CreateSer
 	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
 
 	newServer := models.ServerInfo{
-		Id:      chatID,
-		Name:    req.Name,
-		OwnerId: userID,
+		Id:          chatID,
+		Name:        req.Name,
+		OwnerId:     userID,
+		Visibility:  models.ServerVisibilityPublic,
+		MemberCount: 1,
 	}
 	err := u.ServerRepo.CreateServer(ctx, newServer)
 
@@ -44,11 +67,49 @@ func (u *ServerUsecase) CreateServer(ctx context.Context, req usecases.CreateSer
 		return nil, pkgerrors.Wrap("create server error", err)
 	}
 
+	// the owner is the first member of the server
+	err = u.SubscribeRepo.CreateSubscribe(ctx, models.SubscribeInfo{
+		Id:       models.SubscribeID(uuid.New()),
+		ServerId: chatID,
+		UserId:   userID,
+		JoinedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("create server owner subscribe error", err)
+	}
+
 	return &newServer, nil
 }
 
-func (u *ServerUsecase) SearchServer(ctx context.Context, req usecases.SearchServerRequest) ([]*models.ServerInfo, error) {
-	servers, err := u.ServerRepo.SearchServers(ctx, req.Name)
+func (u *ServerUsecase) SearchServer(ctx context.Context, req usecases.SearchServerRequest) (*models.SearchServersResult, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = models.ServerSearchDefaultLimit
+	}
+	if limit > models.ServerSearchMaxLimit {
+		limit = models.ServerSearchMaxLimit
+	}
+
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, pkgerrors.Wrap("search server error", err)
+	}
+
+	filter := models.SearchServersFilter{
+		Query:    strings.TrimSpace(req.Name),
+		Tags:     tags,
+		Category: strings.ToLower(strings.TrimSpace(req.Category)),
+		// one extra server shows whether there is a next page
+		Limit: limit + 1,
+	}
+	if req.Cursor != "" {
+		filter.After, err = models.DecodeServerSearchCursor(req.Cursor)
+		if err != nil {
+			return nil, pkgerrors.Wrap("search server error: bad cursor", err)
+		}
+	}
+
+	servers, err := u.ServerRepo.SearchServers(ctx, filter)
 	if err != nil {
 		return nil, pkgerrors.Wrap("search server error", err)
 	}
@@ -57,11 +118,19 @@ func (u *ServerUsecase) SearchServer(ctx context.Context, req usecases.SearchSer
 		return nil, pkgerrors.Wrap("servers", models.ErrNotFound)
 	}
 
-	return servers, nil
+	result := &models.SearchServersResult{
+		Servers: servers,
+	}
+	if len(servers) > limit {
+		result.Servers = servers[:limit]
+		result.NextCursor = models.NewServerSearchCursor(result.Servers[limit-1]).Encode()
+	}
+
+	return result, nil
 }
 
 func (u *ServerUsecase) SubscribeServer(ctx context.Context, req usecases.SubscribeServerRequest) (*models.ActionInfo, error) {
-	_, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, pkgerrors.Wrap("subscribe server error", models.ErrNotFound)
@@ -69,6 +138,11 @@ func (u *ServerUsecase) SubscribeServer(ctx context.Context, req usecases.Subscr
 		return nil, pkgerrors.Wrap("subscribe server error", err)
 	}
 
+	// private servers are joined only by invite and stay hidden from non members
+	if server.IsPrivate() {
+		return nil, pkgerrors.Wrap("subscribe server error", models.ErrNotFound)
+	}
+
 	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
 	serverID := models.ServerID(uuid.MustParse(req.ServerId))
 
@@ -76,13 +150,25 @@ func (u *ServerUsecase) SubscribeServer(ctx context.Context, req usecases.Subscr
 		Id:       models.SubscribeID(uuid.New()),
 		ServerId: serverID,
 		UserId:   userID,
+		JoinedAt: time.Now(),
 	}
-	// todo create index to unique subscribes
 	err = u.SubscribeRepo.CreateSubscribe(ctx, newSubscribe)
 	if err != nil {
 		return nil, pkgerrors.Wrap("subscribe create error", err)
 	}
 
+	u.changeMemberCount(ctx, serverID, 1)
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   serverID,
+		ActorId:    userID,
+		Action:     models.AuditActionMemberSubscribe,
+		TargetType: models.AuditTargetUser,
+		TargetId:   userID.String(),
+	})
+	u.emitOutgoingEvent(ctx, serverID, models.OutgoingEventMemberJoined, map[string]string{
+		"user_id": userID.String(),
+	})
+
 	return &models.ActionInfo{
 		Success: true,
 	}, nil
@@ -100,6 +186,18 @@ func (u *ServerUsecase) UnsubscribeServer(ctx context.Context, req usecases.Unsu
 		return nil, pkgerrors.Wrap("unsubscribe error", err)
 	}
 
+	u.changeMemberCount(ctx, serverID, -1)
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   serverID,
+		ActorId:    userID,
+		Action:     models.AuditActionMemberUnsubscribe,
+		TargetType: models.AuditTargetUser,
+		TargetId:   userID.String(),
+	})
+	u.emitOutgoingEvent(ctx, serverID, models.OutgoingEventMemberLeft, map[string]string{
+		"user_id": userID.String(),
+	})
+
 	return &models.ActionInfo{
 		Success: true,
 	}, nil
@@ -116,16 +214,37 @@ func (u *ServerUsecase) SearchServerByUserId(ctx context.Context, req usecases.S
 		return nil, pkgerrors.Wrap("servers", models.ErrNotFound)
 	}
 
-	userServers := make([]string, len(subscribes))
+	serverIds := make([]models.ServerID, len(subscribes))
 	for i, s := range subscribes {
-		userServers[i] = s.ServerId.String()
+		serverIds[i] = s.ServerId
+	}
+
+	deletedIds, err := u.ServerRepo.GetDeletedIds(ctx, serverIds)
+	if err != nil {
+		return nil, pkgerrors.Wrap("search server error", err)
+	}
+
+	deleted := make(map[models.ServerID]bool, len(deletedIds))
+	for _, id := range deletedIds {
+		deleted[id] = true
+	}
+
+	userServers := make([]string, 0, len(serverIds))
+	for _, id := range serverIds {
+		if !deleted[id] {
+			userServers = append(userServers, id.String())
+		}
+	}
+
+	if len(userServers) == 0 {
+		return nil, pkgerrors.Wrap("servers", models.ErrNotFound)
 	}
 
 	return userServers, nil
 }
 
 func (u *ServerUsecase) InviteUserToServer(ctx context.Context, req usecases.InviteUserToServerRequest) (*models.ActionInfo, error) {
-	_, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, pkgerrors.Wrap("servers", models.ErrNotFound)
@@ -133,6 +252,17 @@ func (u *ServerUsecase) InviteUserToServer(ctx context.Context, req usecases.Inv
 		return nil, pkgerrors.Wrap("server search", err)
 	}
 
+	// only members can invite to a private server
+	if server.IsPrivate() {
+		err = u.checkServerMember(ctx, server, req.CurrentUserId)
+		if err != nil {
+			if errors.Is(err, models.PermissionDenied) {
+				return nil, pkgerrors.Wrap("servers", models.ErrNotFound)
+			}
+			return nil, pkgerrors.Wrap("invite member check", err)
+		}
+	}
+
 	userID := models.UserID(uuid.MustParse(req.UserId))
 	serverID := models.ServerID(uuid.MustParse(req.ServerId))
 
@@ -140,20 +270,79 @@ func (u *ServerUsecase) InviteUserToServer(ctx context.Context, req usecases.Inv
 		Id:       models.SubscribeID(uuid.New()),
 		ServerId: serverID,
 		UserId:   userID,
+		JoinedAt: time.Now(),
 	}
-	// todo create index to unique subscribes
 	err = u.SubscribeRepo.CreateSubscribe(ctx, newSubscribe)
 	if err != nil {
 		return nil, pkgerrors.Wrap("create subscribe", err)
 	}
 
+	u.changeMemberCount(ctx, serverID, 1)
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   serverID,
+		ActorId:    models.UserID(uuid.MustParse(req.CurrentUserId)),
+		Action:     models.AuditActionMemberInvite,
+		TargetType: models.AuditTargetUser,
+		TargetId:   userID.String(),
+	})
+	u.emitOutgoingEvent(ctx, serverID, models.OutgoingEventMemberJoined, map[string]string{
+		"user_id":    userID.String(),
+		"inviter_id": req.CurrentUserId,
+	})
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// AddBotToServer - owner subscribes a bot, a private bot can be added by its owner only
+func (u *ServerUsecase) AddBotToServer(ctx context.Context, req usecases.AddBotToServerRequest) (*models.ActionInfo, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("add bot to server", err)
+	}
+
+	bot, err := u.UserService.GetBot(ctx, req.BotId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("add bot to server", err)
+	}
+
+	if !bot.Public && bot.OwnerId != server.OwnerId {
+		return nil, pkgerrors.Wrap("add bot to server", models.PermissionDenied)
+	}
+
+	botID := bot.Id
+	err = u.SubscribeRepo.CreateSubscribe(ctx, models.SubscribeInfo{
+		Id:       models.SubscribeID(uuid.New()),
+		ServerId: server.Id,
+		UserId:   botID,
+		JoinedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("add bot to server", err)
+	}
+
+	u.changeMemberCount(ctx, server.Id, 1)
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionMemberBotAdd,
+		TargetType: models.AuditTargetUser,
+		TargetId:   botID.String(),
+	})
+	u.emitOutgoingEvent(ctx, server.Id, models.OutgoingEventMemberJoined, map[string]string{
+		"user_id":    botID.String(),
+		"inviter_id": req.CurrentUserId,
+		"bot":        "true",
+	})
+
 	return &models.ActionInfo{
 		Success: true,
 	}, nil
 }
 
 func (u *ServerUsecase) PublishMessageOnServer(ctx context.Context, req usecases.PublishMessageOnServerRequest) (*models.ActionInfo, error) {
-	_, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, pkgerrors.Wrap("publish message on server", models.ErrNotFound)
@@ -161,9 +350,34 @@ func (u *ServerUsecase) PublishMessageOnServer(ctx context.Context, req usecases
 		return nil, pkgerrors.Wrap("publish message on server", err)
 	}
 
-	_, err = u.ChatService.SendServerMessage(ctx, usecases.PublishMessageOnServerRequest{
-		ServerId: req.ServerId,
-		Text:     req.Text,
+	err = u.checkServerMember(ctx, server, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("publish message on server", err)
+	}
+
+	// text that does not name a registered command is posted as a plain message, commands are registered
+	// per server here, so they are resolved before the message is handed to the chat service
+	if input := models.ParseCommandInput(req.Text); input != nil {
+		command, err := u.CommandRepo.GetCommandByName(ctx, server.Id, input.Name)
+		switch {
+		case err == nil:
+			err = u.invokeCommand(ctx, server, command, input, req.CurrentUserId)
+			if err != nil {
+				return nil, pkgerrors.Wrap("publish message on server", err)
+			}
+
+			return &models.ActionInfo{
+				Success: true,
+			}, nil
+		case !errors.Is(err, models.ErrNotFound):
+			return nil, pkgerrors.Wrap("publish message on server", err)
+		}
+	}
+
+	err = u.sendServerMessage(ctx, server, usecases.PublishMessageOnServerRequest{
+		ServerId:      req.ServerId,
+		Text:          req.Text,
+		CurrentUserId: req.CurrentUserId,
 	})
 	if err != nil {
 		return nil, pkgerrors.Wrap("publish message on server", err)
@@ -174,8 +388,43 @@ func (u *ServerUsecase) PublishMessageOnServer(ctx context.Context, req usecases
 	}, nil
 }
 
+// sendServerMessage - sends the message to the server chat, the author is already checked by the caller
+func (u *ServerUsecase) sendServerMessage(ctx context.Context, server *models.ServerInfo, msg usecases.PublishMessageOnServerRequest) error {
+	if models.HasEmojiTokens(msg.Text) {
+		msg.Text = u.resolveEmoji(ctx, server, msg.Text)
+	}
+
+	_, err := u.ChatService.SendServerMessage(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	// activity is only used for discovery ranking, the message is already sent
+	err = u.ServerRepo.TouchServerActivity(ctx, server.Id, time.Now())
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", server.Id.String()).Error("touch server activity error")
+	}
+
+	// ephemeral messages are private to one user and are not sent to the callbacks
+	if msg.VisibleTo != "" {
+		return nil
+	}
+
+	data := map[string]string{
+		"author_id": msg.CurrentUserId,
+		"content":   msg.Text,
+	}
+	if msg.WebhookId != "" {
+		data["webhook_id"] = msg.WebhookId
+		data["username"] = msg.Username
+	}
+	u.emitOutgoingEvent(ctx, server.Id, models.OutgoingEventMessageCreated, data)
+
+	return nil
+}
+
 func (u *ServerUsecase) GetMessagesFromServer(ctx context.Context, req usecases.GetMessagesFromServerRequest) (*models.GetMessagesInfo, error) {
-	_, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
 	if err != nil {
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, pkgerrors.Wrap("get messages on server", models.ErrNotFound)
@@ -183,14 +432,556 @@ func (u *ServerUsecase) GetMessagesFromServer(ctx context.Context, req usecases.
 		return nil, pkgerrors.Wrap("get messages on server", err)
 	}
 
+	err = u.checkServerMember(ctx, server, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get messages on server", err)
+	}
+
 	messages, err := u.ChatService.GetServerMessages(ctx, usecases.GetMessagesFromServerRequest{
-		ServerId: req.ServerId,
+		ServerId:      req.ServerId,
+		CurrentUserId: req.CurrentUserId,
 	})
 	if err != nil {
 		return nil, pkgerrors.Wrap("get messages on server", err)
 	}
 
+	u.setAuthorNicknames(ctx, server.Id, messages.Messages)
+
 	return &models.GetMessagesInfo{
 		Messages: messages.Messages,
 	}, nil
 }
+
+func (u *ServerUsecase) CreateModerationRule(ctx context.Context, req usecases.CreateModerationRuleRequest) (*models.ModerationRule, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create moderation rule", err)
+	}
+
+	rule, err := u.ChatService.CreateModerationRule(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create moderation rule", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionModerationRuleCreate,
+		TargetType: models.AuditTargetModerationRule,
+		TargetId:   rule.Id,
+		After: map[string]string{
+			"type":   rule.Type,
+			"action": rule.Action,
+		},
+	})
+
+	return rule, nil
+}
+
+func (u *ServerUsecase) DeleteModerationRule(ctx context.Context, req usecases.DeleteModerationRuleRequest) (*models.ActionInfo, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete moderation rule", err)
+	}
+
+	result, err := u.ChatService.DeleteModerationRule(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete moderation rule", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionModerationRuleDelete,
+		TargetType: models.AuditTargetModerationRule,
+		TargetId:   req.RuleId,
+	})
+
+	return result, nil
+}
+
+func (u *ServerUsecase) GetModerationRules(ctx context.Context, req usecases.GetModerationRulesRequest) ([]*models.ModerationRule, error) {
+	err := u.checkServerOwner(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get moderation rules", err)
+	}
+
+	rules, err := u.ChatService.GetModerationRules(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get moderation rules", err)
+	}
+
+	return rules, nil
+}
+
+func (u *ServerUsecase) GetModerationActions(ctx context.Context, req usecases.GetModerationActionsRequest) ([]*models.ModerationAction, error) {
+	err := u.checkServerOwner(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get moderation actions", err)
+	}
+
+	actions, err := u.ChatService.GetModerationActions(ctx, req)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get moderation actions", err)
+	}
+
+	return actions, nil
+}
+
+func (u *ServerUsecase) GetServer(ctx context.Context, req usecases.GetServerRequest) (*models.ServerInfo, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get server", err)
+	}
+
+	// private servers are hidden from non members
+	if server.IsPrivate() {
+		err = u.checkServerMember(ctx, server, req.CurrentUserId)
+		if err != nil {
+			if errors.Is(err, models.PermissionDenied) {
+				return nil, pkgerrors.Wrap("get server", models.ErrNotFound)
+			}
+			return nil, pkgerrors.Wrap("get server", err)
+		}
+	}
+
+	return serverProfile(server), nil
+}
+
+func (u *ServerUsecase) UpdateServer(ctx context.Context, req usecases.UpdateServerRequest) (*models.ServerInfo, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("update server", err)
+	}
+	before := serverAuditValues(server)
+
+	update := models.ServerProfileUpdate{
+		Name:         req.Name,
+		Description:  req.Description,
+		IconUrl:      req.IconUrl,
+		BannerUrl:    req.BannerUrl,
+		Visibility:   req.Visibility,
+		Discoverable: req.Discoverable,
+	}
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, pkgerrors.Wrap("update server: empty name", models.ErrInvalidArgument)
+		}
+		server.Name = *req.Name
+	}
+	if req.Description != nil {
+		server.Description = *req.Description
+	}
+	if req.IconUrl != nil {
+		server.IconUrl = *req.IconUrl
+	}
+	if req.BannerUrl != nil {
+		server.BannerUrl = *req.BannerUrl
+	}
+	if req.Visibility != nil {
+		if *req.Visibility != models.ServerVisibilityPublic && *req.Visibility != models.ServerVisibilityPrivate {
+			return nil, pkgerrors.Wrap("update server: unknown visibility", models.ErrInvalidArgument)
+		}
+		server.Visibility = *req.Visibility
+	}
+	if req.Tags != nil {
+		server.Tags, err = normalizeTags(req.Tags)
+		if err != nil {
+			return nil, pkgerrors.Wrap("update server", err)
+		}
+		update.Tags = server.Tags
+	}
+	if req.Category != nil {
+		server.Category = strings.ToLower(strings.TrimSpace(*req.Category))
+		update.Category = &server.Category
+	}
+	if req.Discoverable != nil {
+		server.Discoverable = *req.Discoverable
+	}
+
+	err = u.ServerRepo.UpdateServerProfile(ctx, server.Id, update)
+	if err != nil {
+		return nil, pkgerrors.Wrap("update server", err)
+	}
+
+	changedBefore, changedAfter := auditChanges(before, serverAuditValues(server))
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionServerUpdate,
+		TargetType: models.AuditTargetServer,
+		TargetId:   server.Id.String(),
+		Before:     changedBefore,
+		After:      changedAfter,
+	})
+
+	return serverProfile(server), nil
+}
+
+// DeleteServer - the owner can restore the server during models.ServerRestoreGracePeriod
+func (u *ServerUsecase) DeleteServer(ctx context.Context, req usecases.DeleteServerRequest) (*models.ActionInfo, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete server", err)
+	}
+
+	// members and data of other services are kept until the server is purged
+	err = u.ServerRepo.MarkDeleted(ctx, server.Id, time.Now())
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete server", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionServerDelete,
+		TargetType: models.AuditTargetServer,
+		TargetId:   server.Id.String(),
+		Before:     map[string]string{"name": server.Name},
+	})
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+func (u *ServerUsecase) TransferOwnership(ctx context.Context, req usecases.TransferOwnershipRequest) (*models.ServerInfo, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("transfer ownership", err)
+	}
+
+	if req.NewOwnerId == req.CurrentUserId {
+		return nil, pkgerrors.Wrap("transfer ownership: already owner", models.ErrInvalidArgument)
+	}
+
+	// only members of the server can become its owner
+	newOwnerID := models.UserID(uuid.MustParse(req.NewOwnerId))
+	_, err = u.SubscribeRepo.GetSubscribe(ctx, server.Id, newOwnerID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, pkgerrors.Wrap("transfer ownership: new owner is not a member", models.ErrInvalidArgument)
+		}
+		return nil, pkgerrors.Wrap("transfer ownership", err)
+	}
+
+	oldOwnerID := server.OwnerId
+	err = u.ServerRepo.UpdateOwner(ctx, server.Id, oldOwnerID, newOwnerID)
+	if err != nil {
+		return nil, pkgerrors.Wrap("transfer ownership", err)
+	}
+	server.OwnerId = newOwnerID
+
+	// previous owner stays on the server as a regular member
+	_, err = u.SubscribeRepo.GetSubscribe(ctx, server.Id, oldOwnerID)
+	if errors.Is(err, models.ErrNotFound) {
+		err = u.SubscribeRepo.CreateSubscribe(ctx, models.SubscribeInfo{
+			Id:       models.SubscribeID(uuid.New()),
+			ServerId: server.Id,
+			UserId:   oldOwnerID,
+			JoinedAt: time.Now(),
+		})
+		if err == nil {
+			if u.changeMemberCount(ctx, server.Id, 1) {
+				server.MemberCount++
+			}
+		}
+	}
+	if err != nil {
+		return nil, pkgerrors.Wrap("transfer ownership: subscribe previous owner", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    oldOwnerID,
+		Action:     models.AuditActionServerTransferOwnership,
+		TargetType: models.AuditTargetUser,
+		TargetId:   newOwnerID.String(),
+		Before:     map[string]string{"owner_id": oldOwnerID.String()},
+		After:      map[string]string{"owner_id": newOwnerID.String()},
+	})
+
+	return serverProfile(server), nil
+}
+
+// normalizeTags - lower case, trimmed and unique tags
+func normalizeTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+
+	if len(result) > models.ServerMaxTags {
+		return nil, pkgerrors.Wrap("too many tags", models.ErrInvalidArgument)
+	}
+
+	return result, nil
+}
+
+// ListServerMembers - members ordered by subscription, the owner without subscription is listed first
+func (u *ServerUsecase) ListServerMembers(ctx context.Context, req usecases.ListServerMembersRequest) (*models.ListMembersResult, error) {
+	server, err := u.getVisibleServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list server members", err)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = models.MembersDefaultLimit
+	}
+	if limit > models.MembersMaxLimit {
+		limit = models.MembersMaxLimit
+	}
+
+	var after *models.SubscribeID
+	if req.Cursor != "" {
+		id, err := uuid.Parse(req.Cursor)
+		if err != nil {
+			return nil, pkgerrors.Wrap("list server members: bad cursor", models.ErrInvalidArgument)
+		}
+		subscribeID := models.SubscribeID(id)
+		after = &subscribeID
+	}
+
+	// one extra subscription shows whether there is a next page
+	subscribes, err := u.SubscribeRepo.ListByServerId(ctx, server.Id, after, limit+1)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list server members", err)
+	}
+
+	result := &models.ListMembersResult{
+		Members: make([]*models.Member, 0, len(subscribes)+1),
+	}
+	if len(subscribes) > limit {
+		subscribes = subscribes[:limit]
+		result.NextCursor = subscribes[limit-1].Id.String()
+	}
+
+	if after == nil {
+		_, err = u.SubscribeRepo.GetSubscribe(ctx, server.Id, server.OwnerId)
+		if err != nil {
+			if !errors.Is(err, models.ErrNotFound) {
+				return nil, pkgerrors.Wrap("list server members", err)
+			}
+			result.Members = append(result.Members, &models.Member{
+				UserId:  server.OwnerId,
+				IsOwner: true,
+			})
+		}
+	}
+
+	for _, subscribe := range subscribes {
+		result.Members = append(result.Members, subscribeMember(server, subscribe))
+	}
+
+	return result, nil
+}
+
+func (u *ServerUsecase) GetMember(ctx context.Context, req usecases.GetMemberRequest) (*models.Member, error) {
+	server, err := u.getVisibleServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get member", err)
+	}
+
+	userID := models.UserID(uuid.MustParse(req.UserId))
+	subscribe, err := u.SubscribeRepo.GetSubscribe(ctx, server.Id, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) && userID == server.OwnerId {
+			return &models.Member{
+				UserId:  userID,
+				IsOwner: true,
+			}, nil
+		}
+		return nil, pkgerrors.Wrap("get member", err)
+	}
+
+	return subscribeMember(server, subscribe), nil
+}
+
+// SetMemberNickname - members change their own nickname, the owner can change nickname of any member
+func (u *ServerUsecase) SetMemberNickname(ctx context.Context, req usecases.SetMemberNicknameRequest) (*models.Member, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, req.ServerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("set member nickname", err)
+	}
+
+	if req.UserId != req.CurrentUserId && server.OwnerId.String() != req.CurrentUserId {
+		return nil, pkgerrors.Wrap("set member nickname", models.PermissionDenied)
+	}
+
+	nickname := strings.TrimSpace(req.Nickname)
+	if utf8.RuneCountInString(nickname) > models.MemberNicknameMaxLength {
+		return nil, pkgerrors.Wrap("set member nickname: nickname too long", models.ErrInvalidArgument)
+	}
+
+	userID := models.UserID(uuid.MustParse(req.UserId))
+	subscribe, err := u.SubscribeRepo.GetSubscribe(ctx, server.Id, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap("set member nickname", err)
+	}
+
+	if subscribe.Nickname == nickname {
+		return subscribeMember(server, subscribe), nil
+	}
+
+	err = u.SubscribeRepo.SetNickname(ctx, server.Id, userID, nickname)
+	if err != nil {
+		return nil, pkgerrors.Wrap("set member nickname", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    models.UserID(uuid.MustParse(req.CurrentUserId)),
+		Action:     models.AuditActionMemberNickname,
+		TargetType: models.AuditTargetUser,
+		TargetId:   userID.String(),
+		Before:     map[string]string{"nickname": subscribe.Nickname},
+		After:      map[string]string{"nickname": nickname},
+	})
+
+	subscribe.Nickname = nickname
+	return subscribeMember(server, subscribe), nil
+}
+
+func subscribeMember(server *models.ServerInfo, subscribe *models.SubscribeInfo) *models.Member {
+	return &models.Member{
+		UserId:   subscribe.UserId,
+		JoinedAt: subscribe.JoinedAt,
+		IsOwner:  subscribe.UserId == server.OwnerId,
+		Nickname: subscribe.Nickname,
+	}
+}
+
+// setAuthorNicknames - messages are shown without nicknames when the lookup fails
+func (u *ServerUsecase) setAuthorNicknames(ctx context.Context, serverId models.ServerID, messages []*models.Message) {
+	userIds := make([]models.UserID, 0, len(messages))
+	seen := make(map[string]struct{}, len(messages))
+	for _, message := range messages {
+		if _, ok := seen[message.OwnerId]; ok {
+			continue
+		}
+		seen[message.OwnerId] = struct{}{}
+
+		id, err := uuid.Parse(message.OwnerId)
+		if err != nil {
+			continue
+		}
+		userIds = append(userIds, models.UserID(id))
+	}
+
+	if len(userIds) == 0 {
+		return
+	}
+
+	subscribes, err := u.SubscribeRepo.GetByUserIds(ctx, serverId, userIds)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("get author nicknames error")
+		return
+	}
+
+	nicknames := make(map[string]string, len(subscribes))
+	for _, subscribe := range subscribes {
+		nicknames[subscribe.UserId.String()] = subscribe.Nickname
+	}
+	for _, message := range messages {
+		message.AuthorNickname = nicknames[message.OwnerId]
+	}
+}
+
+// getVisibleServer - members of private servers are visible only to members
+func (u *ServerUsecase) getVisibleServer(ctx context.Context, serverId string, userId string) (*models.ServerInfo, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.IsPrivate() {
+		err = u.checkServerMember(ctx, server, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return server, nil
+}
+
+// serverProfile - servers saved before visibility was introduced are public
+func serverProfile(server *models.ServerInfo) *models.ServerInfo {
+	if server.Visibility == "" {
+		server.Visibility = models.ServerVisibilityPublic
+	}
+
+	return server
+}
+
+// changeMemberCount - applies the delta of a saved subscription insert or delete, each membership change
+// is counted once however changes interleave, a failed update is corrected by repair-members
+func (u *ServerUsecase) changeMemberCount(ctx context.Context, serverId models.ServerID, delta int64) bool {
+	err := u.ServerRepo.IncMemberCount(ctx, serverId, delta)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("change member count error")
+		return false
+	}
+
+	return true
+}
+
+// checkServerOwner - only the server owner can manage moderation
+func (u *ServerUsecase) checkServerOwner(ctx context.Context, serverId string, userId string) error {
+	_, err := u.getOwnedServer(ctx, serverId, userId)
+	return err
+}
+
+func (u *ServerUsecase) getOwnedServer(ctx context.Context, serverId string, userId string) (*models.ServerInfo, error) {
+	server, err := u.ServerRepo.GetServerById(ctx, serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.OwnerId.String() != userId {
+		return nil, models.PermissionDenied
+	}
+
+	return server, nil
+}
+
+// checkServerMember - owner or subscriber of the server
+func (u *ServerUsecase) checkServerMember(ctx context.Context, server *models.ServerInfo, userId string) error {
+	if server.OwnerId.String() == userId {
+		return nil
+	}
+
+	userID := models.UserID(uuid.MustParse(userId))
+	_, err := u.SubscribeRepo.GetSubscribe(ctx, server.Id, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return models.PermissionDenied
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (u *ServerUsecase) ExportServerChat(ctx context.Context, req usecases.ExportServerChatRequest, w io.Writer) error {
+	err := u.checkServerOwner(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return pkgerrors.Wrap("export server chat", err)
+	}
+
+	err = u.ChatService.ExportChat(ctx, req, w)
+	if err != nil {
+		return pkgerrors.Wrap("export server chat", err)
+	}
+
+	return nil
+}
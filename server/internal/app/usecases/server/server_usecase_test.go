@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"github.com/Nixonxp/discord/server/internal/app/models"
@@ -10,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"io"
 	"testing"
 	"time"
 )
@@ -24,6 +26,7 @@ func Test_usecase_ServerUsecase_CreateServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
 	}
 
 	type args struct {
@@ -50,9 +53,11 @@ func Test_usecase_ServerUsecase_CreateServer(t *testing.T) {
 				},
 			},
 			want: &models.ServerInfo{
-				Id:      models.ServerID{},
-				Name:    "name",
-				OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				Id:          models.ServerID{},
+				Name:        "name",
+				OwnerId:     models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				Visibility:  models.ServerVisibilityPublic,
+				MemberCount: 1,
 			},
 			wantErr: false,
 
@@ -62,12 +67,22 @@ func Test_usecase_ServerUsecase_CreateServer(t *testing.T) {
 					mock.MatchedBy(func(server models.ServerInfo) bool {
 						return server.Id.String() != "" &&
 							server.OwnerId == models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")) &&
-							server.Name == "name"
+							server.Name == "name" &&
+							server.MemberCount == 1
+					})).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe",
+					ctx,
+					mock.MatchedBy(func(subscribe models.SubscribeInfo) bool {
+						return subscribe.UserId == models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")) &&
+							!subscribe.JoinedAt.IsZero()
 					})).
 					Return(nil)
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
 			},
 		},
 		{
@@ -99,6 +114,31 @@ func Test_usecase_ServerUsecase_CreateServer(t *testing.T) {
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 1)
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Owner CreateSubscribe returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerRequest{
+					Name:          "name",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "create server owner subscribe error: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("CreateServer", ctx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
 			},
 		},
 	}
@@ -111,12 +151,14 @@ func Test_usecase_ServerUsecase_CreateServer(t *testing.T) {
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -159,7 +201,26 @@ func Test_usecase_ServerUsecase_SearchServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	firstServer := &models.ServerInfo{
+		Id:             models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+		Name:           "name",
+		OwnerId:        models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+		Tags:           []string{"games"},
+		Discoverable:   true,
+		LastActivityAt: time.UnixMilli(1700000000000),
+		MemberCount:    10,
+	}
+	secondServer := &models.ServerInfo{
+		Id:           models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b001")),
+		Name:         "name 2",
+		OwnerId:      models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+		Discoverable: true,
+		MemberCount:  5,
 	}
+	cursor := models.NewServerSearchCursor(firstServer)
 
 	type args struct {
 		ctx context.Context
@@ -168,7 +229,7 @@ func Test_usecase_ServerUsecase_SearchServer(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		want        []*models.ServerInfo
+		want        *models.SearchServersResult
 		wantErr     bool
 		errorString string
 
@@ -180,29 +241,26 @@ func Test_usecase_ServerUsecase_SearchServer(t *testing.T) {
 			args: args{
 				ctx: ctx, // dumm
 				req: usecases.SearchServerRequest{
-					Name: "name",
+					Name:     " name ",
+					Tags:     []string{"Games", "games", " "},
+					Category: "Gaming",
 				},
 			},
-			want: []*models.ServerInfo{
-				{
-					Id:      models.ServerID{},
-					Name:    "name",
-					OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
-				},
+			want: &models.SearchServersResult{
+				Servers: []*models.ServerInfo{firstServer},
 			},
 			wantErr: false,
 
 			on: func(f *fields) {
 				f.ServerRepo.On("SearchServers",
 					ctx,
-					"name").
-					Return([]*models.ServerInfo{
-						{
-							Id:      models.ServerID{},
-							Name:    "name",
-							OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
-						},
-					}, nil)
+					models.SearchServersFilter{
+						Query:    "name",
+						Tags:     []string{"games"},
+						Category: "gaming",
+						Limit:    models.ServerSearchDefaultLimit + 1,
+					}).
+					Return([]*models.ServerInfo{firstServer}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.ServerRepo.AssertNumberOfCalls(t, "SearchServers", 1)
@@ -216,20 +274,13 @@ func Test_usecase_ServerUsecase_SearchServer(t *testing.T) {
 					Name: "name",
 				},
 			},
-			want: []*models.ServerInfo{
-				{
-					Id:      models.ServerID{},
-					Name:    "name",
-					OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
-				},
-			},
 			wantErr:     true,
 			errorString: "servers: not found",
 
 			on: func(f *fields) {
 				f.ServerRepo.On("SearchServers",
 					ctx,
-					"name").
+					mock.AnythingOfType("models.SearchServersFilter")).
 					Return([]*models.ServerInfo{}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
@@ -251,13 +302,77 @@ func Test_usecase_ServerUsecase_SearchServer(t *testing.T) {
 			on: func(f *fields) {
 				f.ServerRepo.On("SearchServers",
 					ctx,
-					"name").
+					mock.AnythingOfType("models.SearchServersFilter")).
 					Return(nil, errors.New("some  error"))
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.ServerRepo.AssertNumberOfCalls(t, "SearchServers", 1)
 			},
 		},
+		{
+			name: "Test 4. Positive. Extra server gives next cursor",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SearchServerRequest{
+					Name:  "name",
+					Limit: 1,
+				},
+			},
+			want: &models.SearchServersResult{
+				Servers:    []*models.ServerInfo{firstServer},
+				NextCursor: cursor.Encode(),
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("SearchServers",
+					ctx,
+					mock.MatchedBy(func(filter models.SearchServersFilter) bool {
+						return filter.Limit == 2 && filter.After == nil
+					})).
+					Return([]*models.ServerInfo{firstServer, secondServer}, nil)
+			},
+		},
+		{
+			name: "Test 5. Positive. Next page is requested after cursor",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SearchServerRequest{
+					Name:   "name",
+					Cursor: cursor.Encode(),
+					Limit:  1000,
+				},
+			},
+			want: &models.SearchServersResult{
+				Servers: []*models.ServerInfo{secondServer},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("SearchServers",
+					ctx,
+					mock.MatchedBy(func(filter models.SearchServersFilter) bool {
+						return filter.Limit == models.ServerSearchMaxLimit+1 && assert.ObjectsAreEqual(cursor, filter.After)
+					})).
+					Return([]*models.ServerInfo{secondServer}, nil)
+			},
+		},
+		{
+			name: "Test 6. Negative. Bad cursor",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SearchServerRequest{
+					Name:   "name",
+					Cursor: "bad cursor",
+				},
+			},
+			wantErr:     true,
+			errorString: "search server error: bad cursor: invalid argument",
+
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "SearchServers", mock.Anything, mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -268,12 +383,14 @@ func Test_usecase_ServerUsecase_SearchServer(t *testing.T) {
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -312,6 +429,8 @@ func Test_usecase_ServerUsecase_SubscribeServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
 	}
 
 	type args struct {
@@ -346,7 +465,7 @@ func Test_usecase_ServerUsecase_SubscribeServer(t *testing.T) {
 				f.ServerRepo.On("GetServerById",
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
-					Return(nil, nil)
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
 
 				f.SubscribeRepo.On("CreateSubscribe",
 					ctx,
@@ -356,10 +475,24 @@ func Test_usecase_ServerUsecase_SubscribeServer(t *testing.T) {
 							subscribe.ServerId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b000"
 					})).
 					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), int64(1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionMemberSubscribe &&
+						entry.ActorId == models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), models.OutgoingEventMemberJoined).
+					Return([]*models.OutgoingWebhook{}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
 				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
 				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "IncMemberCount", 1)
 			},
 		},
 		{
@@ -381,7 +514,7 @@ func Test_usecase_ServerUsecase_SubscribeServer(t *testing.T) {
 				f.ServerRepo.On("GetServerById",
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
-					Return(nil, nil)
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
 
 				f.SubscribeRepo.On("CreateSubscribe",
 					ctx,
@@ -447,22 +580,116 @@ func Test_usecase_ServerUsecase_SubscribeServer(t *testing.T) {
 				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
 			},
 		},
+		{
+			name: "Test 5. Negative. User is already subscribed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SubscribeServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "subscribe create error: already exists",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(models.ErrAlreadyExists)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNotCalled(t, "IncMemberCount", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Private server can not be joined without invite",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SubscribeServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "subscribe server error: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&models.ServerInfo{
+						Id:         models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 7. Positive. Member count error is only logged",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SubscribeServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.AnythingOfType("models.SubscribeInfo")).
+					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), int64(1)).
+					Return(errors.New("some error"))
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.AnythingOfType("models.AuditEntry")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), models.OutgoingEventMemberJoined).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// arrange
+			logger, err := log.NewLogger(log.NewDefaultConfig())
+			assert.NoError(t, err)
+
 			f := &fields{
 				ServerRepo:    mocks.NewServerStorage(t),
-				Log:           &log.Logger{},
+				Log:           logger,
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+				OutgoingRepo:  f.OutgoingRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -501,6 +728,8 @@ func Test_usecase_ServerUsecase_UnsubscribeServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
 	}
 
 	type args struct {
@@ -538,9 +767,23 @@ func Test_usecase_ServerUsecase_UnsubscribeServer(t *testing.T) {
 					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
 				).
 					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), int64(-1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionMemberUnsubscribe &&
+						entry.TargetId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795"
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), models.OutgoingEventMemberLeft).
+					Return([]*models.OutgoingWebhook{}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
 				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "IncMemberCount", 1)
 			},
 		},
 		{
@@ -607,12 +850,16 @@ func Test_usecase_ServerUsecase_UnsubscribeServer(t *testing.T) {
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+				OutgoingRepo:  f.OutgoingRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -651,6 +898,7 @@ func Test_usecase_ServerUsecase_SearchServerByUserId(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
 	}
 
 	type args struct {
@@ -692,9 +940,15 @@ func Test_usecase_ServerUsecase_SearchServerByUserId(t *testing.T) {
 							UserId:   models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
 						},
 					}, nil)
+				f.ServerRepo.On("GetDeletedIds",
+					ctx,
+					[]models.ServerID{models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100"))},
+				).
+					Return([]models.ServerID{}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
 				f.SubscribeRepo.AssertNumberOfCalls(t, "GetByUserId", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "GetDeletedIds", 1)
 			},
 		},
 		{
@@ -747,6 +1001,73 @@ func Test_usecase_ServerUsecase_SearchServerByUserId(t *testing.T) {
 				f.SubscribeRepo.AssertNumberOfCalls(t, "GetByUserId", 1)
 			},
 		},
+		{
+			name: "Test 4. Positive. Deleted servers are skipped",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SearchServerByUserIdRequest{
+					UserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: []string{
+				"284fef68-7e3e-4d1d-96a0-8c96f7b3b101",
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("GetByUserId",
+					ctx,
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				).
+					Return([]*models.SubscribeInfo{
+						{
+							Id:       models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b300")),
+							ServerId: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+							UserId:   models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						},
+						{
+							Id:       models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b301")),
+							ServerId: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b101")),
+							UserId:   models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						},
+					}, nil)
+				f.ServerRepo.On("GetDeletedIds", ctx, mock.Anything).
+					Return([]models.ServerID{models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100"))}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetDeletedIds", 1)
+			},
+		},
+		{
+			name: "Test 5. Negative. Only deleted servers",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SearchServerByUserIdRequest{
+					UserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "servers: not found",
+
+			on: func(f *fields) {
+				f.SubscribeRepo.On("GetByUserId",
+					ctx,
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				).
+					Return([]*models.SubscribeInfo{
+						{
+							Id:       models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b300")),
+							ServerId: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+							UserId:   models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						},
+					}, nil)
+				f.ServerRepo.On("GetDeletedIds", ctx, mock.Anything).
+					Return([]models.ServerID{models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100"))}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetDeletedIds", 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -757,12 +1078,14 @@ func Test_usecase_ServerUsecase_SearchServerByUserId(t *testing.T) {
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
@@ -801,6 +1124,8 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
 	}
 
 	type args struct {
@@ -822,8 +1147,9 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 			args: args{
 				ctx: ctx, // dumm
 				req: usecases.InviteUserToServerRequest{
-					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
 			want: &models.ActionInfo{
@@ -836,7 +1162,7 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, nil)
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
 
 				f.SubscribeRepo.On("CreateSubscribe",
 					ctx,
@@ -847,10 +1173,25 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 					}),
 				).
 					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), int64(1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionMemberInvite &&
+						entry.TargetId == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795" &&
+						entry.ActorId == models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), models.OutgoingEventMemberJoined).
+					Return([]*models.OutgoingWebhook{}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
 				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
 				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "IncMemberCount", 1)
 			},
 		},
 		{
@@ -858,8 +1199,9 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 			args: args{
 				ctx: ctx, // dumm
 				req: usecases.InviteUserToServerRequest{
-					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
 			want: &models.ActionInfo{
@@ -873,7 +1215,7 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, nil)
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
 
 				f.SubscribeRepo.On("CreateSubscribe",
 					ctx,
@@ -895,8 +1237,9 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 			args: args{
 				ctx: ctx, // dumm
 				req: usecases.InviteUserToServerRequest{
-					ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					UserId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
 			want: &models.ActionInfo{
@@ -916,33 +1259,2687 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
 			},
 		},
-	}
+		{
+			name: "Test 4. Negative. User is already subscribed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.InviteUserToServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "create subscribe: already exists",
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// arrange
-			f := &fields{
-				ServerRepo:    mocks.NewServerStorage(t),
-				Log:           &log.Logger{},
-				SubscribeRepo: mocks.NewSubscribeStorage(t),
-				ChatService:   mocks.NewServiceChatInterface(t),
-			}
-			au := NewServerUsecase(Deps{
-				ServerRepo:    f.ServerRepo,
-				Log:           f.Log,
-				SubscribeRepo: f.SubscribeRepo,
-				ChatService:   f.ChatService,
-			})
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{Id: models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(models.ErrAlreadyExists)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNotCalled(t, "IncMemberCount", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Positive. Member invites to a private server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.InviteUserToServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+				).
+					Return(&models.SubscribeInfo{}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.AnythingOfType("models.SubscribeInfo")).
+					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), int64(1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.AnythingOfType("models.AuditEntry")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")), models.OutgoingEventMemberJoined).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+			},
+		},
+		{
+			name: "Test 6. Negative. Non member invites to a private server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.InviteUserToServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "servers: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+				OutgoingRepo:  f.OutgoingRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.InviteUserToServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.Register() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_AddBotToServer(t *testing.T) {
+	// prepare
+	var (
+		ctx      = context.Background() // dummy
+		serverId = models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+		ownerId  = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+		botId    = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+		otherId  = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b111"))
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		AuditRepo     *mocks.AuditStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
+		UserService   *mocks.ServiceUserInterface
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.AddBotToServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddBotToServerRequest{
+					ServerId:      serverId.String(),
+					BotId:         botId.String(),
+					CurrentUserId: ownerId.String(),
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, serverId.String()).
+					Return(&models.ServerInfo{Id: serverId, OwnerId: ownerId}, nil)
+
+				f.UserService.On("GetBot", ctx, botId.String()).
+					Return(&models.Bot{Id: botId, OwnerId: ownerId}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe",
+					ctx,
+					mock.MatchedBy(func(subscribe models.SubscribeInfo) bool {
+						return subscribe.UserId == botId &&
+							subscribe.ServerId == serverId &&
+							!subscribe.JoinedAt.IsZero()
+					}),
+				).
+					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, serverId, int64(1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionMemberBotAdd &&
+						entry.TargetId == botId.String() &&
+						entry.ActorId == ownerId
+				})).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverId, models.OutgoingEventMemberJoined).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "IncMemberCount", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddBotToServerRequest{
+					ServerId:      serverId.String(),
+					BotId:         botId.String(),
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b111",
+				},
+			},
+			wantErr:     true,
+			errorString: "add bot to server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, serverId.String()).
+					Return(&models.ServerInfo{Id: serverId, OwnerId: ownerId}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UserService.AssertNotCalled(t, "GetBot", mock.Anything, mock.Anything)
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Bot is already a member",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddBotToServerRequest{
+					ServerId:      serverId.String(),
+					BotId:         botId.String(),
+					CurrentUserId: ownerId.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "add bot to server: already exists",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, serverId.String()).
+					Return(&models.ServerInfo{Id: serverId, OwnerId: ownerId}, nil)
+
+				f.UserService.On("GetBot", ctx, botId.String()).
+					Return(&models.Bot{Id: botId, OwnerId: ownerId}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(models.ErrAlreadyExists)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "IncMemberCount", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Positive. Public bot of another user",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddBotToServerRequest{
+					ServerId:      serverId.String(),
+					BotId:         botId.String(),
+					CurrentUserId: ownerId.String(),
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, serverId.String()).
+					Return(&models.ServerInfo{Id: serverId, OwnerId: ownerId}, nil)
+
+				f.UserService.On("GetBot", ctx, botId.String()).
+					Return(&models.Bot{Id: botId, OwnerId: otherId, Public: true}, nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, serverId, int64(1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.Anything).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverId, models.OutgoingEventMemberJoined).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+			},
+		},
+		{
+			name: "Test 5. Negative. Private bot of another user",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddBotToServerRequest{
+					ServerId:      serverId.String(),
+					BotId:         botId.String(),
+					CurrentUserId: ownerId.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "add bot to server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, serverId.String()).
+					Return(&models.ServerInfo{Id: serverId, OwnerId: ownerId}, nil)
+
+				f.UserService.On("GetBot", ctx, botId.String()).
+					Return(&models.Bot{Id: botId, OwnerId: otherId}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 6. Negative. Bot is not found",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddBotToServerRequest{
+					ServerId:      serverId.String(),
+					BotId:         botId.String(),
+					CurrentUserId: ownerId.String(),
+				},
+			},
+			wantErr:     true,
+			errorString: "add bot to server: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, serverId.String()).
+					Return(&models.ServerInfo{Id: serverId, OwnerId: ownerId}, nil)
+
+				f.UserService.On("GetBot", ctx, botId.String()).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
+				UserService:   mocks.NewServiceUserInterface(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				AuditRepo:     f.AuditRepo,
+				OutgoingRepo:  f.OutgoingRepo,
+				UserService:   f.UserService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.AddBotToServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.AddBotToServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+		OutgoingRepo  *mocks.OutgoingWebhookStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.PublishMessageOnServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:          "text",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				).
+					Return(&models.SubscribeInfo{}, nil)
+
+				f.ChatService.On("SendServerMessage",
+					ctx,
+					usecases.PublishMessageOnServerRequest{
+						ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						Text:          "text",
+						CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					},
+				).
+					Return(nil, nil)
+
+				f.ServerRepo.On("TouchServerActivity",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					mock.AnythingOfType("time.Time"),
+				).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, mock.Anything, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "TouchServerActivity", 1)
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. SendServerMessage returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:          "text",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr:     true,
+			errorString: "publish message on server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				).
+					Return(&models.SubscribeInfo{}, nil)
+
+				f.ChatService.On("SendServerMessage",
+					ctx,
+					usecases.PublishMessageOnServerRequest{
+						ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						Text:          "text",
+						CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					},
+				).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 1)
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. SendServerMessage returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:          "text",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr:     true,
+			errorString: "publish message on server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+			},
+		},
+		{
+			name: "Test 4. Negative. SendServerMessage returns not found error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:          "text",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr:     true,
+			errorString: "publish message on server: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+			},
+		},
+		{
+			name: "Test 5. Positive. Owner without subscribe",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					Text:          "text",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+					}, nil)
+
+				f.ChatService.On("SendServerMessage",
+					ctx,
+					usecases.PublishMessageOnServerRequest{
+						ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						Text:          "text",
+						CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					},
+				).
+					Return(nil, nil)
+
+				f.ServerRepo.On("TouchServerActivity",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					mock.AnythingOfType("time.Time"),
+				).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, mock.Anything, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "GetSubscribe", mock.Anything, mock.Anything, mock.Anything)
+				f.ServerRepo.AssertNumberOfCalls(t, "TouchServerActivity", 1)
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 6. Negative. User is not a member",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.PublishMessageOnServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					Text:          "text",
+				},
+			},
+			wantErr:     true,
+			errorString: "publish message on server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100")),
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendServerMessage", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+				OutgoingRepo:  mocks.NewOutgoingWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+				OutgoingRepo:  f.OutgoingRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.PublishMessageOnServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.Register() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	timeMock, _ := time.Parse("2006 Jan 02 15:04:05", "2012 Dec 07 00:00:00")
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetMessagesFromServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.GetMessagesInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetMessagesFromServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.GetMessagesInfo{
+				Messages: []*models.Message{
+					{
+						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+						Text:      "text",
+						Timestamp: timeMock,
+					},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("GetServerMessages",
+					ctx,
+					usecases.GetMessagesFromServerRequest{
+						ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					},
+				).
+					Return(&models.GetMessagesInfo{
+						Messages: []*models.Message{
+							{
+								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+								Text:      "text",
+								Timestamp: timeMock,
+							},
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.ChatService.AssertNumberOfCalls(t, "GetServerMessages", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. GetServerMessages returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetMessagesFromServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.GetMessagesInfo{
+				Messages: []*models.Message{
+					{
+						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+						Text:      "text",
+						Timestamp: timeMock,
+					},
+				},
+			},
+			wantErr:     true,
+			errorString: "get messages on server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("GetServerMessages",
+					ctx,
+					usecases.GetMessagesFromServerRequest{
+						ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					},
+				).
+					Return(&models.GetMessagesInfo{
+						Messages: []*models.Message{
+							{
+								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+								Text:      "text",
+								Timestamp: timeMock,
+							},
+						},
+					}, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.ChatService.AssertNumberOfCalls(t, "GetServerMessages", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. GetServerById returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetMessagesFromServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.GetMessagesInfo{
+				Messages: []*models.Message{
+					{
+						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+						Text:      "text",
+						Timestamp: timeMock,
+					},
+				},
+			},
+			wantErr:     true,
+			errorString: "get messages on server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+			},
+		},
+		{
+			name: "Test 4. Negative. GetServerById returns not found errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetMessagesFromServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.GetMessagesInfo{
+				Messages: []*models.Message{
+					{
+						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+						Text:      "text",
+						Timestamp: timeMock,
+					},
+				},
+			},
+			wantErr:     true,
+			errorString: "get messages on server: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+			},
+		},
+		{
+			name: "Test 5. Positive. Authors nicknames",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetMessagesFromServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.GetMessagesInfo{
+				Messages: []*models.Message{
+					{
+						Id:             "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+						Text:           "text",
+						Timestamp:      timeMock,
+						OwnerId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						AuthorNickname: "nick",
+					},
+					{
+						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b201",
+						Text:      "text",
+						Timestamp: timeMock,
+						OwnerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+					},
+					{
+						Id:             "284fef68-7e3e-4d1d-96a0-8c96f7b3b202",
+						Text:           "text",
+						Timestamp:      timeMock,
+						OwnerId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						AuthorNickname: "nick",
+					},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("GetServerMessages",
+					ctx,
+					usecases.GetMessagesFromServerRequest{
+						ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					},
+				).
+					Return(&models.GetMessagesInfo{
+						Messages: []*models.Message{
+							{
+								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+								Text:      "text",
+								Timestamp: timeMock,
+								OwnerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+							},
+							{
+								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b201",
+								Text:      "text",
+								Timestamp: timeMock,
+								OwnerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+							},
+							{
+								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b202",
+								Text:      "text",
+								Timestamp: timeMock,
+								OwnerId:   "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+							},
+						},
+					}, nil)
+
+				f.SubscribeRepo.On("GetByUserIds",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					[]models.UserID{
+						models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796")),
+					},
+				).
+					Return([]*models.SubscribeInfo{
+						{
+							UserId:   models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+							Nickname: "nick",
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetByUserIds", 1)
+			},
+		},
+		{
+			name: "Test 6. Negative. User is not a member",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetMessagesFromServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b796",
+				},
+			},
+			wantErr:     true,
+			errorString: "get messages on server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						OwnerId:    models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b796")),
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "GetServerMessages", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetMessagesFromServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.Register() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_CreateModerationRule(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	timeMock, _ := time.Parse("2006 Jan 02 15:04:05", "2012 Dec 07 00:00:00")
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateModerationRuleRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ModerationRule
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Type:          "links",
+					Action:        "block",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ModerationRule{
+				Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+				ServerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				Type:      "links",
+				Action:    "block",
+				CreatedAt: timeMock,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("CreateModerationRule",
+					ctx,
+					mock.AnythingOfType("usecases.CreateModerationRuleRequest"),
+				).
+					Return(&models.ModerationRule{
+						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
+						ServerId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+						Type:      "links",
+						Action:    "block",
+						CreatedAt: timeMock,
+					}, nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionModerationRuleCreate
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.ChatService.AssertNumberOfCalls(t, "CreateModerationRule", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Type:          "links",
+					Action:        "block",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "create moderation rule: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "CreateModerationRule", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. GetServerById returns not found errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Type:          "links",
+					Action:        "block",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "create moderation rule: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 4. Negative. CreateModerationRule returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateModerationRuleRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Type:          "links",
+					Action:        "block",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "create moderation rule: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("CreateModerationRule",
+					ctx,
+					mock.AnythingOfType("usecases.CreateModerationRuleRequest"),
+				).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateModerationRule(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateModerationRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_ExportServerChat(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ExportServerChatRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        string
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportServerChatRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Format:        "csv",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    "id,text\n",
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("ExportChat",
+					ctx,
+					mock.AnythingOfType("usecases.ExportServerChatRequest"),
+					mock.Anything,
+				).
+					Run(func(args mock.Arguments) {
+						_, _ = args.Get(2).(io.Writer).Write([]byte("id,text\n"))
+					}).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "ExportChat", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportServerChatRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Format:        "csv",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "export server chat: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. ExportChat returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExportServerChatRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Format:        "csv",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "export server chat: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000")),
+						Name:    "server",
+						OwnerId: models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+					}, nil)
+
+				f.ChatService.On("ExportChat", ctx, mock.Anything, mock.Anything).
+					Return(errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			out := &bytes.Buffer{}
+			err := au.ExportServerChat(tt.args.ctx, tt.args.req, out)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ExportServerChat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, out.String())
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_GetServer(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ServerInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Stored member count is returned",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			want: &models.ServerInfo{
+				Id:          serverID,
+				Name:        "server",
+				OwnerId:     ownerID,
+				Description: "description",
+				Visibility:  models.ServerVisibilityPublic,
+				MemberCount: 3,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:          serverID,
+						Name:        "server",
+						OwnerId:     ownerID,
+						Description: "description",
+						MemberCount: 3,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "IncMemberCount", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 2. Positive. Private server is visible to members",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			want: &models.ServerInfo{
+				Id:          serverID,
+				Name:        "server",
+				OwnerId:     ownerID,
+				Visibility:  models.ServerVisibilityPrivate,
+				MemberCount: 2,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:          serverID,
+						Name:        "server",
+						OwnerId:     ownerID,
+						Visibility:  models.ServerVisibilityPrivate,
+						MemberCount: 2,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, userID).
+					Return(&models.SubscribeInfo{
+						ServerId: serverID,
+						UserId:   userID,
+					}, nil)
+			},
+		},
+		{
+			name: "Test 3. Negative. Private server is hidden from non members",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "get server: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, userID).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 4. Negative. GetServerById returns not found errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "get server: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GetServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_UpdateServer(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+
+	name := "new name"
+	emptyName := ""
+	description := "new description"
+	private := models.ServerVisibilityPrivate
+	unknownVisibility := "hidden"
+	category := " Gaming"
+	normalizedCategory := "gaming"
+	discoverable := true
+
+	type args struct {
+		ctx context.Context
+		req usecases.UpdateServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ServerInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          &name,
+					Description:   &description,
+					Visibility:    &private,
+					Tags:          []string{"Games", " games "},
+					Category:      &category,
+					Discoverable:  &discoverable,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ServerInfo{
+				Id:           serverID,
+				Name:         "new name",
+				OwnerId:      ownerID,
+				Description:  "new description",
+				IconUrl:      "icon",
+				Visibility:   models.ServerVisibilityPrivate,
+				Tags:         []string{"games"},
+				Category:     "gaming",
+				Discoverable: true,
+				MemberCount:  1,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:          serverID,
+						Name:        "server",
+						OwnerId:     ownerID,
+						IconUrl:     "icon",
+						Visibility:  models.ServerVisibilityPublic,
+						MemberCount: 1,
+					}, nil)
+
+				// icon url and member count are not overwritten
+				f.ServerRepo.On("UpdateServerProfile", ctx, serverID, models.ServerProfileUpdate{
+					Name:         &name,
+					Description:  &description,
+					Visibility:   &private,
+					Tags:         []string{"games"},
+					Category:     &normalizedCategory,
+					Discoverable: &discoverable,
+				}).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionServerUpdate &&
+						entry.Before["name"] == "server" &&
+						entry.After["name"] == "new name" &&
+						entry.After["icon_url"] == "" &&
+						len(entry.Before) == len(entry.After)
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "UpdateServerProfile", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          &name,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "update server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "UpdateServerProfile", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Empty name",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          &emptyName,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "update server: empty name: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+		},
+		{
+			name: "Test 4. Negative. Unknown visibility",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Visibility:    &unknownVisibility,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "update server: unknown visibility: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+		},
+		{
+			name: "Test 5. Negative. UpdateServer returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Description:   &description,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "update server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.ServerRepo.On("UpdateServerProfile", ctx, serverID, mock.AnythingOfType("models.ServerProfileUpdate")).
+					Return(errors.New("some error"))
+			},
+		},
+		{
+			name: "Test 6. Negative. Too many tags",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.UpdateServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Tags:          []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"},
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "update server: too many tags: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "UpdateServerProfile", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.UpdateServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.UpdateServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_DeleteServer(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+
+	type args struct {
+		ctx context.Context
+		req usecases.DeleteServerRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.ServerRepo.On("MarkDeleted", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionServerDelete
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "MarkDeleted", 1)
+				f.ServerRepo.AssertNotCalled(t, "DeleteServer", mock.Anything, mock.Anything)
+				f.SubscribeRepo.AssertNotCalled(t, "DeleteByServerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 2. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "delete server: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "MarkDeleted", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. MarkDeleted returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteServerRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "delete server: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.ServerRepo.On("MarkDeleted", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.DeleteServer(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.DeleteServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_TransferOwnership(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	newOwnerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+
+	type args struct {
+		ctx context.Context
+		req usecases.TransferOwnershipRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ServerInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Previous owner is subscribed to the server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.TransferOwnershipRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					NewOwnerId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ServerInfo{
+				Id:          serverID,
+				Name:        "server",
+				OwnerId:     newOwnerID,
+				Visibility:  models.ServerVisibilityPublic,
+				MemberCount: 2,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:          serverID,
+						Name:        "server",
+						OwnerId:     ownerID,
+						Visibility:  models.ServerVisibilityPublic,
+						MemberCount: 1,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, newOwnerID).
+					Return(&models.SubscribeInfo{
+						ServerId: serverID,
+						UserId:   newOwnerID,
+					}, nil)
+
+				f.ServerRepo.On("UpdateOwner", ctx, serverID, ownerID, newOwnerID).
+					Return(nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, ownerID).
+					Return(nil, models.ErrNotFound)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.MatchedBy(func(s models.SubscribeInfo) bool {
+					return s.ServerId == serverID && s.UserId == ownerID
+				})).
+					Return(nil)
+
+				f.ServerRepo.On("IncMemberCount", ctx, serverID, int64(1)).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.MatchedBy(func(entry models.AuditEntry) bool {
+					return entry.Action == models.AuditActionServerTransferOwnership &&
+						entry.After["owner_id"] == newOwnerID.String()
+				})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "UpdateOwner", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "CreateSubscribe", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "IncMemberCount", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Current user is not the owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.TransferOwnershipRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					NewOwnerId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "transfer ownership: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "UpdateOwner", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Transfer to the current owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.TransferOwnershipRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					NewOwnerId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "transfer ownership: already owner: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+		},
+		{
+			name: "Test 4. Negative. New owner is not a member",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.TransferOwnershipRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					NewOwnerId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "transfer ownership: new owner is not a member: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, newOwnerID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "UpdateOwner", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Owner was changed concurrently",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.TransferOwnershipRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					NewOwnerId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "transfer ownership: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, newOwnerID).
+					Return(&models.SubscribeInfo{ServerId: serverID, UserId: newOwnerID}, nil)
+
+				f.ServerRepo.On("UpdateOwner", ctx, serverID, ownerID, newOwnerID).
+					Return(models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "CreateSubscribe", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.TransferOwnership(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.TransferOwnership() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_ListServerMembers(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo    *mocks.ServerStorage
+		Log           *log.Logger
+		SubscribeRepo *mocks.SubscribeStorage
+		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+	firstSubscribeID := models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b901"))
+	secondSubscribeID := models.SubscribeID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b902"))
+	joinedAt, _ := time.Parse("2006 Jan 02 15:04:05", "2012 Dec 07 00:00:00")
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListServerMembersRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ListMembersResult
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Owner without subscribe is listed first",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerMembersRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Limit:         1,
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			want: &models.ListMembersResult{
+				Members: []*models.Member{
+					{
+						UserId:  ownerID,
+						IsOwner: true,
+					},
+					{
+						UserId:   userID,
+						JoinedAt: joinedAt,
+					},
+				},
+				NextCursor: "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPublic,
+					}, nil)
+
+				f.SubscribeRepo.On("ListByServerId", ctx, serverID, (*models.SubscribeID)(nil), 2).
+					Return([]*models.SubscribeInfo{
+						{
+							Id:       firstSubscribeID,
+							ServerId: serverID,
+							UserId:   userID,
+							JoinedAt: joinedAt,
+						},
+						{
+							Id:       secondSubscribeID,
+							ServerId: serverID,
+							UserId:   models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b801")),
+							JoinedAt: joinedAt,
+						},
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, ownerID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "ListByServerId", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Next page of private server for member",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerMembersRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Cursor:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b901",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			want: &models.ListMembersResult{
+				Members: []*models.Member{
+					{
+						UserId:   ownerID,
+						JoinedAt: joinedAt,
+						IsOwner:  true,
+					},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, userID).
+					Return(&models.SubscribeInfo{
+						Id:       firstSubscribeID,
+						ServerId: serverID,
+						UserId:   userID,
+					}, nil)
+
+				f.SubscribeRepo.On("ListByServerId", ctx, serverID, &firstSubscribeID, models.MembersDefaultLimit+1).
+					Return([]*models.SubscribeInfo{
+						{
+							Id:       secondSubscribeID,
+							ServerId: serverID,
+							UserId:   ownerID,
+							JoinedAt: joinedAt,
+						},
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. Private server for non member",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerMembersRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "list server members: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, userID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNotCalled(t, "ListByServerId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Bad cursor",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerMembersRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Cursor:        "bad cursor",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "list server members: bad cursor: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPublic,
+					}, nil)
+			},
+		},
+		{
+			name: "Test 5. Negative. ListByServerId returns errors",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListServerMembersRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+				},
+			},
+			wantErr:     true,
+			errorString: "list server members: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPublic,
+					}, nil)
+
+				f.SubscribeRepo.On("ListByServerId", ctx, serverID, (*models.SubscribeID)(nil), models.MembersDefaultLimit+1).
+					Return(nil, errors.New("some error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:    mocks.NewServerStorage(t),
+				Log:           &log.Logger{},
+				SubscribeRepo: mocks.NewSubscribeStorage(t),
+				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:    f.ServerRepo,
+				Log:           f.Log,
+				SubscribeRepo: f.SubscribeRepo,
+				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
+			})
 			if tt.on != nil {
 				tt.on(f)
 			}
 
 			// act
-			got, err := au.InviteUserToServer(tt.args.ctx, tt.args.req)
+			got, err := au.ListServerMembers(tt.args.ctx, tt.args.req)
 
 			// assert
 			if (err != nil) != tt.wantErr {
-				t.Errorf("usecase.Register() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("usecase.ListServerMembers() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
@@ -960,7 +3957,7 @@ func Test_usecase_ServerUsecase_InviteUserToServer(t *testing.T) {
 	}
 }
 
-func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
+func Test_usecase_ServerUsecase_GetMember(t *testing.T) {
 	// prepare
 	var (
 		ctx = context.Background() // dummy
@@ -970,16 +3967,22 @@ func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
 	}
 
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+	joinedAt, _ := time.Parse("2006 Jan 02 15:04:05", "2012 Dec 07 00:00:00")
+
 	type args struct {
 		ctx context.Context
-		req usecases.PublishMessageOnServerRequest
+		req usecases.GetMemberRequest
 	}
 	tests := []struct {
 		name        string
 		args        args
-		want        *models.ActionInfo
+		want        *models.Member
 		wantErr     bool
 		errorString string
 
@@ -990,14 +3993,15 @@ func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
 			name: "Test 1. Positive.",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.PublishMessageOnServerRequest{
+				req: usecases.GetMemberRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
-					Text:          "text",
 				},
 			},
-			want: &models.ActionInfo{
-				Success: true,
+			want: &models.Member{
+				UserId:   userID,
+				JoinedAt: joinedAt,
 			},
 			wantErr: false,
 
@@ -1006,101 +4010,97 @@ func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, nil)
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPrivate,
+					}, nil)
 
-				f.ChatService.On("SendServerMessage",
-					ctx,
-					usecases.PublishMessageOnServerRequest{
-						ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-						Text:     "text",
-					},
-				).
-					Return(nil, nil)
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, userID).
+					Return(&models.SubscribeInfo{
+						ServerId: serverID,
+						UserId:   userID,
+						JoinedAt: joinedAt,
+					}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
-				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 1)
 			},
 		},
 		{
-			name: "Test 2. Negative. SendServerMessage returns error",
+			name: "Test 2. Positive. Owner without subscribe",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.PublishMessageOnServerRequest{
+				req: usecases.GetMemberRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
-					Text:          "text",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
-			want: &models.ActionInfo{
-				Success: true,
+			want: &models.Member{
+				UserId:  ownerID,
+				IsOwner: true,
 			},
-			wantErr:     true,
-			errorString: "publish message on server: some error",
+			wantErr: false,
 
 			on: func(f *fields) {
 				f.ServerRepo.On("GetServerById",
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, nil)
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPublic,
+					}, nil)
 
-				f.ChatService.On("SendServerMessage",
-					ctx,
-					usecases.PublishMessageOnServerRequest{
-						ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-						Text:     "text",
-					},
-				).
-					Return(nil, errors.New("some error"))
-			},
-			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
-				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, ownerID).
+					Return(nil, models.ErrNotFound)
 			},
 		},
 		{
-			name: "Test 3. Negative. SendServerMessage returns error",
+			name: "Test 3. Negative. User is not a member",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.PublishMessageOnServerRequest{
+				req: usecases.GetMemberRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
-					Text:          "text",
 				},
 			},
-			want: &models.ActionInfo{
-				Success: true,
-			},
 			wantErr:     true,
-			errorString: "publish message on server: some error",
+			errorString: "get member: not found",
 
 			on: func(f *fields) {
 				f.ServerRepo.On("GetServerById",
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, errors.New("some error"))
-			},
-			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+					Return(&models.ServerInfo{
+						Id:         serverID,
+						Name:       "server",
+						OwnerId:    ownerID,
+						Visibility: models.ServerVisibilityPublic,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe", ctx, serverID, userID).
+					Return(nil, models.ErrNotFound)
 			},
 		},
 		{
-			name: "Test 4. Negative. SendServerMessage returns not found error",
+			name: "Test 4. Negative. GetServerById returns not found errors",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.PublishMessageOnServerRequest{
+				req: usecases.GetMemberRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
-					Text:          "text",
 				},
 			},
-			want: &models.ActionInfo{
-				Success: true,
-			},
 			wantErr:     true,
-			errorString: "publish message on server: not found",
+			errorString: "get member: not found",
 
 			on: func(f *fields) {
 				f.ServerRepo.On("GetServerById",
@@ -1109,9 +4109,6 @@ func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
 				).
 					Return(nil, models.ErrNotFound)
 			},
-			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
-			},
 		},
 	}
 
@@ -1123,23 +4120,25 @@ func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
 			}
 
 			// act
-			got, err := au.PublishMessageOnServer(tt.args.ctx, tt.args.req)
+			got, err := au.GetMember(tt.args.ctx, tt.args.req)
 
 			// assert
 			if (err != nil) != tt.wantErr {
-				t.Errorf("usecase.Register() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("usecase.GetMember() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
@@ -1157,7 +4156,7 @@ func Test_usecase_ServerUsecase_PublishMessageOnServer(t *testing.T) {
 	}
 }
 
-func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
+func Test_usecase_ServerUsecase_SetMemberNickname(t *testing.T) {
 	// prepare
 	var (
 		ctx = context.Background() // dummy
@@ -1167,18 +4166,22 @@ func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
 		Log           *log.Logger
 		SubscribeRepo *mocks.SubscribeStorage
 		ChatService   *mocks.ServiceChatInterface
+		AuditRepo     *mocks.AuditStorage
 	}
 
-	timeMock, _ := time.Parse("2006 Jan 02 15:04:05", "2012 Dec 07 00:00:00")
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	userID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800"))
+	joinedAt, _ := time.Parse("2006 Jan 02 15:04:05", "2012 Dec 07 00:00:00")
 
 	type args struct {
 		ctx context.Context
-		req usecases.GetMessagesFromServerRequest
+		req usecases.SetMemberNicknameRequest
 	}
 	tests := []struct {
 		name        string
 		args        args
-		want        *models.GetMessagesInfo
+		want        *models.Member
 		wantErr     bool
 		errorString string
 
@@ -1189,19 +4192,17 @@ func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
 			name: "Test 1. Positive.",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.GetMessagesFromServerRequest{
+				req: usecases.SetMemberNicknameRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "  nick  ",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
-			want: &models.GetMessagesInfo{
-				Messages: []*models.Message{
-					{
-						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
-						Text:      "text",
-						Timestamp: timeMock,
-					},
-				},
+			want: &models.Member{
+				UserId:   userID,
+				JoinedAt: joinedAt,
+				Nickname: "nick",
 			},
 			wantErr: false,
 
@@ -1210,130 +4211,272 @@ func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, nil)
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
 
-				f.ChatService.On("GetServerMessages",
+				f.SubscribeRepo.On("GetSubscribe",
 					ctx,
-					usecases.GetMessagesFromServerRequest{
-						ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					},
+					serverID,
+					userID,
 				).
-					Return(&models.GetMessagesInfo{
-						Messages: []*models.Message{
-							{
-								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
-								Text:      "text",
-								Timestamp: timeMock,
-							},
-						},
+					Return(&models.SubscribeInfo{
+						ServerId: serverID,
+						UserId:   userID,
+						JoinedAt: joinedAt,
 					}, nil)
+
+				f.SubscribeRepo.On("SetNickname",
+					ctx,
+					serverID,
+					userID,
+					"nick",
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.ActorId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b800" &&
+							entry.Action == models.AuditActionMemberNickname &&
+							entry.TargetId == userID.String() &&
+							entry.Before["nickname"] == "" &&
+							entry.After["nickname"] == "nick"
+					}),
+				).
+					Return(nil)
 			},
 			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
-				f.ChatService.AssertNumberOfCalls(t, "GetServerMessages", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "SetNickname", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
 			},
 		},
 		{
-			name: "Test 2. Negative. GetServerMessages returns errors",
+			name: "Test 2. Positive. Owner changes nickname of a member",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.GetMessagesFromServerRequest{
+				req: usecases.SetMemberNicknameRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "",
 					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 				},
 			},
-			want: &models.GetMessagesInfo{
-				Messages: []*models.Message{
-					{
-						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
-						Text:      "text",
-						Timestamp: timeMock,
-					},
+			want: &models.Member{
+				UserId:   userID,
+				JoinedAt: joinedAt,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					serverID,
+					userID,
+				).
+					Return(&models.SubscribeInfo{
+						ServerId: serverID,
+						UserId:   userID,
+						JoinedAt: joinedAt,
+						Nickname: "nick",
+					}, nil)
+
+				f.SubscribeRepo.On("SetNickname",
+					ctx,
+					serverID,
+					userID,
+					"",
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.ActorId.String() == "284fef68-7e3e-4d1d-96a0-8c96f7b3b795" &&
+							entry.Action == models.AuditActionMemberNickname &&
+							entry.TargetId == userID.String() &&
+							entry.Before["nickname"] == "nick" &&
+							entry.After["nickname"] == ""
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "SetNickname", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 3. Positive. Nickname is not changed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SetMemberNicknameRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "nick",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
-			wantErr:     true,
-			errorString: "get messages on server: some error",
+			want: &models.Member{
+				UserId:   userID,
+				JoinedAt: joinedAt,
+				Nickname: "nick",
+			},
+			wantErr: false,
 
 			on: func(f *fields) {
 				f.ServerRepo.On("GetServerById",
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, nil)
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
 
-				f.ChatService.On("GetServerMessages",
+				f.SubscribeRepo.On("GetSubscribe",
 					ctx,
-					usecases.GetMessagesFromServerRequest{
-						ServerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					},
+					serverID,
+					userID,
 				).
-					Return(&models.GetMessagesInfo{
-						Messages: []*models.Message{
-							{
-								Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
-								Text:      "text",
-								Timestamp: timeMock,
-							},
-						},
-					}, errors.New("some error"))
+					Return(&models.SubscribeInfo{
+						ServerId: serverID,
+						UserId:   userID,
+						JoinedAt: joinedAt,
+						Nickname: "nick",
+					}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
-				f.ChatService.AssertNumberOfCalls(t, "GetServerMessages", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "SetNickname", 0)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 0)
 			},
 		},
 		{
-			name: "Test 3. Negative. GetServerById returns errors",
+			name: "Test 4. Negative. Not owner changes nickname of other member",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.GetMessagesFromServerRequest{
+				req: usecases.SetMemberNicknameRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
-					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "nick",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b801",
 				},
 			},
-			want: &models.GetMessagesInfo{
-				Messages: []*models.Message{
-					{
-						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
-						Text:      "text",
-						Timestamp: timeMock,
-					},
+			want:        nil,
+			wantErr:     true,
+			errorString: "set member nickname: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 0)
+			},
+		},
+		{
+			name: "Test 5. Negative. Nickname too long",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SetMemberNicknameRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "nnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnnn",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
+			want:        nil,
 			wantErr:     true,
-			errorString: "get messages on server: some error",
+			errorString: "set member nickname: nickname too long: invalid argument",
 
 			on: func(f *fields) {
 				f.ServerRepo.On("GetServerById",
 					ctx,
 					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
 				).
-					Return(nil, errors.New("some error"))
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
 			},
 			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 0)
 			},
 		},
 		{
-			name: "Test 4. Negative. GetServerById returns not found errors",
+			name: "Test 6. Negative. User is not a member",
 			args: args{
 				ctx: ctx, // dumm
-				req: usecases.GetMessagesFromServerRequest{
+				req: usecases.SetMemberNicknameRequest{
 					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "nick",
 					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
 				},
 			},
-			want: &models.GetMessagesInfo{
-				Messages: []*models.Message{
-					{
-						Id:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b200",
-						Text:      "text",
-						Timestamp: timeMock,
-					},
+			want:        nil,
+			wantErr:     true,
+			errorString: "set member nickname: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(&models.ServerInfo{
+						Id:      serverID,
+						Name:    "server",
+						OwnerId: ownerID,
+					}, nil)
+
+				f.SubscribeRepo.On("GetSubscribe",
+					ctx,
+					serverID,
+					userID,
+				).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.SubscribeRepo.AssertNumberOfCalls(t, "SetNickname", 0)
+			},
+		},
+		{
+			name: "Test 7. Negative. GetServerById returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SetMemberNicknameRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					UserId:        "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					Nickname:      "nick",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
 				},
 			},
+			want:        nil,
 			wantErr:     true,
-			errorString: "get messages on server: not found",
+			errorString: "set member nickname: not found",
 
 			on: func(f *fields) {
 				f.ServerRepo.On("GetServerById",
@@ -1343,7 +4486,7 @@ func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
 					Return(nil, models.ErrNotFound)
 			},
 			assert: func(t *testing.T, f *fields) {
-				f.ServerRepo.AssertNumberOfCalls(t, "GetServerById", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "GetSubscribe", 0)
 			},
 		},
 	}
@@ -1356,23 +4499,25 @@ func Test_usecase_ServerUsecase_GetMessagesFromServer(t *testing.T) {
 				Log:           &log.Logger{},
 				SubscribeRepo: mocks.NewSubscribeStorage(t),
 				ChatService:   mocks.NewServiceChatInterface(t),
+				AuditRepo:     mocks.NewAuditStorage(t),
 			}
 			au := NewServerUsecase(Deps{
 				ServerRepo:    f.ServerRepo,
 				Log:           f.Log,
 				SubscribeRepo: f.SubscribeRepo,
 				ChatService:   f.ChatService,
+				AuditRepo:     f.AuditRepo,
 			})
 			if tt.on != nil {
 				tt.on(f)
 			}
 
 			// act
-			got, err := au.GetMessagesFromServer(tt.args.ctx, tt.args.req)
+			got, err := au.SetMemberNickname(tt.args.ctx, tt.args.req)
 
 			// assert
 			if (err != nil) != tt.wantErr {
-				t.Errorf("usecase.Register() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("usecase.SetMemberNickname() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
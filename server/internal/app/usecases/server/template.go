@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// CreateServerTemplate - snapshots settings and channels of the server, only the owner can create a template
+func (u *ServerUsecase) CreateServerTemplate(ctx context.Context, req usecases.CreateServerTemplateRequest) (*models.ServerTemplate, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create server template", err)
+	}
+
+	serverChannels, err := u.ChannelService.ListServerChannels(ctx, server.Id.String(), req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create server template: list channels", err)
+	}
+
+	// templates keep channels in display order, every category comes before its channels
+	if len(serverChannels) > models.ServerTemplateMaxChannels {
+		return nil, pkgerrors.Wrap("create server template: too many channels", models.ErrInvalidArgument)
+	}
+
+	name := req.Name
+	if name == "" {
+		name = server.Name
+	}
+
+	server = serverProfile(server)
+	template := models.ServerTemplate{
+		Id:             models.TemplateID(uuid.New()),
+		Name:           name,
+		SourceServerId: server.Id,
+		OwnerId:        server.OwnerId,
+		Description:    server.Description,
+		IconUrl:        server.IconUrl,
+		BannerUrl:      server.BannerUrl,
+		Visibility:     server.Visibility,
+		Tags:           server.Tags,
+		Category:       server.Category,
+		Channels:       templateChannels(serverChannels),
+		CreatedAt:      time.Now(),
+	}
+
+	err = u.TemplateRepo.CreateTemplate(ctx, template)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create server template", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionServerTemplateCreate,
+		TargetType: models.AuditTargetTemplate,
+		TargetId:   template.Id.String(),
+		After:      map[string]string{"name": template.Name},
+	})
+
+	return &template, nil
+}
+
+// ListTemplates - templates created by the user
+func (u *ServerUsecase) ListTemplates(ctx context.Context, req usecases.ListTemplatesRequest) ([]*models.ServerTemplate, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+
+	templates, err := u.TemplateRepo.ListByOwnerId(ctx, userID)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list templates", err)
+	}
+
+	return templates, nil
+}
+
+// CreateServerFromTemplate - creates the server, owner subscription and channels,
+// everything created before a failed step is deleted
+func (u *ServerUsecase) CreateServerFromTemplate(ctx context.Context, req usecases.CreateServerFromTemplateRequest) (*models.ServerInfo, error) {
+	userID := models.UserID(uuid.MustParse(req.CurrentUserId))
+	templateID := models.TemplateID(uuid.MustParse(req.TemplateId))
+
+	template, err := u.TemplateRepo.GetTemplateById(ctx, templateID)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create server from template", err)
+	}
+
+	if template.OwnerId != userID {
+		return nil, pkgerrors.Wrap("create server from template", models.PermissionDenied)
+	}
+
+	name := req.Name
+	if name == "" {
+		name = template.Name
+	}
+
+	newServer := models.ServerInfo{
+		Id:          models.ServerID(uuid.New()),
+		Name:        name,
+		OwnerId:     userID,
+		Description: template.Description,
+		IconUrl:     template.IconUrl,
+		BannerUrl:   template.BannerUrl,
+		Visibility:  template.Visibility,
+		Tags:        template.Tags,
+		Category:    template.Category,
+		MemberCount: 1,
+	}
+	if newServer.Visibility == "" {
+		newServer.Visibility = models.ServerVisibilityPublic
+	}
+
+	err = u.ServerRepo.CreateServer(ctx, newServer)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create server from template", err)
+	}
+
+	err = u.SubscribeRepo.CreateSubscribe(ctx, models.SubscribeInfo{
+		Id:       models.SubscribeID(uuid.New()),
+		ServerId: newServer.Id,
+		UserId:   userID,
+		JoinedAt: time.Now(),
+	})
+	if err != nil {
+		u.rollbackServer(ctx, newServer.Id, false)
+		return nil, pkgerrors.Wrap("create server from template: owner subscribe", err)
+	}
+
+	if len(template.Channels) > 0 {
+		_, err = u.ChannelService.CreateServerChannels(ctx, usecases.CreateServerChannelsRequest{
+			ServerId: newServer.Id.String(),
+			OwnerId:  userID.String(),
+			Channels: template.Channels,
+		})
+		if err != nil {
+			u.rollbackServer(ctx, newServer.Id, true)
+			return nil, pkgerrors.Wrap("create server from template: create channels", err)
+		}
+	}
+
+	return &newServer, nil
+}
+
+// templateChannels - snapshot of channels in display order, parents are kept as indexes of their categories,
+// channels of a missing category are left outside of categories
+func templateChannels(channels []*models.Channel) []models.TemplateChannel {
+	result := make([]models.TemplateChannel, len(channels))
+	categoryIndex := make(map[string]int)
+	for i, channel := range channels {
+		result[i] = models.TemplateChannel{
+			Name:    channel.Name,
+			Type:    channel.Type,
+			Topic:   channel.Topic,
+			Nsfw:    channel.Nsfw,
+			Voice:   channel.Voice,
+			Forum:   channel.Forum,
+			Private: channel.Private,
+		}
+		if channel.IsCategory() {
+			categoryIndex[channel.Id] = i
+		} else if parentIndex, ok := categoryIndex[channel.ParentId]; ok && channel.ParentId != "" {
+			result[i].ParentIndex = &parentIndex
+		}
+	}
+
+	return result
+}
+
+// rollbackServerTimeout - time given to the compensation of a failed server creation
+const rollbackServerTimeout = 30 * time.Second
+
+// rollbackServer - compensation of a failed server creation, failures are only logged,
+// it runs detached from the request context, which may already be cancelled or past its deadline
+func (u *ServerUsecase) rollbackServer(ctx context.Context, serverId models.ServerID, withChannels bool) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackServerTimeout)
+	defer cancel()
+
+	if withChannels {
+		// channels may be created even when the call failed by timeout
+		err := u.ChannelService.DeleteServerChannels(ctx, serverId.String())
+		if err != nil {
+			u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("rollback server channels error")
+		}
+	}
+
+	err := u.SubscribeRepo.DeleteByServerId(ctx, serverId)
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("rollback server subscribes error")
+	}
+
+	err = u.ServerRepo.DeleteServer(ctx, serverId.String())
+	if err != nil {
+		u.Log.WithContext(ctx).WithError(err).WithField("server_id", serverId.String()).Error("rollback server error")
+	}
+}
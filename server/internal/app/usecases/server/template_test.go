@@ -0,0 +1,883 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ServerUsecase_CreateServerTemplate(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo     *mocks.ServerStorage
+		Log            *log.Logger
+		SubscribeRepo  *mocks.SubscribeStorage
+		ChatService    *mocks.ServiceChatInterface
+		AuditRepo      *mocks.AuditStorage
+		TemplateRepo   *mocks.TemplateStorage
+		ChannelService *mocks.ServiceChannelInterface
+	}
+
+	categoryIndex := 1
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	server := models.ServerInfo{
+		Id:          serverID,
+		Name:        "server",
+		OwnerId:     ownerID,
+		Description: "description",
+		Tags:        []string{"go"},
+		Category:    "gaming",
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateServerTemplateRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ServerTemplate
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerTemplateRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "team",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ServerTemplate{
+				Name:           "team",
+				SourceServerId: serverID,
+				OwnerId:        ownerID,
+				Description:    "description",
+				Visibility:     models.ServerVisibilityPublic,
+				Tags:           []string{"go"},
+				Category:       "gaming",
+				Channels: []models.TemplateChannel{
+					{Name: "general", Type: "text", Topic: "hello"},
+					{Name: "talk", Type: models.ChannelTypeCategory},
+					{Name: "random", Type: "text", ParentIndex: &categoryIndex, Nsfw: true},
+					{Name: "lobby", Type: "voice", ParentIndex: &categoryIndex, Voice: &models.ChannelVoiceSettings{Bitrate: 64000}},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(func() *models.ServerInfo {
+						s := server
+						return &s
+					}(), nil)
+
+				f.ChannelService.On("ListServerChannels",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				).
+					Return([]*models.Channel{
+						{Id: "c0", Name: "general", Type: "text", Topic: "hello"},
+						{Id: "c1", Name: "talk", Type: models.ChannelTypeCategory},
+						{Id: "c2", Name: "random", Type: "text", ParentId: "c1", Nsfw: true},
+						{Id: "c3", Name: "lobby", Type: "voice", ParentId: "c1", Voice: &models.ChannelVoiceSettings{Bitrate: 64000}},
+					}, nil)
+
+				f.TemplateRepo.On("CreateTemplate",
+					ctx,
+					mock.MatchedBy(func(template models.ServerTemplate) bool {
+						return template.Name == "team" &&
+							template.SourceServerId == serverID &&
+							len(template.Channels) == 4
+					}),
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.Action == models.AuditActionServerTemplateCreate &&
+							entry.TargetType == models.AuditTargetTemplate &&
+							entry.After["name"] == "team"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TemplateRepo.AssertNumberOfCalls(t, "CreateTemplate", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Server name is used when name is empty",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerTemplateRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.ServerTemplate{
+				Name:           "server",
+				SourceServerId: serverID,
+				OwnerId:        ownerID,
+				Description:    "description",
+				Visibility:     models.ServerVisibilityPublic,
+				Tags:           []string{"go"},
+				Category:       "gaming",
+				Channels: []models.TemplateChannel{
+					{Name: "general"},
+					{Name: "random"},
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(func() *models.ServerInfo {
+						s := server
+						return &s
+					}(), nil)
+
+				f.ChannelService.On("ListServerChannels",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				).
+					Return([]*models.Channel{
+						{Name: "general"},
+						{Name: "random"},
+					}, nil)
+
+				f.TemplateRepo.On("CreateTemplate", ctx, mock.Anything).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry", ctx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TemplateRepo.AssertNumberOfCalls(t, "CreateTemplate", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. Not owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerTemplateRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b801",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server template: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(func() *models.ServerInfo {
+						s := server
+						return &s
+					}(), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelService.AssertNumberOfCalls(t, "ListServerChannels", 0)
+				f.TemplateRepo.AssertNumberOfCalls(t, "CreateTemplate", 0)
+			},
+		},
+		{
+			name: "Test 4. Negative. ListServerChannels returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerTemplateRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server template: list channels: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(func() *models.ServerInfo {
+						s := server
+						return &s
+					}(), nil)
+
+				f.ChannelService.On("ListServerChannels",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TemplateRepo.AssertNumberOfCalls(t, "CreateTemplate", 0)
+			},
+		},
+		{
+			name: "Test 5. Negative. CreateTemplate returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerTemplateRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server template: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+				).
+					Return(func() *models.ServerInfo {
+						s := server
+						return &s
+					}(), nil)
+
+				f.ChannelService.On("ListServerChannels",
+					ctx,
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					"284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				).
+					Return([]*models.Channel{
+						{Name: "general"},
+						{Name: "random"},
+					}, nil)
+
+				f.TemplateRepo.On("CreateTemplate", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:     mocks.NewServerStorage(t),
+				Log:            &log.Logger{},
+				SubscribeRepo:  mocks.NewSubscribeStorage(t),
+				ChatService:    mocks.NewServiceChatInterface(t),
+				AuditRepo:      mocks.NewAuditStorage(t),
+				TemplateRepo:   mocks.NewTemplateStorage(t),
+				ChannelService: mocks.NewServiceChannelInterface(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:     f.ServerRepo,
+				Log:            f.Log,
+				SubscribeRepo:  f.SubscribeRepo,
+				ChatService:    f.ChatService,
+				AuditRepo:      f.AuditRepo,
+				TemplateRepo:   f.TemplateRepo,
+				ChannelService: f.ChannelService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateServerTemplate(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateServerTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			// id and creation time are generated
+			if got != nil {
+				got.Id = models.TemplateID{}
+				got.CreatedAt = time.Time{}
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_ListTemplates(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo     *mocks.ServerStorage
+		Log            *log.Logger
+		SubscribeRepo  *mocks.SubscribeStorage
+		ChatService    *mocks.ServiceChatInterface
+		AuditRepo      *mocks.AuditStorage
+		TemplateRepo   *mocks.TemplateStorage
+		ChannelService *mocks.ServiceChannelInterface
+	}
+
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	templates := []*models.ServerTemplate{
+		{
+			Id:      models.TemplateID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b300")),
+			Name:    "team",
+			OwnerId: ownerID,
+		},
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ListTemplatesRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.ServerTemplate
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListTemplatesRequest{
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    templates,
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("ListByOwnerId", ctx, ownerID).
+					Return(templates, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TemplateRepo.AssertNumberOfCalls(t, "ListByOwnerId", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. ListByOwnerId returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ListTemplatesRequest{
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "list templates: some error",
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("ListByOwnerId", ctx, ownerID).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TemplateRepo.AssertNumberOfCalls(t, "ListByOwnerId", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:     mocks.NewServerStorage(t),
+				Log:            &log.Logger{},
+				SubscribeRepo:  mocks.NewSubscribeStorage(t),
+				ChatService:    mocks.NewServiceChatInterface(t),
+				AuditRepo:      mocks.NewAuditStorage(t),
+				TemplateRepo:   mocks.NewTemplateStorage(t),
+				ChannelService: mocks.NewServiceChannelInterface(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:     f.ServerRepo,
+				Log:            f.Log,
+				SubscribeRepo:  f.SubscribeRepo,
+				ChatService:    f.ChatService,
+				AuditRepo:      f.AuditRepo,
+				TemplateRepo:   f.TemplateRepo,
+				ChannelService: f.ChannelService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ListTemplates(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ListTemplates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_CreateServerFromTemplate(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo     *mocks.ServerStorage
+		Log            *log.Logger
+		SubscribeRepo  *mocks.SubscribeStorage
+		ChatService    *mocks.ServiceChatInterface
+		AuditRepo      *mocks.AuditStorage
+		TemplateRepo   *mocks.TemplateStorage
+		ChannelService *mocks.ServiceChannelInterface
+	}
+
+	templateID := models.TemplateID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b300"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	template := &models.ServerTemplate{
+		Id:          templateID,
+		Name:        "team",
+		OwnerId:     ownerID,
+		Description: "description",
+		Visibility:  models.ServerVisibilityPrivate,
+		Tags:        []string{"go"},
+		Channels: []models.TemplateChannel{
+			{Name: "general"},
+			{Name: "random"},
+		},
+	}
+	// rollback is not cancelled together with the request
+	rollbackCtx := mock.MatchedBy(func(c context.Context) bool {
+		_, hasDeadline := c.Deadline()
+		return c.Err() == nil && hasDeadline
+	})
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	newServer := func(name string) *models.ServerInfo {
+		return &models.ServerInfo{
+			Name:        name,
+			OwnerId:     ownerID,
+			Description: "description",
+			Visibility:  models.ServerVisibilityPrivate,
+			Tags:        []string{"go"},
+			MemberCount: 1,
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateServerFromTemplateRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ServerInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    newServer("team"),
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", ctx, templateID).
+					Return(template, nil)
+
+				f.ServerRepo.On("CreateServer",
+					ctx,
+					mock.MatchedBy(func(server models.ServerInfo) bool {
+						return server.OwnerId == ownerID &&
+							server.Visibility == models.ServerVisibilityPrivate &&
+							server.MemberCount == 1
+					}),
+				).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe",
+					ctx,
+					mock.MatchedBy(func(subscribe models.SubscribeInfo) bool {
+						return subscribe.UserId == ownerID
+					}),
+				).
+					Return(nil)
+
+				f.ChannelService.On("CreateServerChannels",
+					ctx,
+					mock.MatchedBy(func(req usecases.CreateServerChannelsRequest) bool {
+						return req.OwnerId == ownerID.String() &&
+							len(req.Channels) == 2 &&
+							req.Channels[0].Name == "general" &&
+							req.Channels[1].Name == "random"
+					}),
+				).
+					Return([]*models.Channel{{Name: "general"}, {Name: "random"}}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 1)
+				f.ChannelService.AssertNumberOfCalls(t, "CreateServerChannels", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "DeleteServer", 0)
+			},
+		},
+		{
+			name: "Test 2. Positive. Custom server name",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					Name:          "copy",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    newServer("copy"),
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", ctx, templateID).
+					Return(template, nil)
+
+				f.ServerRepo.On("CreateServer",
+					ctx,
+					mock.MatchedBy(func(server models.ServerInfo) bool {
+						return server.Name == "copy"
+					}),
+				).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe",
+					ctx,
+					mock.MatchedBy(func(subscribe models.SubscribeInfo) bool {
+						return subscribe.UserId == ownerID
+					}),
+				).
+					Return(nil)
+
+				f.ChannelService.On("CreateServerChannels",
+					ctx,
+					mock.MatchedBy(func(req usecases.CreateServerChannelsRequest) bool {
+						return req.OwnerId == ownerID.String() &&
+							len(req.Channels) == 2 &&
+							req.Channels[0].Name == "general" &&
+							req.Channels[1].Name == "random"
+					}),
+				).
+					Return([]*models.Channel{{Name: "general"}, {Name: "random"}}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 1)
+			},
+		},
+		{
+			name: "Test 3. Negative. Template of other user",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b801",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server from template: permission denied",
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", ctx, templateID).
+					Return(template, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 0)
+			},
+		},
+		{
+			name: "Test 4. Negative. Template not found",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server from template: not found",
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", ctx, templateID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNumberOfCalls(t, "CreateServer", 0)
+			},
+		},
+		{
+			name: "Test 5. Negative. CreateSubscribe returns error, server is deleted",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server from template: owner subscribe: some error",
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", ctx, templateID).
+					Return(template, nil)
+
+				f.ServerRepo.On("CreateServer",
+					ctx,
+					mock.MatchedBy(func(server models.ServerInfo) bool {
+						return server.OwnerId == ownerID &&
+							server.Visibility == models.ServerVisibilityPrivate &&
+							server.MemberCount == 1
+					}),
+				).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", ctx, mock.Anything).
+					Return(errors.New("some error"))
+
+				f.SubscribeRepo.On("DeleteByServerId", rollbackCtx, mock.Anything).
+					Return(nil)
+
+				f.ServerRepo.On("DeleteServer", rollbackCtx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelService.AssertNumberOfCalls(t, "CreateServerChannels", 0)
+				f.ChannelService.AssertNumberOfCalls(t, "DeleteServerChannels", 0)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteByServerId", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "DeleteServer", 1)
+			},
+		},
+		{
+			name: "Test 6. Negative. CreateServerChannels returns error, server and channels are deleted",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server from template: create channels: some error",
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", ctx, templateID).
+					Return(template, nil)
+
+				f.ServerRepo.On("CreateServer",
+					ctx,
+					mock.MatchedBy(func(server models.ServerInfo) bool {
+						return server.OwnerId == ownerID &&
+							server.Visibility == models.ServerVisibilityPrivate &&
+							server.MemberCount == 1
+					}),
+				).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe",
+					ctx,
+					mock.MatchedBy(func(subscribe models.SubscribeInfo) bool {
+						return subscribe.UserId == ownerID
+					}),
+				).
+					Return(nil)
+
+				f.ChannelService.On("CreateServerChannels",
+					ctx,
+					mock.MatchedBy(func(req usecases.CreateServerChannelsRequest) bool {
+						return req.OwnerId == ownerID.String() &&
+							len(req.Channels) == 2 &&
+							req.Channels[0].Name == "general" &&
+							req.Channels[1].Name == "random"
+					}),
+				).
+					Return(nil, errors.New("some error"))
+
+				f.ChannelService.On("DeleteServerChannels", rollbackCtx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", rollbackCtx, mock.Anything).
+					Return(nil)
+
+				f.ServerRepo.On("DeleteServer", rollbackCtx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelService.AssertNumberOfCalls(t, "DeleteServerChannels", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteByServerId", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "DeleteServer", 1)
+			},
+		},
+		{
+			name: "Test 7. Negative. Request is cancelled, rollback still deletes the server",
+			args: args{
+				ctx: cancelledCtx,
+				req: usecases.CreateServerFromTemplateRequest{
+					TemplateId:    "284fef68-7e3e-4d1d-96a0-8c96f7b3b300",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create server from template: create channels: context canceled",
+
+			on: func(f *fields) {
+				f.TemplateRepo.On("GetTemplateById", cancelledCtx, templateID).
+					Return(template, nil)
+
+				f.ServerRepo.On("CreateServer", cancelledCtx, mock.AnythingOfType("models.ServerInfo")).
+					Return(nil)
+
+				f.SubscribeRepo.On("CreateSubscribe", cancelledCtx, mock.AnythingOfType("models.SubscribeInfo")).
+					Return(nil)
+
+				f.ChannelService.On("CreateServerChannels", cancelledCtx, mock.AnythingOfType("usecases.CreateServerChannelsRequest")).
+					Return(nil, context.Canceled)
+
+				f.ChannelService.On("DeleteServerChannels", rollbackCtx, mock.Anything).
+					Return(nil)
+
+				f.SubscribeRepo.On("DeleteByServerId", rollbackCtx, mock.Anything).
+					Return(nil)
+
+				f.ServerRepo.On("DeleteServer", rollbackCtx, mock.Anything).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChannelService.AssertNumberOfCalls(t, "DeleteServerChannels", 1)
+				f.SubscribeRepo.AssertNumberOfCalls(t, "DeleteByServerId", 1)
+				f.ServerRepo.AssertNumberOfCalls(t, "DeleteServer", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:     mocks.NewServerStorage(t),
+				Log:            &log.Logger{},
+				SubscribeRepo:  mocks.NewSubscribeStorage(t),
+				ChatService:    mocks.NewServiceChatInterface(t),
+				AuditRepo:      mocks.NewAuditStorage(t),
+				TemplateRepo:   mocks.NewTemplateStorage(t),
+				ChannelService: mocks.NewServiceChannelInterface(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:     f.ServerRepo,
+				Log:            f.Log,
+				SubscribeRepo:  f.SubscribeRepo,
+				ChatService:    f.ChatService,
+				AuditRepo:      f.AuditRepo,
+				TemplateRepo:   f.TemplateRepo,
+				ChannelService: f.ChannelService,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateServerFromTemplate(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateServerFromTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			// server id is generated
+			if got != nil {
+				got.Id = models.ServerID{}
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
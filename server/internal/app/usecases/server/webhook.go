@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/server/pkg/errors"
+	"github.com/google/uuid"
+	"time"
+)
+
+// CreateWebhook - only the owner manages webhooks, the plain token is returned only here and on regeneration
+func (u *ServerUsecase) CreateWebhook(ctx context.Context, req usecases.CreateWebhookRequest) (*models.Webhook, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create webhook", err)
+	}
+
+	count, err := u.WebhookRepo.CountByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create webhook", err)
+	}
+	if count >= models.ServerWebhookLimit {
+		return nil, pkgerrors.Wrap("create webhook: too many webhooks", models.ErrInvalidArgument)
+	}
+
+	webhook := models.Webhook{
+		Id:        models.WebhookID(uuid.New()),
+		ServerId:  server.Id,
+		Name:      req.Name,
+		AvatarUrl: req.AvatarUrl,
+		CreatorId: server.OwnerId,
+		CreatedAt: time.Now(),
+	}
+	err = webhook.SetNewToken()
+	if err != nil {
+		return nil, pkgerrors.Wrap("create webhook: generate token", err)
+	}
+
+	err = u.WebhookRepo.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create webhook", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionWebhookCreate,
+		TargetType: models.AuditTargetWebhook,
+		TargetId:   webhook.Id.String(),
+		After:      map[string]string{"name": webhook.Name},
+	})
+
+	return &webhook, nil
+}
+
+func (u *ServerUsecase) ListWebhooks(ctx context.Context, req usecases.ListWebhooksRequest) ([]*models.Webhook, error) {
+	server, err := u.getOwnedServer(ctx, req.ServerId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list webhooks", err)
+	}
+
+	webhooks, err := u.WebhookRepo.ListByServerId(ctx, server.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("list webhooks", err)
+	}
+
+	return webhooks, nil
+}
+
+// RegenerateWebhookToken - the previous token stops working immediately
+func (u *ServerUsecase) RegenerateWebhookToken(ctx context.Context, req usecases.RegenerateWebhookTokenRequest) (*models.Webhook, error) {
+	server, webhook, err := u.getOwnedWebhook(ctx, req.ServerId, req.WebhookId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("regenerate webhook token", err)
+	}
+
+	err = webhook.SetNewToken()
+	if err != nil {
+		return nil, pkgerrors.Wrap("regenerate webhook token: generate token", err)
+	}
+
+	err = u.WebhookRepo.UpdateTokenHash(ctx, webhook.Id, webhook.TokenHash)
+	if err != nil {
+		return nil, pkgerrors.Wrap("regenerate webhook token", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionWebhookRegenerateToken,
+		TargetType: models.AuditTargetWebhook,
+		TargetId:   webhook.Id.String(),
+	})
+
+	return webhook, nil
+}
+
+func (u *ServerUsecase) DeleteWebhook(ctx context.Context, req usecases.DeleteWebhookRequest) (*models.ActionInfo, error) {
+	server, webhook, err := u.getOwnedWebhook(ctx, req.ServerId, req.WebhookId, req.CurrentUserId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete webhook", err)
+	}
+
+	err = u.WebhookRepo.DeleteWebhook(ctx, webhook.Id)
+	if err != nil {
+		return nil, pkgerrors.Wrap("delete webhook", err)
+	}
+
+	u.recordAudit(ctx, models.AuditEntry{
+		ServerId:   server.Id,
+		ActorId:    server.OwnerId,
+		Action:     models.AuditActionWebhookDelete,
+		TargetType: models.AuditTargetWebhook,
+		TargetId:   webhook.Id.String(),
+		Before:     map[string]string{"name": webhook.Name},
+	})
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// ExecuteWebhook - posts into the server chat on behalf of the webhook, the token replaces user authentication
+func (u *ServerUsecase) ExecuteWebhook(ctx context.Context, req usecases.ExecuteWebhookRequest) (*models.ActionInfo, error) {
+	webhook, err := u.WebhookRepo.GetWebhookById(ctx, models.WebhookID(uuid.MustParse(req.WebhookId)))
+	if err != nil {
+		return nil, pkgerrors.Wrap("execute webhook", err)
+	}
+
+	if !webhook.MatchToken(req.Token) {
+		return nil, pkgerrors.Wrap("execute webhook: invalid token", models.Unauthenticated)
+	}
+
+	server, err := u.ServerRepo.GetServerById(ctx, webhook.ServerId.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap("execute webhook", err)
+	}
+
+	username := req.Username
+	if username == "" {
+		username = webhook.Name
+	}
+	avatarUrl := req.AvatarUrl
+	if avatarUrl == "" {
+		avatarUrl = webhook.AvatarUrl
+	}
+
+	err = u.sendServerMessage(ctx, server, usecases.PublishMessageOnServerRequest{
+		ServerId:      server.Id.String(),
+		Text:          req.Content,
+		CurrentUserId: webhook.Id.String(),
+		WebhookId:     webhook.Id.String(),
+		Username:      username,
+		AvatarUrl:     avatarUrl,
+	})
+	if err != nil {
+		return nil, pkgerrors.Wrap("execute webhook", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+// getOwnedWebhook - webhook of another server is reported as not found
+func (u *ServerUsecase) getOwnedWebhook(ctx context.Context, serverId string, webhookId string, userId string) (*models.ServerInfo, *models.Webhook, error) {
+	server, err := u.getOwnedServer(ctx, serverId, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook, err := u.WebhookRepo.GetWebhookById(ctx, models.WebhookID(uuid.MustParse(webhookId)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if webhook.ServerId != server.Id {
+		return nil, nil, models.ErrNotFound
+	}
+
+	return server, webhook, nil
+}
@@ -0,0 +1,783 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/server/internal/app/models"
+	"github.com/Nixonxp/discord/server/internal/app/usecases"
+	"github.com/Nixonxp/discord/server/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/server/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func Test_usecase_ServerUsecase_CreateWebhook(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo  *mocks.ServerStorage
+		Log         *log.Logger
+		AuditRepo   *mocks.AuditStorage
+		WebhookRepo *mocks.WebhookStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateWebhookRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.Webhook
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "ci",
+					AvatarUrl:     "https://example.com/ci.png",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want: &models.Webhook{
+				ServerId:  serverID,
+				Name:      "ci",
+				AvatarUrl: "https://example.com/ci.png",
+				CreatorId: ownerID,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(1), nil)
+
+				f.WebhookRepo.On("CreateWebhook",
+					ctx,
+					mock.MatchedBy(func(webhook models.Webhook) bool {
+						return webhook.ServerId == serverID &&
+							webhook.Name == "ci" &&
+							webhook.Token != "" &&
+							webhook.TokenHash == models.HashWebhookToken(webhook.Token)
+					}),
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.ServerId == serverID &&
+							entry.Action == models.AuditActionWebhookCreate &&
+							entry.TargetType == models.AuditTargetWebhook &&
+							entry.After["name"] == "ci"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNumberOfCalls(t, "CreateWebhook", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Not owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "ci",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b001",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create webhook: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Too many webhooks",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "ci",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create webhook: too many webhooks: invalid argument",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(models.ServerWebhookLimit), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. CreateWebhook returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					Name:          "ci",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "create webhook: some error",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("CountByServerId", ctx, serverID).
+					Return(int64(0), nil)
+
+				f.WebhookRepo.On("CreateWebhook", ctx, mock.Anything).
+					Return(errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.AuditRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:  mocks.NewServerStorage(t),
+				Log:         &log.Logger{},
+				AuditRepo:   mocks.NewAuditStorage(t),
+				WebhookRepo: mocks.NewWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:  f.ServerRepo,
+				Log:         f.Log,
+				AuditRepo:   f.AuditRepo,
+				WebhookRepo: f.WebhookRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateWebhook(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateWebhook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+				if tt.assert != nil {
+					tt.assert(t, f)
+				}
+				return
+			}
+
+			// id, token and creation time are generated
+			if got != nil {
+				assert.True(t, got.MatchToken(got.Token))
+				got.Id = models.WebhookID{}
+				got.Token = ""
+				got.TokenHash = ""
+				got.CreatedAt = time.Time{}
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_RegenerateWebhookToken(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo  *mocks.ServerStorage
+		Log         *log.Logger
+		AuditRepo   *mocks.AuditStorage
+		WebhookRepo *mocks.WebhookStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	otherServerID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b002"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	webhookID := models.WebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+	oldHash := models.HashWebhookToken("old token")
+
+	type args struct {
+		ctx context.Context
+		req usecases.RegenerateWebhookTokenRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RegenerateWebhookTokenRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&models.Webhook{Id: webhookID, ServerId: serverID, Name: "ci", TokenHash: oldHash}, nil)
+
+				f.WebhookRepo.On("UpdateTokenHash",
+					ctx,
+					webhookID,
+					mock.MatchedBy(func(hash string) bool {
+						return hash != "" && hash != oldHash
+					}),
+				).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.Action == models.AuditActionWebhookRegenerateToken &&
+							entry.TargetId == webhookID.String()
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNumberOfCalls(t, "UpdateTokenHash", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Webhook of another server",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RegenerateWebhookTokenRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			wantErr:     true,
+			errorString: "regenerate webhook token: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&models.Webhook{Id: webhookID, ServerId: otherServerID, TokenHash: oldHash}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNotCalled(t, "UpdateTokenHash", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Not owner",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.RegenerateWebhookTokenRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b001",
+				},
+			},
+			wantErr:     true,
+			errorString: "regenerate webhook token: permission denied",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNotCalled(t, "GetWebhookById", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:  mocks.NewServerStorage(t),
+				Log:         &log.Logger{},
+				AuditRepo:   mocks.NewAuditStorage(t),
+				WebhookRepo: mocks.NewWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:  f.ServerRepo,
+				Log:         f.Log,
+				AuditRepo:   f.AuditRepo,
+				WebhookRepo: f.WebhookRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.RegenerateWebhookToken(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.RegenerateWebhookToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			} else {
+				assert.True(t, got.MatchToken(got.Token))
+				assert.False(t, got.MatchToken("old token"))
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_DeleteWebhook(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo  *mocks.ServerStorage
+		Log         *log.Logger
+		AuditRepo   *mocks.AuditStorage
+		WebhookRepo *mocks.WebhookStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	webhookID := models.WebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.DeleteWebhookRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&models.Webhook{Id: webhookID, ServerId: serverID, Name: "ci"}, nil)
+
+				f.WebhookRepo.On("DeleteWebhook", ctx, webhookID).
+					Return(nil)
+
+				f.AuditRepo.On("CreateEntry",
+					ctx,
+					mock.MatchedBy(func(entry models.AuditEntry) bool {
+						return entry.Action == models.AuditActionWebhookDelete &&
+							entry.Before["name"] == "ci"
+					}),
+				).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNumberOfCalls(t, "DeleteWebhook", 1)
+				f.AuditRepo.AssertNumberOfCalls(t, "CreateEntry", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Webhook not found",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.DeleteWebhookRequest{
+					ServerId:      "284fef68-7e3e-4d1d-96a0-8c96f7b3b000",
+					WebhookId:     "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					CurrentUserId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "delete webhook: not found",
+
+			on: func(f *fields) {
+				f.ServerRepo.On("GetServerById", ctx, "284fef68-7e3e-4d1d-96a0-8c96f7b3b000").
+					Return(&server, nil)
+
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.WebhookRepo.AssertNotCalled(t, "DeleteWebhook", mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:  mocks.NewServerStorage(t),
+				Log:         &log.Logger{},
+				AuditRepo:   mocks.NewAuditStorage(t),
+				WebhookRepo: mocks.NewWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:  f.ServerRepo,
+				Log:         f.Log,
+				AuditRepo:   f.AuditRepo,
+				WebhookRepo: f.WebhookRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.DeleteWebhook(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.DeleteWebhook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_ServerUsecase_ExecuteWebhook(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		ServerRepo   *mocks.ServerStorage
+		Log          *log.Logger
+		ChatService  *mocks.ServiceChatInterface
+		WebhookRepo  *mocks.WebhookStorage
+		OutgoingRepo *mocks.OutgoingWebhookStorage
+	}
+
+	serverID := models.ServerID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b000"))
+	ownerID := models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	webhookID := models.WebhookID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b100"))
+	server := models.ServerInfo{
+		Id:      serverID,
+		Name:    "server",
+		OwnerId: ownerID,
+	}
+	webhook := models.Webhook{
+		Id:        webhookID,
+		ServerId:  serverID,
+		Name:      "ci",
+		AvatarUrl: "https://example.com/ci.png",
+		TokenHash: models.HashWebhookToken("secret"),
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.ExecuteWebhookRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Overrides are used",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExecuteWebhookRequest{
+					WebhookId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					Token:     "secret",
+					Content:   "build passed",
+					Username:  "release bot",
+					AvatarUrl: "https://example.com/release.png",
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&webhook, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, serverID.String()).
+					Return(&server, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      serverID.String(),
+					Text:          "build passed",
+					CurrentUserId: webhookID.String(),
+					WebhookId:     webhookID.String(),
+					Username:      "release bot",
+					AvatarUrl:     "https://example.com/release.png",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNumberOfCalls(t, "SendServerMessage", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Webhook name and avatar are used without overrides",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExecuteWebhookRequest{
+					WebhookId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					Token:     "secret",
+					Content:   "build passed",
+				},
+			},
+			want:    &models.ActionInfo{Success: true},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&webhook, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, serverID.String()).
+					Return(&server, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, usecases.PublishMessageOnServerRequest{
+					ServerId:      serverID.String(),
+					Text:          "build passed",
+					CurrentUserId: webhookID.String(),
+					WebhookId:     webhookID.String(),
+					Username:      "ci",
+					AvatarUrl:     "https://example.com/ci.png",
+				}).
+					Return(&models.ActionInfo{Success: true}, nil)
+
+				f.ServerRepo.On("TouchServerActivity", ctx, serverID, mock.AnythingOfType("time.Time")).
+					Return(nil)
+
+				f.OutgoingRepo.On("ListSubscribed", ctx, serverID, models.OutgoingEventMessageCreated).
+					Return([]*models.OutgoingWebhook{}, nil)
+			},
+			assert: nil,
+		},
+		{
+			name: "Test 3. Negative. Invalid token",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExecuteWebhookRequest{
+					WebhookId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					Token:     "wrong",
+					Content:   "build passed",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "execute webhook: invalid token: unauthenticated",
+
+			on: func(f *fields) {
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&webhook, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "GetServerById", mock.Anything, mock.Anything)
+				f.ChatService.AssertNotCalled(t, "SendServerMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Server is deleted",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExecuteWebhookRequest{
+					WebhookId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					Token:     "secret",
+					Content:   "build passed",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "execute webhook: not found",
+
+			on: func(f *fields) {
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&webhook, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, serverID.String()).
+					Return(nil, models.ErrNotFound)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ChatService.AssertNotCalled(t, "SendServerMessage", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. SendServerMessage returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.ExecuteWebhookRequest{
+					WebhookId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b100",
+					Token:     "secret",
+					Content:   "build passed",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "execute webhook: some error",
+
+			on: func(f *fields) {
+				f.WebhookRepo.On("GetWebhookById", ctx, webhookID).
+					Return(&webhook, nil)
+
+				f.ServerRepo.On("GetServerById", ctx, serverID.String()).
+					Return(&server, nil)
+
+				f.ChatService.On("SendServerMessage", ctx, mock.Anything).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.ServerRepo.AssertNotCalled(t, "TouchServerActivity", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				ServerRepo:   mocks.NewServerStorage(t),
+				Log:          &log.Logger{},
+				ChatService:  mocks.NewServiceChatInterface(t),
+				WebhookRepo:  mocks.NewWebhookStorage(t),
+				OutgoingRepo: mocks.NewOutgoingWebhookStorage(t),
+			}
+			au := NewServerUsecase(Deps{
+				ServerRepo:   f.ServerRepo,
+				Log:          f.Log,
+				ChatService:  f.ChatService,
+				WebhookRepo:  f.WebhookRepo,
+				OutgoingRepo: f.OutgoingRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.ExecuteWebhook(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.ExecuteWebhook() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
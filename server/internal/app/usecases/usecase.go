@@ -3,24 +3,80 @@ package usecases
 import (
 	"context"
 	"github.com/Nixonxp/discord/server/internal/app/models"
+	"io"
+	"time"
 )
 
 type UsecaseInterface interface {
 	CreateServer(ctx context.Context, req CreateServerRequest) (*models.ServerInfo, error)
-	SearchServer(ctx context.Context, req SearchServerRequest) ([]*models.ServerInfo, error)
+	SearchServer(ctx context.Context, req SearchServerRequest) (*models.SearchServersResult, error)
+	GetServer(ctx context.Context, req GetServerRequest) (*models.ServerInfo, error)
+	UpdateServer(ctx context.Context, req UpdateServerRequest) (*models.ServerInfo, error)
+	DeleteServer(ctx context.Context, req DeleteServerRequest) (*models.ActionInfo, error)
+	RestoreServer(ctx context.Context, req RestoreServerRequest) (*models.ServerInfo, error)
+	PurgeDeletedServers(ctx context.Context) (int, error)
+	TransferOwnership(ctx context.Context, req TransferOwnershipRequest) (*models.ServerInfo, error)
+	ListServerMembers(ctx context.Context, req ListServerMembersRequest) (*models.ListMembersResult, error)
+	GetMember(ctx context.Context, req GetMemberRequest) (*models.Member, error)
+	SetMemberNickname(ctx context.Context, req SetMemberNicknameRequest) (*models.Member, error)
+	RepairMembers(ctx context.Context) (*models.RepairMembersResult, error)
 	SubscribeServer(ctx context.Context, req SubscribeServerRequest) (*models.ActionInfo, error)
 	UnsubscribeServer(ctx context.Context, req UnsubscribeServerRequest) (*models.ActionInfo, error)
 	SearchServerByUserId(ctx context.Context, req SearchServerByUserIdRequest) ([]string, error)
 	InviteUserToServer(ctx context.Context, req InviteUserToServerRequest) (*models.ActionInfo, error)
+	AddBotToServer(ctx context.Context, req AddBotToServerRequest) (*models.ActionInfo, error)
 	PublishMessageOnServer(ctx context.Context, req PublishMessageOnServerRequest) (*models.ActionInfo, error)
 	GetMessagesFromServer(ctx context.Context, req GetMessagesFromServerRequest) (*models.GetMessagesInfo, error)
+	CreateModerationRule(ctx context.Context, req CreateModerationRuleRequest) (*models.ModerationRule, error)
+	DeleteModerationRule(ctx context.Context, req DeleteModerationRuleRequest) (*models.ActionInfo, error)
+	GetModerationRules(ctx context.Context, req GetModerationRulesRequest) ([]*models.ModerationRule, error)
+	GetModerationActions(ctx context.Context, req GetModerationActionsRequest) ([]*models.ModerationAction, error)
+	ExportServerChat(ctx context.Context, req ExportServerChatRequest, w io.Writer) error
+	GetAuditLog(ctx context.Context, req GetAuditLogRequest) (*models.AuditLogResult, error)
+	RecordAuditEntry(ctx context.Context, req RecordAuditEntryRequest) (*models.ActionInfo, error)
+	CreateServerTemplate(ctx context.Context, req CreateServerTemplateRequest) (*models.ServerTemplate, error)
+	ListTemplates(ctx context.Context, req ListTemplatesRequest) ([]*models.ServerTemplate, error)
+	CreateServerFromTemplate(ctx context.Context, req CreateServerFromTemplateRequest) (*models.ServerInfo, error)
+	CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*models.Webhook, error)
+	ListWebhooks(ctx context.Context, req ListWebhooksRequest) ([]*models.Webhook, error)
+	RegenerateWebhookToken(ctx context.Context, req RegenerateWebhookTokenRequest) (*models.Webhook, error)
+	DeleteWebhook(ctx context.Context, req DeleteWebhookRequest) (*models.ActionInfo, error)
+	ExecuteWebhook(ctx context.Context, req ExecuteWebhookRequest) (*models.ActionInfo, error)
+	CreateOutgoingWebhook(ctx context.Context, req CreateOutgoingWebhookRequest) (*models.OutgoingWebhook, error)
+	ListOutgoingWebhooks(ctx context.Context, req ListOutgoingWebhooksRequest) ([]*models.OutgoingWebhook, error)
+	EnableOutgoingWebhook(ctx context.Context, req EnableOutgoingWebhookRequest) (*models.OutgoingWebhook, error)
+	DeleteOutgoingWebhook(ctx context.Context, req DeleteOutgoingWebhookRequest) (*models.ActionInfo, error)
+	ListOutgoingDeliveries(ctx context.Context, req ListOutgoingDeliveriesRequest) ([]*models.OutgoingDelivery, error)
+	DeliverOutgoingEvents(ctx context.Context) (int, error)
+	CreateCommand(ctx context.Context, req CreateCommandRequest) (*models.Command, error)
+	ListCommands(ctx context.Context, req ListCommandsRequest) ([]*models.Command, error)
+	DeleteCommand(ctx context.Context, req DeleteCommandRequest) (*models.ActionInfo, error)
+	RespondInteraction(ctx context.Context, req RespondInteractionRequest) (*models.ActionInfo, error)
+	WaitDispatches(ctx context.Context) error
+	CreateEmoji(ctx context.Context, req CreateEmojiRequest) (*models.Emoji, error)
+	ListServerEmoji(ctx context.Context, req ListServerEmojiRequest) ([]*models.Emoji, error)
+	RenameEmoji(ctx context.Context, req RenameEmojiRequest) (*models.Emoji, error)
+	DeleteEmoji(ctx context.Context, req DeleteEmojiRequest) (*models.ActionInfo, error)
+	GetEmojiImage(ctx context.Context, req GetEmojiImageRequest) (*models.EmojiImage, error)
 }
 
 //go:generate mockery --name=ServerStorage --filename=server_storage_mock.go --disable-version-string
 type ServerStorage interface {
 	CreateServer(ctx context.Context, server models.ServerInfo) error
-	SearchServers(ctx context.Context, serverName string) ([]*models.ServerInfo, error)
+	SearchServers(ctx context.Context, filter models.SearchServersFilter) ([]*models.ServerInfo, error)
 	GetServerById(ctx context.Context, id string) (*models.ServerInfo, error)
+	UpdateServerProfile(ctx context.Context, id models.ServerID, update models.ServerProfileUpdate) error
+	UpdateOwner(ctx context.Context, id models.ServerID, from models.UserID, to models.UserID) error
+	DeleteServer(ctx context.Context, id string) error
+	GetDeletedServerById(ctx context.Context, id string) (*models.ServerInfo, error)
+	MarkDeleted(ctx context.Context, id models.ServerID, at time.Time) error
+	RestoreServer(ctx context.Context, id models.ServerID) error
+	ListDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.ServerInfo, error)
+	GetDeletedIds(ctx context.Context, ids []models.ServerID) ([]models.ServerID, error)
+	TouchServerActivity(ctx context.Context, id models.ServerID, at time.Time) error
+	SetMemberCount(ctx context.Context, id models.ServerID, count int64) error
+	IncMemberCount(ctx context.Context, id models.ServerID, delta int64) error
+	ListServers(ctx context.Context, after *models.ServerID, limit int) ([]*models.ServerInfo, error)
 }
 
 //go:generate mockery --name=SubscribeStorage --filename=subscribe_storage_mock.go --disable-version-string
@@ -28,10 +84,134 @@ type SubscribeStorage interface {
 	CreateSubscribe(ctx context.Context, server models.SubscribeInfo) error
 	DeleteSubscribe(ctx context.Context, serverId models.ServerID, userId models.UserID) error
 	GetByUserId(ctx context.Context, userId models.UserID) ([]*models.SubscribeInfo, error)
+	GetSubscribe(ctx context.Context, serverId models.ServerID, userId models.UserID) (*models.SubscribeInfo, error)
+	CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error)
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) error
+	ListByServerId(ctx context.Context, serverId models.ServerID, after *models.SubscribeID, limit int) ([]*models.SubscribeInfo, error)
+	GetByUserIds(ctx context.Context, serverId models.ServerID, userIds []models.UserID) ([]*models.SubscribeInfo, error)
+	SetNickname(ctx context.Context, serverId models.ServerID, userId models.UserID, nickname string) error
+	FindDuplicates(ctx context.Context) ([]models.SubscribeID, error)
+	DeleteByIds(ctx context.Context, ids []models.SubscribeID) (int64, error)
+}
+
+//go:generate mockery --name=AuditStorage --filename=audit_storage_mock.go --disable-version-string
+type AuditStorage interface {
+	CreateEntry(ctx context.Context, entry models.AuditEntry) error
+	ListEntries(ctx context.Context, filter models.AuditLogFilter) ([]*models.AuditEntry, error)
+}
+
+//go:generate mockery --name=TemplateStorage --filename=template_storage_mock.go --disable-version-string
+type TemplateStorage interface {
+	CreateTemplate(ctx context.Context, template models.ServerTemplate) error
+	GetTemplateById(ctx context.Context, id models.TemplateID) (*models.ServerTemplate, error)
+	ListByOwnerId(ctx context.Context, ownerId models.UserID) ([]*models.ServerTemplate, error)
+}
+
+//go:generate mockery --name=WebhookStorage --filename=webhook_storage_mock.go --disable-version-string
+type WebhookStorage interface {
+	CreateWebhook(ctx context.Context, webhook models.Webhook) error
+	GetWebhookById(ctx context.Context, id models.WebhookID) (*models.Webhook, error)
+	ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Webhook, error)
+	CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error)
+	UpdateTokenHash(ctx context.Context, id models.WebhookID, tokenHash string) error
+	DeleteWebhook(ctx context.Context, id models.WebhookID) error
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) error
+}
+
+//go:generate mockery --name=OutgoingWebhookStorage --filename=outgoing_webhook_storage_mock.go --disable-version-string
+type OutgoingWebhookStorage interface {
+	CreateOutgoingWebhook(ctx context.Context, webhook models.OutgoingWebhook) error
+	GetOutgoingWebhookById(ctx context.Context, id models.OutgoingWebhookID) (*models.OutgoingWebhook, error)
+	ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.OutgoingWebhook, error)
+	ListSubscribed(ctx context.Context, serverId models.ServerID, eventType string) ([]*models.OutgoingWebhook, error)
+	CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error)
+	Enable(ctx context.Context, id models.OutgoingWebhookID) error
+	RecordSuccess(ctx context.Context, id models.OutgoingWebhookID) error
+	RecordFailure(ctx context.Context, id models.OutgoingWebhookID, disableAfter int, at time.Time) (bool, error)
+	DeleteOutgoingWebhook(ctx context.Context, id models.OutgoingWebhookID) error
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) error
+}
+
+//go:generate mockery --name=OutgoingDeliveryStorage --filename=outgoing_delivery_storage_mock.go --disable-version-string
+type OutgoingDeliveryStorage interface {
+	CreateDelivery(ctx context.Context, delivery models.OutgoingDelivery) error
+	UpdateAttempt(ctx context.Context, delivery models.OutgoingDelivery) error
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*models.OutgoingDelivery, error)
+	ListByWebhookId(ctx context.Context, webhookId models.OutgoingWebhookID, limit int) ([]*models.OutgoingDelivery, error)
+	DeleteByWebhookId(ctx context.Context, webhookId models.OutgoingWebhookID) error
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) error
+}
+
+//go:generate mockery --name=CommandStorage --filename=command_storage_mock.go --disable-version-string
+type CommandStorage interface {
+	CreateCommand(ctx context.Context, command models.Command) error
+	GetCommandById(ctx context.Context, id models.CommandID) (*models.Command, error)
+	GetCommandByName(ctx context.Context, serverId models.ServerID, name string) (*models.Command, error)
+	ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Command, error)
+	CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error)
+	DeleteCommand(ctx context.Context, id models.CommandID) error
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) error
+}
+
+//go:generate mockery --name=InteractionStorage --filename=interaction_storage_mock.go --disable-version-string
+type InteractionStorage interface {
+	CreateInteraction(ctx context.Context, interaction models.Interaction) error
+	GetInteractionById(ctx context.Context, id models.InteractionID) (*models.Interaction, error)
+	MarkResponded(ctx context.Context, id models.InteractionID, now time.Time) (bool, error)
+	ReleaseResponded(ctx context.Context, id models.InteractionID) error
+}
+
+//go:generate mockery --name=EmojiStorage --filename=emoji_storage_mock.go --disable-version-string
+type EmojiStorage interface {
+	CreateEmoji(ctx context.Context, emoji models.Emoji) error
+	GetEmojiById(ctx context.Context, id models.EmojiID) (*models.Emoji, error)
+	GetEmojiByName(ctx context.Context, serverId models.ServerID, name string) (*models.Emoji, error)
+	ListByServerId(ctx context.Context, serverId models.ServerID) ([]*models.Emoji, error)
+	CountByServerId(ctx context.Context, serverId models.ServerID) (int64, error)
+	RenameEmoji(ctx context.Context, id models.EmojiID, name string) error
+	DeleteEmoji(ctx context.Context, id models.EmojiID) error
+	DeleteByServerId(ctx context.Context, serverId models.ServerID) error
+}
+
+//go:generate mockery --name=BlobStore --filename=blob_store_mock.go --disable-version-string
+type BlobStore interface {
+	// Put - keys are slash separated paths, an existing object is replaced
+	Put(ctx context.Context, key string, data []byte) error
+	// Get - models.ErrNotFound when there is no object with the key
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
 }
 
 //go:generate mockery --name=ServiceChatInterface --filename=service_chat_mock.go --disable-version-string
 type ServiceChatInterface interface {
 	SendServerMessage(ctx context.Context, msg PublishMessageOnServerRequest) (*models.ActionInfo, error)
 	GetServerMessages(ctx context.Context, msg GetMessagesFromServerRequest) (*models.GetMessagesInfo, error)
+	CreateModerationRule(ctx context.Context, req CreateModerationRuleRequest) (*models.ModerationRule, error)
+	DeleteModerationRule(ctx context.Context, req DeleteModerationRuleRequest) (*models.ActionInfo, error)
+	GetModerationRules(ctx context.Context, req GetModerationRulesRequest) ([]*models.ModerationRule, error)
+	GetModerationActions(ctx context.Context, req GetModerationActionsRequest) ([]*models.ModerationAction, error)
+	ExportChat(ctx context.Context, req ExportServerChatRequest, w io.Writer) error
+}
+
+//go:generate mockery --name=ServiceChannelInterface --filename=service_channel_mock.go --disable-version-string
+type ServiceChannelInterface interface {
+	ListServerChannels(ctx context.Context, serverId string, userId string) ([]*models.Channel, error)
+	CreateServerChannels(ctx context.Context, req CreateServerChannelsRequest) ([]*models.Channel, error)
+	DeleteServerChannels(ctx context.Context, serverId string) error
+}
+
+//go:generate mockery --name=ServiceUserInterface --filename=service_user_mock.go --disable-version-string
+type ServiceUserInterface interface {
+	GetBot(ctx context.Context, botId string) (*models.Bot, error)
+}
+
+//go:generate mockery --name=OutgoingSenderInterface --filename=outgoing_sender_mock.go --disable-version-string
+type OutgoingSenderInterface interface {
+	// Send - returns the response status code, an error for transport failures and non 2xx answers
+	Send(ctx context.Context, req models.OutgoingRequest) (int, error)
+}
+
+//go:generate mockery --name=KafkaProducerServiceInterface --filename=kafka_producer_service_mock.go --disable-version-string
+type KafkaProducerServiceInterface interface {
+	PublishServerDeleted(ctx context.Context, event models.ServerDeletedEvent) error
 }
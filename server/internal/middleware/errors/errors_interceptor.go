@@ -20,25 +20,47 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 	) (resp interface{}, err error) {
 		resp, err = handler(ctx, req)
 
-		if _, ok := status.FromError(err); ok {
-			return
-		}
-
-		switch {
-		case errors.Is(err, models.ErrAlreadyExists):
-			err = status.Error(codes.AlreadyExists, err.Error())
-		case errors.Is(err, models.ErrUnimplemented):
-			err = status.Error(codes.Unimplemented, err.Error())
-		case errors.Is(err, models.ErrNotFound):
-			err = status.Error(codes.NotFound, err.Error())
-		case errors.Is(err, models.Unauthenticated):
-			err = status.Error(codes.Unauthenticated, err.Error())
-		case errors.Is(err, models.PermissionDenied):
-			err = status.Error(codes.PermissionDenied, err.Error())
-		default:
-			err = status.Error(codes.Internal, err.Error())
-		}
-
-		return
+		return resp, convertError(err)
 	}
 }
+
+// ErrorsStreamInterceptor - convert any arror returned by stream handler to rpc error
+func ErrorsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return convertError(handler(srv, ss))
+	}
+}
+
+func convertError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, models.ErrAlreadyExists):
+		err = status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrUnimplemented):
+		err = status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, models.ErrNotFound):
+		err = status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrInvalidArgument):
+		err = status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.Unauthenticated):
+		err = status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, models.PermissionDenied):
+		err = status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, models.ErrFailedPrecondition):
+		err = status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrUnavailable):
+		err = status.Error(codes.Unavailable, err.Error())
+	default:
+		err = status.Error(codes.Internal, err.Error())
+	}
+
+	return err
+}
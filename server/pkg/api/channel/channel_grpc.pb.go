@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: internal/app/api/channel/channel.proto
+
+package channel
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ChannelService_ListServerChannels_FullMethodName   = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/ListServerChannels"
+	ChannelService_CreateServerChannels_FullMethodName = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/CreateServerChannels"
+	ChannelService_DeleteServerChannels_FullMethodName = "/github.com.Nixonxp.discord.channel.api.v1.ChannelService/DeleteServerChannels"
+)
+
+// ChannelServiceClient is the client API for ChannelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChannelServiceClient interface {
+	ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	CreateServerChannels(ctx context.Context, in *CreateServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error)
+	DeleteServerChannels(ctx context.Context, in *DeleteServerChannelsRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+}
+
+type channelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChannelServiceClient(cc grpc.ClientConnInterface) ChannelServiceClient {
+	return &channelServiceClient{cc}
+}
+
+func (c *channelServiceClient) ListServerChannels(ctx context.Context, in *ListServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_ListServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) CreateServerChannels(ctx context.Context, in *CreateServerChannelsRequest, opts ...grpc.CallOption) (*ListServerChannelsResponse, error) {
+	out := new(ListServerChannelsResponse)
+	err := c.cc.Invoke(ctx, ChannelService_CreateServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) DeleteServerChannels(ctx context.Context, in *DeleteServerChannelsRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, ChannelService_DeleteServerChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChannelServiceServer is the server API for ChannelService service.
+// All implementations must embed UnimplementedChannelServiceServer
+// for forward compatibility
+type ChannelServiceServer interface {
+	ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error)
+	CreateServerChannels(context.Context, *CreateServerChannelsRequest) (*ListServerChannelsResponse, error)
+	DeleteServerChannels(context.Context, *DeleteServerChannelsRequest) (*ActionResponse, error)
+	mustEmbedUnimplementedChannelServiceServer()
+}
+
+// UnimplementedChannelServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedChannelServiceServer struct {
+}
+
+func (UnimplementedChannelServiceServer) ListServerChannels(context.Context, *ListServerChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) CreateServerChannels(context.Context, *CreateServerChannelsRequest) (*ListServerChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServerChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) DeleteServerChannels(context.Context, *DeleteServerChannelsRequest) (*ActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServerChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
+
+// UnsafeChannelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChannelServiceServer will
+// result in compilation errors.
+type UnsafeChannelServiceServer interface {
+	mustEmbedUnimplementedChannelServiceServer()
+}
+
+func RegisterChannelServiceServer(s grpc.ServiceRegistrar, srv ChannelServiceServer) {
+	s.RegisterService(&ChannelService_ServiceDesc, srv)
+}
+
+func _ChannelService_ListServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServerChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListServerChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_ListServerChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListServerChannels(ctx, req.(*ListServerChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_CreateServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServerChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).CreateServerChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_CreateServerChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).CreateServerChannels(ctx, req.(*CreateServerChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_DeleteServerChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServerChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).DeleteServerChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChannelService_DeleteServerChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).DeleteServerChannels(ctx, req.(*DeleteServerChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChannelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "github.com.Nixonxp.discord.channel.api.v1.ChannelService",
+	HandlerType: (*ChannelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListServerChannels",
+			Handler:    _ChannelService_ListServerChannels_Handler,
+		},
+		{
+			MethodName: "CreateServerChannels",
+			Handler:    _ChannelService_CreateServerChannels_Handler,
+		},
+		{
+			MethodName: "DeleteServerChannels",
+			Handler:    _ChannelService_DeleteServerChannels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/api/channel/channel.proto",
+}
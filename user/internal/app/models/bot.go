@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// MaxBotsPerOwner - upper bound of bots registered by one user
+const MaxBotsPerOwner = 25
+
+// Bot - bot account registered by a user, the bot itself is a user with IsBot set,
+// TokenHash is empty while the bot has no active token
+type Bot struct {
+	Id          UserID
+	OwnerId     UserID
+	Name        string
+	Description string
+	Public      bool
+	TokenHash   string
+	CreatedAt   time.Time
+}
@@ -17,6 +17,7 @@ type User struct {
 	Password       string
 	AvatarPhotoUrl string
 	OauthId        string
+	IsBot          bool
 }
 
 type ActionInfo struct {
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"github.com/Nixonxp/discord/user/internal/app/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+type botRow struct {
+	ID          uuid.UUID `db:"id"`
+	OwnerId     uuid.UUID `db:"owner_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Public      bool      `db:"public"`
+	TokenHash   string    `db:"token_hash"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ValuesMap - values of the bots table, the name is stored in the users table
+func (r *botRow) ValuesMap() map[string]any {
+	return map[string]any{
+		"id":          r.ID,
+		"owner_id":    r.OwnerId,
+		"description": r.Description,
+		"public":      r.Public,
+		"token_hash":  r.TokenHash,
+		"created_at":  r.CreatedAt,
+	}
+}
+
+// columns - bot columns joined with the bot user name
+func columns() []string {
+	return []string{
+		"b.id",
+		"b.owner_id",
+		"u.name",
+		"b.description",
+		"b.public",
+		"b.token_hash",
+		"b.created_at",
+	}
+}
+
+func newBotRowFromModelsBot(bot *models.Bot) *botRow {
+	return &botRow{
+		ID:          uuid.UUID(bot.Id),
+		OwnerId:     uuid.UUID(bot.OwnerId),
+		Name:        bot.Name,
+		Description: bot.Description,
+		Public:      bot.Public,
+		TokenHash:   bot.TokenHash,
+		CreatedAt:   bot.CreatedAt,
+	}
+}
+
+func newBotModelsFromBotRow(row *botRow) *models.Bot {
+	return &models.Bot{
+		Id:          models.UserID(row.ID),
+		OwnerId:     models.UserID(row.OwnerId),
+		Name:        row.Name,
+		Description: row.Description,
+		Public:      row.Public,
+		TokenHash:   row.TokenHash,
+		CreatedAt:   row.CreatedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/Nixonxp/discord/user/internal/app/models"
+	repository "github.com/Nixonxp/discord/user/internal/app/repository/user_storage"
+	pkgerrors "github.com/Nixonxp/discord/user/pkg/errors"
+	log "github.com/Nixonxp/discord/user/pkg/logger"
+)
+
+const (
+	botsTable       = "bots"
+	botsJoinedTable = "bots b JOIN users u ON u.id = b.id"
+)
+
+type PGBotRepository struct {
+	queryBuilder sq.StatementBuilderType
+	driver       repository.QueryEngineProvider
+	log          *log.Logger
+}
+
+func NewBotPostgresqlRepository(driver repository.QueryEngineProvider, log *log.Logger) *PGBotRepository {
+	return &PGBotRepository{
+		queryBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		driver:       driver,
+		log:          log,
+	}
+}
+
+func (r *PGBotRepository) CreateBot(ctx context.Context, bot *models.Bot) error {
+	row := newBotRowFromModelsBot(bot)
+	query := sq.Insert(botsTable).
+		SetMap(row.ValuesMap()).
+		PlaceholderFormat(sq.Dollar)
+
+	if _, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query); err != nil {
+		return pkgerrors.Wrap("create bot exec error repo", err)
+	}
+
+	return nil
+}
+
+func (r *PGBotRepository) GetBotById(ctx context.Context, botId models.UserID) (*models.Bot, error) {
+	query := sq.Select(columns()...).
+		From(botsJoinedTable).
+		Where(sq.Eq{"b.id": botId.String()}).
+		PlaceholderFormat(sq.Dollar)
+
+	row := &botRow{}
+	if err := r.driver.GetQueryEngine(ctx).Getx(ctx, row, query); err != nil {
+		if err.Error() == repository.ErrNoRows.Error() {
+			return nil, pkgerrors.Wrap("bot not found", models.ErrNotFound)
+		}
+
+		r.log.WithContext(ctx).WithError(err).WithField("botId", botId.String()).Error("get bot exec error repo")
+		return nil, err
+	}
+
+	return newBotModelsFromBotRow(row), nil
+}
+
+func (r *PGBotRepository) GetBotsByOwnerId(ctx context.Context, ownerId models.UserID) ([]*models.Bot, error) {
+	query := sq.Select(columns()...).
+		From(botsJoinedTable).
+		Where(sq.Eq{"b.owner_id": ownerId.String()}).
+		OrderBy("b.created_at").
+		PlaceholderFormat(sq.Dollar)
+
+	var rows []*botRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("ownerId", ownerId.String()).Error("get bots by owner exec error repo")
+		return nil, err
+	}
+
+	bots := make([]*models.Bot, len(rows))
+	for i, row := range rows {
+		bots[i] = newBotModelsFromBotRow(row)
+	}
+
+	return bots, nil
+}
+
+func (r *PGBotRepository) UpdateBotTokenHash(ctx context.Context, botId models.UserID, tokenHash string) error {
+	query := sq.Update(botsTable).
+		Set("token_hash", tokenHash).
+		Where(sq.Eq{"id": botId.String()}).
+		PlaceholderFormat(sq.Dollar)
+
+	result, err := r.driver.GetQueryEngine(ctx).Execx(ctx, query)
+	if err != nil {
+		r.log.WithContext(ctx).WithError(err).WithField("botId", botId.String()).Error("update bot token exec error repo")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
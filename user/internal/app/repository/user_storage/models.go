@@ -9,10 +9,11 @@ type userRow struct {
 	ID             uuid.UUID `db:"id"`
 	Login          string    `db:"login"`
 	Name           string    `db:"name"`
-	Email          string    `db:"email"`
+	Email          *string   `db:"email"`
 	Password       string    `db:"password"`
 	AvatarPhotoUrl *string   `db:"avatar_photo_url"`
 	OauthId        *string   `db:"oauth_id"`
+	IsBot          bool      `db:"is_bot"`
 }
 
 func (r *userRow) ValuesMap() map[string]any {
@@ -24,6 +25,7 @@ func (r *userRow) ValuesMap() map[string]any {
 		"password":         r.Password,
 		"avatar_photo_url": r.AvatarPhotoUrl,
 		"oauth_id":         r.OauthId,
+		"is_bot":           r.IsBot,
 	}
 }
 
@@ -36,6 +38,7 @@ func columns() []string {
 		"password",
 		"avatar_photo_url",
 		"oauth_id",
+		"is_bot",
 	}
 }
 
@@ -51,14 +54,21 @@ func (r *userRow) Values(columns ...string) []any {
 }
 
 func newUserRowFromModelsUser(user *models.User) (*userRow, error) {
+	// bots have no email
+	var email *string
+	if !user.IsBot {
+		email = &user.Email
+	}
+
 	return &userRow{
 		ID:             uuid.UUID(user.Id),
 		Login:          user.Login,
 		Name:           user.Name,
-		Email:          user.Email,
+		Email:          email,
 		Password:       user.Password,
 		AvatarPhotoUrl: &user.AvatarPhotoUrl,
 		OauthId:        &user.OauthId,
+		IsBot:          user.IsBot,
 	}, nil
 }
 
@@ -72,13 +82,18 @@ func newUserModelsFromUserRow(userRow *userRow) (*models.User, error) {
 		userRow.OauthId = &str
 	}
 
+	if userRow.Email == nil {
+		userRow.Email = &str
+	}
+
 	return &models.User{
 		Id:             models.UserID(userRow.ID),
 		Login:          userRow.Login,
 		Name:           userRow.Name,
-		Email:          userRow.Email,
+		Email:          *userRow.Email,
 		Password:       userRow.Password,
 		AvatarPhotoUrl: *userRow.AvatarPhotoUrl,
 		OauthId:        *userRow.OauthId,
+		IsBot:          userRow.IsBot,
 	}, nil
 }
@@ -157,6 +157,35 @@ func (r *PGUserRepository) GetUserById(ctx context.Context, userId models.UserID
 	return resultUser, nil
 }
 
+func (r *PGUserRepository) GetUsersByIds(ctx context.Context, userIds []models.UserID) ([]*models.User, error) {
+	ids := make([]string, len(userIds))
+	for i, id := range userIds {
+		ids[i] = id.String()
+	}
+
+	query := sq.Select(columns()...).
+		From(userTable).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar)
+
+	var rows []*userRow
+	if err := r.driver.GetQueryEngine(ctx).Selectx(ctx, &rows, query); err != nil {
+		r.ctxLog(ctx).WithError(err).WithField("userIds", ids).Error("get users by ids exec error repo")
+		return nil, err
+	}
+
+	users := make([]*models.User, 0, len(rows))
+	for _, row := range rows {
+		user, err := newUserModelsFromUserRow(row)
+		if err != nil {
+			return nil, pkgerrors.Wrap("error map row to user model", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
 func (r *PGUserRepository) ctxLog(ctx context.Context) *log.Logger {
 	return r.log.WithContext(ctx)
 }
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"github.com/Nixonxp/discord/user/internal/app/models"
+	"github.com/Nixonxp/discord/user/internal/app/usecases"
+	pb "github.com/Nixonxp/discord/user/pkg/api/v1"
+	grpcutils "github.com/Nixonxp/discord/user/pkg/grpc_utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *UserServer) CreateBot(ctx context.Context, req *pb.CreateBotRequest) (*pb.Bot, error) {
+	s.ctxLog(ctx).Infof("create bot: received: %s", req.GetOwnerId())
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.BotsUsecase.CreateBot(ctx, usecases.CreateBotRequest{
+		OwnerId:     req.GetOwnerId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Public:      req.GetPublic(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return botToPb(result), nil
+}
+
+func (s *UserServer) GetBot(ctx context.Context, req *pb.GetBotRequest) (*pb.Bot, error) {
+	s.ctxLog(ctx).Infof("get bot: received: %s", req.GetBotId())
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.BotsUsecase.GetBot(ctx, usecases.GetBotRequest{
+		BotId: req.GetBotId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return botToPb(result), nil
+}
+
+func (s *UserServer) GetBotsByOwner(ctx context.Context, req *pb.GetBotsByOwnerRequest) (*pb.GetBotsByOwnerResponse, error) {
+	s.ctxLog(ctx).Infof("get bots by owner: received: %s", req.GetOwnerId())
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.BotsUsecase.GetBotsByOwner(ctx, usecases.GetBotsByOwnerRequest{
+		OwnerId: req.GetOwnerId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bots := make([]*pb.Bot, len(result))
+	for i, bot := range result {
+		bots[i] = botToPb(bot)
+	}
+
+	return &pb.GetBotsByOwnerResponse{
+		Bots: bots,
+	}, nil
+}
+
+func (s *UserServer) SetBotTokenHash(ctx context.Context, req *pb.SetBotTokenHashRequest) (*pb.ActionResponse, error) {
+	s.ctxLog(ctx).Infof("set bot token hash: received: %s", req.GetBotId())
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	result, err := s.BotsUsecase.SetBotTokenHash(ctx, usecases.SetBotTokenHashRequest{
+		BotId:     req.GetBotId(),
+		TokenHash: req.GetTokenHash(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionResponse{
+		Success: result.Success,
+	}, nil
+}
+
+func botToPb(bot *models.Bot) *pb.Bot {
+	return &pb.Bot{
+		Id:          bot.Id.String(),
+		OwnerId:     bot.OwnerId.String(),
+		Name:        bot.Name,
+		Description: bot.Description,
+		Public:      bot.Public,
+		TokenHash:   bot.TokenHash,
+		CreatedAt:   timestamppb.New(bot.CreatedAt),
+	}
+}
@@ -79,7 +79,7 @@ func (s *UserServer) GetUserInvites(ctx context.Context, req *pb.GetUserInvitesR
 }
 
 func (s *UserServer) AddToFriendByUserId(ctx context.Context, req *pb.AddToFriendByUserIdRequest) (*pb.ActionResponse, error) {
-	s.ctxLog(ctx).Infof("add user to friends received: %d", req.UserId)
+	s.ctxLog(ctx).Infof("add user to friends received: %s", req.UserId)
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
 	}
@@ -131,7 +131,7 @@ func (s *UserServer) CreateOrGetUser(ctx context.Context, req *pb.CreateOrGetUse
 }
 
 func (s *UserServer) AcceptFriendInvite(ctx context.Context, req *pb.AcceptFriendInviteRequest) (*pb.ActionResponse, error) {
-	s.ctxLog(ctx).Infof("accept user to friends received: %d", req.GetInviteId())
+	s.ctxLog(ctx).Infof("accept user to friends received: %s", req.GetInviteId())
 
 	if err := s.validator.Validate(req); err != nil {
 		return nil, grpcutils.RPCValidationError(err)
@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 	"fmt"
+	repositoryBot "github.com/Nixonxp/discord/user/internal/app/repository/bot_storage"
 	repositoryFriendInvte "github.com/Nixonxp/discord/user/internal/app/repository/friend_invites_storage"
 	repositoryUserFriends "github.com/Nixonxp/discord/user/internal/app/repository/user_friends_storage"
 	repository "github.com/Nixonxp/discord/user/internal/app/repository/user_storage"
 	"github.com/Nixonxp/discord/user/internal/app/usecases"
+	bot_usc "github.com/Nixonxp/discord/user/internal/app/usecases/bots"
 	friend_usc "github.com/Nixonxp/discord/user/internal/app/usecases/friends"
 	user_usc "github.com/Nixonxp/discord/user/internal/app/usecases/users"
 	middleware "github.com/Nixonxp/discord/user/internal/middleware/errors"
@@ -35,6 +37,7 @@ type Config struct {
 type Deps struct {
 	UserUsecase    usecases.UserUsecaseInterface
 	FriendsUsecase usecases.FriendUsecaseInterface
+	BotsUsecase    usecases.BotUsecaseInterface
 	Log            *log.Logger
 }
 
@@ -68,6 +71,7 @@ func NewUserServer(_ context.Context, s *MainServer) (*UserServer, error) {
 				&pb.GetUserForLoginRequest{},
 				&pb.UpdateUserRequest{},
 				&pb.GetUserByLoginRequest{},
+				&pb.GetUsersByIdsRequest{},
 				&pb.GetUserFriendsRequest{},
 				&pb.AddToFriendByUserIdRequest{},
 				&pb.AcceptFriendInviteRequest{},
@@ -75,6 +79,10 @@ func NewUserServer(_ context.Context, s *MainServer) (*UserServer, error) {
 				&pb.GetUserInvitesRequest{},
 				&pb.DeleteFromFriendRequest{},
 				&pb.CreateOrGetUserRequest{},
+				&pb.CreateBotRequest{},
+				&pb.GetBotRequest{},
+				&pb.GetBotsByOwnerRequest{},
+				&pb.SetBotTokenHashRequest{},
 			),
 		)
 		if err != nil {
@@ -86,6 +94,7 @@ func NewUserServer(_ context.Context, s *MainServer) (*UserServer, error) {
 	userRepo := repository.NewUserPostgresqlRepository(s.postgres.GetInstance(), s.logger.GetInstance())
 	friendInvitesRepo := repositoryFriendInvte.NewFriendInvitesPostgresqlRepository(s.postgres.GetInstance(), s.logger.GetInstance())
 	friendsRepo := repositoryUserFriends.NewUserFriendsPostgresqlRepository(s.postgres.GetInstance(), s.logger.GetInstance())
+	botRepo := repositoryBot.NewBotPostgresqlRepository(s.postgres.GetInstance(), s.logger.GetInstance())
 
 	userUsecase := user_usc.NewUserUsecase(user_usc.Deps{
 		UserRepo:           userRepo,
@@ -101,6 +110,13 @@ func NewUserServer(_ context.Context, s *MainServer) (*UserServer, error) {
 		Log:                s.logger.GetInstance(),
 	})
 
+	botUsecase := bot_usc.NewBotUsecase(bot_usc.Deps{
+		UserRepo:           userRepo,
+		BotRepo:            botRepo,
+		TransactionManager: s.postgres.GetInstance(),
+		Log:                s.logger.GetInstance(),
+	})
+
 	globalLimiter := rate_limiter.NewRateLimiter(10000)
 	grpcConfig := Config{
 		ChainUnaryInterceptors: []grpc.UnaryServerInterceptor{
@@ -117,6 +133,7 @@ func NewUserServer(_ context.Context, s *MainServer) (*UserServer, error) {
 
 	srv.UserUsecase = userUsecase
 	srv.FriendsUsecase = friendUsecase
+	srv.BotsUsecase = botUsecase
 	srv.Log = s.logger.GetInstance()
 
 	grpcServerOptions := UnaryInterceptorsToGrpcServerOptions(grpcConfig.UnaryInterceptors...)
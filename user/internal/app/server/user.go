@@ -55,6 +55,7 @@ func (s *UserServer) GetUserForLogin(ctx context.Context, req *pb.GetUserForLogi
 		Name:     result.Name,
 		Email:    result.Email,
 		Password: result.Password,
+		IsBot:    result.IsBot,
 	}, nil
 }
 
@@ -117,5 +118,41 @@ func (s *UserServer) GetUserByLogin(ctx context.Context, req *pb.GetUserByLoginR
 		Name:           result.Name,
 		Email:          result.Email,
 		AvatarPhotoUrl: result.AvatarPhotoUrl,
+		IsBot:          result.IsBot,
+	}, nil
+}
+
+func (s *UserServer) GetUsersByIds(ctx context.Context, req *pb.GetUsersByIdsRequest) (*pb.GetUsersByIdsResponse, error) {
+	s.ctxLog(ctx).Infof("get users by ids: received: %d", len(req.GetIds()))
+
+	if err := s.validator.Validate(req); err != nil {
+		return nil, grpcutils.RPCValidationError(err)
+	}
+
+	_, err := auth.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, models.Unauthenticated
+	}
+
+	result, err := s.UserUsecase.GetUsersByIds(ctx, usecases.GetUsersByIdsRequest{
+		Ids: req.GetIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*pb.UserProfile, len(result))
+	for i, user := range result {
+		users[i] = &pb.UserProfile{
+			Id:             user.Id.String(),
+			Login:          user.Login,
+			Name:           user.Name,
+			AvatarPhotoUrl: user.AvatarPhotoUrl,
+			IsBot:          user.IsBot,
+		}
+	}
+
+	return &pb.GetUsersByIdsResponse{
+		Users: users,
 	}, nil
 }
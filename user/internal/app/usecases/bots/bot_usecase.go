@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/user/internal/app/models"
+	"github.com/Nixonxp/discord/user/internal/app/usecases"
+	pkgerrors "github.com/Nixonxp/discord/user/pkg/errors"
+	log "github.com/Nixonxp/discord/user/pkg/logger"
+	"github.com/Nixonxp/discord/user/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"time"
+)
+
+// botLoginPrefix - bots get a generated login, so they never collide with user logins
+const botLoginPrefix = "bot-"
+
+type Deps struct {
+	TransactionManager usecases.TransactionManager
+	UserRepo           usecases.UsersStorage
+	BotRepo            usecases.BotsStorage
+	Log                *log.Logger
+}
+
+type BotUsecase struct {
+	Deps
+}
+
+var _ usecases.BotUsecaseInterface = (*BotUsecase)(nil)
+
+func NewBotUsecase(d Deps) *BotUsecase {
+	return &BotUsecase{
+		Deps: d,
+	}
+}
+
+// CreateBot - registers a bot user owned by a human user, the bot has no token until it is issued
+func (u *BotUsecase) CreateBot(ctx context.Context, req usecases.CreateBotRequest) (*models.Bot, error) {
+	ownerId, err := parseUserId(req.OwnerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create bot", err)
+	}
+
+	owner, err := u.UserRepo.GetUserById(ctx, ownerId)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, pkgerrors.Wrap("create bot: owner", models.ErrNotFound)
+		}
+		return nil, pkgerrors.Wrap("create bot: owner", err)
+	}
+	if owner.IsBot {
+		return nil, pkgerrors.Wrap("create bot: bots cannot own bots", models.PermissionDenied)
+	}
+
+	bots, err := u.BotRepo.GetBotsByOwnerId(ctx, ownerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create bot", err)
+	}
+	if len(bots) >= models.MaxBotsPerOwner {
+		return nil, pkgerrors.Wrap("create bot: too many bots", models.ErrInvalidArgument)
+	}
+
+	botId := models.UserID(uuid.New())
+	user := &models.User{
+		Id:    botId,
+		Login: botLoginPrefix + botId.String(),
+		Name:  req.Name,
+		IsBot: true,
+	}
+	bot := &models.Bot{
+		Id:          botId,
+		OwnerId:     ownerId,
+		Name:        req.Name,
+		Description: req.Description,
+		Public:      req.Public,
+		CreatedAt:   time.Now(),
+	}
+
+	err = u.TransactionManager.RunReadCommitted(ctx, transaction_manager.ReadWrite,
+		func(txCtx context.Context) error {
+			err := u.UserRepo.CreateUser(txCtx, user)
+			if err != nil {
+				return err
+			}
+
+			return u.BotRepo.CreateBot(txCtx, bot)
+		},
+	)
+	if err != nil {
+		return nil, pkgerrors.Wrap("create bot", err)
+	}
+
+	return bot, nil
+}
+
+func (u *BotUsecase) GetBot(ctx context.Context, req usecases.GetBotRequest) (*models.Bot, error) {
+	botId, err := parseUserId(req.BotId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get bot", err)
+	}
+
+	bot, err := u.BotRepo.GetBotById(ctx, botId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get bot", err)
+	}
+
+	return bot, nil
+}
+
+func (u *BotUsecase) GetBotsByOwner(ctx context.Context, req usecases.GetBotsByOwnerRequest) ([]*models.Bot, error) {
+	ownerId, err := parseUserId(req.OwnerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get bots by owner", err)
+	}
+
+	bots, err := u.BotRepo.GetBotsByOwnerId(ctx, ownerId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get bots by owner", err)
+	}
+
+	return bots, nil
+}
+
+// SetBotTokenHash - replaces the token hash of the bot, an empty hash revokes the token
+func (u *BotUsecase) SetBotTokenHash(ctx context.Context, req usecases.SetBotTokenHashRequest) (*models.ActionInfo, error) {
+	botId, err := parseUserId(req.BotId)
+	if err != nil {
+		return nil, pkgerrors.Wrap("set bot token hash", err)
+	}
+
+	err = u.BotRepo.UpdateBotTokenHash(ctx, botId, req.TokenHash)
+	if err != nil {
+		return nil, pkgerrors.Wrap("set bot token hash", err)
+	}
+
+	return &models.ActionInfo{
+		Success: true,
+	}, nil
+}
+
+func parseUserId(id string) (models.UserID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return models.UserID{}, models.ErrInvalidArgument
+	}
+
+	return models.UserID(parsed), nil
+}
@@ -0,0 +1,344 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"github.com/Nixonxp/discord/user/internal/app/models"
+	"github.com/Nixonxp/discord/user/internal/app/usecases"
+	"github.com/Nixonxp/discord/user/internal/app/usecases/mocks"
+	log "github.com/Nixonxp/discord/user/pkg/logger"
+	"github.com/Nixonxp/discord/user/pkg/transaction_manager"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func Test_usecase_BotUsecase_CreateBot(t *testing.T) {
+	// prepare
+	var (
+		ctx     = context.Background() // dummy
+		ownerId = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b999"))
+	)
+	type fields struct {
+		TransactionManager *mocks.TransactionManager
+		Log                *log.Logger
+		UserRepo           *mocks.UsersStorage
+		BotRepo            *mocks.BotsStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.CreateBotRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateBotRequest{
+					OwnerId:     ownerId.String(),
+					Name:        "helper",
+					Description: "helps",
+					Public:      true,
+				},
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUserById", ctx, ownerId).
+					Return(&models.User{Id: ownerId, Login: "owner"}, nil)
+				f.BotRepo.On("GetBotsByOwnerId", ctx, ownerId).
+					Return([]*models.Bot{}, nil)
+				f.TransactionManager.On("RunReadCommitted",
+					ctx,
+					transaction_manager.ReadWrite,
+					mock.Anything).
+					Run(func(args mock.Arguments) {
+						_ = args.Get(2).(func(context.Context) error)(ctx)
+					}).
+					Return(nil)
+				f.UserRepo.On("CreateUser",
+					ctx,
+					mock.MatchedBy(func(user *models.User) bool {
+						return user.IsBot &&
+							user.Name == "helper" &&
+							user.Login == "bot-"+user.Id.String() &&
+							user.Email == "" &&
+							user.Password == ""
+					})).
+					Return(nil)
+				f.BotRepo.On("CreateBot",
+					ctx,
+					mock.MatchedBy(func(bot *models.Bot) bool {
+						return bot.OwnerId == ownerId &&
+							bot.Name == "helper" &&
+							bot.Description == "helps" &&
+							bot.Public &&
+							bot.TokenHash == "" &&
+							!bot.CreatedAt.IsZero()
+					})).
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNumberOfCalls(t, "CreateUser", 1)
+				f.BotRepo.AssertNumberOfCalls(t, "CreateBot", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Owner is a bot",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateBotRequest{
+					OwnerId: ownerId.String(),
+					Name:    "helper",
+				},
+			},
+			wantErr:     true,
+			errorString: "create bot: bots cannot own bots: permission denied",
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUserById", ctx, ownerId).
+					Return(&models.User{Id: ownerId, IsBot: true}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BotRepo.AssertNotCalled(t, "GetBotsByOwnerId", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Owner not found",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateBotRequest{
+					OwnerId: ownerId.String(),
+					Name:    "helper",
+				},
+			},
+			wantErr:     true,
+			errorString: "create bot: owner: not found",
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUserById", ctx, ownerId).
+					Return(nil, models.ErrNotFound)
+			},
+		},
+		{
+			name: "Test 4. Negative. Too many bots",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateBotRequest{
+					OwnerId: ownerId.String(),
+					Name:    "helper",
+				},
+			},
+			wantErr:     true,
+			errorString: "create bot: too many bots: invalid argument",
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUserById", ctx, ownerId).
+					Return(&models.User{Id: ownerId}, nil)
+				f.BotRepo.On("GetBotsByOwnerId", ctx, ownerId).
+					Return(make([]*models.Bot, models.MaxBotsPerOwner), nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.TransactionManager.AssertNotCalled(t, "RunReadCommitted", mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. Transaction returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateBotRequest{
+					OwnerId: ownerId.String(),
+					Name:    "helper",
+				},
+			},
+			wantErr:     true,
+			errorString: "create bot: some error",
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUserById", ctx, ownerId).
+					Return(&models.User{Id: ownerId}, nil)
+				f.BotRepo.On("GetBotsByOwnerId", ctx, ownerId).
+					Return([]*models.Bot{}, nil)
+				f.TransactionManager.On("RunReadCommitted",
+					ctx,
+					transaction_manager.ReadWrite,
+					mock.Anything).
+					Return(errors.New("some error"))
+			},
+		},
+		{
+			name: "Test 6. Negative. Invalid owner id",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.CreateBotRequest{
+					OwnerId: "invalid",
+					Name:    "helper",
+				},
+			},
+			wantErr:     true,
+			errorString: "create bot: invalid argument",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				TransactionManager: mocks.NewTransactionManager(t),
+				Log:                &log.Logger{},
+				UserRepo:           mocks.NewUsersStorage(t),
+				BotRepo:            mocks.NewBotsStorage(t),
+			}
+			au := NewBotUsecase(Deps{
+				TransactionManager: f.TransactionManager,
+				Log:                f.Log,
+				UserRepo:           f.UserRepo,
+				BotRepo:            f.BotRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.CreateBot(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.CreateBot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			assert.Equal(t, ownerId, got.OwnerId)
+			assert.Equal(t, "helper", got.Name)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
+
+func Test_usecase_BotUsecase_SetBotTokenHash(t *testing.T) {
+	// prepare
+	var (
+		ctx   = context.Background() // dummy
+		botId = models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))
+	)
+	type fields struct {
+		TransactionManager *mocks.TransactionManager
+		Log                *log.Logger
+		UserRepo           *mocks.UsersStorage
+		BotRepo            *mocks.BotsStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.SetBotTokenHashRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        *models.ActionInfo
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive.",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SetBotTokenHashRequest{
+					BotId:     botId.String(),
+					TokenHash: "hash",
+				},
+			},
+			want: &models.ActionInfo{
+				Success: true,
+			},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.BotRepo.On("UpdateBotTokenHash", ctx, botId, "hash").
+					Return(nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.BotRepo.AssertNumberOfCalls(t, "UpdateBotTokenHash", 1)
+			},
+		},
+		{
+			name: "Test 2. Negative. Bot not found",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.SetBotTokenHashRequest{
+					BotId: botId.String(),
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "set bot token hash: not found",
+
+			on: func(f *fields) {
+				f.BotRepo.On("UpdateBotTokenHash", ctx, botId, "").
+					Return(models.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				TransactionManager: mocks.NewTransactionManager(t),
+				Log:                &log.Logger{},
+				UserRepo:           mocks.NewUsersStorage(t),
+				BotRepo:            mocks.NewBotsStorage(t),
+			}
+			au := NewBotUsecase(Deps{
+				TransactionManager: f.TransactionManager,
+				Log:                f.Log,
+				UserRepo:           f.UserRepo,
+				BotRepo:            f.BotRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.SetBotTokenHash(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.SetBotTokenHash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, tt.errorString, err.Error())
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -34,6 +34,10 @@ type GetUserByLoginRequest struct {
 	Login string
 }
 
+type GetUsersByIdsRequest struct {
+	Ids []string
+}
+
 type GetUserFriendsRequest struct {
 	UserId string
 }
@@ -61,3 +65,23 @@ type DeleteFromFriendRequest struct {
 	FriendId      string
 	CurrentUserId string
 }
+
+type CreateBotRequest struct {
+	OwnerId     string
+	Name        string
+	Description string
+	Public      bool
+}
+
+type GetBotRequest struct {
+	BotId string
+}
+
+type GetBotsByOwnerRequest struct {
+	OwnerId string
+}
+
+type SetBotTokenHashRequest struct {
+	BotId     string
+	TokenHash string
+}
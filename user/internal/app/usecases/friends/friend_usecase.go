@@ -46,10 +46,13 @@ func (u *FriendUsecase) GetUserFriends(ctx context.Context, req usecases.GetUser
 
 func (u *FriendUsecase) AddToFriendByUserId(ctx context.Context, req usecases.AddToFriendByUserIdRequest) (*models.ActionInfo, error) {
 	userID := models.UserID(uuid.MustParse(req.UserId))
-	_, err := u.UserRepo.GetUserById(ctx, userID)
+	user, err := u.UserRepo.GetUserById(ctx, userID)
 	if err != nil {
 		return nil, pkgerrors.Wrap("user not found", models.ErrNotFound)
 	}
+	if user.IsBot {
+		return nil, pkgerrors.Wrap("bots cannot be added to friends", models.ErrInvalidArgument)
+	}
 
 	inviteId, _ := uuid.NewUUID()
 	err = u.FriendInvitesRepo.CreateInvite(ctx, &models.FriendInvite{
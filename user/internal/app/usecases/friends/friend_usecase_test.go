@@ -293,6 +293,35 @@ func Test_usecase_FriendUsecase_AddToFriendByUserId(t *testing.T) {
 				f.UserRepo.AssertNumberOfCalls(t, "GetUserById", 1)
 			},
 		},
+		{
+			name: "Test 4. Negative. User is a bot",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.AddToFriendByUserIdRequest{
+					UserId:  "284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+					OwnerId: "284fef68-7e3e-4d1d-96a0-8c96f7b3b999",
+				},
+			},
+			want:        nil,
+			wantErr:     true,
+			errorString: "bots cannot be added to friends: invalid argument",
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUserById",
+					ctx,
+					models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795"))).
+					Return(&models.User{
+						Id:    models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						Login: "bot-284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						Name:  "bot",
+						IsBot: true,
+					}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNumberOfCalls(t, "GetUserById", 1)
+				f.FriendInvitesRepo.AssertNotCalled(t, "CreateInvite", mock.Anything, mock.Anything)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -122,6 +122,36 @@ func (_m *UsersStorage) GetUserByOauthId(ctx context.Context, oauthId string) (*
 	return r0, r1
 }
 
+// GetUsersByIds provides a mock function with given fields: ctx, userIds
+func (_m *UsersStorage) GetUsersByIds(ctx context.Context, userIds []models.UserID) ([]*models.User, error) {
+	ret := _m.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByIds")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.UserID) ([]*models.User, error)); ok {
+		return rf(ctx, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.UserID) []*models.User); ok {
+		r0 = rf(ctx, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.UserID) error); ok {
+		r1 = rf(ctx, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *UsersStorage) UpdateUser(ctx context.Context, user *models.User) error {
 	ret := _m.Called(ctx, user)
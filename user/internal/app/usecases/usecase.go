@@ -11,6 +11,14 @@ type UserUsecaseInterface interface {
 	CreateOrGetUser(ctx context.Context, req CreateOrGetUserRequest) (*models.User, error)
 	UpdateUser(ctx context.Context, req UpdateUserRequest) (*models.User, error)
 	GetUserForLogin(ctx context.Context, req GetUserByLoginRequest) (*models.User, error)
+	GetUsersByIds(ctx context.Context, req GetUsersByIdsRequest) ([]*models.User, error)
+}
+
+type BotUsecaseInterface interface {
+	CreateBot(ctx context.Context, req CreateBotRequest) (*models.Bot, error)
+	GetBot(ctx context.Context, req GetBotRequest) (*models.Bot, error)
+	GetBotsByOwner(ctx context.Context, req GetBotsByOwnerRequest) ([]*models.Bot, error)
+	SetBotTokenHash(ctx context.Context, req SetBotTokenHashRequest) (*models.ActionInfo, error)
 }
 
 type FriendUsecaseInterface interface {
@@ -29,6 +37,15 @@ type UsersStorage interface {
 	GetUserByLogin(ctx context.Context, login string) (*models.User, error)
 	GetUserByOauthId(ctx context.Context, oauthId string) (*models.User, error)
 	GetUserById(ctx context.Context, userId models.UserID) (*models.User, error)
+	GetUsersByIds(ctx context.Context, userIds []models.UserID) ([]*models.User, error)
+}
+
+//go:generate mockery --name=BotsStorage --filename=bots_storage_mock.go --disable-version-string
+type BotsStorage interface {
+	CreateBot(ctx context.Context, bot *models.Bot) error
+	GetBotById(ctx context.Context, botId models.UserID) (*models.Bot, error)
+	GetBotsByOwnerId(ctx context.Context, ownerId models.UserID) ([]*models.Bot, error)
+	UpdateBotTokenHash(ctx context.Context, botId models.UserID, tokenHash string) error
 }
 
 //go:generate mockery --name=TransactionManager --filename=transaction_manager_mock.go --disable-version-string
@@ -116,3 +116,40 @@ func (u *UserUsecase) GetUserForLogin(ctx context.Context, req usecases.GetUserB
 
 	return user, nil
 }
+
+// maxUsersByIds - upper bound of ids in one batch request
+const maxUsersByIds = 100
+
+// GetUsersByIds - batch lookup of user profiles, duplicated ids are collapsed and unknown ids are skipped
+func (u *UserUsecase) GetUsersByIds(ctx context.Context, req usecases.GetUsersByIdsRequest) ([]*models.User, error) {
+	if len(req.Ids) > maxUsersByIds {
+		return nil, pkgerrors.Wrap("too many user ids", models.ErrInvalidArgument)
+	}
+
+	seen := make(map[models.UserID]struct{}, len(req.Ids))
+	userIds := make([]models.UserID, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, pkgerrors.Wrap("invalid user id", models.ErrInvalidArgument)
+		}
+
+		userId := models.UserID(parsed)
+		if _, ok := seen[userId]; ok {
+			continue
+		}
+		seen[userId] = struct{}{}
+		userIds = append(userIds, userId)
+	}
+
+	if len(userIds) == 0 {
+		return []*models.User{}, nil
+	}
+
+	users, err := u.UserRepo.GetUsersByIds(ctx, userIds)
+	if err != nil {
+		return nil, pkgerrors.Wrap("get users by ids", err)
+	}
+
+	return users, nil
+}
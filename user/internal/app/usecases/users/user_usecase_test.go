@@ -744,3 +744,175 @@ func Test_usecase_UserUsecase_GetUserForLogin(t *testing.T) {
 		})
 	}
 }
+
+func Test_usecase_UserUsecase_GetUsersByIds(t *testing.T) {
+	// prepare
+	var (
+		ctx = context.Background() // dummy
+	)
+	type fields struct {
+		TransactionManager *mocks.TransactionManager
+		Log                *log.Logger
+		UserRepo           *mocks.UsersStorage
+	}
+
+	type args struct {
+		ctx context.Context
+		req usecases.GetUsersByIdsRequest
+	}
+
+	user := &models.User{
+		Id:             models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+		Login:          "login",
+		Name:           "name",
+		AvatarPhotoUrl: "url",
+	}
+
+	tooManyIds := make([]string, 101)
+	for i := range tooManyIds {
+		tooManyIds[i] = uuid.New().String()
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		want        []*models.User
+		wantErr     bool
+		errorString string
+
+		on     func(*fields)
+		assert func(*testing.T, *fields)
+	}{
+		{
+			name: "Test 1. Positive. Duplicated ids are collapsed",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetUsersByIdsRequest{
+					Ids: []string{
+						"284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						"284fef68-7e3e-4d1d-96a0-8c96f7b3b795",
+						"284fef68-7e3e-4d1d-96a0-8c96f7b3b800",
+					},
+				},
+			},
+			want:    []*models.User{user},
+			wantErr: false,
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUsersByIds",
+					ctx,
+					[]models.UserID{
+						models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b795")),
+						models.UserID(uuid.MustParse("284fef68-7e3e-4d1d-96a0-8c96f7b3b800")),
+					}).
+					Return([]*models.User{user}, nil)
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNumberOfCalls(t, "GetUsersByIds", 1)
+			},
+		},
+		{
+			name: "Test 2. Positive. Empty ids",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetUsersByIdsRequest{},
+			},
+			want:    []*models.User{},
+			wantErr: false,
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNotCalled(t, "GetUsersByIds", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 3. Negative. Invalid id",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetUsersByIdsRequest{
+					Ids: []string{"invalid"},
+				},
+			},
+			wantErr:     true,
+			errorString: "invalid user id: invalid argument",
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNotCalled(t, "GetUsersByIds", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 4. Negative. Too many ids",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetUsersByIdsRequest{
+					Ids: tooManyIds,
+				},
+			},
+			wantErr:     true,
+			errorString: "too many user ids: invalid argument",
+
+			on: nil,
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNotCalled(t, "GetUsersByIds", mock.Anything, mock.Anything)
+			},
+		},
+		{
+			name: "Test 5. Negative. GetUsersByIds returns error",
+			args: args{
+				ctx: ctx, // dumm
+				req: usecases.GetUsersByIdsRequest{
+					Ids: []string{"284fef68-7e3e-4d1d-96a0-8c96f7b3b795"},
+				},
+			},
+			wantErr:     true,
+			errorString: "get users by ids: some error",
+
+			on: func(f *fields) {
+				f.UserRepo.On("GetUsersByIds", ctx, mock.Anything).
+					Return(nil, errors.New("some error"))
+			},
+			assert: func(t *testing.T, f *fields) {
+				f.UserRepo.AssertNumberOfCalls(t, "GetUsersByIds", 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			f := &fields{
+				TransactionManager: mocks.NewTransactionManager(t),
+				Log:                &log.Logger{},
+				UserRepo:           mocks.NewUsersStorage(t),
+			}
+			au := NewUserUsecase(Deps{
+				TransactionManager: f.TransactionManager,
+				Log:                f.Log,
+				UserRepo:           f.UserRepo,
+			})
+			if tt.on != nil {
+				tt.on(f)
+			}
+
+			// act
+			got, err := au.GetUsersByIds(tt.args.ctx, tt.args.req)
+
+			// assert
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usecase.GetUsersByIds() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (err != nil) && tt.wantErr {
+				assert.Equal(t, err.Error(), tt.errorString)
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+
+			if tt.assert != nil {
+				tt.assert(t, f)
+			}
+		})
+	}
+}
@@ -35,6 +35,9 @@ func ErrorsUnaryInterceptor(log *log.Logger) grpc.UnaryServerInterceptor {
 		case errors.Is(err, models.ErrNotFound):
 			log.WithContext(ctx).WithError(err).Warn("not found")
 			err = status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrInvalidArgument):
+			log.WithContext(ctx).WithError(err).Warn("invalid argument")
+			err = status.Error(codes.InvalidArgument, err.Error())
 		case errors.Is(err, models.ErrCredInvalid):
 			log.WithContext(ctx).WithError(err).Warn("error invalid credentials")
 			err = status.Error(codes.Unauthenticated, err.Error())
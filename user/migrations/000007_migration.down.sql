@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS bots;
+DELETE FROM users WHERE is_bot;
+ALTER TABLE users ALTER COLUMN email SET NOT NULL;
+ALTER TABLE users DROP COLUMN IF EXISTS is_bot;
@@ -0,0 +1,11 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_bot BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE users ALTER COLUMN email DROP NOT NULL;
+CREATE TABLE IF NOT EXISTS bots (
+     id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+     owner_id uuid NOT NULL,
+     description varchar(1024) NOT NULL DEFAULT '',
+     public boolean NOT NULL DEFAULT false,
+     token_hash varchar(64) NOT NULL DEFAULT '',
+     created_at timestamp NOT NULL
+);
+CREATE INDEX IF NOT EXISTS bots_owner_id_idx ON bots (owner_id);